@@ -0,0 +1,187 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// NewConditionalCacheHTTPClient wraps httpClient with a transport that does real HTTP
+// conditional revalidation instead of a blind time-based cache: a cacheable response is stored
+// locally together with its ETag/Last-Modified validators, and a later request for the same
+// resource sends If-None-Match/If-Modified-Since so a 304 can be served from the local copy
+// rather than either re-fetching the full response or trusting how much time has passed.
+func NewConditionalCacheHTTPClient(httpClient *http.Client, cacheDir string) *http.Client {
+	newClient := *httpClient
+	newClient.Transport = &conditionalCacheRoundTripper{
+		dir: cacheDir,
+		rt:  httpClient.Transport,
+	}
+	return &newClient
+}
+
+type conditionalCacheRoundTripper struct {
+	dir string
+	rt  http.RoundTripper
+	mu  sync.Mutex
+}
+
+func (c *conditionalCacheRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isConditionallyCacheable(req) {
+		return c.roundTrip(req)
+	}
+
+	key, err := conditionalCacheKey(req)
+	if err != nil {
+		return c.roundTrip(req)
+	}
+
+	entry := c.read(key)
+	if entry != nil {
+		if etag := entry.Header.Get("ETag"); etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		if lastMod := entry.Header.Get("Last-Modified"); lastMod != "" {
+			req.Header.Set("If-Modified-Since", lastMod)
+		}
+	}
+
+	res, err := c.roundTrip(req)
+	if err != nil {
+		return res, err
+	}
+
+	if entry != nil && res.StatusCode == http.StatusNotModified {
+		_, _ = io.Copy(io.Discard, res.Body)
+		res.Body.Close()
+		cached := entry.response(req)
+		return cached, nil
+	}
+
+	if res.StatusCode < 300 && (res.Header.Get("ETag") != "" || res.Header.Get("Last-Modified") != "") {
+		c.store(key, res)
+	}
+
+	return res, nil
+}
+
+func (c *conditionalCacheRoundTripper) roundTrip(req *http.Request) (*http.Response, error) {
+	if c.rt != nil {
+		return c.rt.RoundTrip(req)
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// isConditionallyCacheable mirrors the set of requests the underlying blanket file cache treats
+// as safe to cache: GET/HEAD, plus GraphQL POSTs, whose body is part of the cache key.
+func isConditionallyCacheable(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead:
+		return true
+	case http.MethodPost:
+		return req.URL.Path == "/graphql" || req.URL.Path == "/api/graphql"
+	default:
+		return false
+	}
+}
+
+func conditionalCacheKey(req *http.Request) (string, error) {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s:", req.Method)
+	fmt.Fprintf(h, "%s:", req.URL.String())
+	fmt.Fprintf(h, "%s:", req.Header.Get(authorization))
+
+	if req.Body != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return "", err
+		}
+		defer body.Close()
+		if _, err := io.Copy(h, body); err != nil {
+			return "", err
+		}
+	}
+
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
+type conditionalCacheEntry struct {
+	Header http.Header
+	Body   []byte
+	Status string
+	Code   int
+}
+
+func (e *conditionalCacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        e.Status,
+		StatusCode:    e.Code,
+		Header:        e.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
+
+func (c *conditionalCacheRoundTripper) entryPath(key string) string {
+	if len(key) < 6 {
+		return filepath.Join(c.dir, key)
+	}
+	return filepath.Join(c.dir, key[0:2], key[2:4], key[4:])
+}
+
+func (c *conditionalCacheRoundTripper) read(key string) *conditionalCacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	f, err := os.Open(c.entryPath(key))
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	res, err := http.ReadResponse(bufio.NewReader(f), nil)
+	if err != nil {
+		return nil
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil
+	}
+
+	return &conditionalCacheEntry{Header: res.Header, Body: body, Status: res.Status, Code: res.StatusCode}
+}
+
+func (c *conditionalCacheRoundTripper) store(key string, res *http.Response) {
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	path := c.entryPath(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	_ = res.Write(f)
+
+	res.Body = io.NopCloser(bytes.NewReader(body))
+}
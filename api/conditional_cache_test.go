@@ -0,0 +1,74 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConditionalCacheHTTPClient(t *testing.T) {
+	var requests int
+	var gotIfNoneMatch string
+	body := "hello"
+	etag := `"v1"`
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		gotIfNoneMatch = r.Header.Get("If-None-Match")
+		if gotIfNoneMatch == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(body))
+	}))
+	defer ts.Close()
+
+	client := NewConditionalCacheHTTPClient(&http.Client{}, t.TempDir())
+
+	res, err := client.Get(ts.URL)
+	require.NoError(t, err)
+	got, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	res.Body.Close()
+	assert.Equal(t, body, string(got))
+	assert.Equal(t, 1, requests)
+	assert.Equal(t, "", gotIfNoneMatch, "first request should not send a validator")
+
+	res, err = client.Get(ts.URL)
+	require.NoError(t, err)
+	got, err = io.ReadAll(res.Body)
+	require.NoError(t, err)
+	res.Body.Close()
+	assert.Equal(t, body, string(got), "304 response should be served from the stored body")
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, etag, gotIfNoneMatch, "second request should revalidate with the stored ETag")
+	assert.Equal(t, http.StatusOK, res.StatusCode, "caller should see 200, not the 304 that produced it")
+}
+
+func TestConditionalCacheHTTPClient_uncacheableRequestPassesThrough(t *testing.T) {
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer ts.Close()
+
+	client := NewConditionalCacheHTTPClient(&http.Client{}, t.TempDir())
+
+	res, err := client.Post(ts.URL, "application/json", nil)
+	require.NoError(t, err)
+	res.Body.Close()
+	assert.Equal(t, http.StatusCreated, res.StatusCode)
+
+	res, err = client.Post(ts.URL, "application/json", nil)
+	require.NoError(t, err)
+	res.Body.Close()
+	assert.Equal(t, http.StatusCreated, res.StatusCode)
+	assert.Equal(t, 2, requests, "non-GraphQL POSTs are never cached, so both requests hit the server")
+}
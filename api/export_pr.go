@@ -28,6 +28,25 @@ func (issue *Issue) ExportData(fields []string) map[string]interface{} {
 				})
 			}
 			data[f] = items
+		case "milestoneProgress":
+			if issue.Milestone == nil {
+				data[f] = nil
+				break
+			}
+			closed, total, ok := issue.Milestone.Progress()
+			if !ok {
+				data[f] = nil
+				break
+			}
+			percent := 0
+			if total > 0 {
+				percent = closed * 100 / total
+			}
+			data[f] = map[string]interface{}{
+				"closedIssues":    closed,
+				"totalIssues":     total,
+				"percentComplete": percent,
+			}
 		default:
 			sf := fieldByName(v, f)
 			data[f] = sf.Interface()
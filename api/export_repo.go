@@ -32,6 +32,8 @@ func (repo *Repository) ExportData(fields []string) map[string]interface{} {
 				topics = append(topics, n.Topic)
 			}
 			data[f] = topics
+		case "hasBranchProtectionRule":
+			data[f] = repo.HasBranchProtectionRule != nil && repo.HasBranchProtectionRule.BranchProtectionRule != nil
 		default:
 			sf := fieldByName(v, f)
 			data[f] = sf.Interface()
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/trace"
 	"github.com/cli/cli/v2/utils"
 	ghAPI "github.com/cli/go-gh/v2/pkg/api"
 )
@@ -65,9 +66,32 @@ func NewHTTPClient(opts HTTPClientOptions) (*http.Client, error) {
 		client.Transport = AddAuthTokenHeader(client.Transport, opts.Config)
 	}
 
+	client.Transport = AddTracing(client.Transport)
+
 	return client, nil
 }
 
+// AddTracing records a trace span for every request made through rt,
+// capturing its method, URL, and resulting status code or error. It is a
+// no-op unless GH_DEBUG=trace has configured a trace logger for the process.
+func AddTracing(rt http.RoundTripper) http.RoundTripper {
+	return &funcTripper{roundTrip: func(req *http.Request) (*http.Response, error) {
+		span := trace.Default().StartSpan("http", map[string]any{
+			"method": req.Method,
+			"url":    req.URL.String(),
+		})
+		res, err := rt.RoundTrip(req)
+		fields := map[string]any{}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = res.StatusCode
+		}
+		span.End(fields)
+		return res, err
+	}}
+}
+
 func NewCachedHTTPClient(httpClient *http.Client, ttl time.Duration) *http.Client {
 	newClient := *httpClient
 	newClient.Transport = AddCacheTTLHeader(httpClient.Transport, ttl)
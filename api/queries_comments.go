@@ -99,6 +99,22 @@ func CommentUpdate(client *Client, repoHost string, params CommentUpdateInput) (
 	return mutation.UpdateIssueComment.IssueComment.URL, nil
 }
 
+func CommentDelete(client *Client, repoHost string, commentID string) error {
+	var mutation struct {
+		DeleteIssueComment struct {
+			ClientMutationId string
+		} `graphql:"deleteIssueComment(input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"input": githubv4.DeleteIssueCommentInput{
+			ID: githubv4.ID(commentID),
+		},
+	}
+
+	return client.Mutate(repoHost, "CommentDelete", &mutation, variables)
+}
+
 func (c Comment) Identifier() string {
 	return c.ID
 }
@@ -21,29 +21,30 @@ type IssuesAndTotalCount struct {
 }
 
 type Issue struct {
-	Typename         string `json:"__typename"`
-	ID               string
-	Number           int
-	Title            string
-	URL              string
-	State            string
-	StateReason      string
-	Closed           bool
-	Body             string
-	ActiveLockReason string
-	Locked           bool
-	CreatedAt        time.Time
-	UpdatedAt        time.Time
-	ClosedAt         *time.Time
-	Comments         Comments
-	Author           Author
-	Assignees        Assignees
-	Labels           Labels
-	ProjectCards     ProjectCards
-	ProjectItems     ProjectItems
-	Milestone        *Milestone
-	ReactionGroups   ReactionGroups
-	IsPinned         bool
+	Typename          string `json:"__typename"`
+	ID                string
+	Number            int
+	Title             string
+	URL               string
+	State             string
+	StateReason       string
+	Closed            bool
+	Body              string
+	ActiveLockReason  string
+	Locked            bool
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	ClosedAt          *time.Time
+	Comments          Comments
+	Author            Author
+	AuthorAssociation string
+	Assignees         Assignees
+	Labels            Labels
+	ProjectCards      ProjectCards
+	ProjectItems      ProjectItems
+	Milestone         *Milestone
+	ReactionGroups    ReactionGroups
+	IsPinned          bool
 }
 
 // return values for Issue.Typename
@@ -133,10 +134,25 @@ func (p ProjectItems) ProjectTitles() []string {
 }
 
 type Milestone struct {
-	Number      int        `json:"number"`
-	Title       string     `json:"title"`
-	Description string     `json:"description"`
-	DueOn       *time.Time `json:"dueOn"`
+	Number       int                  `json:"number"`
+	Title        string               `json:"title"`
+	Description  string               `json:"description"`
+	DueOn        *time.Time           `json:"dueOn"`
+	ClosedIssues *MilestoneIssueCount `json:"closedIssues,omitempty"`
+	AllIssues    *MilestoneIssueCount `json:"allIssues,omitempty"`
+}
+
+type MilestoneIssueCount struct {
+	TotalCount int `json:"totalCount"`
+}
+
+// Progress reports how many of the milestone's issues are closed and how many it has in
+// total. It returns false if that data wasn't requested as part of the issue query.
+func (m Milestone) Progress() (closed, total int, ok bool) {
+	if m.ClosedIssues == nil || m.AllIssues == nil {
+		return 0, 0, false
+	}
+	return m.ClosedIssues.TotalCount, m.AllIssues.TotalCount, true
 }
 
 type IssuesDisabledError struct {
@@ -73,6 +73,51 @@ func OrganizationTeam(client *Client, hostname string, org string, teamSlug stri
 	return &query.Organization.Team, nil
 }
 
+// OrganizationTeamMembers fetches the login names of the members of the team
+// in an organization with the given slug.
+func OrganizationTeamMembers(client *Client, hostname string, org string, teamSlug string) ([]string, error) {
+	type responseData struct {
+		Organization struct {
+			Team struct {
+				Members struct {
+					Nodes []struct {
+						Login string
+					}
+					PageInfo struct {
+						HasNextPage bool
+						EndCursor   string
+					}
+				} `graphql:"members(first: 100, after: $endCursor)"`
+			} `graphql:"team(slug: $teamSlug)"`
+		} `graphql:"organization(login: $owner)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner":     githubv4.String(org),
+		"teamSlug":  githubv4.String(teamSlug),
+		"endCursor": (*githubv4.String)(nil),
+	}
+
+	var logins []string
+	for {
+		var query responseData
+		err := client.Query(hostname, "OrganizationTeamMembers", &query, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, node := range query.Organization.Team.Members.Nodes {
+			logins = append(logins, node.Login)
+		}
+		if !query.Organization.Team.Members.PageInfo.HasNextPage {
+			break
+		}
+		variables["endCursor"] = githubv4.String(query.Organization.Team.Members.PageInfo.EndCursor)
+	}
+
+	return logins, nil
+}
+
 // OrganizationTeams fetches all the teams in an organization
 func OrganizationTeams(client *Client, repo ghrepo.Interface) ([]OrgTeam, error) {
 	type responseData struct {
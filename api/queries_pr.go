@@ -43,10 +43,18 @@ type PullRequest struct {
 	MergeStateStatus    string
 	IsInMergeQueue      bool
 	IsMergeQueueEnabled bool // Indicates whether the pull request's base ref has a merge queue enabled.
-	CreatedAt           time.Time
-	UpdatedAt           time.Time
-	ClosedAt            *time.Time
-	MergedAt            *time.Time
+
+	// MergeQueuePosition and PendingDeploymentEnvironments have no equivalent
+	// in the GraphQL schema, so they're never populated by a GraphQL fetch.
+	// `gh pr status` fills them in with additional requests after the
+	// initial fetch, when asked to via --conflict-status.
+	MergeQueuePosition            int
+	PendingDeploymentEnvironments []string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	ClosedAt  *time.Time
+	MergedAt  *time.Time
 
 	AutoMergeRequest *AutoMergeRequest
 
@@ -58,6 +66,7 @@ type PullRequest struct {
 	}
 
 	Author              Author
+	AuthorAssociation   string
 	MergedBy            *Author
 	HeadRepositoryOwner Owner
 	HeadRepository      *PRRepository
@@ -270,6 +279,9 @@ type PullRequestFile struct {
 	Path      string `json:"path"`
 	Additions int    `json:"additions"`
 	Deletions int    `json:"deletions"`
+	// Status is one of ADDED, CHANGED, COPIED, DELETED, MODIFIED, or RENAMED. Note that the
+	// GraphQL API does not expose the previous path of a renamed file.
+	Status string `json:"status"`
 }
 
 type ReviewRequests struct {
@@ -505,6 +517,7 @@ func CreatePullRequest(client *Client, repo *Repository, params map[string]inter
 			createPullRequest(input: $input) {
 				pullRequest {
 					id
+					number
 					url
 				}
 			}
@@ -713,6 +726,42 @@ func ConvertPullRequestToDraft(client *Client, repo ghrepo.Interface, pr *PullRe
 	return client.Mutate(repo.RepoHost(), "ConvertPullRequestToDraft", &mutation, variables)
 }
 
+func EnqueuePullRequest(client *Client, repo ghrepo.Interface, pr *PullRequest) error {
+	var mutation struct {
+		EnqueuePullRequest struct {
+			MergeQueueEntry struct {
+				ID githubv4.ID
+			}
+		} `graphql:"enqueuePullRequest(input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"input": githubv4.EnqueuePullRequestInput{
+			PullRequestID: pr.ID,
+		},
+	}
+
+	return client.Mutate(repo.RepoHost(), "EnqueuePullRequest", &mutation, variables)
+}
+
+func DequeuePullRequest(client *Client, repo ghrepo.Interface, entryID string) error {
+	var mutation struct {
+		DequeuePullRequest struct {
+			MergeQueueEntry struct {
+				ID githubv4.ID
+			}
+		} `graphql:"dequeuePullRequest(input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"input": githubv4.DequeuePullRequestInput{
+			ID: entryID,
+		},
+	}
+
+	return client.Mutate(repo.RepoHost(), "DequeuePullRequest", &mutation, variables)
+}
+
 func BranchDeleteRemote(client *Client, repo ghrepo.Interface, branch string) error {
 	path := fmt.Sprintf("repos/%s/%s/git/refs/heads/%s", repo.RepoOwner(), repo.RepoName(), url.PathEscape(branch))
 	return client.REST(repo.RepoHost(), "DELETE", path, nil, nil)
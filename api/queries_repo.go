@@ -67,10 +67,24 @@ type Repository struct {
 	PullRequests struct {
 		TotalCount int `json:"totalCount"`
 	}
+	// OpenAlertCount is only populated when the viewer has permission to see the
+	// repository's Dependabot alerts; GitHub returns null rather than an error
+	// when that permission is missing, so this stays nil in that case.
+	OpenAlertCount *struct {
+		TotalCount int `json:"totalCount"`
+	}
 
-	CodeOfConduct                 *CodeOfConduct
-	ContactLinks                  []ContactLink
-	DefaultBranchRef              BranchRef
+	CodeOfConduct    *CodeOfConduct
+	ContactLinks     []ContactLink
+	DefaultBranchRef BranchRef
+	// HasBranchProtectionRule reports only whether the default branch has a
+	// protection rule configured, not the rule's contents; the full
+	// branchProtectionRules connection is left unexposed (see RepositoryFields).
+	HasBranchProtectionRule *struct {
+		BranchProtectionRule *struct {
+			ID string
+		}
+	}
 	DeleteBranchOnMerge           bool
 	DiskUsage                     int
 	FundingLinks                  []FundingLink
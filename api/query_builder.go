@@ -106,7 +106,8 @@ var prFiles = shortenQuery(`
 		nodes {
 			additions,
 			deletions,
-			path
+			path,
+			status: changeType
 		}
 	}
 `)
@@ -252,6 +253,7 @@ func RequiredStatusCheckRollupGraphQL(prID, after string, includeEvent bool) str
 var sharedIssuePRFields = []string{
 	"assignees",
 	"author",
+	"authorAssociation",
 	"body",
 	"closed",
 	"comments",
@@ -276,6 +278,7 @@ var sharedIssuePRFields = []string{
 // to PR queries, e.g. the PullRequestGraphql function.
 var issueOnlyFields = []string{
 	"isPinned",
+	"milestoneProgress",
 	"stateReason",
 }
 
@@ -296,6 +299,7 @@ var PullRequestFields = append(sharedIssuePRFields,
 	"headRepositoryOwner",
 	"isCrossRepository",
 	"isDraft",
+	"isInMergeQueue",
 	"latestReviews",
 	"maintainerCanModify",
 	"mergeable",
@@ -333,6 +337,8 @@ func IssueGraphQL(fields []string) string {
 			q = append(q, `projectItems(first:100){nodes{id, project{id,title}, status:fieldValueByName(name: "Status") { ... on ProjectV2ItemFieldSingleSelectValue{optionId,name}}},totalCount}`)
 		case "milestone":
 			q = append(q, `milestone{number,title,description,dueOn}`)
+		case "milestoneProgress":
+			q = append(q, `milestone{number,title,description,dueOn,closedIssues:issues(states:CLOSED){totalCount},allIssues:issues{totalCount}}`)
 		case "reactionGroups":
 			q = append(q, `reactionGroups{content,users{totalCount}}`)
 		case "mergeCommit":
@@ -416,10 +422,12 @@ var RepositoryFields = []string{
 	"watchers",
 	"issues",
 	"pullRequests",
+	"openAlertCount",
 
 	"codeOfConduct",
 	"contactLinks",
 	"defaultBranchRef",
+	"hasBranchProtectionRule",
 	"deleteBranchOnMerge",
 	"diskUsage",
 	"fundingLinks",
@@ -455,7 +463,7 @@ var RepositoryFields = []string{
 	"projects",
 	"projectsV2",
 
-	// "branchProtectionRules", // too complex to expose
+	// "branchProtectionRules", // too complex to expose; see hasBranchProtectionRule for a presence-only check
 	// "collaborators", // does it make sense to expose without affiliation filter?
 }
 
@@ -509,6 +517,10 @@ func RepositoryGraphQL(fields []string) string {
 			q = append(q, "pullRequests(states:OPEN){totalCount}")
 		case "defaultBranchRef":
 			q = append(q, "defaultBranchRef{name}")
+		case "hasBranchProtectionRule":
+			q = append(q, "hasBranchProtectionRule:defaultBranchRef{branchProtectionRule{id}}")
+		case "openAlertCount":
+			q = append(q, "openAlertCount:vulnerabilityAlerts(states:OPEN){totalCount}")
 		default:
 			q = append(q, field)
 		}
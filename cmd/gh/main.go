@@ -18,6 +18,8 @@ import (
 	"github.com/cli/cli/v2/internal/build"
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/config/migration"
+	"github.com/cli/cli/v2/internal/telemetry"
+	"github.com/cli/cli/v2/internal/trace"
 	"github.com/cli/cli/v2/internal/update"
 	"github.com/cli/cli/v2/pkg/cmd/factory"
 	"github.com/cli/cli/v2/pkg/cmd/root"
@@ -35,11 +37,14 @@ var updaterEnabled = ""
 type exitCode int
 
 const (
-	exitOK      exitCode = 0
-	exitError   exitCode = 1
-	exitCancel  exitCode = 2
-	exitAuth    exitCode = 4
-	exitPending exitCode = 8
+	exitOK        exitCode = 0
+	exitError     exitCode = 1
+	exitCancel    exitCode = 2
+	exitAuth      exitCode = 4
+	exitPending   exitCode = 8
+	exitNoResults exitCode = 16
+	exitRateLimit exitCode = 32
+	exitNetwork   exitCode = 64
 )
 
 func main() {
@@ -47,14 +52,27 @@ func main() {
 	os.Exit(int(code))
 }
 
-func mainRun() exitCode {
+func mainRun() (ec exitCode) {
 	buildDate := build.Date
 	buildVersion := build.Version
-	hasDebug, _ := utils.IsDebugEnabled()
+	hasDebug, debugValue := utils.IsDebugEnabled()
 
 	cmdFactory := factory.New(buildVersion)
 	stderr := cmdFactory.IOStreams.ErrOut
 
+	var rootCmd *cobra.Command
+	var err error
+	startedAt := time.Now()
+	defer func() { recordTelemetry(cmdFactory, rootCmd, startedAt, os.Args[1:], ec) }()
+
+	if strings.Contains(debugValue, "trace") {
+		closeTrace := setupTrace(stderr)
+		defer closeTrace()
+
+		span := trace.Default().StartSpan("command", map[string]any{"args": os.Args[1:]})
+		defer func() { span.End(map[string]any{"exit_code": int(ec)}) }()
+	}
+
 	ctx := context.Background()
 
 	if cfg, err := cmdFactory.Config(); err == nil {
@@ -97,7 +115,7 @@ func mainRun() exitCode {
 		cobra.MousetrapHelpText = ""
 	}
 
-	rootCmd, err := root.NewCmdRoot(cmdFactory, buildVersion, buildDate)
+	rootCmd, err = root.NewCmdRoot(cmdFactory, buildVersion, buildDate)
 	if err != nil {
 		fmt.Fprintf(stderr, "failed to create root command: %s\n", err)
 		return exitError
@@ -118,6 +136,7 @@ func mainRun() exitCode {
 
 	if cmd, err := rootCmd.ExecuteContextC(ctx); err != nil {
 		var pagerPipeError *iostreams.ErrClosedPagerPipe
+		var failFastError cmdutil.FailFastError
 		var noResultsError cmdutil.NoResultsError
 		var extError *root.ExternalCommandExitError
 		var authError *root.AuthError
@@ -136,12 +155,21 @@ func mainRun() exitCode {
 		} else if errors.As(err, &pagerPipeError) {
 			// ignore the error raised when piping to a closed pager
 			return exitOK
+		} else if errors.As(err, &failFastError) {
+			fmt.Fprintln(stderr, failFastError.Error())
+			return exitNoResults
 		} else if errors.As(err, &noResultsError) {
 			if cmdFactory.IOStreams.IsStdoutTTY() {
 				fmt.Fprintln(stderr, noResultsError.Error())
 			}
 			// no results is not a command failure
 			return exitOK
+		} else if cmdutil.IsRateLimitError(err) {
+			printError(stderr, err, cmd, hasDebug)
+			return exitRateLimit
+		} else if cmdutil.IsNetworkError(err) {
+			printError(stderr, err, cmd, hasDebug)
+			return exitNetwork
 		} else if errors.As(err, &extError) {
 			// pass on exit codes from extensions and shell aliases
 			return exitCode(extError.ExitCode())
@@ -161,6 +189,14 @@ func mainRun() exitCode {
 		} else if u := factory.SSOURL(); u != "" {
 			// handles organization SAML enforcement error
 			fmt.Fprintf(stderr, "Authorize in your web browser:  %s\n", u)
+			if cmdFactory.IOStreams.CanPrompt() {
+				if open, _ := cmdFactory.Prompter.Confirm("Open this URL in your browser now?", true); open {
+					if err := cmdFactory.Browser.Browse(u); err != nil {
+						fmt.Fprintf(stderr, "Failed to open browser:  %s\n", err)
+					}
+				}
+			}
+			fmt.Fprintln(stderr, "Once authorized, run the same command again.")
 		} else if msg := httpErr.ScopesSuggestion(); msg != "" {
 			fmt.Fprintln(stderr, msg)
 		}
@@ -193,6 +229,60 @@ func mainRun() exitCode {
 	return exitOK
 }
 
+// setupTrace configures the process-wide trace logger to write JSON events
+// to the file named by GH_DEBUG_FILE, falling back to stderr if unset, and
+// returns a function that releases any file it opened.
+func setupTrace(stderr io.Writer) func() {
+	path := os.Getenv("GH_DEBUG_FILE")
+	if path == "" {
+		trace.Configure(stderr)
+		return func() {}
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		fmt.Fprintf(stderr, "warning: could not open GH_DEBUG_FILE %q: %v\n", path, err)
+		trace.Configure(stderr)
+		return func() {}
+	}
+
+	trace.Configure(f)
+	return func() { _ = f.Close() }
+}
+
+// recordTelemetry appends a local timing/exit-code record for this
+// invocation, if the user has opted in via `gh config set telemetry
+// enabled`. It is best-effort: any failure to read the config or write the
+// record is silently ignored, since telemetry must never be the reason a
+// command fails.
+func recordTelemetry(f *cmdutil.Factory, rootCmd *cobra.Command, startedAt time.Time, args []string, ec exitCode) {
+	cfg, err := f.Config()
+	if err != nil {
+		return
+	}
+	// Telemetry, like prompt settings, is a global preference that isn't
+	// scoped by host.
+	if cfg.Telemetry("").Value != "enabled" {
+		return
+	}
+	if rootCmd == nil {
+		return
+	}
+	_ = telemetry.Append(telemetryCommandName(rootCmd, args), startedAt, time.Since(startedAt), int(ec))
+}
+
+// telemetryCommandName returns the resolved subcommand path, e.g. "pr view"
+// for `gh pr view 123 --web`. It walks the cobra command tree rather than
+// taking every leading non-flag token, so positional arguments (issue
+// numbers, repo names, URLs, etc.) are never recorded.
+func telemetryCommandName(rootCmd *cobra.Command, args []string) string {
+	cmd, _, err := rootCmd.Find(args)
+	if err != nil || cmd == nil || cmd == rootCmd {
+		return "(root)"
+	}
+	return strings.TrimPrefix(cmd.CommandPath(), rootCmd.Name()+" ")
+}
+
 // isExtensionCommand returns true if args resolve to an extension command.
 func isExtensionCommand(rootCmd *cobra.Command, args []string) bool {
 	c, _, err := rootCmd.Find(args)
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/url"
+	"os"
 	"os/exec"
 	"path"
 	"regexp"
@@ -15,10 +16,19 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/cli/safeexec"
 )
 
+// gitTimeoutEnvVar lets users cap how long any single git invocation is allowed to run, which is
+// useful when a remote is unresponsive and would otherwise hang a command forever.
+const gitTimeoutEnvVar = "GH_GIT_TIMEOUT"
+
+// gitProcessKillDelay is how long a git subprocess is given to exit after being interrupted
+// before it is forcibly killed.
+const gitProcessKillDelay = 7 * time.Second
+
 var remoteRE = regexp.MustCompile(`(.+)\s+(.+)\s+\((push|fetch)\)`)
 
 // This regexp exists to match lines of the following form:
@@ -87,11 +97,39 @@ func (c *Client) Command(ctx context.Context, args ...string) (*Command, error)
 	if err != nil {
 		return nil, err
 	}
+	ctx, cancel := contextWithGitTimeout(ctx)
 	cmd := commandContext(ctx, c.GitPath, args...)
 	cmd.Stderr = c.Stderr
 	cmd.Stdin = c.Stdin
 	cmd.Stdout = c.Stdout
-	return &Command{cmd}, nil
+	configureCancellation(cmd)
+	return &Command{Cmd: cmd, cancel: cancel}, nil
+}
+
+// contextWithGitTimeout derives a context that is cancelled once the duration configured via the
+// GH_GIT_TIMEOUT environment variable has elapsed. When the variable is unset or invalid, the
+// returned context only ever gets cancelled by the caller.
+func contextWithGitTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if v := os.Getenv(gitTimeoutEnvVar); v != "" {
+		if timeout, err := time.ParseDuration(v); err == nil && timeout > 0 {
+			return context.WithTimeout(ctx, timeout)
+		}
+	}
+	return context.WithCancel(ctx)
+}
+
+// configureCancellation arranges for cmd to be interrupted rather than killed outright when its
+// context is cancelled, giving git a chance to clean up (e.g. release its index lock) before
+// gitProcessKillDelay forces it to exit.
+func configureCancellation(cmd *exec.Cmd) {
+	cmd.WaitDelay = gitProcessKillDelay
+	if runtime.GOOS == "windows" {
+		// os.Interrupt is not implemented on Windows; fall back to the default Kill behavior.
+		return
+	}
+	cmd.Cancel = func() error {
+		return cmd.Process.Signal(os.Interrupt)
+	}
 }
 
 // AuthenticatedCommand is a wrapper around Command that included configuration to use gh
@@ -293,6 +331,54 @@ func (c *Client) Commits(ctx context.Context, baseRef, headRef string) ([]*Commi
 	return commits, nil
 }
 
+// MergeBase returns the SHA of the best common ancestor of ref1 and ref2.
+func (c *Client) MergeBase(ctx context.Context, ref1, ref2 string) (string, error) {
+	args := []string{"merge-base", ref1, ref2}
+	cmd, err := c.Command(ctx, args...)
+	if err != nil {
+		return "", err
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// IsAncestor reports whether ancestor is an ancestor of (or the same commit as) descendant.
+func (c *Client) IsAncestor(ctx context.Context, ancestor, descendant string) (bool, error) {
+	args := []string{"merge-base", "--is-ancestor", ancestor, descendant}
+	cmd, err := c.Command(ctx, args...)
+	if err != nil {
+		return false, err
+	}
+	if _, err := cmd.Output(); err != nil {
+		var gitErr *GitError
+		if errors.As(err, &gitErr) && gitErr.ExitCode == 1 {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// HasMergeConflicts reports whether merging head into base would produce conflicts.
+func (c *Client) HasMergeConflicts(ctx context.Context, base, head string) (bool, error) {
+	args := []string{"merge-tree", "--write-tree", base, head}
+	cmd, err := c.Command(ctx, args...)
+	if err != nil {
+		return false, err
+	}
+	if _, err := cmd.Output(); err != nil {
+		var gitErr *GitError
+		if errors.As(err, &gitErr) && gitErr.ExitCode == 1 {
+			return true, nil
+		}
+		return false, err
+	}
+	return false, nil
+}
+
 func (c *Client) LastCommit(ctx context.Context) (*Commit, error) {
 	output, err := c.lookupCommit(ctx, "HEAD", "%H,%s")
 	if err != nil {
@@ -359,6 +445,68 @@ func (c *Client) ReadBranchConfig(ctx context.Context, branch string) (cfg Branc
 	return
 }
 
+// Submodules parses .gitmodules at the repository root and returns the submodules it declares.
+// It returns a nil slice if the repository has no .gitmodules file.
+func (c *Client) Submodules(ctx context.Context) ([]*Submodule, error) {
+	args := []string{"config", "--file", ".gitmodules", "--get-regexp", `^submodule\..*\.(path|url)$`}
+	cmd, err := c.Command(ctx, args...)
+	if err != nil {
+		return nil, err
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		var gitErr *GitError
+		if ok := errors.As(err, &gitErr); ok && gitErr.ExitCode == 1 {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var order []string
+	byName := map[string]*Submodule{}
+	for _, line := range outputLines(out) {
+		parts := strings.SplitN(line, " ", 2)
+		if len(parts) < 2 {
+			continue
+		}
+		keys := strings.Split(parts[0], ".")
+		if len(keys) < 3 {
+			continue
+		}
+		name := keys[1]
+		sub, ok := byName[name]
+		if !ok {
+			sub = &Submodule{Name: name}
+			byName[name] = sub
+			order = append(order, name)
+		}
+		switch keys[len(keys)-1] {
+		case "path":
+			sub.Path = parts[1]
+		case "url":
+			sub.URL = parts[1]
+		}
+	}
+
+	submodules := make([]*Submodule, len(order))
+	for i, name := range order {
+		submodules[i] = byName[name]
+	}
+	return submodules, nil
+}
+
+// UpdateSubmoduleURL overrides the URL used to fetch the named submodule. The override is stored
+// in local git config rather than .gitmodules, so it only affects this clone.
+func (c *Client) UpdateSubmoduleURL(ctx context.Context, name, url string) error {
+	args := []string{"config", fmt.Sprintf("submodule.%s.url", name), url}
+	cmd, err := c.Command(ctx, args...)
+	if err != nil {
+		return err
+	}
+	_, err = cmd.Output()
+	return err
+}
+
 func (c *Client) DeleteLocalTag(ctx context.Context, tag string) error {
 	args := []string{"tag", "-d", tag}
 	cmd, err := c.Command(ctx, args...)
@@ -615,6 +763,19 @@ func (c *Client) Clone(ctx context.Context, cloneURL string, args []string, mods
 	return target, nil
 }
 
+// SubmoduleUpdate initializes and updates all submodules recursively.
+func (c *Client) SubmoduleUpdate(ctx context.Context, mods ...CommandModifier) error {
+	args := []string{"submodule", "update", "--init", "--recursive"}
+	cmd, err := c.AuthenticatedCommand(ctx, args...)
+	if err != nil {
+		return err
+	}
+	for _, mod := range mods {
+		mod(cmd)
+	}
+	return cmd.Run()
+}
+
 func resolveGitPath() (string, error) {
 	path, err := safeexec.LookPath("git")
 	if err != nil {
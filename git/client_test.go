@@ -12,6 +12,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -60,6 +61,41 @@ func TestClientCommand(t *testing.T) {
 	}
 }
 
+func TestContextWithGitTimeout(t *testing.T) {
+	t.Run("no timeout configured", func(t *testing.T) {
+		t.Setenv(gitTimeoutEnvVar, "")
+		ctx, cancel := contextWithGitTimeout(context.Background())
+		defer cancel()
+		_, hasDeadline := ctx.Deadline()
+		assert.False(t, hasDeadline)
+	})
+
+	t.Run("valid timeout configured", func(t *testing.T) {
+		t.Setenv(gitTimeoutEnvVar, "5m")
+		ctx, cancel := contextWithGitTimeout(context.Background())
+		defer cancel()
+		deadline, hasDeadline := ctx.Deadline()
+		assert.True(t, hasDeadline)
+		assert.WithinDuration(t, time.Now().Add(5*time.Minute), deadline, time.Minute)
+	})
+
+	t.Run("invalid timeout configured", func(t *testing.T) {
+		t.Setenv(gitTimeoutEnvVar, "not-a-duration")
+		ctx, cancel := contextWithGitTimeout(context.Background())
+		defer cancel()
+		_, hasDeadline := ctx.Deadline()
+		assert.False(t, hasDeadline)
+	})
+
+	t.Run("non-positive timeout configured", func(t *testing.T) {
+		t.Setenv(gitTimeoutEnvVar, "0s")
+		ctx, cancel := contextWithGitTimeout(context.Background())
+		defer cancel()
+		_, hasDeadline := ctx.Deadline()
+		assert.False(t, hasDeadline)
+	})
+}
+
 func TestClientAuthenticatedCommand(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -687,6 +723,141 @@ func createCommitsCommandContext(t *testing.T, testData stubbedCommitsCommandDat
 	}
 }
 
+func TestClientMergeBase(t *testing.T) {
+	tests := []struct {
+		name          string
+		cmdExitStatus int
+		cmdStdout     string
+		cmdStderr     string
+		wantCmdArgs   string
+		wantSha       string
+		wantErrorMsg  string
+	}{
+		{
+			name:        "returns merge base",
+			cmdStdout:   "abc123\n",
+			wantCmdArgs: `path/to/git merge-base master feature`,
+			wantSha:     "abc123",
+		},
+		{
+			name:          "git error",
+			cmdExitStatus: 1,
+			cmdStderr:     "git error message",
+			wantCmdArgs:   `path/to/git merge-base master feature`,
+			wantErrorMsg:  "failed to run git: git error message",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, cmdCtx := createCommandContext(t, tt.cmdExitStatus, tt.cmdStdout, tt.cmdStderr)
+			client := Client{
+				GitPath:        "path/to/git",
+				commandContext: cmdCtx,
+			}
+			sha, err := client.MergeBase(context.Background(), "master", "feature")
+			assert.Equal(t, tt.wantCmdArgs, strings.Join(cmd.Args[3:], " "))
+			if tt.wantErrorMsg == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantSha, sha)
+			} else {
+				assert.EqualError(t, err, tt.wantErrorMsg)
+			}
+		})
+	}
+}
+
+func TestClientIsAncestor(t *testing.T) {
+	tests := []struct {
+		name           string
+		cmdExitStatus  int
+		cmdStderr      string
+		wantCmdArgs    string
+		wantIsAncestor bool
+		wantErrorMsg   string
+	}{
+		{
+			name:           "is an ancestor",
+			wantCmdArgs:    `path/to/git merge-base --is-ancestor abc123 def456`,
+			wantIsAncestor: true,
+		},
+		{
+			name:          "is not an ancestor",
+			cmdExitStatus: 1,
+			wantCmdArgs:   `path/to/git merge-base --is-ancestor abc123 def456`,
+		},
+		{
+			name:          "git error",
+			cmdExitStatus: 128,
+			cmdStderr:     "git error message",
+			wantCmdArgs:   `path/to/git merge-base --is-ancestor abc123 def456`,
+			wantErrorMsg:  "failed to run git: git error message",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, cmdCtx := createCommandContext(t, tt.cmdExitStatus, "", tt.cmdStderr)
+			client := Client{
+				GitPath:        "path/to/git",
+				commandContext: cmdCtx,
+			}
+			isAncestor, err := client.IsAncestor(context.Background(), "abc123", "def456")
+			assert.Equal(t, tt.wantCmdArgs, strings.Join(cmd.Args[3:], " "))
+			if tt.wantErrorMsg == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantIsAncestor, isAncestor)
+			} else {
+				assert.EqualError(t, err, tt.wantErrorMsg)
+			}
+		})
+	}
+}
+
+func TestClientHasMergeConflicts(t *testing.T) {
+	tests := []struct {
+		name             string
+		cmdExitStatus    int
+		cmdStderr        string
+		wantCmdArgs      string
+		wantHasConflicts bool
+		wantErrorMsg     string
+	}{
+		{
+			name:        "no conflicts",
+			wantCmdArgs: `path/to/git merge-tree --write-tree master feature`,
+		},
+		{
+			name:             "has conflicts",
+			cmdExitStatus:    1,
+			wantCmdArgs:      `path/to/git merge-tree --write-tree master feature`,
+			wantHasConflicts: true,
+		},
+		{
+			name:          "git error",
+			cmdExitStatus: 128,
+			cmdStderr:     "git error message",
+			wantCmdArgs:   `path/to/git merge-tree --write-tree master feature`,
+			wantErrorMsg:  "failed to run git: git error message",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, cmdCtx := createCommandContext(t, tt.cmdExitStatus, "", tt.cmdStderr)
+			client := Client{
+				GitPath:        "path/to/git",
+				commandContext: cmdCtx,
+			}
+			hasConflicts, err := client.HasMergeConflicts(context.Background(), "master", "feature")
+			assert.Equal(t, tt.wantCmdArgs, strings.Join(cmd.Args[3:], " "))
+			if tt.wantErrorMsg == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantHasConflicts, hasConflicts)
+			} else {
+				assert.EqualError(t, err, tt.wantErrorMsg)
+			}
+		})
+	}
+}
+
 func TestClientLastCommit(t *testing.T) {
 	client := Client{
 		RepoDir: "./fixtures/simple.git",
@@ -736,6 +907,96 @@ func TestClientReadBranchConfig(t *testing.T) {
 	}
 }
 
+func TestClientSubmodules(t *testing.T) {
+	tests := []struct {
+		name           string
+		cmdExitStatus  int
+		cmdStdout      string
+		cmdStderr      string
+		wantCmdArgs    string
+		wantSubmodules []*Submodule
+		wantErrorMsg   string
+	}{
+		{
+			name:          "no .gitmodules file",
+			cmdExitStatus: 1,
+			wantCmdArgs:   `path/to/git config --file .gitmodules --get-regexp ^submodule\..*\.(path|url)$`,
+		},
+		{
+			name:        "parses submodules",
+			cmdStdout:   "submodule.lib.path vendor/lib\nsubmodule.lib.url https://github.com/o/lib\nsubmodule.other.url git@github.com:o/other.git\nsubmodule.other.path vendor/other",
+			wantCmdArgs: `path/to/git config --file .gitmodules --get-regexp ^submodule\..*\.(path|url)$`,
+			wantSubmodules: []*Submodule{
+				{Name: "lib", Path: "vendor/lib", URL: "https://github.com/o/lib"},
+				{Name: "other", Path: "vendor/other", URL: "git@github.com:o/other.git"},
+			},
+		},
+		{
+			name:          "git error",
+			cmdExitStatus: 2,
+			cmdStderr:     "git error message",
+			wantCmdArgs:   `path/to/git config --file .gitmodules --get-regexp ^submodule\..*\.(path|url)$`,
+			wantErrorMsg:  "failed to run git: git error message",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, cmdCtx := createCommandContext(t, tt.cmdExitStatus, tt.cmdStdout, tt.cmdStderr)
+			client := Client{
+				GitPath:        "path/to/git",
+				commandContext: cmdCtx,
+			}
+			submodules, err := client.Submodules(context.Background())
+			assert.Equal(t, tt.wantCmdArgs, strings.Join(cmd.Args[3:], " "))
+			if tt.wantErrorMsg == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantSubmodules, submodules)
+			} else {
+				assert.EqualError(t, err, tt.wantErrorMsg)
+			}
+		})
+	}
+}
+
+func TestClientUpdateSubmoduleURL(t *testing.T) {
+	tests := []struct {
+		name          string
+		cmdExitStatus int
+		cmdStdout     string
+		cmdStderr     string
+		wantCmdArgs   string
+		wantErrorMsg  string
+	}{
+		{
+			name:        "update submodule url",
+			wantCmdArgs: `path/to/git config submodule.lib.url https://github.com/o/lib.git`,
+		},
+		{
+			name:          "git error",
+			cmdExitStatus: 1,
+			cmdStderr:     "git error message",
+			wantCmdArgs:   `path/to/git config submodule.lib.url https://github.com/o/lib.git`,
+			wantErrorMsg:  "failed to run git: git error message",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, cmdCtx := createCommandContext(t, tt.cmdExitStatus, tt.cmdStdout, tt.cmdStderr)
+			client := Client{
+				GitPath:        "path/to/git",
+				commandContext: cmdCtx,
+			}
+			err := client.UpdateSubmoduleURL(context.Background(), "lib", "https://github.com/o/lib.git")
+			assert.Equal(t, tt.wantCmdArgs, strings.Join(cmd.Args[3:], " "))
+			if tt.wantErrorMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErrorMsg)
+			}
+		})
+	}
+}
+
 func TestClientDeleteLocalTag(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -1329,6 +1590,51 @@ func TestClientClone(t *testing.T) {
 	}
 }
 
+func TestClientSubmoduleUpdate(t *testing.T) {
+	tests := []struct {
+		name          string
+		mods          []CommandModifier
+		cmdExitStatus int
+		cmdStdout     string
+		cmdStderr     string
+		wantCmdArgs   string
+		wantErrorMsg  string
+	}{
+		{
+			name:        "submodule update",
+			wantCmdArgs: `path/to/git -c credential.helper= -c credential.helper=!"gh" auth git-credential submodule update --init --recursive`,
+		},
+		{
+			name:        "accepts command modifiers",
+			mods:        []CommandModifier{WithRepoDir("/path/to/repo")},
+			wantCmdArgs: `path/to/git -C /path/to/repo -c credential.helper= -c credential.helper=!"gh" auth git-credential submodule update --init --recursive`,
+		},
+		{
+			name:          "git error",
+			cmdExitStatus: 1,
+			cmdStderr:     "git error message",
+			wantCmdArgs:   `path/to/git -c credential.helper= -c credential.helper=!"gh" auth git-credential submodule update --init --recursive`,
+			wantErrorMsg:  "failed to run git: git error message",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, cmdCtx := createCommandContext(t, tt.cmdExitStatus, tt.cmdStdout, tt.cmdStderr)
+			client := Client{
+				GitPath:        "path/to/git",
+				commandContext: cmdCtx,
+			}
+			err := client.SubmoduleUpdate(context.Background(), tt.mods...)
+			assert.Equal(t, tt.wantCmdArgs, strings.Join(cmd.Args[3:], " "))
+			if tt.wantErrorMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErrorMsg)
+			}
+		})
+	}
+}
+
 func TestParseCloneArgs(t *testing.T) {
 	type wanted struct {
 		args []string
@@ -1426,6 +1732,21 @@ func TestClientAddRemote(t *testing.T) {
 	}
 }
 
+func TestCommandOutputCapturesPartialStdoutOnError(t *testing.T) {
+	_, cmdCtx := createCommandContext(t, 1, "partial output before failure", "something went wrong")
+	client := Client{
+		GitPath:        "path/to/git",
+		commandContext: cmdCtx,
+	}
+	cmd, err := client.Command(context.Background(), "status")
+	require.NoError(t, err)
+	_, err = cmd.Output()
+	var gitErr *GitError
+	require.True(t, errors.As(err, &gitErr))
+	assert.Equal(t, "partial output before failure", gitErr.Stdout)
+	assert.Equal(t, "something went wrong", gitErr.Stderr)
+}
+
 func initRepo(t *testing.T, dir string) {
 	errBuf := &bytes.Buffer{}
 	inBuf := &bytes.Buffer{}
@@ -14,9 +14,13 @@ type commandCtx = func(ctx context.Context, name string, args ...string) *exec.C
 
 type Command struct {
 	*exec.Cmd
+	cancel context.CancelFunc
 }
 
 func (gc *Command) Run() error {
+	if gc.cancel != nil {
+		defer gc.cancel()
+	}
 	stderr := &bytes.Buffer{}
 	if gc.Cmd.Stderr == nil {
 		gc.Cmd.Stderr = stderr
@@ -36,13 +40,18 @@ func (gc *Command) Run() error {
 }
 
 func (gc *Command) Output() ([]byte, error) {
+	if gc.cancel != nil {
+		defer gc.cancel()
+	}
 	gc.Stdout = nil
 	gc.Stderr = nil
 	// This is a hack in order to not break the hundreds of
 	// existing tests that rely on `run.PrepareCmd` to be invoked.
 	out, err := run.PrepareCmd(gc.Cmd).Output()
 	if err != nil {
-		ge := GitError{err: err}
+		// Surface whatever the command had already written to stdout before it failed, which is
+		// especially useful when a command was cancelled partway through.
+		ge := GitError{err: err, Stdout: string(out)}
 		var exitError *exec.ExitError
 		if errors.As(err, &exitError) {
 			ge.Stderr = string(exitError.Stderr)
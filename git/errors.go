@@ -1,6 +1,7 @@
 package git
 
 import (
+	"context"
 	"errors"
 	"fmt"
 )
@@ -24,10 +25,16 @@ func (e *NotInstalled) Unwrap() error {
 type GitError struct {
 	ExitCode int
 	Stderr   string
-	err      error
+	// Stdout holds whatever the command had already written to standard output before it failed,
+	// which is most useful when a command is cancelled partway through.
+	Stdout string
+	err    error
 }
 
 func (ge *GitError) Error() string {
+	if errors.Is(ge.err, context.DeadlineExceeded) {
+		return fmt.Sprintf("git: command timed out: %v", ge.err)
+	}
 	if ge.Stderr == "" {
 		return fmt.Sprintf("failed to run git: %v", ge.err)
 	}
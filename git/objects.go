@@ -75,3 +75,10 @@ type BranchConfig struct {
 	RemoteURL  *url.URL
 	MergeRef   string
 }
+
+// Submodule is a submodule entry as recorded in a repository's .gitmodules file.
+type Submodule struct {
+	Name string
+	Path string
+	URL  string
+}
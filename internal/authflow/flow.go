@@ -27,6 +27,17 @@ var (
 	jsonTypeRE = regexp.MustCompile(`[/+]json($|;)`)
 )
 
+// OAuthClientID returns the client ID of the "GitHub CLI" OAuth app used for interactive login,
+// and for refreshing tokens it issued.
+func OAuthClientID() string {
+	return oauthClientID
+}
+
+// OAuthClientSecret returns the client secret paired with OAuthClientID.
+func OAuthClientSecret() string {
+	return oauthClientSecret
+}
+
 func AuthFlow(oauthHost string, IO *iostreams.IOStreams, notice string, additionalScopes []string, isInteractive bool, b browser.Browser) (string, string, error) {
 	w := IO.ErrOut
 	cs := IO.ColorScheme()
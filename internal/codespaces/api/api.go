@@ -813,6 +813,8 @@ type CreateCodespaceParams struct {
 	VSCSTargetURL          string
 	PermissionsOptOut      bool
 	DisplayName            string
+	Features               map[string]string
+	DotfilesRepository     string
 }
 
 // CreateCodespace creates a codespace with the given parameters and returns a non-nil error if it
@@ -853,17 +855,19 @@ func (a *API) CreateCodespace(ctx context.Context, params *CreateCodespaceParams
 }
 
 type startCreateRequest struct {
-	RepositoryID           int    `json:"repository_id"`
-	IdleTimeoutMinutes     int    `json:"idle_timeout_minutes,omitempty"`
-	RetentionPeriodMinutes *int   `json:"retention_period_minutes,omitempty"`
-	Ref                    string `json:"ref"`
-	Location               string `json:"location"`
-	Machine                string `json:"machine"`
-	DevContainerPath       string `json:"devcontainer_path,omitempty"`
-	VSCSTarget             string `json:"vscs_target,omitempty"`
-	VSCSTargetURL          string `json:"vscs_target_url,omitempty"`
-	PermissionsOptOut      bool   `json:"multi_repo_permissions_opt_out"`
-	DisplayName            string `json:"display_name"`
+	RepositoryID           int               `json:"repository_id"`
+	IdleTimeoutMinutes     int               `json:"idle_timeout_minutes,omitempty"`
+	RetentionPeriodMinutes *int              `json:"retention_period_minutes,omitempty"`
+	Ref                    string            `json:"ref"`
+	Location               string            `json:"location"`
+	Machine                string            `json:"machine"`
+	DevContainerPath       string            `json:"devcontainer_path,omitempty"`
+	VSCSTarget             string            `json:"vscs_target,omitempty"`
+	VSCSTargetURL          string            `json:"vscs_target_url,omitempty"`
+	PermissionsOptOut      bool              `json:"multi_repo_permissions_opt_out"`
+	DisplayName            string            `json:"display_name"`
+	Features               map[string]string `json:"features,omitempty"`
+	DotfilesRepository     string            `json:"dotfiles_repository,omitempty"`
 }
 
 var errProvisioningInProgress = errors.New("provisioning in progress")
@@ -898,6 +902,8 @@ func (a *API) startCreate(ctx context.Context, params *CreateCodespaceParams) (*
 		VSCSTargetURL:          params.VSCSTargetURL,
 		PermissionsOptOut:      params.PermissionsOptOut,
 		DisplayName:            params.DisplayName,
+		Features:               params.Features,
+		DotfilesRepository:     params.DotfilesRepository,
 	})
 
 	if err != nil {
@@ -147,6 +147,63 @@ func parseSCPArgs(args []string) (cmdArgs, command []string, err error) {
 	return parseArgs(args, "cFiJloPS")
 }
 
+// Sync runs an rsync command over the specified port, transferring only files that have
+// changed since the last sync. rsyncArgs should contain both rsync flags as well as the
+// list of files to copy, with the flags first.
+//
+// Remote files indicated by a "remote:" prefix are resolved relative to the remote user's
+// home directory, as with Copy.
+func Sync(ctx context.Context, rsyncArgs []string, port int, destination string) error {
+	cmd, err := newRsyncCommand(ctx, port, destination, rsyncArgs)
+	if err != nil {
+		return fmt.Errorf("failed to create rsync command: %w", err)
+	}
+
+	return cmd.Run()
+}
+
+// newRsyncCommand populates an exec.Cmd to run an rsync command for the files specified in
+// cmdArgs, tunnelling the transfer through the forwarded SSH port. cmdArgs is parsed such
+// that rsync flags precede the files to copy in the command.
+func newRsyncCommand(ctx context.Context, port int, dst string, cmdArgs []string) (*exec.Cmd, error) {
+	remoteShell := fmt.Sprintf(
+		"ssh -p %d -o NoHostAuthenticationForLocalhost=yes -o PasswordAuthentication=no",
+		port,
+	)
+
+	cmdArgs, command, err := parseRsyncArgs(cmdArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	cmdArgs = append(cmdArgs, "-e", remoteShell)
+
+	for _, arg := range command {
+		// Replace "remote:" prefix with (e.g.) "root@localhost:".
+		if rest := strings.TrimPrefix(arg, "remote:"); rest != arg {
+			arg = dst + ":" + rest
+		}
+		cmdArgs = append(cmdArgs, arg)
+	}
+
+	exe, err := safeexec.LookPath("rsync")
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute rsync: %w", err)
+	}
+
+	cmd := exec.CommandContext(ctx, exe, cmdArgs...)
+
+	cmd.Stdin = nil
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+
+	return cmd, nil
+}
+
+func parseRsyncArgs(args []string) (cmdArgs, command []string, err error) {
+	return parseArgs(args, "e")
+}
+
 // parseArgs parses arguments into two distinct slices of flags and command. Parsing stops
 // as soon as a non-flag argument is found assuming the remaining arguments are the command.
 // It returns an error if a unary flag is provided without an argument.
@@ -108,6 +108,53 @@ func TestTokenFromKeyringNonExistent(t *testing.T) {
 	require.ErrorContains(t, err, "secret not found in keyring")
 }
 
+func TestTokenFromKeyringSlot(t *testing.T) {
+	// Given a keyring that contains a token under a named slot for a host
+	authCfg := newTestAuthConfig(t)
+	require.NoError(t, authCfg.SetTokenInKeyringSlot("github.com", "ci", "test-token"))
+
+	// When we get the token from the slot for that host
+	token, err := authCfg.TokenFromKeyringSlot("github.com", "ci")
+
+	// Then it returns successfully with the correct token
+	require.NoError(t, err)
+	require.Equal(t, "test-token", token)
+}
+
+func TestActiveTokenPrefersTokenSlotOverHost(t *testing.T) {
+	// Given a keyring that contains both a host token and a named slot token for that same host
+	authCfg := newTestAuthConfig(t)
+	_, err := authCfg.Login("github.com", "test-user", "host-token", "", true)
+	require.NoError(t, err)
+	require.NoError(t, authCfg.SetTokenInKeyringSlot("github.com", "ci", "slot-token"))
+
+	// When GH_TOKEN_SLOT names the slot
+	t.Setenv("GH_TOKEN_SLOT", "ci")
+
+	// Then ActiveToken returns the slot's token for that host
+	token, source := authCfg.ActiveToken("github.com")
+	require.Equal(t, "slot-token", token)
+	require.Equal(t, "GH_TOKEN_SLOT", source)
+}
+
+func TestActiveTokenIgnoresTokenSlotForOtherHost(t *testing.T) {
+	// Given a keyring that contains a host token for github.com and a named slot token
+	// created against a different host
+	authCfg := newTestAuthConfig(t)
+	_, err := authCfg.Login("github.com", "test-user", "host-token", "", true)
+	require.NoError(t, err)
+	require.NoError(t, authCfg.SetTokenInKeyringSlot("ghe.io", "ci", "slot-token"))
+
+	// When GH_TOKEN_SLOT names the slot
+	t.Setenv("GH_TOKEN_SLOT", "ci")
+
+	// Then ActiveToken for github.com falls through to the host's own token, since the
+	// slot was never created for github.com
+	token, source := authCfg.ActiveToken("github.com")
+	require.Equal(t, "host-token", token)
+	require.Equal(t, "keyring", source)
+}
+
 func TestHasEnvTokenWithoutAnyEnvToken(t *testing.T) {
 	// Given we have no env set
 	authCfg := newTestAuthConfig(t)
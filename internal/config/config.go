@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"slices"
+	"time"
 
 	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/keyring"
@@ -15,19 +16,24 @@ import (
 )
 
 const (
-	aliasesKey            = "aliases"
-	browserKey            = "browser"
-	editorKey             = "editor"
-	gitProtocolKey        = "git_protocol"
-	hostsKey              = "hosts"
-	httpUnixSocketKey     = "http_unix_socket"
-	oauthTokenKey         = "oauth_token"
-	pagerKey              = "pager"
-	promptKey             = "prompt"
-	preferEditorPromptKey = "prefer_editor_prompt"
-	userKey               = "user"
-	usersKey              = "users"
-	versionKey            = "version"
+	aliasesKey                    = "aliases"
+	browserKey                    = "browser"
+	editorKey                     = "editor"
+	gitProtocolKey                = "git_protocol"
+	hostsKey                      = "hosts"
+	httpUnixSocketKey             = "http_unix_socket"
+	oauthTokenKey                 = "oauth_token"
+	oauthExpiresAtKey             = "oauth_expires_at"
+	oauthRefreshTokenKey          = "oauth_refresh_token"
+	pagerKey                      = "pager"
+	promptKey                     = "prompt"
+	preferEditorPromptKey         = "prefer_editor_prompt"
+	accessiblePrompterKey         = "accessible_prompter"
+	strictDeletionConfirmationKey = "strict_deletion_confirmation"
+	telemetryKey                  = "telemetry"
+	userKey                       = "user"
+	usersKey                      = "users"
+	versionKey                    = "version"
 )
 
 func NewConfig() (gh.Config, error) {
@@ -143,6 +149,21 @@ func (c *cfg) PreferEditorPrompt(hostname string) gh.ConfigEntry {
 	return c.GetOrDefault(hostname, preferEditorPromptKey).Unwrap()
 }
 
+func (c *cfg) AccessiblePrompter(hostname string) gh.ConfigEntry {
+	// Intentionally panic if there is no user provided value or default value (which would be a programmer error)
+	return c.GetOrDefault(hostname, accessiblePrompterKey).Unwrap()
+}
+
+func (c *cfg) StrictDeletionConfirmation(hostname string) gh.ConfigEntry {
+	// Intentionally panic if there is no user provided value or default value (which would be a programmer error)
+	return c.GetOrDefault(hostname, strictDeletionConfirmationKey).Unwrap()
+}
+
+func (c *cfg) Telemetry(hostname string) gh.ConfigEntry {
+	// Intentionally panic if there is no user provided value or default value (which would be a programmer error)
+	return c.GetOrDefault(hostname, telemetryKey).Unwrap()
+}
+
 func (c *cfg) Version() o.Option[string] {
 	return c.get("", versionKey)
 }
@@ -206,6 +227,11 @@ func (c *AuthConfig) ActiveToken(hostname string) (string, string) {
 	if c.tokenOverride != nil {
 		return c.tokenOverride(hostname)
 	}
+	if slot := os.Getenv("GH_TOKEN_SLOT"); slot != "" {
+		if token, err := c.TokenFromKeyringSlot(hostname, slot); err == nil {
+			return token, "GH_TOKEN_SLOT"
+		}
+	}
 	token, source := ghAuth.TokenFromEnvOrConfig(hostname)
 	if token == "" {
 		var err error
@@ -217,6 +243,24 @@ func (c *AuthConfig) ActiveToken(hostname string) (string, string) {
 	return token, source
 }
 
+// ExpiresAt returns the expiration time of the active token for hostname, and whether the active
+// token has a known expiration at all. Classic OAuth tokens and PATs never expire and so will
+// always report ok=false; GitHub App user-to-server tokens do expire and are recorded here so
+// that callers such as the git-credential helper can refresh them ahead of time.
+func (c *AuthConfig) ExpiresAt(hostname string) (time.Time, bool) {
+	val, err := c.cfg.Get([]string{hostsKey, hostname, oauthExpiresAtKey})
+	if err != nil || val == "" {
+		return time.Time{}, false
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, val)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return expiresAt, true
+}
+
 // HasEnvToken returns true when a token has been specified in an
 // environment variable, else returns false.
 func (c *AuthConfig) HasEnvToken() bool {
@@ -266,6 +310,55 @@ func (c *AuthConfig) TokenFromKeyringForUser(hostname, username string) (string,
 	return keyring.Get(keyringServiceName(hostname), username)
 }
 
+// RefreshToken retrieves the stored OAuth refresh token for hostname, preferring encrypted
+// storage and falling back to the general configuration. It returns an empty string, with no
+// error, if no refresh token has been recorded for hostname.
+func (c *AuthConfig) RefreshToken(hostname string) (string, error) {
+	if token, err := keyring.Get(keyringServiceName(hostname), refreshTokenKeyringUser); err == nil {
+		return token, nil
+	}
+
+	token, _ := c.cfg.Get([]string{hostsKey, hostname, oauthRefreshTokenKey})
+	return token, nil
+}
+
+// UpdateToken persists a refreshed access token and its new expiration for hostname, alongside
+// whichever active token it is replacing, storing it the same way (encrypted or plain text) that
+// the token it replaces was stored. When the OAuth server rotated the refresh token too,
+// newRefreshToken records the replacement; pass an empty string to leave the stored refresh token
+// untouched.
+func (c *AuthConfig) UpdateToken(hostname, token string, expiresAt time.Time, newRefreshToken string) error {
+	if _, err := keyring.Get(keyringServiceName(hostname), ""); err == nil {
+		if err := keyring.Set(keyringServiceName(hostname), "", token); err != nil {
+			return fmt.Errorf("failed to update token in keyring: %w", err)
+		}
+	} else {
+		c.cfg.Set([]string{hostsKey, hostname, oauthTokenKey}, token)
+	}
+
+	c.cfg.Set([]string{hostsKey, hostname, oauthExpiresAtKey}, expiresAt.UTC().Format(time.RFC3339))
+
+	if newRefreshToken != "" {
+		if err := keyring.Set(keyringServiceName(hostname), refreshTokenKeyringUser, newRefreshToken); err != nil {
+			c.cfg.Set([]string{hostsKey, hostname, oauthRefreshTokenKey}, newRefreshToken)
+		}
+	}
+
+	return ghConfig.Write(c.cfg)
+}
+
+// TokenFromKeyringSlot will retrieve the token stored under the given named slot for hostname.
+// Slots are populated by commands such as "gh auth token create" and consumed by setting the
+// GH_TOKEN_SLOT environment variable to the slot's name.
+func (c *AuthConfig) TokenFromKeyringSlot(hostname, slot string) (string, error) {
+	return keyring.Get(keyringSlotServiceName(hostname, slot), "")
+}
+
+// SetTokenInKeyringSlot stores token under the given named slot, scoped to hostname.
+func (c *AuthConfig) SetTokenInKeyringSlot(hostname, slot, token string) error {
+	return keyring.Set(keyringSlotServiceName(hostname, slot), "", token)
+}
+
 // ActiveUser will retrieve the username for the active user at the given hostname.
 // This will not be accurate if the oauth token is set from an environment variable.
 func (c *AuthConfig) ActiveUser(hostname string) (string, error) {
@@ -463,10 +556,19 @@ func (c *AuthConfig) TokenForUser(hostname, user string) (string, string, error)
 	return "", "default", fmt.Errorf("no token found for '%s'", user)
 }
 
+// refreshTokenKeyringUser is the keyring "user" slot that OAuth refresh tokens are stored under,
+// alongside the active token which is stored under the empty "user". GitHub usernames can't
+// contain underscores, so this can't collide with a real per-user token slot.
+const refreshTokenKeyringUser = "_refresh_token"
+
 func keyringServiceName(hostname string) string {
 	return "gh:" + hostname
 }
 
+func keyringSlotServiceName(hostname, slot string) string {
+	return "gh:token-slot:" + hostname + ":" + slot
+}
+
 type AliasConfig struct {
 	cfg *ghConfig.Config
 }
@@ -572,6 +674,15 @@ var Options = []ConfigOption{
 			return c.PreferEditorPrompt(hostname).Value
 		},
 	},
+	{
+		Key:           accessiblePrompterKey,
+		Description:   "toggle accessible mode for prompts and progress indicators, for screen readers",
+		DefaultValue:  "disabled",
+		AllowedValues: []string{"enabled", "disabled"},
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.AccessiblePrompter(hostname).Value
+		},
+	},
 	{
 		Key:          pagerKey,
 		Description:  "the terminal pager program to send standard output to",
@@ -580,6 +691,24 @@ var Options = []ConfigOption{
 			return c.Pager(hostname).Value
 		},
 	},
+	{
+		Key:           strictDeletionConfirmationKey,
+		Description:   "require --confirm-token instead of --yes for destructive commands run non-interactively",
+		DefaultValue:  "disabled",
+		AllowedValues: []string{"enabled", "disabled"},
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.StrictDeletionConfirmation(hostname).Value
+		},
+	},
+	{
+		Key:           telemetryKey,
+		Description:   "record command timing and exit codes to a local, non-networked metrics file",
+		DefaultValue:  "disabled",
+		AllowedValues: []string{"enabled", "disabled"},
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.Telemetry(hostname).Value
+		},
+	},
 	{
 		Key:          httpUnixSocketKey,
 		Description:  "the path to a Unix socket through which to make an HTTP connection",
@@ -73,6 +73,15 @@ func NewFromString(cfgStr string) *ghmock.ConfigMock {
 	mock.PreferEditorPromptFunc = func(hostname string) gh.ConfigEntry {
 		return cfg.PreferEditorPrompt(hostname)
 	}
+	mock.AccessiblePrompterFunc = func(hostname string) gh.ConfigEntry {
+		return cfg.AccessiblePrompter(hostname)
+	}
+	mock.StrictDeletionConfirmationFunc = func(hostname string) gh.ConfigEntry {
+		return cfg.StrictDeletionConfirmation(hostname)
+	}
+	mock.TelemetryFunc = func(hostname string) gh.ConfigEntry {
+		return cfg.Telemetry(hostname)
+	}
 	mock.VersionFunc = func() o.Option[string] {
 		return cfg.Version()
 	}
@@ -0,0 +1,201 @@
+// Package extensionrpc implements a small, opt-in protocol that lets an
+// extension ask the parent gh process to do its prompting, table rendering,
+// and spinner display, instead of reimplementing them. This keeps an
+// extension's prompts, tables, and accessibility behavior (NO_COLOR, screen
+// reader mode, etc.) consistent with core gh commands without the
+// extension having to vendor gh's own IOStreams and prompter packages.
+//
+// The parent process listens on a Unix domain socket for the lifetime of an
+// extension invocation and passes its path to the extension via the
+// GH_UI_SOCKET environment variable. An extension that doesn't read that
+// variable, or that isn't running on a platform with Unix domain socket
+// support, behaves exactly as it does today: it's free to read and write
+// its own stdio.
+//
+// The wire protocol is newline-delimited JSON request/response pairs, one
+// request in flight at a time:
+//
+//	{"id":1,"method":"prompt.confirm","params":{"message":"Continue?","default":true}}
+//	{"id":1,"result":true}
+package extensionrpc
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+
+	"github.com/cli/cli/v2/internal/prompter"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/iostreams"
+)
+
+// SocketEnvVar is the environment variable an extension reads to find the
+// Unix domain socket a Server is listening on.
+const SocketEnvVar = "GH_UI_SOCKET"
+
+type request struct {
+	ID     int             `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+type response struct {
+	ID     int         `json:"id"`
+	Result interface{} `json:"result,omitempty"`
+	Error  string      `json:"error,omitempty"`
+}
+
+// Server answers requests from a single extension invocation by delegating
+// to the parent process's own IOStreams and prompter.
+type Server struct {
+	IO       *iostreams.IOStreams
+	Prompter prompter.Prompter
+}
+
+// NewServer returns a Server that serves prompts, tables, and spinners
+// using io and p.
+func NewServer(io *iostreams.IOStreams, p prompter.Prompter) *Server {
+	return &Server{IO: io, Prompter: p}
+}
+
+// Listen creates a Unix domain socket under a temporary directory and
+// starts serving requests on it in the background. It returns the socket
+// path to pass to the extension via SocketEnvVar, and a close function the
+// caller must call once the extension has exited to stop serving and clean
+// up the socket.
+//
+// Unix domain sockets aren't available on Windows in a form net.Listen can
+// use portably, so on that platform Listen returns an empty path and a
+// no-op close function; extensions there fall back to their own stdio.
+func (s *Server) Listen() (socketPath string, closeFn func(), err error) {
+	if !supported() {
+		return "", func() {}, nil
+	}
+
+	dir, err := os.MkdirTemp("", "gh-extension-ui-*")
+	if err != nil {
+		return "", nil, err
+	}
+
+	socketPath = dir + "/ui.sock"
+	ln, err := net.Listen("unix", socketPath)
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return "", nil, err
+	}
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			s.serveConn(conn)
+		}
+	}()
+
+	closeFn = func() {
+		_ = ln.Close()
+		_ = os.RemoveAll(dir)
+	}
+	return socketPath, closeFn, nil
+}
+
+func (s *Server) serveConn(conn net.Conn) {
+	defer conn.Close()
+
+	scanner := bufio.NewScanner(conn)
+	enc := json.NewEncoder(conn)
+	for scanner.Scan() {
+		var req request
+		if err := json.Unmarshal(scanner.Bytes(), &req); err != nil {
+			continue
+		}
+
+		result, err := s.dispatch(req.Method, req.Params)
+		resp := response{ID: req.ID, Result: result}
+		if err != nil {
+			resp.Error = err.Error()
+		}
+		if err := enc.Encode(resp); err != nil {
+			return
+		}
+	}
+}
+
+func (s *Server) dispatch(method string, params json.RawMessage) (interface{}, error) {
+	switch method {
+	case "prompt.confirm":
+		var p struct {
+			Message string `json:"message"`
+			Default bool   `json:"default"`
+		}
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, err
+		}
+		return s.Prompter.Confirm(p.Message, p.Default)
+
+	case "prompt.input":
+		var p struct {
+			Message string `json:"message"`
+			Default string `json:"default"`
+		}
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, err
+		}
+		return s.Prompter.Input(p.Message, p.Default)
+
+	case "prompt.select":
+		var p struct {
+			Message string   `json:"message"`
+			Default string   `json:"default"`
+			Options []string `json:"options"`
+		}
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, err
+		}
+		idx, err := s.Prompter.Select(p.Message, p.Default, p.Options)
+		if err != nil {
+			return nil, err
+		}
+		return p.Options[idx], nil
+
+	case "spinner.start":
+		var p struct {
+			Label string `json:"label"`
+		}
+		_ = json.Unmarshal(params, &p)
+		if p.Label != "" {
+			s.IO.StartProgressIndicatorWithLabel(p.Label)
+		} else {
+			s.IO.StartProgressIndicator()
+		}
+		return nil, nil
+
+	case "spinner.stop":
+		s.IO.StopProgressIndicator()
+		return nil, nil
+
+	case "table.render":
+		var p struct {
+			Headers []string   `json:"headers"`
+			Rows    [][]string `json:"rows"`
+		}
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, err
+		}
+		tp := tableprinter.New(s.IO, tableprinter.WithHeader(p.Headers...))
+		for _, row := range p.Rows {
+			for _, cell := range row {
+				tp.AddField(cell)
+			}
+			tp.EndRow()
+		}
+		return nil, tp.Render()
+
+	default:
+		return nil, fmt.Errorf("unknown method %q", method)
+	}
+}
@@ -0,0 +1,10 @@
+//go:build !windows
+// +build !windows
+
+package extensionrpc
+
+// supported reports whether Unix domain sockets are available for the RPC
+// server to listen on.
+func supported() bool {
+	return true
+}
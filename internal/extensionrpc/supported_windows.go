@@ -0,0 +1,9 @@
+package extensionrpc
+
+// supported reports whether Unix domain sockets are available for the RPC
+// server to listen on. Windows' net.Listen("unix", ...) support is
+// version- and filesystem-dependent, so extensions on Windows fall back to
+// their own stdio instead.
+func supported() bool {
+	return false
+}
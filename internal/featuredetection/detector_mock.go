@@ -14,6 +14,10 @@ func (md *DisabledDetectorMock) RepositoryFeatures() (RepositoryFeatures, error)
 	return RepositoryFeatures{}, nil
 }
 
+func (md *DisabledDetectorMock) PlatformFeatures() (PlatformFeatures, error) {
+	return PlatformFeatures{}, nil
+}
+
 type EnabledDetectorMock struct{}
 
 func (md *EnabledDetectorMock) IssueFeatures() (IssueFeatures, error) {
@@ -27,3 +31,7 @@ func (md *EnabledDetectorMock) PullRequestFeatures() (PullRequestFeatures, error
 func (md *EnabledDetectorMock) RepositoryFeatures() (RepositoryFeatures, error) {
 	return allRepositoryFeatures, nil
 }
+
+func (md *EnabledDetectorMock) PlatformFeatures() (PlatformFeatures, error) {
+	return allPlatformFeatures, nil
+}
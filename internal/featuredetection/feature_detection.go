@@ -12,6 +12,7 @@ type Detector interface {
 	IssueFeatures() (IssueFeatures, error)
 	PullRequestFeatures() (PullRequestFeatures, error)
 	RepositoryFeatures() (RepositoryFeatures, error)
+	PlatformFeatures() (PlatformFeatures, error)
 }
 
 type IssueFeatures struct {
@@ -49,6 +50,18 @@ var allRepositoryFeatures = RepositoryFeatures{
 	AutoMerge:                true,
 }
 
+// PlatformFeatures represents capabilities that apply to a whole host rather than to a
+// single GraphQL type, such as whether a GHES instance has a given product enabled at all.
+type PlatformFeatures struct {
+	ProjectsV2   bool
+	Attestations bool
+}
+
+var allPlatformFeatures = PlatformFeatures{
+	ProjectsV2:   true,
+	Attestations: true,
+}
+
 type detector struct {
 	host       string
 	httpClient *http.Client
@@ -198,3 +211,33 @@ func (d *detector) RepositoryFeatures() (RepositoryFeatures, error) {
 
 	return features, nil
 }
+
+func (d *detector) PlatformFeatures() (PlatformFeatures, error) {
+	// Tenancy instances track github.com's feature set, but GHES releases lag behind and
+	// don't support Attestations at all, so on GHES ProjectsV2 is probed explicitly and
+	// Attestations is reported as unavailable.
+	if !ghinstance.IsEnterprise(d.host) || ghinstance.IsTenancy(d.host) {
+		return allPlatformFeatures, nil
+	}
+
+	features := PlatformFeatures{
+		// Attestations are not available on GHES regardless of version.
+		Attestations: false,
+	}
+
+	var featureDetection struct {
+		ProjectV2 struct {
+			Name string
+		} `graphql:"ProjectV2: __type(name: \"ProjectV2\")"`
+	}
+
+	gql := api.NewClientFromHTTP(d.httpClient)
+	err := gql.Query(d.host, "ProjectV2_type", &featureDetection, nil)
+	if err != nil {
+		return features, err
+	}
+
+	features.ProjectsV2 = featureDetection.ProjectV2.Name != ""
+
+	return features, nil
+}
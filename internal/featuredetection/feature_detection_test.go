@@ -348,3 +348,77 @@ func TestRepositoryFeatures(t *testing.T) {
 		})
 	}
 }
+
+func TestPlatformFeatures(t *testing.T) {
+	tests := []struct {
+		name          string
+		hostname      string
+		queryResponse map[string]string
+		wantFeatures  PlatformFeatures
+		wantErr       bool
+	}{
+		{
+			name:     "github.com",
+			hostname: "github.com",
+			wantFeatures: PlatformFeatures{
+				ProjectsV2:   true,
+				Attestations: true,
+			},
+			wantErr: false,
+		},
+		{
+			name:     "tenancy host",
+			hostname: "tenant.ghe.com",
+			wantFeatures: PlatformFeatures{
+				ProjectsV2:   true,
+				Attestations: true,
+			},
+			wantErr: false,
+		},
+		{
+			name:     "GHE without projects v2",
+			hostname: "git.my.org",
+			queryResponse: map[string]string{
+				`query ProjectV2_type\b`: `{"data": {}}`,
+			},
+			wantFeatures: PlatformFeatures{
+				ProjectsV2:   false,
+				Attestations: false,
+			},
+			wantErr: false,
+		},
+		{
+			name:     "GHE with projects v2",
+			hostname: "git.my.org",
+			queryResponse: map[string]string{
+				`query ProjectV2_type\b`: heredoc.Doc(`
+					{ "data": { "ProjectV2": { "name": "ProjectV2" } } }
+				`),
+			},
+			wantFeatures: PlatformFeatures{
+				ProjectsV2:   true,
+				Attestations: false,
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			httpClient := &http.Client{}
+			httpmock.ReplaceTripper(httpClient, reg)
+			for query, resp := range tt.queryResponse {
+				reg.Register(httpmock.GraphQL(query), httpmock.StringResponse(resp))
+			}
+			detector := detector{host: tt.hostname, httpClient: httpClient}
+			gotFeatures, err := detector.PlatformFeatures()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantFeatures, gotFeatures)
+		})
+	}
+}
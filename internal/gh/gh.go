@@ -10,6 +10,8 @@
 package gh
 
 import (
+	"time"
+
 	o "github.com/cli/cli/v2/pkg/option"
 	ghConfig "github.com/cli/go-gh/v2/pkg/config"
 )
@@ -49,6 +51,16 @@ type Config interface {
 	Prompt(hostname string) ConfigEntry
 	// PreferEditorPrompt returns the configured editor-based prompt, optionally scoped by host.
 	PreferEditorPrompt(hostname string) ConfigEntry
+	// AccessiblePrompter returns whether prompts and progress output should use plain,
+	// screen-reader-friendly sequential rendering, optionally scoped by host.
+	AccessiblePrompter(hostname string) ConfigEntry
+	// StrictDeletionConfirmation returns whether destructive commands must be given a
+	// --confirm-token when running non-interactively, instead of accepting --yes on its own,
+	// optionally scoped by host.
+	StrictDeletionConfirmation(hostname string) ConfigEntry
+	// Telemetry returns whether command timing and exit codes are recorded to a local,
+	// non-networked metrics file, optionally scoped by host.
+	Telemetry(hostname string) ConfigEntry
 
 	// Aliases provides persistent storage and modification of command aliases.
 	Aliases() AliasConfig
@@ -97,6 +109,19 @@ type AuthConfig interface {
 	// general configuration, and finally encrypted storage.
 	ActiveToken(hostname string) (token string, source string)
 
+	// ExpiresAt returns the expiration time of the active token for hostname, and whether the
+	// active token has a known expiration at all. GitHub App user-to-server tokens expire;
+	// classic OAuth tokens and PATs don't, and so always report ok=false.
+	ExpiresAt(hostname string) (expiresAt time.Time, ok bool)
+
+	// RefreshToken retrieves the stored OAuth refresh token for hostname, if one has been
+	// recorded.
+	RefreshToken(hostname string) (token string, err error)
+
+	// UpdateToken persists a refreshed access token and its new expiration for hostname,
+	// optionally rotating the stored refresh token too.
+	UpdateToken(hostname, token string, expiresAt time.Time, newRefreshToken string) error
+
 	// HasEnvToken returns true when a token has been specified in an environment variable, else returns false.
 	HasEnvToken() bool
 
@@ -110,6 +135,13 @@ type AuthConfig interface {
 	// surprising cases is just too high to risk compared to the utility of having the function being smart.
 	TokenFromKeyringForUser(hostname, username string) (token string, err error)
 
+	// TokenFromKeyringSlot will retrieve the token stored under the given named slot for hostname.
+	// Used to read back tokens created with TokenFromKeyringSlot's counterpart, SetTokenInKeyringSlot.
+	TokenFromKeyringSlot(hostname, slot string) (token string, err error)
+
+	// SetTokenInKeyringSlot stores token under the given named slot, scoped to hostname.
+	SetTokenInKeyringSlot(hostname, slot, token string) error
+
 	// ActiveUser will retrieve the username for the active user at the given hostname.
 	//
 	// This will not be accurate if the oauth token is set from an environment variable.
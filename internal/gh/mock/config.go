@@ -58,6 +58,12 @@ var _ gh.Config = &ConfigMock{}
 //			SetFunc: func(hostname string, key string, value string)  {
 //				panic("mock out the Set method")
 //			},
+//			StrictDeletionConfirmationFunc: func(hostname string) gh.ConfigEntry {
+//				panic("mock out the StrictDeletionConfirmation method")
+//			},
+//			TelemetryFunc: func(hostname string) gh.ConfigEntry {
+//				panic("mock out the Telemetry method")
+//			},
 //			VersionFunc: func() o.Option[string] {
 //				panic("mock out the Version method")
 //			},
@@ -71,6 +77,9 @@ var _ gh.Config = &ConfigMock{}
 //
 //	}
 type ConfigMock struct {
+	// AccessiblePrompterFunc mocks the AccessiblePrompter method.
+	AccessiblePrompterFunc func(hostname string) gh.ConfigEntry
+
 	// AliasesFunc mocks the Aliases method.
 	AliasesFunc func() gh.AliasConfig
 
@@ -110,6 +119,12 @@ type ConfigMock struct {
 	// SetFunc mocks the Set method.
 	SetFunc func(hostname string, key string, value string)
 
+	// StrictDeletionConfirmationFunc mocks the StrictDeletionConfirmation method.
+	StrictDeletionConfirmationFunc func(hostname string) gh.ConfigEntry
+
+	// TelemetryFunc mocks the Telemetry method.
+	TelemetryFunc func(hostname string) gh.ConfigEntry
+
 	// VersionFunc mocks the Version method.
 	VersionFunc func() o.Option[string]
 
@@ -118,6 +133,11 @@ type ConfigMock struct {
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// AccessiblePrompter holds details about calls to the AccessiblePrompter method.
+		AccessiblePrompter []struct {
+			// Hostname is the hostname argument value.
+			Hostname string
+		}
 		// Aliases holds details about calls to the Aliases method.
 		Aliases []struct {
 		}
@@ -183,6 +203,16 @@ type ConfigMock struct {
 			// Value is the value argument value.
 			Value string
 		}
+		// StrictDeletionConfirmation holds details about calls to the StrictDeletionConfirmation method.
+		StrictDeletionConfirmation []struct {
+			// Hostname is the hostname argument value.
+			Hostname string
+		}
+		// Telemetry holds details about calls to the Telemetry method.
+		Telemetry []struct {
+			// Hostname is the hostname argument value.
+			Hostname string
+		}
 		// Version holds details about calls to the Version method.
 		Version []struct {
 		}
@@ -190,21 +220,56 @@ type ConfigMock struct {
 		Write []struct {
 		}
 	}
-	lockAliases            sync.RWMutex
-	lockAuthentication     sync.RWMutex
-	lockBrowser            sync.RWMutex
-	lockCacheDir           sync.RWMutex
-	lockEditor             sync.RWMutex
-	lockGetOrDefault       sync.RWMutex
-	lockGitProtocol        sync.RWMutex
-	lockHTTPUnixSocket     sync.RWMutex
-	lockMigrate            sync.RWMutex
-	lockPager              sync.RWMutex
-	lockPreferEditorPrompt sync.RWMutex
-	lockPrompt             sync.RWMutex
-	lockSet                sync.RWMutex
-	lockVersion            sync.RWMutex
-	lockWrite              sync.RWMutex
+	lockAccessiblePrompter         sync.RWMutex
+	lockAliases                    sync.RWMutex
+	lockAuthentication             sync.RWMutex
+	lockBrowser                    sync.RWMutex
+	lockCacheDir                   sync.RWMutex
+	lockEditor                     sync.RWMutex
+	lockGetOrDefault               sync.RWMutex
+	lockGitProtocol                sync.RWMutex
+	lockHTTPUnixSocket             sync.RWMutex
+	lockMigrate                    sync.RWMutex
+	lockPager                      sync.RWMutex
+	lockPreferEditorPrompt         sync.RWMutex
+	lockPrompt                     sync.RWMutex
+	lockSet                        sync.RWMutex
+	lockStrictDeletionConfirmation sync.RWMutex
+	lockTelemetry                  sync.RWMutex
+	lockVersion                    sync.RWMutex
+	lockWrite                      sync.RWMutex
+}
+
+// AccessiblePrompter calls AccessiblePrompterFunc.
+func (mock *ConfigMock) AccessiblePrompter(hostname string) gh.ConfigEntry {
+	if mock.AccessiblePrompterFunc == nil {
+		panic("ConfigMock.AccessiblePrompterFunc: method is nil but Config.AccessiblePrompter was just called")
+	}
+	callInfo := struct {
+		Hostname string
+	}{
+		Hostname: hostname,
+	}
+	mock.lockAccessiblePrompter.Lock()
+	mock.calls.AccessiblePrompter = append(mock.calls.AccessiblePrompter, callInfo)
+	mock.lockAccessiblePrompter.Unlock()
+	return mock.AccessiblePrompterFunc(hostname)
+}
+
+// AccessiblePrompterCalls gets all the calls that were made to AccessiblePrompter.
+// Check the length with:
+//
+//	len(mockedConfig.AccessiblePrompterCalls())
+func (mock *ConfigMock) AccessiblePrompterCalls() []struct {
+	Hostname string
+} {
+	var calls []struct {
+		Hostname string
+	}
+	mock.lockAccessiblePrompter.RLock()
+	calls = mock.calls.AccessiblePrompter
+	mock.lockAccessiblePrompter.RUnlock()
+	return calls
 }
 
 // Aliases calls AliasesFunc.
@@ -620,6 +685,70 @@ func (mock *ConfigMock) SetCalls() []struct {
 	return calls
 }
 
+// StrictDeletionConfirmation calls StrictDeletionConfirmationFunc.
+func (mock *ConfigMock) StrictDeletionConfirmation(hostname string) gh.ConfigEntry {
+	if mock.StrictDeletionConfirmationFunc == nil {
+		panic("ConfigMock.StrictDeletionConfirmationFunc: method is nil but Config.StrictDeletionConfirmation was just called")
+	}
+	callInfo := struct {
+		Hostname string
+	}{
+		Hostname: hostname,
+	}
+	mock.lockStrictDeletionConfirmation.Lock()
+	mock.calls.StrictDeletionConfirmation = append(mock.calls.StrictDeletionConfirmation, callInfo)
+	mock.lockStrictDeletionConfirmation.Unlock()
+	return mock.StrictDeletionConfirmationFunc(hostname)
+}
+
+// StrictDeletionConfirmationCalls gets all the calls that were made to StrictDeletionConfirmation.
+// Check the length with:
+//
+//	len(mockedConfig.StrictDeletionConfirmationCalls())
+func (mock *ConfigMock) StrictDeletionConfirmationCalls() []struct {
+	Hostname string
+} {
+	var calls []struct {
+		Hostname string
+	}
+	mock.lockStrictDeletionConfirmation.RLock()
+	calls = mock.calls.StrictDeletionConfirmation
+	mock.lockStrictDeletionConfirmation.RUnlock()
+	return calls
+}
+
+// Telemetry calls TelemetryFunc.
+func (mock *ConfigMock) Telemetry(hostname string) gh.ConfigEntry {
+	if mock.TelemetryFunc == nil {
+		panic("ConfigMock.TelemetryFunc: method is nil but Config.Telemetry was just called")
+	}
+	callInfo := struct {
+		Hostname string
+	}{
+		Hostname: hostname,
+	}
+	mock.lockTelemetry.Lock()
+	mock.calls.Telemetry = append(mock.calls.Telemetry, callInfo)
+	mock.lockTelemetry.Unlock()
+	return mock.TelemetryFunc(hostname)
+}
+
+// TelemetryCalls gets all the calls that were made to Telemetry.
+// Check the length with:
+//
+//	len(mockedConfig.TelemetryCalls())
+func (mock *ConfigMock) TelemetryCalls() []struct {
+	Hostname string
+} {
+	var calls []struct {
+		Hostname string
+	}
+	mock.lockTelemetry.RLock()
+	calls = mock.calls.Telemetry
+	mock.lockTelemetry.RUnlock()
+	return calls
+}
+
 // Version calls VersionFunc.
 func (mock *ConfigMock) Version() o.Option[string] {
 	if mock.VersionFunc == nil {
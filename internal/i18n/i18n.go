@@ -0,0 +1,122 @@
+// Package i18n provides a small message catalog and locale detection so that
+// command output, prompts, and cmdutil error hints can eventually be
+// translated. Message keys are themselves the English text, so callers that
+// don't go through T are unaffected; ship locales are English and a
+// pseudo-locale used to find strings that haven't been routed through the
+// catalog yet.
+package i18n
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Locale identifies a language the CLI can render messages in.
+type Locale string
+
+const (
+	// English is the default locale. Message keys are themselves English
+	// text, so English never needs catalog entries.
+	English Locale = "en"
+	// Pseudo decorates every string it renders so that text which bypasses
+	// the catalog (i.e. isn't passed through T) is easy to spot.
+	Pseudo Locale = "en-XA"
+)
+
+// EnvLocale reports the locale requested via GH_LANG, falling back to LANG
+// and finally to English when neither is set or recognized.
+func EnvLocale() Locale {
+	for _, env := range []string{"GH_LANG", "LANG"} {
+		if locale, ok := parseLocale(os.Getenv(env)); ok {
+			return locale
+		}
+	}
+	return English
+}
+
+func parseLocale(v string) (Locale, bool) {
+	tag := strings.SplitN(v, ".", 2)[0]
+	tag = strings.ReplaceAll(tag, "_", "-")
+	tag = strings.ToLower(tag)
+
+	switch tag {
+	case "":
+		return "", false
+	case "c", "posix":
+		return English, true
+	case "en-xa":
+		return Pseudo, true
+	}
+
+	lang, _, _ := strings.Cut(tag, "-")
+	if lang == "en" {
+		return English, true
+	}
+	return "", false
+}
+
+// Printer renders message keys in a single locale.
+type Printer struct {
+	locale Locale
+}
+
+// NewPrinter returns a Printer that renders messages in locale.
+func NewPrinter(locale Locale) *Printer {
+	return &Printer{locale: locale}
+}
+
+// Default is the Printer backing the package-level T, configured from the
+// process environment.
+var Default = NewPrinter(EnvLocale())
+
+// T formats key, translating it first if the printer's locale has a catalog
+// entry for it. key is itself valid English output, so untranslated locales
+// degrade gracefully to English.
+func (p *Printer) T(key string, args ...interface{}) string {
+	msg := key
+	if translated, ok := catalog[p.locale][key]; ok {
+		msg = translated
+	}
+
+	out := msg
+	if len(args) > 0 {
+		out = fmt.Sprintf(msg, args...)
+	}
+
+	if p.locale == Pseudo {
+		out = pseudoLocalize(out)
+	}
+
+	return out
+}
+
+// T formats key using the package-level Default printer.
+func T(key string, args ...interface{}) string {
+	return Default.T(key, args...)
+}
+
+// catalog holds non-English translations, keyed by the English message used
+// as the lookup key. A locale with no entry for a key falls back to the key
+// itself.
+var catalog = map[Locale]map[string]string{}
+
+var pseudoAccents = map[rune]rune{
+	'a': 'á', 'e': 'é', 'i': 'í', 'o': 'ó', 'u': 'ú',
+	'A': 'Á', 'E': 'É', 'I': 'Í', 'O': 'Ó', 'U': 'Ú',
+}
+
+// pseudoLocalize wraps s in brackets and accents its vowels, making it easy
+// to tell apart from real translations and to spot strings that skipped T.
+func pseudoLocalize(s string) string {
+	var b strings.Builder
+	b.WriteByte('[')
+	for _, r := range s {
+		if a, ok := pseudoAccents[r]; ok {
+			r = a
+		}
+		b.WriteRune(r)
+	}
+	b.WriteByte(']')
+	return b.String()
+}
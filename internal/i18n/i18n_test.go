@@ -0,0 +1,43 @@
+package i18n
+
+import "testing"
+
+func TestParseLocale(t *testing.T) {
+	tests := []struct {
+		env  string
+		want Locale
+		ok   bool
+	}{
+		{env: "en_US.UTF-8", want: English, ok: true},
+		{env: "en-XA", want: Pseudo, ok: true},
+		{env: "C", want: English, ok: true},
+		{env: "fr_FR.UTF-8", ok: false},
+		{env: "", ok: false},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseLocale(tt.env)
+		if ok != tt.ok {
+			t.Errorf("parseLocale(%q) ok = %v, want %v", tt.env, ok, tt.ok)
+			continue
+		}
+		if ok && got != tt.want {
+			t.Errorf("parseLocale(%q) = %q, want %q", tt.env, got, tt.want)
+		}
+	}
+}
+
+func TestPrinterT(t *testing.T) {
+	en := NewPrinter(English)
+	if got := en.T("Title"); got != "Title" {
+		t.Errorf("English T(%q) = %q", "Title", got)
+	}
+	if got := en.T("hello %s", "world"); got != "hello world" {
+		t.Errorf("English T with args = %q", got)
+	}
+
+	pseudo := NewPrinter(Pseudo)
+	if got := pseudo.T("Title"); got != "[Títlé]" {
+		t.Errorf("Pseudo T(%q) = %q, want %q", "Title", got, "[Títlé]")
+	}
+}
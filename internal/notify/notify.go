@@ -0,0 +1,66 @@
+// Package notify provides a best-effort desktop notification for long-running commands that
+// support backgrounding, such as `gh run watch` or `gh pr checks --watch`.
+package notify
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+)
+
+// Notifier sends a desktop notification.
+type Notifier interface {
+	Notify(title, body string) error
+}
+
+// New returns the Notifier for the current platform, shelling out to whatever notification
+// mechanism is native to it: osascript on macOS, notify-send on Linux, and a PowerShell-driven
+// toast on Windows.
+func New() Notifier {
+	switch runtime.GOOS {
+	case "darwin":
+		return new(macNotifier)
+	case "windows":
+		return new(windowsNotifier)
+	default:
+		return new(linuxNotifier)
+	}
+}
+
+type macNotifier struct{}
+
+func (*macNotifier) Notify(title, body string) error {
+	script := fmt.Sprintf("display notification %q with title %q", body, title)
+	return exec.Command("osascript", "-e", script).Run()
+}
+
+type linuxNotifier struct{}
+
+func (*linuxNotifier) Notify(title, body string) error {
+	return exec.Command("notify-send", title, body).Run()
+}
+
+type windowsNotifier struct{}
+
+func (*windowsNotifier) Notify(title, body string) error {
+	// title and body come from untrusted sources (e.g. a workflow's `name:`
+	// field), so they must never be spliced into the script text itself:
+	// PowerShell expands $(...) subexpressions inside double-quoted
+	// strings, which would let a crafted title run arbitrary commands. Pass
+	// them as process arguments instead and read them back via $args, which
+	// never touches the script's parser.
+	script := `
+		$title = $args[0]
+		$body = $args[1]
+		[Windows.UI.Notifications.ToastNotificationManager, Windows.UI.Notifications, ContentType = WindowsRuntime] | Out-Null
+		[Windows.UI.Notifications.ToastNotification, Windows.UI.Notifications, ContentType = WindowsRuntime] | Out-Null
+		[Windows.Data.Xml.Dom.XmlDocument, Windows.Data.Xml.Dom, ContentType = WindowsRuntime] | Out-Null
+		$template = [Windows.UI.Notifications.ToastNotificationManager]::GetTemplateContent([Windows.UI.Notifications.ToastTemplateType]::ToastText02)
+		$textNodes = $template.GetElementsByTagName('text')
+		$textNodes.Item(0).AppendChild($template.CreateTextNode($title)) | Out-Null
+		$textNodes.Item(1).AppendChild($template.CreateTextNode($body)) | Out-Null
+		$toast = [Windows.UI.Notifications.ToastNotification]::new($template)
+		[Windows.UI.Notifications.ToastNotificationManager]::CreateToastNotifier('GitHub CLI').Show($toast)
+	`
+	return exec.Command("powershell", "-NoProfile", "-Command", script, title, body).Run()
+}
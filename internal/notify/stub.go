@@ -0,0 +1,20 @@
+package notify
+
+type notification struct {
+	title string
+	body  string
+}
+
+// Stub records notifications instead of sending real desktop notifications, for use in tests.
+type Stub struct {
+	notifications []notification
+}
+
+func (s *Stub) Notify(title, body string) error {
+	s.notifications = append(s.notifications, notification{title: title, body: body})
+	return nil
+}
+
+func (s *Stub) Notified() bool {
+	return len(s.notifications) > 0
+}
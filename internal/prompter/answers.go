@@ -0,0 +1,171 @@
+package prompter
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// WithAnswers wraps p so that any prompt whose message matches a key in the
+// YAML file at answersFile is answered automatically instead of prompting
+// interactively. Prompts with no matching key fall through to p. This backs
+// the GH_PROMPT_ANSWERS environment variable, for semi-automated runbooks and
+// deterministic tests.
+func WithAnswers(p Prompter, answersFile string) (Prompter, error) {
+	data, err := os.ReadFile(answersFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not read prompt answers file: %w", err)
+	}
+
+	var answers map[string]interface{}
+	if err := yaml.Unmarshal(data, &answers); err != nil {
+		return nil, fmt.Errorf("could not parse prompt answers file: %w", err)
+	}
+
+	return &answerFilePrompter{Prompter: p, answers: answers}, nil
+}
+
+type answerFilePrompter struct {
+	Prompter
+	answers map[string]interface{}
+}
+
+func (p *answerFilePrompter) lookup(prompt string) (interface{}, bool) {
+	v, ok := p.answers[prompt]
+	return v, ok
+}
+
+func (p *answerFilePrompter) stringAnswer(prompt string) (string, bool, error) {
+	v, ok := p.lookup(prompt)
+	if !ok {
+		return "", false, nil
+	}
+	answer, ok := v.(string)
+	if !ok {
+		return "", true, fmt.Errorf("answer for %q must be a string", prompt)
+	}
+	return answer, true, nil
+}
+
+func (p *answerFilePrompter) Select(prompt, defaultValue string, options []string) (int, error) {
+	v, ok := p.lookup(prompt)
+	if !ok {
+		return p.Prompter.Select(prompt, defaultValue, options)
+	}
+	answer, ok := v.(string)
+	if !ok {
+		return 0, fmt.Errorf("answer for %q must be a string", prompt)
+	}
+	for i, o := range options {
+		if o == answer {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("answer %q for %q is not one of the available options", answer, prompt)
+}
+
+func (p *answerFilePrompter) MultiSelect(prompt string, defaults, options []string) ([]int, error) {
+	v, ok := p.lookup(prompt)
+	if !ok {
+		return p.Prompter.MultiSelect(prompt, defaults, options)
+	}
+	rawAnswers, ok := v.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("answer for %q must be a list of strings", prompt)
+	}
+	indices := make([]int, 0, len(rawAnswers))
+	for _, raw := range rawAnswers {
+		answer, ok := raw.(string)
+		if !ok {
+			return nil, fmt.Errorf("answer for %q must be a list of strings", prompt)
+		}
+		found := false
+		for i, o := range options {
+			if o == answer {
+				indices = append(indices, i)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("answer %q for %q is not one of the available options", answer, prompt)
+		}
+	}
+	return indices, nil
+}
+
+func (p *answerFilePrompter) Input(prompt, defaultValue string) (string, error) {
+	if answer, ok, err := p.stringAnswer(prompt); err != nil {
+		return "", err
+	} else if ok {
+		return answer, nil
+	}
+	return p.Prompter.Input(prompt, defaultValue)
+}
+
+func (p *answerFilePrompter) Password(prompt string) (string, error) {
+	if answer, ok, err := p.stringAnswer(prompt); err != nil {
+		return "", err
+	} else if ok {
+		return answer, nil
+	}
+	return p.Prompter.Password(prompt)
+}
+
+func (p *answerFilePrompter) Confirm(prompt string, defaultValue bool) (bool, error) {
+	v, ok := p.lookup(prompt)
+	if !ok {
+		return p.Prompter.Confirm(prompt, defaultValue)
+	}
+	answer, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("answer for %q must be a boolean", prompt)
+	}
+	return answer, nil
+}
+
+func (p *answerFilePrompter) AuthToken() (string, error) {
+	const prompt = "Paste your authentication token:"
+	if answer, ok, err := p.stringAnswer(prompt); err != nil {
+		return "", err
+	} else if ok {
+		return answer, nil
+	}
+	return p.Prompter.AuthToken()
+}
+
+func (p *answerFilePrompter) ConfirmDeletion(requiredValue string) error {
+	prompt := fmt.Sprintf("Type %s to confirm deletion:", requiredValue)
+	answer, ok, err := p.stringAnswer(prompt)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return p.Prompter.ConfirmDeletion(requiredValue)
+	}
+	if !strings.EqualFold(answer, requiredValue) {
+		return fmt.Errorf("You entered %s", answer)
+	}
+	return nil
+}
+
+func (p *answerFilePrompter) InputHostname() (string, error) {
+	const prompt = "GHE hostname:"
+	if answer, ok, err := p.stringAnswer(prompt); err != nil {
+		return "", err
+	} else if ok {
+		return answer, nil
+	}
+	return p.Prompter.InputHostname()
+}
+
+func (p *answerFilePrompter) MarkdownEditor(prompt, defaultValue string, blankAllowed bool) (string, error) {
+	if answer, ok, err := p.stringAnswer(prompt); err != nil {
+		return "", err
+	} else if ok {
+		return answer, nil
+	}
+	return p.Prompter.MarkdownEditor(prompt, defaultValue, blankAllowed)
+}
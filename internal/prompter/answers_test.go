@@ -0,0 +1,106 @@
+package prompter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeAnswersFile(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "answers.yml")
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0600))
+	return path
+}
+
+func TestWithAnswers_Select(t *testing.T) {
+	path := writeAnswersFile(t, `"Choose a protocol:": HTTPS`)
+
+	mock := NewMockPrompter(t)
+	p, err := WithAnswers(mock, path)
+	require.NoError(t, err)
+
+	ix, err := p.Select("Choose a protocol:", "", []string{"HTTPS", "SSH"})
+	require.NoError(t, err)
+	assert.Equal(t, 0, ix)
+}
+
+func TestWithAnswers_SelectUnknownOption(t *testing.T) {
+	path := writeAnswersFile(t, `"Choose a protocol:": GIT`)
+
+	mock := NewMockPrompter(t)
+	p, err := WithAnswers(mock, path)
+	require.NoError(t, err)
+
+	_, err = p.Select("Choose a protocol:", "", []string{"HTTPS", "SSH"})
+	assert.EqualError(t, err, `answer "GIT" for "Choose a protocol:" is not one of the available options`)
+}
+
+func TestWithAnswers_FallsThroughWhenNoMatch(t *testing.T) {
+	path := writeAnswersFile(t, `"Choose a protocol:": HTTPS`)
+
+	mock := NewMockPrompter(t)
+	mock.RegisterInput("Some other prompt:", func(_, _ string) (string, error) {
+		return "typed answer", nil
+	})
+
+	p, err := WithAnswers(mock, path)
+	require.NoError(t, err)
+
+	answer, err := p.Input("Some other prompt:", "")
+	require.NoError(t, err)
+	assert.Equal(t, "typed answer", answer)
+}
+
+func TestWithAnswers_Confirm(t *testing.T) {
+	path := writeAnswersFile(t, `"Proceed?": true`)
+
+	mock := NewMockPrompter(t)
+	p, err := WithAnswers(mock, path)
+	require.NoError(t, err)
+
+	answer, err := p.Confirm("Proceed?", false)
+	require.NoError(t, err)
+	assert.True(t, answer)
+}
+
+func TestWithAnswers_ConfirmDeletion(t *testing.T) {
+	path := writeAnswersFile(t, `"Type my-repo to confirm deletion:": my-repo`)
+
+	mock := NewMockPrompter(t)
+	p, err := WithAnswers(mock, path)
+	require.NoError(t, err)
+
+	require.NoError(t, p.ConfirmDeletion("my-repo"))
+}
+
+func TestWithAnswers_ConfirmDeletionMismatch(t *testing.T) {
+	path := writeAnswersFile(t, `"Type my-repo to confirm deletion:": wrong-repo`)
+
+	mock := NewMockPrompter(t)
+	p, err := WithAnswers(mock, path)
+	require.NoError(t, err)
+
+	assert.EqualError(t, p.ConfirmDeletion("my-repo"), "You entered wrong-repo")
+}
+
+func TestWithAnswers_MultiSelect(t *testing.T) {
+	path := writeAnswersFile(t, "\"Pick labels:\":\n  - bug\n  - docs\n")
+
+	mock := NewMockPrompter(t)
+	p, err := WithAnswers(mock, path)
+	require.NoError(t, err)
+
+	ixs, err := p.MultiSelect("Pick labels:", nil, []string{"bug", "enhancement", "docs"})
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 2}, ixs)
+}
+
+func TestWithAnswers_InvalidFile(t *testing.T) {
+	mock := NewMockPrompter(t)
+	_, err := WithAnswers(mock, filepath.Join(t.TempDir(), "missing.yml"))
+	assert.Error(t, err)
+}
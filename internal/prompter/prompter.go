@@ -6,6 +6,7 @@ import (
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/trace"
 	"github.com/cli/cli/v2/pkg/surveyext"
 	ghPrompter "github.com/cli/go-gh/v2/pkg/prompter"
 )
@@ -45,23 +46,38 @@ type surveyPrompter struct {
 }
 
 func (p *surveyPrompter) Select(prompt, defaultValue string, options []string) (int, error) {
-	return p.prompter.Select(prompt, defaultValue, options)
+	span := trace.Default().StartSpan("prompt", map[string]any{"type": "select", "prompt": prompt})
+	result, err := p.prompter.Select(prompt, defaultValue, options)
+	span.End(nil)
+	return result, err
 }
 
 func (p *surveyPrompter) MultiSelect(prompt string, defaultValues, options []string) ([]int, error) {
-	return p.prompter.MultiSelect(prompt, defaultValues, options)
+	span := trace.Default().StartSpan("prompt", map[string]any{"type": "multi_select", "prompt": prompt})
+	result, err := p.prompter.MultiSelect(prompt, defaultValues, options)
+	span.End(nil)
+	return result, err
 }
 
 func (p *surveyPrompter) Input(prompt, defaultValue string) (string, error) {
-	return p.prompter.Input(prompt, defaultValue)
+	span := trace.Default().StartSpan("prompt", map[string]any{"type": "input", "prompt": prompt})
+	result, err := p.prompter.Input(prompt, defaultValue)
+	span.End(nil)
+	return result, err
 }
 
 func (p *surveyPrompter) Password(prompt string) (string, error) {
-	return p.prompter.Password(prompt)
+	span := trace.Default().StartSpan("prompt", map[string]any{"type": "password", "prompt": prompt})
+	result, err := p.prompter.Password(prompt)
+	span.End(nil)
+	return result, err
 }
 
 func (p *surveyPrompter) Confirm(prompt string, defaultValue bool) (bool, error) {
-	return p.prompter.Confirm(prompt, defaultValue)
+	span := trace.Default().StartSpan("prompt", map[string]any{"type": "confirm", "prompt": prompt})
+	result, err := p.prompter.Confirm(prompt, defaultValue)
+	span.End(nil)
+	return result, err
 }
 
 func (p *surveyPrompter) AuthToken() (string, error) {
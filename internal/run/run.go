@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/cli/cli/v2/internal/trace"
 	"github.com/cli/cli/v2/utils"
 )
 
@@ -34,7 +35,9 @@ func (c cmdWithStderr) Output() ([]byte, error) {
 	if isVerbose, _ := utils.IsDebugEnabled(); isVerbose {
 		_ = printArgs(os.Stderr, c.Cmd.Args)
 	}
+	span := trace.Default().StartSpan("git", map[string]any{"args": c.Cmd.Args})
 	out, err := c.Cmd.Output()
+	span.End(map[string]any{"error": errString(err)})
 	if c.Cmd.Stderr != nil || err == nil {
 		return out, err
 	}
@@ -53,12 +56,16 @@ func (c cmdWithStderr) Run() error {
 	if isVerbose, _ := utils.IsDebugEnabled(); isVerbose {
 		_ = printArgs(os.Stderr, c.Cmd.Args)
 	}
+	span := trace.Default().StartSpan("git", map[string]any{"args": c.Cmd.Args})
 	if c.Cmd.Stderr != nil {
-		return c.Cmd.Run()
+		err := c.Cmd.Run()
+		span.End(map[string]any{"error": errString(err)})
+		return err
 	}
 	errStream := &bytes.Buffer{}
 	c.Cmd.Stderr = errStream
 	err := c.Cmd.Run()
+	span.End(map[string]any{"error": errString(err)})
 	if err != nil {
 		err = &CmdError{
 			Args:   c.Cmd.Args,
@@ -69,6 +76,13 @@ func (c cmdWithStderr) Run() error {
 	return err
 }
 
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
 // CmdError provides more visibility into why an exec.Cmd had failed
 type CmdError struct {
 	Args   []string
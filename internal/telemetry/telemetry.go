@@ -0,0 +1,86 @@
+// Package telemetry implements an opt-in, local-only record of command
+// timing and exit codes, written as newline-delimited JSON so it can be
+// read back a line at a time without loading the whole file into memory.
+//
+// Nothing here ever leaves the machine: there is no network client in this
+// package, only a file under the user's state directory.
+package telemetry
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cli/cli/v2/internal/config"
+)
+
+// Record is a single entry in the local telemetry file, describing one
+// invocation of gh.
+type Record struct {
+	Command    string    `json:"command"`
+	StartedAt  time.Time `json:"started_at"`
+	DurationMS int64     `json:"duration_ms"`
+	ExitCode   int       `json:"exit_code"`
+}
+
+// FilePath returns the path to the local NDJSON file that records append
+// to. The file does not need to exist yet.
+func FilePath() string {
+	return filepath.Join(config.StateDir(), "telemetry.ndjson")
+}
+
+// Append writes a record to the local telemetry file, creating it if
+// necessary.
+func Append(command string, startedAt time.Time, duration time.Duration, exitCode int) error {
+	path := FilePath()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	line, err := json.Marshal(Record{
+		Command:    command,
+		StartedAt:  startedAt,
+		DurationMS: duration.Milliseconds(),
+		ExitCode:   exitCode,
+	})
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+	_, err = f.Write(line)
+	return err
+}
+
+// ReadAll reads every record from the local telemetry file. A missing file
+// is treated as an empty history rather than an error. Lines that fail to
+// parse are skipped, since a record being malformed or truncated (e.g. from
+// a crash mid-write) shouldn't make the rest of the history unreadable.
+func ReadAll() ([]Record, error) {
+	f, err := os.Open(FilePath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var records []Record
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var r Record
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			continue
+		}
+		records = append(records, r)
+	}
+	return records, scanner.Err()
+}
@@ -0,0 +1,87 @@
+package text
+
+import "strings"
+
+var sparkBlocks = []rune(" ▁▂▃▄▅▆▇█")
+
+// Sparkline renders counts, oldest first, as a single line of block characters no wider than
+// width. When there are more data points than width, adjacent points are averaged together;
+// when there are fewer, the line is left-padded with blanks so every row lines up on the right.
+// Callers that want a colored sparkline should wrap the result themselves, the same way other
+// table fields are colored.
+func Sparkline(counts []int, width int) string {
+	if width <= 0 || len(counts) == 0 {
+		return ""
+	}
+
+	buckets := make([]int, width)
+	if len(counts) >= width {
+		for i := range buckets {
+			lo := i * len(counts) / width
+			hi := (i + 1) * len(counts) / width
+			if hi <= lo {
+				hi = lo + 1
+			}
+			sum := 0
+			for _, c := range counts[lo:hi] {
+				sum += c
+			}
+			buckets[i] = sum / (hi - lo)
+		}
+	} else {
+		offset := width - len(counts)
+		copy(buckets[offset:], counts)
+	}
+
+	max := 0
+	for _, b := range buckets {
+		if b > max {
+			max = b
+		}
+	}
+
+	out := make([]rune, len(buckets))
+	for i, b := range buckets {
+		level := 0
+		if max > 0 {
+			level = b * (len(sparkBlocks) - 1) / max
+		}
+		out[i] = sparkBlocks[level]
+	}
+	return string(out)
+}
+
+// BarChart renders a single horizontal bar width characters wide, filled left-to-right in
+// proportion to value/max and padded out with a lighter block so every bar is the same length.
+// It's meant to sit inside a table column alongside the raw number, so it returns only the bar
+// itself, with no label.
+func BarChart(value, max, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	if max <= 0 {
+		return strings.Repeat("░", width)
+	}
+
+	filled := value * width / max
+	if filled > width {
+		filled = width
+	}
+	if filled < 0 {
+		filled = 0
+	}
+
+	return strings.Repeat("█", filled) + strings.Repeat("░", width-filled)
+}
+
+// PercentBar is BarChart scaled to a 0-100 percentage, clamping out-of-range values instead of
+// letting them under- or over-fill the bar.
+func PercentBar(pct float64, width int) string {
+	if pct < 0 {
+		pct = 0
+	} else if pct > 100 {
+		pct = 100
+	}
+	const scale = 1000
+	return BarChart(int(pct*scale), 100*scale, width)
+}
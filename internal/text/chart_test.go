@@ -0,0 +1,72 @@
+package text
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSparkline(t *testing.T) {
+	tests := []struct {
+		name   string
+		counts []int
+		width  int
+		want   string
+	}{
+		{name: "empty counts", counts: nil, width: 10, want: ""},
+		{name: "zero width", counts: []int{1, 2, 3}, width: 0, want: ""},
+		{name: "fewer points than width pads left", counts: []int{5}, width: 3, want: "  " + string(sparkBlocks[len(sparkBlocks)-1])},
+		{name: "all zero", counts: []int{0, 0, 0}, width: 3, want: strings.Repeat(string(sparkBlocks[0]), 3)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Sparkline(tt.counts, tt.width))
+		})
+	}
+}
+
+func TestSparkline_downsamples(t *testing.T) {
+	got := Sparkline([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 5)
+	assert.Equal(t, 5, len([]rune(got)))
+}
+
+func TestBarChart(t *testing.T) {
+	tests := []struct {
+		name  string
+		value int
+		max   int
+		width int
+		want  string
+	}{
+		{name: "half full", value: 5, max: 10, width: 10, want: "█████░░░░░"},
+		{name: "zero max", value: 5, max: 0, width: 4, want: "░░░░"},
+		{name: "zero width", value: 5, max: 10, width: 0, want: ""},
+		{name: "over max clamps to full", value: 20, max: 10, width: 4, want: "████"},
+		{name: "negative value clamps to empty", value: -5, max: 10, width: 4, want: "░░░░"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, BarChart(tt.value, tt.max, tt.width))
+		})
+	}
+}
+
+func TestPercentBar(t *testing.T) {
+	tests := []struct {
+		name string
+		pct  float64
+		want string
+	}{
+		{name: "zero", pct: 0, want: "░░░░"},
+		{name: "full", pct: 100, want: "████"},
+		{name: "half", pct: 50, want: "██░░"},
+		{name: "over 100 clamps", pct: 150, want: "████"},
+		{name: "negative clamps", pct: -10, want: "░░░░"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, PercentBar(tt.pct, 4))
+		})
+	}
+}
@@ -0,0 +1,104 @@
+// Package trace implements a structured, leveled logger for diagnosing "gh
+// is slow" style bug reports. It is enabled by setting GH_DEBUG=trace (see
+// `gh help environment`), and records command lifecycle, HTTP, git
+// subprocess, and prompt events as JSON lines to the writer configured via
+// Configure, typically the file named by GH_DEBUG_FILE.
+package trace
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+var (
+	mu      sync.Mutex
+	current = NewLogger(io.Discard)
+)
+
+// Configure installs w as the destination for all trace events recorded
+// through Default until the next call to Configure.
+func Configure(w io.Writer) {
+	mu.Lock()
+	defer mu.Unlock()
+	current = NewLogger(w)
+}
+
+// Default returns the process-wide Logger installed by Configure, or a
+// no-op Logger if tracing was never enabled.
+func Default() *Logger {
+	mu.Lock()
+	defer mu.Unlock()
+	return current
+}
+
+// Logger writes trace events as newline-delimited JSON.
+type Logger struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// NewLogger returns a Logger that writes events to w.
+func NewLogger(w io.Writer) *Logger {
+	return &Logger{enc: json.NewEncoder(w)}
+}
+
+// Event is a single point-in-time occurrence, such as a prompt being shown.
+type Event struct {
+	Time   time.Time      `json:"time"`
+	Kind   string         `json:"kind"`
+	Fields map[string]any `json:"fields,omitempty"`
+}
+
+// Event records a point-in-time occurrence under kind, with optional
+// structured fields.
+func (l *Logger) Event(kind string, fields map[string]any) {
+	if l == nil {
+		return
+	}
+	l.write(Event{Time: time.Now(), Kind: kind, Fields: fields})
+}
+
+// Span represents an in-progress operation, such as an HTTP request or a git
+// subprocess invocation, started by StartSpan.
+type Span struct {
+	logger *Logger
+	kind   string
+	start  time.Time
+	fields map[string]any
+}
+
+// StartSpan begins timing an operation under kind. The returned Span must be
+// finished with End.
+func (l *Logger) StartSpan(kind string, fields map[string]any) *Span {
+	return &Span{logger: l, kind: kind, start: time.Now(), fields: fields}
+}
+
+// End records the span's duration, merging in any additional fields
+// collected over the course of the operation (such as a response's status
+// code).
+func (s *Span) End(fields map[string]any) {
+	if s == nil || s.logger == nil {
+		return
+	}
+
+	merged := make(map[string]any, len(s.fields)+len(fields)+1)
+	for k, v := range s.fields {
+		merged[k] = v
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+	merged["duration_ms"] = time.Since(s.start).Milliseconds()
+
+	s.logger.write(Event{Time: s.start, Kind: s.kind, Fields: merged})
+}
+
+func (l *Logger) write(e Event) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	// Errors encoding or writing a trace event are not actionable by the
+	// caller and must never surface as a command failure.
+	_ = l.enc.Encode(e)
+}
@@ -0,0 +1,54 @@
+package trace
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoggerEvent(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewLogger(buf)
+
+	logger.Event("command.start", map[string]any{"args": []string{"pr", "list"}})
+
+	var e Event
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &e))
+	assert.Equal(t, "command.start", e.Kind)
+	assert.Equal(t, []any{"pr", "list"}, e.Fields["args"])
+}
+
+func TestSpanEnd(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewLogger(buf)
+
+	span := logger.StartSpan("http", map[string]any{"method": "GET"})
+	span.End(map[string]any{"status": 200})
+
+	var e Event
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &e))
+	assert.Equal(t, "http", e.Kind)
+	assert.Equal(t, "GET", e.Fields["method"])
+	assert.EqualValues(t, 200, e.Fields["status"])
+	assert.Contains(t, e.Fields, "duration_ms")
+}
+
+func TestConfigureAndDefault(t *testing.T) {
+	buf := &bytes.Buffer{}
+	Configure(buf)
+	t.Cleanup(func() { Configure(io.Discard) })
+
+	Default().Event("test", nil)
+
+	assert.True(t, strings.Contains(buf.String(), `"kind":"test"`))
+}
+
+func TestNilSpanEndIsNoop(t *testing.T) {
+	var span *Span
+	assert.NotPanics(t, func() { span.End(nil) })
+}
@@ -20,10 +20,13 @@ import (
 	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/ghinstance"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/api/graphqlschema"
+	"github.com/cli/cli/v2/pkg/cmd/api/openapi"
 	"github.com/cli/cli/v2/pkg/cmd/factory"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/cli/cli/v2/pkg/jsoncolor"
+	"github.com/cli/cli/v2/pkg/surveyext"
 	"github.com/cli/go-gh/v2/pkg/jq"
 	"github.com/cli/go-gh/v2/pkg/template"
 	"github.com/spf13/cobra"
@@ -58,6 +61,10 @@ type ApiOptions struct {
 	CacheTTL            time.Duration
 	FilterOutput        string
 	Verbose             bool
+	HelpEndpoint        bool
+	Validate            bool
+	Edit                bool
+	Output              *cmdutil.OutputFileFlag
 }
 
 func NewCmdApi(f *cmdutil.Factory, runF func(*ApiOptions) error) *cobra.Command {
@@ -210,6 +217,12 @@ func NewCmdApi(f *cmdutil.Factory, runF func(*ApiOptions) error) *cobra.Command
 			`),
 		},
 		Args: cobra.ExactArgs(1),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return openapi.CompletePaths(toComplete), cobra.ShellCompDirectiveNoFileComp
+		},
 		PreRun: func(c *cobra.Command, args []string) {
 			opts.BaseRepo = cmdutil.OverrideBaseRepoFunc(f, "")
 		},
@@ -262,6 +275,10 @@ func NewCmdApi(f *cmdutil.Factory, runF func(*ApiOptions) error) *cobra.Command
 				return err
 			}
 
+			if (opts.Validate || opts.Edit) && opts.RequestPath != "graphql" {
+				return cmdutil.FlagErrorf("`--validate` and `--edit` are only supported for `graphql` requests")
+			}
+
 			if runF != nil {
 				return runF(&opts)
 			}
@@ -284,10 +301,18 @@ func NewCmdApi(f *cmdutil.Factory, runF func(*ApiOptions) error) *cobra.Command
 	cmd.Flags().StringVarP(&opts.FilterOutput, "jq", "q", "", "Query to select values from the response using jq syntax")
 	cmd.Flags().DurationVar(&opts.CacheTTL, "cache", 0, "Cache the response, e.g. \"3600s\", \"60m\", \"1h\"")
 	cmd.Flags().BoolVar(&opts.Verbose, "verbose", false, "Include full HTTP request and response in the output")
+	cmd.Flags().BoolVar(&opts.HelpEndpoint, "help-endpoint", false, "Show documentation for the given endpoint instead of making a request")
+	cmd.Flags().BoolVar(&opts.Validate, "validate", false, "Check the \"graphql\" query against the server's schema before sending the request")
+	cmd.Flags().BoolVar(&opts.Edit, "edit", false, "Edit the \"graphql\" query in the configured editor before sending the request")
+	opts.Output = cmdutil.AddOutputFlag(cmd)
 	return cmd
 }
 
-func apiRun(opts *ApiOptions) error {
+func apiRun(opts *ApiOptions) (err error) {
+	if opts.HelpEndpoint {
+		return runHelpEndpoint(opts)
+	}
+
 	params, err := parseFields(opts)
 	if err != nil {
 		return err
@@ -300,6 +325,13 @@ func apiRun(opts *ApiOptions) error {
 	}
 	method := opts.RequestMethod
 	requestHeaders := opts.RequestHeaders
+
+	if opts.Edit {
+		if err := editGraphQLQuery(opts, params); err != nil {
+			return err
+		}
+	}
+
 	var requestBody interface{}
 	if len(params) > 0 {
 		requestBody = params
@@ -309,7 +341,25 @@ func apiRun(opts *ApiOptions) error {
 		method = "POST"
 	}
 
-	if !opts.Silent {
+	if !isGraphQL {
+		if err := validateRequiredParameters(method, requestPath, params); err != nil {
+			return err
+		}
+	}
+
+	out, err := opts.Output.Open(opts.IO.Out)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			out.Discard()
+		} else {
+			err = out.Close()
+		}
+	}()
+
+	if !opts.Silent && !opts.Output.IsSet() {
 		if err := opts.IO.StartPager(); err == nil {
 			defer opts.IO.StopPager()
 		} else {
@@ -317,8 +367,8 @@ func apiRun(opts *ApiOptions) error {
 		}
 	}
 
-	var bodyWriter io.Writer = opts.IO.Out
-	var headersWriter io.Writer = opts.IO.Out
+	var bodyWriter io.Writer = out
+	var headersWriter io.Writer = out
 	if opts.Silent {
 		bodyWriter = io.Discard
 	}
@@ -394,6 +444,12 @@ func apiRun(opts *ApiOptions) error {
 		host = opts.Hostname
 	}
 
+	if opts.Validate {
+		if err := validateGraphQLQuery(api.NewClientFromHTTP(httpClient), host, cfg, params); err != nil {
+			return err
+		}
+	}
+
 	tmpl := template.New(bodyWriter, opts.IO.TerminalWidth(), opts.IO.ColorEnabled())
 	err = tmpl.Parse(opts.Template)
 	if err != nil {
@@ -437,7 +493,7 @@ func apiRun(opts *ApiOptions) error {
 		}
 
 		if hasNextPage && opts.ShowResponseHeaders {
-			fmt.Fprint(opts.IO.Out, "\n")
+			fmt.Fprint(out, "\n")
 		}
 	}
 
@@ -569,6 +625,110 @@ func fillPlaceholders(value string, opts *ApiOptions) (string, error) {
 	}), err
 }
 
+// validateRequiredParameters checks that every required field parameter of a known
+// endpoint has been supplied via `-f`/`-F`, returning a descriptive error if not.
+// Endpoints not present in the openapi registry are not validated.
+func validateRequiredParameters(method, requestPath string, params map[string]interface{}) error {
+	endpoint, ok := openapi.Find(method, requestPath)
+	if !ok {
+		return nil
+	}
+
+	var missing []string
+	for _, name := range endpoint.RequiredFieldParameters() {
+		if _, ok := params[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	sort.Strings(missing)
+	return fmt.Errorf("missing required parameter(s) for %s %s: %s", method, requestPath, strings.Join(missing, ", "))
+}
+
+// editGraphQLQuery opens the "query" field's current value in the configured editor and
+// writes the result back into params.
+func editGraphQLQuery(opts *ApiOptions, params map[string]interface{}) error {
+	initialValue, _ := params["query"].(string)
+
+	editorCommand, err := cmdutil.DetermineEditor(opts.Config)
+	if err != nil {
+		return err
+	}
+
+	edited, err := surveyext.Edit(editorCommand, "*.graphql", initialValue, opts.IO.In, opts.IO.Out, opts.IO.ErrOut)
+	if err != nil {
+		return err
+	}
+
+	params["query"] = edited
+	return nil
+}
+
+// validateGraphQLQuery checks the "query" field against the host's GraphQL schema and
+// returns an error listing any unknown fields, without making the request.
+func validateGraphQLQuery(client graphqlschema.GraphQLClient, host string, cfg gh.Config, params map[string]interface{}) error {
+	query, ok := params["query"].(string)
+	if !ok || query == "" {
+		return errors.New("`--validate` requires a \"query\" field")
+	}
+
+	schema, err := graphqlschema.Load(client, host, cfg.CacheDir(), graphqlschema.DefaultTTL)
+	if err != nil {
+		return err
+	}
+
+	problems, err := schema.Validate(query)
+	if err != nil {
+		return fmt.Errorf("could not parse query: %w", err)
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("query references unknown field(s): %s", strings.Join(problems, ", "))
+	}
+	return nil
+}
+
+// runHelpEndpoint prints documentation for the endpoint named by opts.RequestPath instead
+// of making a request.
+func runHelpEndpoint(opts *ApiOptions) error {
+	method := opts.RequestMethod
+	if !opts.RequestMethodPassed {
+		method = "GET"
+	}
+
+	requestPath, err := fillPlaceholders(opts.RequestPath, opts)
+	if err != nil {
+		return fmt.Errorf("unable to expand placeholder in path: %w", err)
+	}
+
+	endpoint, ok := openapi.Find(method, requestPath)
+	if !ok {
+		return fmt.Errorf("no built-in documentation found for %s %s", method, opts.RequestPath)
+	}
+
+	cs := opts.IO.ColorScheme()
+	w := opts.IO.Out
+	fmt.Fprintf(w, "%s %s\n", cs.Bold(endpoint.Method), endpoint.Path)
+	fmt.Fprintf(w, "%s\n", endpoint.Summary)
+	if len(endpoint.Parameters) > 0 {
+		fmt.Fprintln(w, "\nParameters:")
+		for _, p := range endpoint.Parameters {
+			required := ""
+			if p.Required {
+				required = ", required"
+			}
+			fmt.Fprintf(w, "  %s (%s%s): %s\n", cs.Bold(p.Name), p.In, required, p.Description)
+		}
+	}
+	if endpoint.DocsURL != "" {
+		fmt.Fprintf(w, "\n%s\n", endpoint.DocsURL)
+	}
+
+	return nil
+}
+
 func printHeaders(w io.Writer, headers http.Header, colorize bool) {
 	var names []string
 	for name := range headers {
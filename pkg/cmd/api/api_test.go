@@ -367,6 +367,58 @@ func Test_NewCmdApi(t *testing.T) {
 			},
 			wantsErr: false,
 		},
+		{
+			name: "with help-endpoint",
+			cli:  "repos/{owner}/{repo}/issues --help-endpoint",
+			wants: ApiOptions{
+				Hostname:            "",
+				RequestMethod:       "GET",
+				RequestMethodPassed: false,
+				RequestPath:         "repos/{owner}/{repo}/issues",
+				RequestInputFile:    "",
+				RawFields:           []string(nil),
+				MagicFields:         []string(nil),
+				RequestHeaders:      []string(nil),
+				ShowResponseHeaders: false,
+				Paginate:            false,
+				Silent:              false,
+				CacheTTL:            0,
+				Template:            "",
+				FilterOutput:        "",
+				Verbose:             false,
+				HelpEndpoint:        true,
+			},
+			wantsErr: false,
+		},
+		{
+			name: "with validate and edit",
+			cli:  "graphql --validate --edit",
+			wants: ApiOptions{
+				Hostname:            "",
+				RequestMethod:       "GET",
+				RequestMethodPassed: false,
+				RequestPath:         "graphql",
+				RequestInputFile:    "",
+				RawFields:           []string(nil),
+				MagicFields:         []string(nil),
+				RequestHeaders:      []string(nil),
+				ShowResponseHeaders: false,
+				Paginate:            false,
+				Silent:              false,
+				CacheTTL:            0,
+				Template:            "",
+				FilterOutput:        "",
+				Verbose:             false,
+				Validate:            true,
+				Edit:                true,
+			},
+			wantsErr: false,
+		},
+		{
+			name:     "validate without graphql",
+			cli:      "repos/{owner}/{repo}/issues --validate",
+			wantsErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -404,6 +456,9 @@ func Test_NewCmdApi(t *testing.T) {
 			assert.Equal(t, tt.wants.Template, opts.Template)
 			assert.Equal(t, tt.wants.FilterOutput, opts.FilterOutput)
 			assert.Equal(t, tt.wants.Verbose, opts.Verbose)
+			assert.Equal(t, tt.wants.HelpEndpoint, opts.HelpEndpoint)
+			assert.Equal(t, tt.wants.Validate, opts.Validate)
+			assert.Equal(t, tt.wants.Edit, opts.Edit)
 		})
 	}
 }
@@ -1234,6 +1289,123 @@ func Test_apiRun_DELETE(t *testing.T) {
 	}
 }
 
+func Test_apiRun_missingRequiredParameter(t *testing.T) {
+	ios, _, _, _ := iostreams.Test()
+
+	err := apiRun(&ApiOptions{
+		IO: ios,
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		HttpClient: func() (*http.Client, error) {
+			var tr roundTripper = func(req *http.Request) (*http.Response, error) {
+				t.Fatal("expected no request to be made")
+				return nil, nil
+			}
+			return &http.Client{Transport: tr}, nil
+		},
+		RequestPath:   "repos/OWNER/REPO/issues",
+		RequestMethod: "POST",
+	})
+	require.EqualError(t, err, "missing required parameter(s) for POST repos/OWNER/REPO/issues: title")
+}
+
+func Test_apiRun_validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		wantErr string
+	}{
+		{
+			name:  "valid query",
+			query: "query { viewer { login } }",
+		},
+		{
+			name:    "unknown field",
+			query:   "query { viewer { bogus } }",
+			wantErr: "query references unknown field(s): Query.viewer.bogus",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("XDG_CACHE_HOME", t.TempDir())
+			ios, _, _, _ := iostreams.Test()
+
+			schemaResponse := `{"data":{"__schema":{"queryType":{"name":"Query"},"mutationType":null,"types":[
+				{"kind":"OBJECT","name":"Query","fields":[{"name":"viewer","type":{"kind":"OBJECT","name":"User","ofType":null}}]},
+				{"kind":"OBJECT","name":"User","fields":[{"name":"login","type":{"kind":"SCALAR","name":"String","ofType":null}}]}
+			]}}}`
+
+			requests := 0
+			err := apiRun(&ApiOptions{
+				IO: ios,
+				Config: func() (gh.Config, error) {
+					return config.NewBlankConfig(), nil
+				},
+				HttpClient: func() (*http.Client, error) {
+					var tr roundTripper = func(req *http.Request) (*http.Response, error) {
+						requests++
+						if tt.wantErr != "" && requests > 1 {
+							t.Fatal("expected no request beyond schema introspection for an invalid query")
+						}
+						return &http.Response{
+							StatusCode: 200,
+							Body:       io.NopCloser(strings.NewReader(schemaResponse)),
+							Header:     http.Header{"Content-Type": []string{"application/json"}},
+						}, nil
+					}
+					return &http.Client{Transport: tr}, nil
+				},
+				RequestPath: "graphql",
+				RawFields:   []string{"query=" + tt.query},
+				Validate:    true,
+			})
+
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, 2, requests)
+		})
+	}
+}
+
+func Test_runHelpEndpoint(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+
+	opts := &ApiOptions{
+		IO:          ios,
+		RequestPath: "repos/{owner}/{repo}/issues",
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	require.NoError(t, runHelpEndpoint(opts))
+	out := stdout.String()
+	assert.Contains(t, out, "GET /repos/{owner}/{repo}/issues")
+	assert.Contains(t, out, "List repository issues")
+	assert.Contains(t, out, "owner (path, required)")
+	assert.Contains(t, out, "https://docs.github.com/rest/issues/issues#list-repository-issues")
+}
+
+func Test_runHelpEndpoint_unknown(t *testing.T) {
+	ios, _, _, _ := iostreams.Test()
+
+	opts := &ApiOptions{
+		IO:          ios,
+		RequestPath: "repos/{owner}/{repo}/projects",
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	err := runHelpEndpoint(opts)
+	require.EqualError(t, err, "no built-in documentation found for GET repos/{owner}/{repo}/projects")
+}
+
 func Test_apiRun_inputFile(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -0,0 +1,340 @@
+package graphqlschema
+
+import (
+	"fmt"
+	"strings"
+)
+
+type selectionField struct {
+	name      string
+	onType    string // set for inline fragments ("... on Type { ... }"); empty for plain fields
+	spread    string // set for fragment spreads ("...Name"); empty otherwise
+	selection []selectionField
+}
+
+type operation struct {
+	kind      string // "query", "mutation", or "subscription"
+	selection []selectionField
+}
+
+type fragmentDef struct {
+	onType    string
+	selection []selectionField
+}
+
+type document struct {
+	operations []operation
+	fragments  map[string]fragmentDef
+}
+
+// Validate parses query and reports every field selected that doesn't exist on the schema
+// type it's selected from, as dotted paths like "Repository.issues.nonExistentField".
+// Constructs the parser can't resolve (fragments it hasn't seen, unions selected without a
+// type-narrowing fragment, subscription operations) are skipped rather than flagged, since
+// this is meant to catch typos, not to be a full GraphQL validator.
+func (s *Schema) Validate(query string) ([]string, error) {
+	doc, err := parseDocument(query)
+	if err != nil {
+		return nil, err
+	}
+
+	var problems []string
+	for _, op := range doc.operations {
+		rootType := s.QueryTypeName
+		if op.kind == "mutation" {
+			rootType = s.MutationTypeName
+		} else if op.kind == "subscription" {
+			// The subscription root type isn't part of the fetched schema.
+			rootType = ""
+		}
+		if rootType == "" {
+			continue
+		}
+		problems = append(problems, s.validateSelection(op.selection, rootType, rootType, doc.fragments)...)
+	}
+	return problems, nil
+}
+
+func (s *Schema) validateSelection(selection []selectionField, typeName, path string, fragments map[string]fragmentDef) []string {
+	fields := s.FieldsOf(typeName)
+
+	var problems []string
+	for _, f := range selection {
+		if f.spread != "" {
+			if frag, ok := fragments[f.spread]; ok {
+				problems = append(problems, s.validateSelection(frag.selection, frag.onType, path, fragments)...)
+			}
+			continue
+		}
+		if f.onType != "" {
+			problems = append(problems, s.validateSelection(f.selection, f.onType, path, fragments)...)
+			continue
+		}
+		if f.name == "__typename" {
+			continue
+		}
+		if fields == nil {
+			// typeName isn't a known object/interface (e.g. a scalar or a union selected
+			// without a type-narrowing fragment) - nothing we can check here.
+			continue
+		}
+
+		fieldPath := path + "." + f.name
+		returnType, ok := fields[f.name]
+		if !ok {
+			problems = append(problems, fieldPath)
+			continue
+		}
+		if len(f.selection) > 0 {
+			problems = append(problems, s.validateSelection(f.selection, returnType, fieldPath, fragments)...)
+		}
+	}
+	return problems
+}
+
+// parseDocument parses a (possibly minimal) GraphQL query document into its operations
+// and named fragment definitions.
+func parseDocument(src string) (document, error) {
+	p := &queryParser{src: src}
+	doc := document{fragments: map[string]fragmentDef{}}
+
+	p.skipIgnored()
+	for p.pos < len(p.src) {
+		switch {
+		case p.consumeKeyword("query"):
+			op, err := p.parseOperation("query")
+			if err != nil {
+				return doc, err
+			}
+			doc.operations = append(doc.operations, op)
+		case p.consumeKeyword("mutation"):
+			op, err := p.parseOperation("mutation")
+			if err != nil {
+				return doc, err
+			}
+			doc.operations = append(doc.operations, op)
+		case p.consumeKeyword("subscription"):
+			op, err := p.parseOperation("subscription")
+			if err != nil {
+				return doc, err
+			}
+			doc.operations = append(doc.operations, op)
+		case p.consumeKeyword("fragment"):
+			name, def, err := p.parseFragmentDefinition()
+			if err != nil {
+				return doc, err
+			}
+			doc.fragments[name] = def
+		case p.peek() == '{':
+			selection, err := p.parseSelectionSet()
+			if err != nil {
+				return doc, err
+			}
+			doc.operations = append(doc.operations, operation{kind: "query", selection: selection})
+		default:
+			return doc, fmt.Errorf("unexpected input at position %d", p.pos)
+		}
+		p.skipIgnored()
+	}
+
+	return doc, nil
+}
+
+type queryParser struct {
+	src string
+	pos int
+}
+
+func (p *queryParser) peek() byte {
+	if p.pos >= len(p.src) {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func (p *queryParser) skipIgnored() {
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		if c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',' {
+			p.pos++
+			continue
+		}
+		if c == '#' {
+			for p.pos < len(p.src) && p.src[p.pos] != '\n' {
+				p.pos++
+			}
+			continue
+		}
+		break
+	}
+}
+
+func isNameByte(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+func (p *queryParser) parseName() string {
+	start := p.pos
+	for p.pos < len(p.src) && isNameByte(p.src[p.pos]) {
+		p.pos++
+	}
+	return p.src[start:p.pos]
+}
+
+func (p *queryParser) consumeKeyword(kw string) bool {
+	save := p.pos
+	p.skipIgnored()
+	name := p.parseName()
+	if name == kw {
+		return true
+	}
+	p.pos = save
+	return false
+}
+
+// skipBalanced consumes a balanced (...) or [...] construct starting at the current position.
+func (p *queryParser) skipBalanced(open, close byte) {
+	if p.peek() != open {
+		return
+	}
+	depth := 0
+	for p.pos < len(p.src) {
+		switch p.src[p.pos] {
+		case open:
+			depth++
+		case close:
+			depth--
+			if depth == 0 {
+				p.pos++
+				return
+			}
+		case '"':
+			p.skipStringLiteral()
+			continue
+		}
+		p.pos++
+	}
+}
+
+func (p *queryParser) skipStringLiteral() {
+	p.pos++ // opening quote
+	for p.pos < len(p.src) && p.src[p.pos] != '"' {
+		if p.src[p.pos] == '\\' {
+			p.pos++
+		}
+		p.pos++
+	}
+	if p.pos < len(p.src) {
+		p.pos++ // closing quote
+	}
+}
+
+func (p *queryParser) parseOperation(kind string) (operation, error) {
+	p.skipIgnored()
+	if p.peek() != '{' && p.peek() != '(' {
+		p.parseName() // optional operation name
+	}
+	p.skipIgnored()
+	p.skipBalanced('(', ')') // optional variable definitions
+	p.skipIgnored()
+	selection, err := p.parseSelectionSet()
+	return operation{kind: kind, selection: selection}, err
+}
+
+func (p *queryParser) parseFragmentDefinition() (string, fragmentDef, error) {
+	p.skipIgnored()
+	name := p.parseName()
+	p.skipIgnored()
+	p.consumeKeyword("on")
+	p.skipIgnored()
+	onType := p.parseName()
+	p.skipIgnored()
+	selection, err := p.parseSelectionSet()
+	return name, fragmentDef{onType: onType, selection: selection}, err
+}
+
+func (p *queryParser) parseSelectionSet() ([]selectionField, error) {
+	p.skipIgnored()
+	if p.peek() != '{' {
+		return nil, fmt.Errorf("expected selection set at position %d", p.pos)
+	}
+	p.pos++ // consume '{'
+
+	var fields []selectionField
+	for {
+		p.skipIgnored()
+		if p.peek() == '}' {
+			p.pos++
+			return fields, nil
+		}
+		if p.pos >= len(p.src) {
+			return fields, fmt.Errorf("unterminated selection set")
+		}
+
+		if strings.HasPrefix(p.src[p.pos:], "...") {
+			p.pos += 3
+			f, err := p.parseFragmentUse()
+			if err != nil {
+				return fields, err
+			}
+			fields = append(fields, f)
+			continue
+		}
+
+		f, err := p.parseField()
+		if err != nil {
+			return fields, err
+		}
+		fields = append(fields, f)
+	}
+}
+
+func (p *queryParser) parseFragmentUse() (selectionField, error) {
+	p.skipIgnored()
+	if p.consumeKeyword("on") {
+		p.skipIgnored()
+		onType := p.parseName()
+		p.skipIgnored()
+		selection, err := p.parseSelectionSet()
+		return selectionField{onType: onType, selection: selection}, err
+	}
+	name := p.parseName()
+	return selectionField{spread: name}, nil
+}
+
+func (p *queryParser) parseField() (selectionField, error) {
+	p.skipIgnored()
+	name := p.parseName()
+	if name == "" {
+		return selectionField{}, fmt.Errorf("expected a field name at position %d", p.pos)
+	}
+
+	p.skipIgnored()
+	if p.peek() == ':' {
+		p.pos++ // consume ':'
+		p.skipIgnored()
+		name = p.parseName() // the preceding name was an alias; this is the real field name
+	}
+
+	p.skipIgnored()
+	p.skipBalanced('(', ')') // arguments
+
+	p.skipIgnored()
+	for p.peek() == '@' {
+		p.pos++
+		p.parseName()
+		p.skipIgnored()
+		p.skipBalanced('(', ')')
+		p.skipIgnored()
+	}
+
+	f := selectionField{name: name}
+	if p.peek() == '{' {
+		selection, err := p.parseSelectionSet()
+		if err != nil {
+			return f, err
+		}
+		f.selection = selection
+	}
+	return f, nil
+}
@@ -0,0 +1,105 @@
+package graphqlschema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSchema() *Schema {
+	return &Schema{
+		QueryTypeName:    "Query",
+		MutationTypeName: "Mutation",
+		fieldsByType: map[string]map[string]string{
+			"Query": {
+				"repository": "Repository",
+				"viewer":     "User",
+			},
+			"Mutation": {
+				"createIssue": "CreateIssuePayload",
+			},
+			"Repository": {
+				"name":   "String",
+				"issues": "IssueConnection",
+			},
+			"IssueConnection": {
+				"nodes": "Issue",
+			},
+			"Issue": {
+				"title": "String",
+				"body":  "String",
+			},
+			"User": {
+				"login": "String",
+			},
+			"CreateIssuePayload": {
+				"issue": "Issue",
+			},
+		},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		wantErrs []string
+	}{
+		{
+			name: "valid nested query",
+			query: `query {
+				repository(owner: "cli", name: "cli") {
+					name
+					issues {
+						nodes { title body }
+					}
+				}
+			}`,
+		},
+		{
+			name: "unknown nested field",
+			query: `query {
+				repository(owner: "cli", name: "cli") {
+					issues {
+						nodes { title nonExistentField }
+					}
+				}
+			}`,
+			wantErrs: []string{"Query.repository.issues.nodes.nonExistentField"},
+		},
+		{
+			name:     "unknown top-level field",
+			query:    `{ repository(owner: "cli", name: "cli") { name } viewr { login } }`,
+			wantErrs: []string{"Query.viewr"},
+		},
+		{
+			name:  "__typename is always valid",
+			query: `{ repository(owner: "cli", name: "cli") { __typename name } }`,
+		},
+		{
+			name:  "mutation root",
+			query: `mutation { createIssue(input: {}) { issue { title } } }`,
+		},
+		{
+			name: "named fragment is resolved",
+			query: `
+				query { repository(owner: "cli", name: "cli") { ...repoFields } }
+				fragment repoFields on Repository { name bogus }
+			`,
+			wantErrs: []string{"Query.repository.bogus"},
+		},
+		{
+			name:  "aliased field is checked by its real name",
+			query: `{ repo: repository(owner: "cli", name: "cli") { n: name } }`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problems, err := testSchema().Validate(tt.query)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.wantErrs, problems)
+		})
+	}
+}
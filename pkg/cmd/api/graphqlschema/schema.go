@@ -0,0 +1,185 @@
+// Package graphqlschema supports `gh api graphql --validate` by fetching and caching a
+// host's GraphQL introspection schema, and checking a query's field selections against it.
+package graphqlschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// DefaultTTL is how long a cached schema is considered fresh before it is re-fetched.
+// GHES instances upgrade infrequently, so a cached schema is valid for a while.
+const DefaultTTL = 24 * time.Hour
+
+// introspectionQuery requests just enough of the standard GraphQL introspection schema
+// to validate field selections: every named type's kind, and for object and interface
+// types, the name and (wrapped) return type of each of their fields.
+const introspectionQuery = `
+query {
+	__schema {
+		queryType { name }
+		mutationType { name }
+		types {
+			kind
+			name
+			fields {
+				name
+				type { ...typeRef }
+			}
+		}
+	}
+}
+fragment typeRef on __Type {
+	kind
+	name
+	ofType {
+		kind
+		name
+		ofType {
+			kind
+			name
+			ofType {
+				kind
+				name
+				ofType {
+					kind
+					name
+				}
+			}
+		}
+	}
+}
+`
+
+type graphqlType struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+type fieldType struct {
+	Kind   string     `json:"kind"`
+	Name   string     `json:"name"`
+	OfType *fieldType `json:"ofType"`
+}
+
+type schemaField struct {
+	Name string    `json:"name"`
+	Type fieldType `json:"type"`
+}
+
+type schemaType struct {
+	Kind   string        `json:"kind"`
+	Name   string        `json:"name"`
+	Fields []schemaField `json:"fields"`
+}
+
+type introspectionResult struct {
+	Schema struct {
+		QueryType    *graphqlType `json:"queryType"`
+		MutationType *graphqlType `json:"mutationType"`
+		Types        []schemaType `json:"types"`
+	} `json:"__schema"`
+}
+
+// Schema is a queryable view of a host's GraphQL schema, built from an introspection result.
+type Schema struct {
+	QueryTypeName    string
+	MutationTypeName string
+	// fieldsByType maps a named object or interface type to its field name -> return type name.
+	fieldsByType map[string]map[string]string
+}
+
+// cacheEnvelope is the on-disk representation of a cached schema.
+type cacheEnvelope struct {
+	FetchedAt time.Time           `json:"fetchedAt"`
+	Result    introspectionResult `json:"result"`
+}
+
+// GraphQLClient is satisfied by api.Client.
+type GraphQLClient interface {
+	GraphQL(hostname, query string, variables map[string]interface{}, data interface{}) error
+}
+
+// Load returns the GraphQL schema for hostname, reading it from the on-disk cache under
+// cacheDir if present and younger than ttl, or fetching and caching it otherwise.
+func Load(client GraphQLClient, hostname, cacheDir string, ttl time.Duration) (*Schema, error) {
+	cachePath := cacheFilePath(cacheDir, hostname)
+
+	if env, err := readCache(cachePath); err == nil && time.Since(env.FetchedAt) < ttl {
+		return newSchema(env.Result), nil
+	}
+
+	var result introspectionResult
+	if err := client.GraphQL(hostname, introspectionQuery, nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to fetch GraphQL schema for %s: %w", hostname, err)
+	}
+
+	_ = writeCache(cachePath, cacheEnvelope{FetchedAt: time.Now(), Result: result})
+
+	return newSchema(result), nil
+}
+
+func newSchema(result introspectionResult) *Schema {
+	s := &Schema{fieldsByType: map[string]map[string]string{}}
+	if result.Schema.QueryType != nil {
+		s.QueryTypeName = result.Schema.QueryType.Name
+	}
+	if result.Schema.MutationType != nil {
+		s.MutationTypeName = result.Schema.MutationType.Name
+	}
+	for _, t := range result.Schema.Types {
+		if t.Kind != "OBJECT" && t.Kind != "INTERFACE" {
+			continue
+		}
+		fields := make(map[string]string, len(t.Fields))
+		for _, f := range t.Fields {
+			fields[f.Name] = namedTypeOf(f.Type)
+		}
+		s.fieldsByType[t.Name] = fields
+	}
+	return s
+}
+
+// namedTypeOf unwraps NON_NULL and LIST wrappers to find the underlying named type.
+func namedTypeOf(t fieldType) string {
+	for t.Name == "" && t.OfType != nil {
+		t = *t.OfType
+	}
+	return t.Name
+}
+
+// FieldsOf returns the field name -> return type name map for a named object or interface
+// type, or nil if typeName isn't a known object/interface (e.g. it's a scalar, enum, or union).
+func (s *Schema) FieldsOf(typeName string) map[string]string {
+	return s.fieldsByType[typeName]
+}
+
+func cacheFilePath(cacheDir, hostname string) string {
+	return filepath.Join(cacheDir, "graphql-schema", hostname+".json")
+}
+
+func readCache(path string) (cacheEnvelope, error) {
+	var env cacheEnvelope
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return env, err
+	}
+	if err := json.Unmarshal(data, &env); err != nil {
+		return env, err
+	}
+	return env, nil
+}
+
+func writeCache(path string, env cacheEnvelope) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	data, err := json.Marshal(env)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
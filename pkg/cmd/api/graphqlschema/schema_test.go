@@ -0,0 +1,60 @@
+package graphqlschema
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeGraphQLClient struct {
+	calls  int
+	result introspectionResult
+	err    error
+}
+
+func (f *fakeGraphQLClient) GraphQL(hostname, query string, variables map[string]interface{}, data interface{}) error {
+	f.calls++
+	if f.err != nil {
+		return f.err
+	}
+	*data.(*introspectionResult) = f.result
+	return nil
+}
+
+func TestLoad(t *testing.T) {
+	cacheDir := t.TempDir()
+	client := &fakeGraphQLClient{
+		result: introspectionResult{
+			Schema: struct {
+				QueryType    *graphqlType `json:"queryType"`
+				MutationType *graphqlType `json:"mutationType"`
+				Types        []schemaType `json:"types"`
+			}{
+				QueryType: &graphqlType{Name: "Query"},
+				Types: []schemaType{
+					{Kind: "OBJECT", Name: "Query", Fields: []schemaField{
+						{Name: "viewer", Type: fieldType{Kind: "OBJECT", Name: "User"}},
+					}},
+				},
+			},
+		},
+	}
+
+	schema, err := Load(client, "github.com", cacheDir, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, "Query", schema.QueryTypeName)
+	assert.Equal(t, 1, client.calls)
+
+	// A second load within the TTL should be served from the cache, not the client.
+	schema, err = Load(client, "github.com", cacheDir, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, "Query", schema.QueryTypeName)
+	assert.Equal(t, 1, client.calls)
+
+	// A zero TTL forces a re-fetch.
+	_, err = Load(client, "github.com", cacheDir, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, client.calls)
+}
@@ -0,0 +1,121 @@
+// Package openapi provides a small, built-in subset of the GitHub REST API
+// description used by `gh api` to complete endpoint paths, validate required
+// parameters, and render per-endpoint help. The full GitHub OpenAPI
+// description is tens of megabytes, so rather than embedding or fetching it
+// wholesale, this package ships a curated set of the most commonly used
+// endpoints. Anything not covered here is simply not validated or completed,
+// the same as before this package existed.
+package openapi
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+
+	_ "embed"
+)
+
+//go:embed endpoints.json
+var endpointsJSON []byte
+
+// A Parameter describes a single path, query, or body parameter accepted by an Endpoint.
+type Parameter struct {
+	Name        string `json:"name"`
+	In          string `json:"in"`
+	Required    bool   `json:"required"`
+	Description string `json:"description"`
+}
+
+// An Endpoint describes a single REST API operation.
+type Endpoint struct {
+	Method     string      `json:"method"`
+	Path       string      `json:"path"`
+	Summary    string      `json:"summary"`
+	DocsURL    string      `json:"docsUrl"`
+	Parameters []Parameter `json:"parameters"`
+}
+
+var (
+	loadOnce  sync.Once
+	endpoints []Endpoint
+)
+
+func all() []Endpoint {
+	loadOnce.Do(func() {
+		// The embedded JSON is a build-time asset under our control; a parse
+		// failure here indicates a bug in this package, not bad user input.
+		if err := json.Unmarshal(endpointsJSON, &endpoints); err != nil {
+			panic("openapi: invalid embedded endpoints.json: " + err.Error())
+		}
+	})
+	return endpoints
+}
+
+// Find returns the Endpoint matching the given HTTP method and request path, if known.
+// The path may contain concrete values in place of an endpoint's {placeholder} segments,
+// and may have a leading slash, trailing slash, or query string; all are ignored for matching.
+func Find(method, path string) (Endpoint, bool) {
+	segments := pathSegments(path)
+	for _, ep := range all() {
+		if !strings.EqualFold(ep.Method, method) {
+			continue
+		}
+		if segmentsMatch(pathSegments(ep.Path), segments) {
+			return ep, true
+		}
+	}
+	return Endpoint{}, false
+}
+
+// CompletePaths returns the known endpoint paths that start with prefix, for use in shell completion.
+func CompletePaths(prefix string) []string {
+	prefix = strings.TrimPrefix(prefix, "/")
+	seen := map[string]bool{}
+	var matches []string
+	for _, ep := range all() {
+		p := strings.TrimPrefix(ep.Path, "/")
+		if seen[p] || !strings.HasPrefix(p, prefix) {
+			continue
+		}
+		seen[p] = true
+		matches = append(matches, p)
+	}
+	return matches
+}
+
+// RequiredFieldParameters returns the names of the parameters this Endpoint requires
+// that are supplied as request fields (i.e. via `-f`/`-F`), excluding path parameters,
+// which are instead substituted directly into the endpoint argument.
+func (e Endpoint) RequiredFieldParameters() []string {
+	var names []string
+	for _, p := range e.Parameters {
+		if p.Required && !strings.EqualFold(p.In, "path") {
+			names = append(names, p.Name)
+		}
+	}
+	return names
+}
+
+func pathSegments(path string) []string {
+	path = strings.SplitN(path, "?", 2)[0]
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return nil
+	}
+	return strings.Split(path, "/")
+}
+
+func segmentsMatch(template, actual []string) bool {
+	if len(template) != len(actual) {
+		return false
+	}
+	for i, t := range template {
+		if strings.HasPrefix(t, "{") && strings.HasSuffix(t, "}") {
+			continue
+		}
+		if !strings.EqualFold(t, actual[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,66 @@
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFind(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    string
+		path      string
+		wantFound bool
+		wantPath  string
+	}{
+		{
+			name:      "matches a concrete path against its template",
+			method:    "GET",
+			path:      "/repos/cli/cli/issues",
+			wantFound: true,
+			wantPath:  "/repos/{owner}/{repo}/issues",
+		},
+		{
+			name:      "matches regardless of leading slash or query string",
+			method:    "get",
+			path:      "repos/cli/cli/issues?state=open",
+			wantFound: true,
+			wantPath:  "/repos/{owner}/{repo}/issues",
+		},
+		{
+			name:      "distinguishes by method",
+			method:    "POST",
+			path:      "/repos/cli/cli/issues",
+			wantFound: true,
+			wantPath:  "/repos/{owner}/{repo}/issues",
+		},
+		{
+			name:      "no match for an unknown path",
+			method:    "GET",
+			path:      "/repos/cli/cli/projects",
+			wantFound: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ep, ok := Find(tt.method, tt.path)
+			require.Equal(t, tt.wantFound, ok)
+			if tt.wantFound {
+				assert.Equal(t, tt.wantPath, ep.Path)
+			}
+		})
+	}
+}
+
+func TestRequiredFieldParameters(t *testing.T) {
+	ep, ok := Find("POST", "/repos/cli/cli/issues")
+	require.True(t, ok)
+	assert.Equal(t, []string{"title"}, ep.RequiredFieldParameters())
+}
+
+func TestCompletePaths(t *testing.T) {
+	matches := CompletePaths("repos/{owner}/{repo}/pu")
+	assert.Contains(t, matches, "repos/{owner}/{repo}/pulls")
+}
@@ -3,19 +3,40 @@ package oci
 import (
 	"errors"
 	"fmt"
+	"io"
 
+	"github.com/cli/cli/v2/pkg/cmd/attestation/api"
 	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	protobundle "github.com/sigstore/protobuf-specs/gen/pb-go/bundle/v1"
+	"github.com/sigstore/sigstore-go/pkg/bundle"
 )
 
 var ErrDenied = errors.New("the provided token was denied access to the requested resource, please check the token's expiration and repository access")
 var ErrRegistryAuthz = errors.New("remote registry authorization failed, please authenticate with the registry and try again")
 
+// sigstoreBundleArtifactType is the OCI artifact type that cosign and other
+// tools use when attaching Sigstore bundles to an image via the OCI 1.1
+// referrers API.
+const sigstoreBundleArtifactType = "application/vnd.dev.sigstore.bundle.v0.3+json"
+
 type Client interface {
 	GetImageDigest(imgName string) (*v1.Hash, error)
+	GetAttestations(imgName, digest string) ([]*api.Attestation, error)
+	GetManifestPlatforms(imgName string) ([]PlatformDigest, error)
+}
+
+// PlatformDigest pairs the digest of a platform-specific manifest with the
+// platform it targets, e.g. "linux/amd64". Platform is empty when the image
+// reference resolves directly to a single-platform manifest rather than a
+// manifest list.
+type PlatformDigest struct {
+	Platform string
+	Digest   string
 }
 
 func checkForUnauthorizedOrDeniedErr(err transport.Error) error {
@@ -33,6 +54,8 @@ func checkForUnauthorizedOrDeniedErr(err transport.Error) error {
 type LiveClient struct {
 	parseReference func(string, ...name.Option) (name.Reference, error)
 	get            func(name.Reference, ...remote.Option) (*remote.Descriptor, error)
+	referrers      func(name.Digest, ...remote.Option) (v1.ImageIndex, error)
+	image          func(name.Reference, ...remote.Option) (v1.Image, error)
 }
 
 // where name is formed like ghcr.io/github/my-image-repo
@@ -59,6 +82,125 @@ func (c LiveClient) GetImageDigest(imgName string) (*v1.Hash, error) {
 	return &desc.Digest, nil
 }
 
+// GetAttestations looks up Sigstore bundles attached to the image at digest
+// via the OCI 1.1 referrers API, e.g. attestations attached with
+// `cosign attach attestation`. It returns an empty slice, not an error, if
+// the registry has no referrers for the digest.
+func (c LiveClient) GetAttestations(imgName, digest string) ([]*api.Attestation, error) {
+	ref, err := c.parseReference(imgName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create image tag: %v", err)
+	}
+
+	subject := ref.Context().Digest(digest)
+
+	index, err := c.referrers(subject, remote.WithAuthFromKeychain(authn.DefaultKeychain), remote.WithFilter("artifactType", sigstoreBundleArtifactType))
+	if err != nil {
+		var transportErr *transport.Error
+		if errors.As(err, &transportErr) {
+			if accessErr := checkForUnauthorizedOrDeniedErr(*transportErr); accessErr != nil {
+				return nil, accessErr
+			}
+		}
+		return nil, fmt.Errorf("failed to fetch OCI referrers: %v", err)
+	}
+
+	manifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OCI referrers index: %v", err)
+	}
+
+	var attestations []*api.Attestation
+	for _, desc := range manifest.Manifests {
+		img, err := c.image(ref.Context().Digest(desc.Digest.String()), remote.WithAuthFromKeychain(authn.DefaultKeychain))
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch referrer manifest %s: %v", desc.Digest, err)
+		}
+
+		layers, err := img.Layers()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read referrer manifest %s: %v", desc.Digest, err)
+		}
+
+		for _, layer := range layers {
+			mediaType, err := layer.MediaType()
+			if err != nil || mediaType != types.MediaType(sigstoreBundleArtifactType) {
+				continue
+			}
+
+			raw, err := readLayer(layer)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read attestation bundle %s: %v", desc.Digest, err)
+			}
+
+			b := &bundle.ProtobufBundle{Bundle: new(protobundle.Bundle)}
+			if err := b.UnmarshalJSON(raw); err != nil {
+				return nil, fmt.Errorf("failed to parse attestation bundle %s: %v", desc.Digest, err)
+			}
+
+			attestations = append(attestations, &api.Attestation{Bundle: b})
+		}
+	}
+
+	return attestations, nil
+}
+
+// GetManifestPlatforms resolves imgName to the digest(s) of the manifest(s)
+// it points to. If imgName resolves to a manifest list (a multi-platform
+// image index), it returns one entry per platform in the list. Otherwise it
+// returns a single entry with an empty Platform.
+func (c LiveClient) GetManifestPlatforms(imgName string) ([]PlatformDigest, error) {
+	ref, err := c.parseReference(imgName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create image tag: %v", err)
+	}
+
+	desc, err := c.get(ref, remote.WithAuthFromKeychain(authn.DefaultKeychain))
+	if err != nil {
+		var transportErr *transport.Error
+		if errors.As(err, &transportErr) {
+			if accessErr := checkForUnauthorizedOrDeniedErr(*transportErr); accessErr != nil {
+				return nil, accessErr
+			}
+		}
+		return nil, fmt.Errorf("failed to fetch remote image: %v", err)
+	}
+
+	if !desc.MediaType.IsIndex() {
+		return []PlatformDigest{{Digest: desc.Digest.String()}}, nil
+	}
+
+	index, err := desc.ImageIndex()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest list: %v", err)
+	}
+
+	manifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest list: %v", err)
+	}
+
+	var platforms []PlatformDigest
+	for _, m := range manifest.Manifests {
+		var platform string
+		if m.Platform != nil {
+			platform = m.Platform.String()
+		}
+		platforms = append(platforms, PlatformDigest{Platform: platform, Digest: m.Digest.String()})
+	}
+
+	return platforms, nil
+}
+
+func readLayer(layer v1.Layer) ([]byte, error) {
+	rc, err := layer.Uncompressed()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
 // Unlike other parts of this command set, we cannot pass a custom HTTP client
 // to the go-containerregistry library. This means we have limited visibility
 // into the HTTP requests being made to container registries.
@@ -66,5 +208,7 @@ func NewLiveClient() *LiveClient {
 	return &LiveClient{
 		parseReference: name.ParseReference,
 		get:            remote.Get,
+		referrers:      remote.Referrers,
+		image:          remote.Image,
 	}
 }
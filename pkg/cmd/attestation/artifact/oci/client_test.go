@@ -6,8 +6,13 @@ import (
 
 	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
 
 	"github.com/stretchr/testify/require"
 )
@@ -81,3 +86,159 @@ func TestGetImageDigest_Denied(t *testing.T) {
 	require.ErrorIs(t, err, ErrDenied)
 	require.Nil(t, digest)
 }
+
+const testBundleJSON = `{"mediaType":"application/vnd.dev.sigstore.bundle.v0.3+json"}`
+
+func TestGetAttestations_Success(t *testing.T) {
+	layer := static.NewLayer([]byte(testBundleJSON), types.MediaType(sigstoreBundleArtifactType))
+	img, err := mutate.AppendLayers(empty.Image, layer)
+	require.NoError(t, err)
+
+	idx := mutate.AppendManifests(empty.Index, mutate.IndexAddendum{Add: img})
+
+	c := LiveClient{
+		parseReference: func(string, ...name.Option) (name.Reference, error) {
+			return name.Tag{}, nil
+		},
+		referrers: func(name.Digest, ...remote.Option) (v1.ImageIndex, error) {
+			return idx, nil
+		},
+		image: func(name.Reference, ...remote.Option) (v1.Image, error) {
+			return img, nil
+		},
+	}
+
+	attestations, err := c.GetAttestations("test", "sha256:abc")
+	require.NoError(t, err)
+	require.Len(t, attestations, 1)
+}
+
+func TestGetAttestations_NoReferrers(t *testing.T) {
+	c := LiveClient{
+		parseReference: func(string, ...name.Option) (name.Reference, error) {
+			return name.Tag{}, nil
+		},
+		referrers: func(name.Digest, ...remote.Option) (v1.ImageIndex, error) {
+			return empty.Index, nil
+		},
+		image: func(name.Reference, ...remote.Option) (v1.Image, error) {
+			return nil, fmt.Errorf("should not be called")
+		},
+	}
+
+	attestations, err := c.GetAttestations("test", "sha256:abc")
+	require.NoError(t, err)
+	require.Empty(t, attestations)
+}
+
+func TestGetAttestations_ReferenceFail(t *testing.T) {
+	c := LiveClient{
+		parseReference: func(string, ...name.Option) (name.Reference, error) {
+			return nil, fmt.Errorf("failed to parse reference")
+		},
+	}
+
+	attestations, err := c.GetAttestations("test", "sha256:abc")
+	require.Error(t, err)
+	require.Nil(t, attestations)
+}
+
+func TestGetManifestPlatforms_SingleImage(t *testing.T) {
+	expectedDigest := v1.Hash{Hex: "1234567890abcdef", Algorithm: "sha256"}
+
+	c := LiveClient{
+		parseReference: func(string, ...name.Option) (name.Reference, error) {
+			return name.Tag{}, nil
+		},
+		get: func(name.Reference, ...remote.Option) (*remote.Descriptor, error) {
+			d := remote.Descriptor{}
+			d.Digest = expectedDigest
+			d.MediaType = types.DockerManifestSchema2
+			return &d, nil
+		},
+	}
+
+	platforms, err := c.GetManifestPlatforms("test")
+	require.NoError(t, err)
+	require.Equal(t, []PlatformDigest{{Digest: expectedDigest.String()}}, platforms)
+}
+
+func TestGetManifestPlatforms_ManifestList(t *testing.T) {
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+
+	idx := mutate.AppendManifests(empty.Index, mutate.IndexAddendum{
+		Add: img,
+		Descriptor: v1.Descriptor{
+			Platform: &v1.Platform{OS: "linux", Architecture: "amd64"},
+		},
+	})
+
+	rawManifest, err := idx.RawManifest()
+	require.NoError(t, err)
+	mediaType, err := idx.MediaType()
+	require.NoError(t, err)
+	indexDigest, err := idx.Digest()
+	require.NoError(t, err)
+
+	c := LiveClient{
+		parseReference: func(string, ...name.Option) (name.Reference, error) {
+			return name.Tag{}, nil
+		},
+		get: func(name.Reference, ...remote.Option) (*remote.Descriptor, error) {
+			d := remote.Descriptor{Manifest: rawManifest}
+			d.Digest = indexDigest
+			d.MediaType = mediaType
+			return &d, nil
+		},
+	}
+
+	platforms, err := c.GetManifestPlatforms("test")
+	require.NoError(t, err)
+	require.Len(t, platforms, 1)
+	require.Equal(t, "linux/amd64", platforms[0].Platform)
+}
+
+func TestGetManifestPlatforms_ReferenceFail(t *testing.T) {
+	c := LiveClient{
+		parseReference: func(string, ...name.Option) (name.Reference, error) {
+			return nil, fmt.Errorf("failed to parse reference")
+		},
+	}
+
+	platforms, err := c.GetManifestPlatforms("test")
+	require.Error(t, err)
+	require.Nil(t, platforms)
+}
+
+func TestGetManifestPlatforms_AuthFail(t *testing.T) {
+	c := LiveClient{
+		parseReference: func(string, ...name.Option) (name.Reference, error) {
+			return name.Tag{}, nil
+		},
+		get: func(name.Reference, ...remote.Option) (*remote.Descriptor, error) {
+			return nil, &transport.Error{Errors: []transport.Diagnostic{{Code: transport.UnauthorizedErrorCode}}}
+		},
+	}
+
+	platforms, err := c.GetManifestPlatforms("test")
+	require.Error(t, err)
+	require.ErrorIs(t, err, ErrRegistryAuthz)
+	require.Nil(t, platforms)
+}
+
+func TestGetAttestations_AuthFail(t *testing.T) {
+	c := LiveClient{
+		parseReference: func(string, ...name.Option) (name.Reference, error) {
+			return name.Tag{}, nil
+		},
+		referrers: func(name.Digest, ...remote.Option) (v1.ImageIndex, error) {
+			return nil, &transport.Error{Errors: []transport.Diagnostic{{Code: transport.UnauthorizedErrorCode}}}
+		},
+	}
+
+	attestations, err := c.GetAttestations("test", "sha256:abc")
+	require.Error(t, err)
+	require.ErrorIs(t, err, ErrRegistryAuthz)
+	require.Nil(t, attestations)
+}
@@ -3,6 +3,7 @@ package oci
 import (
 	"fmt"
 
+	"github.com/cli/cli/v2/pkg/cmd/attestation/api"
 	"github.com/google/go-containerregistry/pkg/v1"
 )
 
@@ -15,20 +16,52 @@ func (c MockClient) GetImageDigest(imgName string) (*v1.Hash, error) {
 	}, nil
 }
 
+func (c MockClient) GetAttestations(imgName, digest string) ([]*api.Attestation, error) {
+	return nil, nil
+}
+
+func (c MockClient) GetManifestPlatforms(imgName string) ([]PlatformDigest, error) {
+	return []PlatformDigest{{Digest: "sha256:1234567890abcdef"}}, nil
+}
+
 type ReferenceFailClient struct{}
 
 func (c ReferenceFailClient) GetImageDigest(imgName string) (*v1.Hash, error) {
 	return nil, fmt.Errorf("failed to parse reference")
 }
 
+func (c ReferenceFailClient) GetAttestations(imgName, digest string) ([]*api.Attestation, error) {
+	return nil, fmt.Errorf("failed to parse reference")
+}
+
+func (c ReferenceFailClient) GetManifestPlatforms(imgName string) ([]PlatformDigest, error) {
+	return nil, fmt.Errorf("failed to parse reference")
+}
+
 type AuthFailClient struct{}
 
 func (c AuthFailClient) GetImageDigest(imgName string) (*v1.Hash, error) {
 	return nil, ErrRegistryAuthz
 }
 
+func (c AuthFailClient) GetAttestations(imgName, digest string) ([]*api.Attestation, error) {
+	return nil, ErrRegistryAuthz
+}
+
+func (c AuthFailClient) GetManifestPlatforms(imgName string) ([]PlatformDigest, error) {
+	return nil, ErrRegistryAuthz
+}
+
 type DeniedClient struct{}
 
 func (c DeniedClient) GetImageDigest(imgName string) (*v1.Hash, error) {
 	return nil, ErrDenied
 }
+
+func (c DeniedClient) GetAttestations(imgName, digest string) ([]*api.Attestation, error) {
+	return nil, ErrDenied
+}
+
+func (c DeniedClient) GetManifestPlatforms(imgName string) ([]PlatformDigest, error) {
+	return nil, ErrDenied
+}
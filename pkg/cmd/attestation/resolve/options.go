@@ -0,0 +1,16 @@
+package resolve
+
+import (
+	"github.com/cli/cli/v2/pkg/cmd/attestation/artifact/oci"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/io"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+)
+
+// Options captures the options for the resolve command
+type Options struct {
+	ImageRef  string
+	Platform  string
+	Logger    *io.Handler
+	OCIClient oci.Client
+	exporter  cmdutil.Exporter
+}
@@ -0,0 +1,149 @@
+package resolve
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/artifact/oci"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/auth"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/io"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/distribution/reference"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+)
+
+func NewResolveCmd(f *cmdutil.Factory, runF func(*Options) error) *cobra.Command {
+	opts := &Options{}
+	resolveCmd := &cobra.Command{
+		Use:    "resolve [oci://]<image-reference> [--platform <os/arch>]",
+		Args:   cmdutil.ExactArgs(1, "must specify a container image reference"),
+		Hidden: true,
+		Short:  "Resolve a container image reference to its digest",
+		Long: heredoc.Docf(`
+			### NOTE: This feature is currently in beta, and subject to change.
+
+			Resolve a mutable image reference, such as a tag, to the immutable
+			digest(s) it currently points to.
+
+			If the reference resolves to a manifest list, the digest of every
+			platform-specific manifest in the list is printed. This is useful
+			before running %[1]sgh attestation verify%[1]s or
+			%[1]sgh attestation download%[1]s against an %[1]soci://%[1]s reference,
+			since those commands verify attestations for a single digest and
+			"no attestations found" is often the result of a tag resolving to a
+			digest other than the one the attestations were generated for.
+
+			Use the %[1]s--platform%[1]s flag to only print the digest for a single
+			platform, e.g. %[1]slinux/amd64%[1]s.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Print the digest(s) an image tag currently resolves to
+			$ gh attestation resolve oci://<my-OCI-image>
+
+			# Print only the digest for a single platform
+			$ gh attestation resolve oci://<my-OCI-image> --platform linux/amd64
+		`),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.Logger = io.NewHandler(f.IOStreams)
+			opts.ImageRef = args[0]
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.OCIClient = oci.NewLiveClient()
+
+			if err := auth.IsHostSupported(); err != nil {
+				return err
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			if err := runResolve(opts); err != nil {
+				return fmt.Errorf("Failed to resolve the image reference: %w", err)
+			}
+			return nil
+		},
+	}
+
+	resolveCmd.Flags().StringVarP(&opts.Platform, "platform", "p", "", "Only print the digest for this platform, e.g. linux/amd64")
+	cmdutil.AddFormatFlags(resolveCmd, &opts.exporter)
+
+	return resolveCmd
+}
+
+type resolvedPlatform struct {
+	Platform  string `json:"platform"`
+	Digest    string `json:"digest"`
+	Reference string `json:"reference"`
+}
+
+func runResolve(opts *Options) error {
+	imgName := strings.TrimPrefix(opts.ImageRef, "oci://")
+
+	named, err := reference.Parse(imgName)
+	if err != nil {
+		return fmt.Errorf("%s is not a valid container image reference: %v", imgName, err)
+	}
+	imgName = named.String()
+
+	namedRepo, ok := named.(reference.Named)
+	if !ok {
+		return fmt.Errorf("%s does not refer to a named image repository", imgName)
+	}
+	repo := namedRepo.Name()
+
+	platforms, err := opts.OCIClient.GetManifestPlatforms(imgName)
+	if err != nil {
+		return err
+	}
+
+	if opts.Platform != "" {
+		platforms = filterPlatform(platforms, opts.Platform)
+		if len(platforms) == 0 {
+			return fmt.Errorf("no manifest found for platform %s", opts.Platform)
+		}
+	}
+
+	resolved := make([]resolvedPlatform, len(platforms))
+	for i, p := range platforms {
+		resolved[i] = resolvedPlatform{
+			Platform:  p.Platform,
+			Digest:    p.Digest,
+			Reference: fmt.Sprintf("oci://%s@%s", repo, p.Digest),
+		}
+	}
+
+	if opts.exporter != nil {
+		return opts.exporter.Write(opts.Logger.IO, resolved)
+	}
+
+	headers := []string{"Platform", "Digest", "Reference"}
+	t := tableprinter.New(opts.Logger.IO, tableprinter.WithHeader(headers...))
+
+	for _, r := range resolved {
+		platform := r.Platform
+		if platform == "" {
+			platform = "-"
+		}
+		t.AddField(platform, tableprinter.WithTruncate(nil))
+		t.AddField(r.Digest, tableprinter.WithTruncate(nil))
+		t.AddField(r.Reference, tableprinter.WithTruncate(nil))
+		t.EndRow()
+	}
+
+	return t.Render()
+}
+
+func filterPlatform(platforms []oci.PlatformDigest, platform string) []oci.PlatformDigest {
+	var filtered []oci.PlatformDigest
+	for _, p := range platforms {
+		if p.Platform == platform {
+			filtered = append(filtered, p)
+		}
+	}
+	return filtered
+}
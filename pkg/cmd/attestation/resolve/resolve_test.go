@@ -0,0 +1,174 @@
+package resolve
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmd/attestation/artifact/oci"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/io"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewResolveCmd(t *testing.T) {
+	testIO, _, _, _ := iostreams.Test()
+	f := &cmdutil.Factory{
+		IOStreams: testIO,
+	}
+
+	t.Run("Missing image reference", func(t *testing.T) {
+		cmd := NewResolveCmd(f, func(o *Options) error { return nil })
+		cmd.SetArgs([]string{})
+		cmd.SetIn(&bytes.Buffer{})
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetErr(&bytes.Buffer{})
+		_, err := cmd.ExecuteC()
+		assert.Error(t, err)
+	})
+
+	testcases := []struct {
+		name          string
+		cli           string
+		wantsErr      bool
+		wantsExporter bool
+	}{
+		{
+			name: "Happy path",
+			cli:  "oci://ghcr.io/github/example:v1",
+		},
+		{
+			name: "With platform flag",
+			cli:  "oci://ghcr.io/github/example:v1 --platform linux/amd64",
+		},
+		{
+			name:          "Prints output in JSON format",
+			cli:           "oci://ghcr.io/github/example:v1 --format json",
+			wantsExporter: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			var opts *Options
+			cmd := NewResolveCmd(f, func(o *Options) error {
+				opts = o
+				return nil
+			})
+
+			argv := strings.Split(tc.cli, " ")
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+			_, err := cmd.ExecuteC()
+			if tc.wantsErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.NotNil(t, opts.Logger)
+			assert.NotNil(t, opts.OCIClient)
+			assert.Equal(t, tc.wantsExporter, opts.exporter != nil)
+		})
+	}
+}
+
+type mockManifestListClient struct {
+	oci.MockClient
+	platforms []oci.PlatformDigest
+	err       error
+}
+
+func (m mockManifestListClient) GetManifestPlatforms(imgName string) ([]oci.PlatformDigest, error) {
+	return m.platforms, m.err
+}
+
+func TestRunResolve(t *testing.T) {
+	t.Run("prints every platform in a manifest list", func(t *testing.T) {
+		testIO, _, out, _ := iostreams.Test()
+		opts := Options{
+			ImageRef: "oci://ghcr.io/github/example:v1",
+			Logger:   io.NewHandler(testIO),
+			OCIClient: mockManifestListClient{platforms: []oci.PlatformDigest{
+				{Platform: "linux/amd64", Digest: "sha256:aaa"},
+				{Platform: "linux/arm64", Digest: "sha256:bbb"},
+			}},
+		}
+
+		require.NoError(t, runResolve(&opts))
+		assert.Contains(t, out.String(), "linux/amd64")
+		assert.Contains(t, out.String(), "sha256:aaa")
+		assert.Contains(t, out.String(), "oci://ghcr.io/github/example@sha256:aaa")
+		assert.Contains(t, out.String(), "linux/arm64")
+	})
+
+	t.Run("preserves a registry host with a port in the reference", func(t *testing.T) {
+		testIO, _, out, _ := iostreams.Test()
+		opts := Options{
+			ImageRef: "oci://localhost:5000/github/example:v1",
+			Logger:   io.NewHandler(testIO),
+			OCIClient: mockManifestListClient{platforms: []oci.PlatformDigest{
+				{Platform: "linux/amd64", Digest: "sha256:aaa"},
+			}},
+		}
+
+		require.NoError(t, runResolve(&opts))
+		assert.Contains(t, out.String(), "oci://localhost:5000/github/example@sha256:aaa")
+	})
+
+	t.Run("filters to a single platform", func(t *testing.T) {
+		testIO, _, out, _ := iostreams.Test()
+		opts := Options{
+			ImageRef: "oci://ghcr.io/github/example:v1",
+			Platform: "linux/arm64",
+			Logger:   io.NewHandler(testIO),
+			OCIClient: mockManifestListClient{platforms: []oci.PlatformDigest{
+				{Platform: "linux/amd64", Digest: "sha256:aaa"},
+				{Platform: "linux/arm64", Digest: "sha256:bbb"},
+			}},
+		}
+
+		require.NoError(t, runResolve(&opts))
+		assert.NotContains(t, out.String(), "linux/amd64")
+		assert.Contains(t, out.String(), "linux/arm64")
+	})
+
+	t.Run("returns an error when the platform is not found", func(t *testing.T) {
+		opts := Options{
+			ImageRef: "oci://ghcr.io/github/example:v1",
+			Platform: "windows/amd64",
+			Logger:   io.NewTestHandler(),
+			OCIClient: mockManifestListClient{platforms: []oci.PlatformDigest{
+				{Platform: "linux/amd64", Digest: "sha256:aaa"},
+			}},
+		}
+
+		require.Error(t, runResolve(&opts))
+	})
+
+	t.Run("returns an error for an invalid image reference", func(t *testing.T) {
+		opts := Options{
+			ImageRef:  "oci://",
+			Logger:    io.NewTestHandler(),
+			OCIClient: mockManifestListClient{},
+		}
+
+		require.Error(t, runResolve(&opts))
+	})
+
+	t.Run("returns an error when the registry lookup fails", func(t *testing.T) {
+		opts := Options{
+			ImageRef:  "oci://ghcr.io/github/example:v1",
+			Logger:    io.NewTestHandler(),
+			OCIClient: mockManifestListClient{err: fmt.Errorf("registry unavailable")},
+		}
+
+		require.Error(t, runResolve(&opts))
+	})
+}
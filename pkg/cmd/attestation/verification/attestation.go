@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 
 	"github.com/cli/cli/v2/pkg/cmd/attestation/api"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/artifact/oci"
 	protobundle "github.com/sigstore/protobuf-specs/gen/pb-go/bundle/v1"
 	"github.com/sigstore/sigstore-go/pkg/bundle"
 )
@@ -22,6 +23,12 @@ type FetchAttestationsConfig struct {
 	Limit      int
 	Owner      string
 	Repo       string
+
+	// OCIClient and ArtifactImage are set when the artifact being verified is
+	// an OCI image, so that attestations attached to the image itself via
+	// the OCI 1.1 referrers API can be discovered without a GitHub API call.
+	OCIClient     oci.Client
+	ArtifactImage string
 }
 
 func (c *FetchAttestationsConfig) IsBundleProvided() bool {
@@ -94,6 +101,18 @@ func loadBundlesFromJSONLinesFile(path string) ([]*api.Attestation, error) {
 }
 
 func GetRemoteAttestations(c FetchAttestationsConfig) ([]*api.Attestation, error) {
+	if c.ArtifactImage != "" && c.OCIClient != nil {
+		attestations, err := c.OCIClient.GetAttestations(c.ArtifactImage, c.Digest)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch attestations from OCI registry: %w", err)
+		}
+		if len(attestations) > 0 {
+			return attestations, nil
+		}
+		// Fall back to the GitHub API below; the registry may simply not
+		// have any attestations attached via the referrers API.
+	}
+
 	if c.APIClient == nil {
 		return nil, fmt.Errorf("api client must be provided")
 	}
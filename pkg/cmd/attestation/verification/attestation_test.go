@@ -1,16 +1,37 @@
 package verification
 
 import (
+	"fmt"
 	"testing"
 
+	v1 "github.com/google/go-containerregistry/pkg/v1"
 	protobundle "github.com/sigstore/protobuf-specs/gen/pb-go/bundle/v1"
 	dsse "github.com/sigstore/protobuf-specs/gen/pb-go/dsse"
 	"github.com/sigstore/sigstore-go/pkg/bundle"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cli/cli/v2/pkg/cmd/attestation/api"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/artifact/oci"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/test/data"
 )
 
+type mockOCIClient struct {
+	attestations []*api.Attestation
+	err          error
+}
+
+func (m mockOCIClient) GetImageDigest(imgName string) (*v1.Hash, error) {
+	return nil, nil
+}
+
+func (m mockOCIClient) GetAttestations(imgName, digest string) ([]*api.Attestation, error) {
+	return m.attestations, m.err
+}
+
+func (m mockOCIClient) GetManifestPlatforms(imgName string) ([]oci.PlatformDigest, error) {
+	return nil, nil
+}
+
 func TestLoadBundlesFromJSONLinesFile(t *testing.T) {
 	path := "../test/data/sigstore-js-2.1.0_with_2_bundles.jsonl"
 	attestations, err := loadBundlesFromJSONLinesFile(path)
@@ -53,6 +74,51 @@ func TestGetLocalAttestations(t *testing.T) {
 	})
 }
 
+func TestGetRemoteAttestations_OCIReferrers(t *testing.T) {
+	t.Run("uses OCI referrers when present", func(t *testing.T) {
+		att := api.Attestation{Bundle: data.SigstoreBundle(nil)}
+		c := FetchAttestationsConfig{
+			APIClient:     api.NewFailTestClient(),
+			OCIClient:     mockOCIClient{attestations: []*api.Attestation{&att}},
+			ArtifactImage: "ghcr.io/github/example",
+			Digest:        "sha256:abc",
+			Owner:         "github",
+		}
+
+		attestations, err := GetRemoteAttestations(c)
+		require.NoError(t, err)
+		require.Len(t, attestations, 1)
+	})
+
+	t.Run("falls back to the GitHub API when no referrers are found", func(t *testing.T) {
+		c := FetchAttestationsConfig{
+			APIClient:     api.NewTestClient(),
+			OCIClient:     mockOCIClient{},
+			ArtifactImage: "ghcr.io/github/example",
+			Digest:        "sha256:abc",
+			Owner:         "github",
+		}
+
+		attestations, err := GetRemoteAttestations(c)
+		require.NoError(t, err)
+		require.Len(t, attestations, 2)
+	})
+
+	t.Run("returns an error when the registry lookup fails", func(t *testing.T) {
+		c := FetchAttestationsConfig{
+			APIClient:     api.NewTestClient(),
+			OCIClient:     mockOCIClient{err: fmt.Errorf("registry unavailable")},
+			ArtifactImage: "ghcr.io/github/example",
+			Digest:        "sha256:abc",
+			Owner:         "github",
+		}
+
+		attestations, err := GetRemoteAttestations(c)
+		require.Error(t, err)
+		require.Nil(t, attestations)
+	})
+}
+
 func TestFilterAttestations(t *testing.T) {
 	attestations := []*api.Attestation{
 		{
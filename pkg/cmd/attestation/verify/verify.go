@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/cli/cli/v2/internal/text"
 	"github.com/cli/cli/v2/pkg/cmd/attestation/api"
@@ -55,6 +56,11 @@ func NewVerifyCmd(f *cmdutil.Factory, runF func(*Options) error) *cobra.Command
 			the artifact using attestations stored on disk (c.f. the %[1]sdownload%[1]s command),
 			provide a path to the %[1]s--bundle%[1]s flag.
 
+			For a container image, the command also checks the registry itself for
+			attestations attached via the OCI 1.1 referrers API (e.g. with
+			%[1]scosign attach attestation%[1]s) before falling back to the GitHub API,
+			using your existing container registry credentials.
+
 			To see the full results that are generated upon successful verification, i.e.
 			for use with a policy engine, provide the %[1]s--format=json%[1]s flag.
 
@@ -189,6 +195,10 @@ func runVerify(opts *Options) error {
 		Owner:      opts.Owner,
 		Repo:       opts.Repo,
 	}
+	if imgName, ok := strings.CutPrefix(artifact.URL, "oci://"); ok {
+		c.OCIClient = opts.OCIClient
+		c.ArtifactImage = imgName
+	}
 	attestations, err := verification.GetAttestations(c)
 	if err != nil {
 		if ok := errors.Is(err, api.ErrNoAttestations{}); ok {
@@ -198,6 +208,8 @@ func runVerify(opts *Options) error {
 
 		if c.IsBundleProvided() {
 			opts.Logger.Printf(opts.Logger.ColorScheme.Red("✗ Loading attestations from %s failed\n"), artifact.URL)
+		} else if c.ArtifactImage != "" {
+			opts.Logger.Printf(opts.Logger.ColorScheme.Red("✗ Loading attestations for %s failed\n"), artifact.URL)
 		} else {
 			opts.Logger.Println(opts.Logger.ColorScheme.Red("✗ Loading attestations from GitHub API failed"))
 		}
@@ -207,6 +219,11 @@ func runVerify(opts *Options) error {
 	pluralAttestation := text.Pluralize(len(attestations), "attestation")
 	if c.IsBundleProvided() {
 		opts.Logger.Printf("Loaded %s from %s\n", pluralAttestation, opts.BundlePath)
+	} else if c.ArtifactImage != "" {
+		// attestations may have come from the registry's OCI referrers API
+		// or, failing that, the GitHub API, so we avoid naming a specific
+		// source here
+		opts.Logger.Printf("Loaded %s for %s\n", pluralAttestation, artifact.URL)
 	} else {
 		opts.Logger.Printf("Loaded %s from GitHub API\n", pluralAttestation)
 	}
@@ -3,8 +3,10 @@ package login
 import (
 	"bufio"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -13,21 +15,34 @@ import (
 
 const tokenUser = "x-access-token"
 
+// tokenExpiryWindow is how far ahead of a token's actual expiration git-credential tries to
+// refresh it, so that the token handed back to git is still valid for the length of the
+// operation git is about to perform.
+const tokenExpiryWindow = 5 * time.Minute
+
 type config interface {
 	ActiveToken(string) (string, string)
 	ActiveUser(string) (string, error)
+	ExpiresAt(string) (time.Time, bool)
+	RefreshToken(string) (string, error)
+	UpdateToken(hostname, token string, expiresAt time.Time, newRefreshToken string) error
 }
 
 type CredentialOptions struct {
-	IO     *iostreams.IOStreams
-	Config func() (config, error)
+	IO         *iostreams.IOStreams
+	Config     func() (config, error)
+	HttpClient func() (*http.Client, error)
 
 	Operation string
+
+	// Now stubs out the clock in tests; defaults to time.Now.
+	Now func() time.Time
 }
 
 func NewCmdCredential(f *cmdutil.Factory, runF func(*CredentialOptions) error) *cobra.Command {
 	opts := &CredentialOptions{
-		IO: f.IOStreams,
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
 		Config: func() (config, error) {
 			cfg, err := f.Config()
 			if err != nil {
@@ -118,6 +133,14 @@ func helperRun(opts *CredentialOptions) error {
 		gotToken, source = cfg.ActiveToken(lookupHost)
 	}
 
+	if gotToken != "" && !strings.HasSuffix(source, "_TOKEN") {
+		if refreshed, err := opts.refreshExpiringToken(cfg, lookupHost); err != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "warning: failed to refresh expiring token for %s: %v\n", lookupHost, err)
+		} else if refreshed != "" {
+			gotToken = refreshed
+		}
+	}
+
 	if strings.HasSuffix(source, "_TOKEN") {
 		gotUser = tokenUser
 	} else {
@@ -142,3 +165,70 @@ func helperRun(opts *CredentialOptions) error {
 
 	return nil
 }
+
+func (opts *CredentialOptions) now() time.Time {
+	if opts.Now != nil {
+		return opts.Now()
+	}
+	return time.Now()
+}
+
+// refreshExpiringToken checks whether the active token for hostname is a GitHub App
+// user-to-server token that is about to expire, and if so refreshes and persists it using the
+// stored refresh token. It returns an empty string, with no error, when no refresh was
+// necessary or possible (e.g. the token has no known expiration, or there's no refresh token
+// on record), so that callers fall back to the token they already had.
+//
+// Refreshing is guarded by a cross-process lock, since git commonly spawns several credential
+// helper invocations concurrently (e.g. fetching submodules), and we don't want each of them
+// racing to refresh the same token.
+func (opts *CredentialOptions) refreshExpiringToken(cfg config, hostname string) (string, error) {
+	expiresAt, ok := cfg.ExpiresAt(hostname)
+	if !ok || expiresAt.After(opts.now().Add(tokenExpiryWindow)) {
+		return "", nil
+	}
+
+	unlock, err := acquireRefreshLock(hostname)
+	if err != nil {
+		// Locking is best-effort: we'd rather hand git the token we already have than fail
+		// the git operation outright because a lock file couldn't be created.
+		return "", nil
+	}
+	defer unlock()
+
+	// Re-read the config now that we hold the lock: a concurrent invocation may have already
+	// refreshed the token while we were waiting.
+	cfg, err = opts.Config()
+	if err != nil {
+		return "", err
+	}
+	expiresAt, ok = cfg.ExpiresAt(hostname)
+	if !ok {
+		return "", nil
+	}
+	if expiresAt.After(opts.now().Add(tokenExpiryWindow)) {
+		token, _ := cfg.ActiveToken(hostname)
+		return token, nil
+	}
+
+	refreshToken, err := cfg.RefreshToken(hostname)
+	if err != nil || refreshToken == "" {
+		return "", nil
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return "", err
+	}
+
+	token, newExpiresAt, newRefreshToken, err := refreshAccessToken(httpClient, hostname, refreshToken)
+	if err != nil {
+		return "", err
+	}
+
+	if err := cfg.UpdateToken(hostname, token, newExpiresAt, newRefreshToken); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
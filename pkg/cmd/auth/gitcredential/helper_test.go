@@ -2,9 +2,12 @@ package login
 
 import (
 	"fmt"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/pkg/httpmock"
 	"github.com/cli/cli/v2/pkg/iostreams"
 )
 
@@ -18,6 +21,18 @@ func (c tinyConfig) ActiveUser(host string) (string, error) {
 	return c[fmt.Sprintf("%s:%s", host, "user")], nil
 }
 
+func (c tinyConfig) ExpiresAt(host string) (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (c tinyConfig) RefreshToken(host string) (string, error) {
+	return "", nil
+}
+
+func (c tinyConfig) UpdateToken(host, token string, expiresAt time.Time, newRefreshToken string) error {
+	return nil
+}
+
 func Test_helperRun(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -255,3 +270,75 @@ func Test_helperRun(t *testing.T) {
 		})
 	}
 }
+
+// refreshingConfig is a fake config that reports an expired token with a refresh token on
+// record, and records whatever helperRun ends up persisting via UpdateToken.
+type refreshingConfig struct {
+	token, refreshToken string
+	expiresAt           time.Time
+
+	updated *struct {
+		token, refreshToken string
+		expiresAt           time.Time
+	}
+}
+
+func (c *refreshingConfig) ActiveToken(string) (string, string) { return c.token, "keyring" }
+func (c *refreshingConfig) ActiveUser(string) (string, error)   { return "monalisa", nil }
+func (c *refreshingConfig) ExpiresAt(string) (time.Time, bool)  { return c.expiresAt, true }
+func (c *refreshingConfig) RefreshToken(string) (string, error) { return c.refreshToken, nil }
+func (c *refreshingConfig) UpdateToken(_, token string, expiresAt time.Time, newRefreshToken string) error {
+	c.updated = &struct {
+		token, refreshToken string
+		expiresAt           time.Time
+	}{token, newRefreshToken, expiresAt}
+	return nil
+}
+
+func Test_helperRun_refreshesExpiringToken(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("POST", "login/oauth/access_token"),
+		httpmock.JSONResponse(map[string]interface{}{
+			"access_token":  "NEWTOKEN",
+			"expires_in":    28800,
+			"refresh_token": "NEWREFRESH",
+		}))
+
+	cfg := &refreshingConfig{
+		token:        "OLDTOKEN",
+		refreshToken: "OLDREFRESH",
+		expiresAt:    time.Now().Add(-time.Minute),
+	}
+
+	ios, stdin, stdout, _ := iostreams.Test()
+	fmt.Fprint(stdin, "protocol=https\nhost=example.com\n")
+
+	opts := &CredentialOptions{
+		Operation: "get",
+		IO:        ios,
+		Config: func() (config, error) {
+			return cfg, nil
+		},
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+	}
+
+	if err := helperRun(opts); err != nil {
+		t.Fatalf("helperRun() error = %v", err)
+	}
+
+	wantStdout := "protocol=https\nhost=example.com\nusername=monalisa\npassword=NEWTOKEN\n"
+	if stdout.String() != wantStdout {
+		t.Errorf("stdout: got %q, wants %q", stdout.String(), wantStdout)
+	}
+
+	if cfg.updated == nil {
+		t.Fatal("expected UpdateToken to be called")
+	}
+	if cfg.updated.token != "NEWTOKEN" || cfg.updated.refreshToken != "NEWREFRESH" {
+		t.Errorf("UpdateToken called with token=%q refreshToken=%q", cfg.updated.token, cfg.updated.refreshToken)
+	}
+}
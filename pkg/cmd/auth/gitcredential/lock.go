@@ -0,0 +1,48 @@
+package login
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	ghConfig "github.com/cli/cli/v2/internal/config"
+)
+
+// staleLockAge is how old a lock file can get before a waiting process assumes its owner
+// died without cleaning up and takes over, rather than waiting on it forever.
+const staleLockAge = 30 * time.Second
+
+// acquireRefreshLock takes an advisory, cross-process lock scoped to hostname, so that
+// multiple git subprocesses racing to refresh the same expiring token (e.g. a clone fetching
+// several submodules in parallel) serialize instead of each independently hitting the token
+// endpoint. The caller must call the returned function to release the lock.
+func acquireRefreshLock(hostname string) (func(), error) {
+	dir := filepath.Join(ghConfig.StateDir(), "git-credential-locks")
+	if err := os.MkdirAll(dir, 0771); err != nil {
+		return nil, err
+	}
+	path := filepath.Join(dir, fmt.Sprintf("%s.lock", hostname))
+
+	deadline := time.Now().Add(2 * staleLockAge)
+	for {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			_ = f.Close()
+			return func() { _ = os.Remove(path) }, nil
+		}
+		if !os.IsExist(err) {
+			return nil, err
+		}
+
+		if info, statErr := os.Stat(path); statErr == nil && time.Since(info.ModTime()) > staleLockAge {
+			_ = os.Remove(path)
+			continue
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for refresh lock on %s", hostname)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
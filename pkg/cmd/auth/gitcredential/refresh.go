@@ -0,0 +1,62 @@
+package login
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cli/cli/v2/internal/authflow"
+	"github.com/cli/cli/v2/internal/ghinstance"
+)
+
+type refreshTokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	ExpiresIn        int64  `json:"expires_in"`
+	RefreshToken     string `json:"refresh_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// refreshAccessToken exchanges refreshToken for a new access token using the OAuth app that
+// gh itself authenticated with, returning the new token, its expiration, and (if the server
+// rotated it) the new refresh token to store in its place.
+func refreshAccessToken(httpClient *http.Client, hostname, refreshToken string) (token string, expiresAt time.Time, newRefreshToken string, err error) {
+	endpoint := ghinstance.HostPrefix(hostname) + "login/oauth/access_token"
+
+	body := url.Values{
+		"client_id":     {authflow.OAuthClientID()},
+		"client_secret": {authflow.OAuthClientSecret()},
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+	}
+
+	req, err := http.NewRequest("POST", endpoint, strings.NewReader(body.Encode()))
+	if err != nil {
+		return "", time.Time{}, "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", time.Time{}, "", err
+	}
+	defer resp.Body.Close()
+
+	var parsed refreshTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return "", time.Time{}, "", fmt.Errorf("could not parse token refresh response: %w", err)
+	}
+
+	if parsed.Error != "" {
+		return "", time.Time{}, "", fmt.Errorf("%s: %s", parsed.Error, parsed.ErrorDescription)
+	}
+	if parsed.AccessToken == "" {
+		return "", time.Time{}, "", fmt.Errorf("token refresh response did not include an access token")
+	}
+
+	return parsed.AccessToken, time.Now().Add(time.Duration(parsed.ExpiresIn) * time.Second), parsed.RefreshToken, nil
+}
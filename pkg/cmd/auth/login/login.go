@@ -182,6 +182,8 @@ func loginRun(opts *LoginOptions) error {
 	}
 
 	if opts.Token != "" {
+		opts.IO.AddSecret(opts.Token)
+
 		if err := shared.HasMinimumScopes(httpClient, hostname, opts.Token); err != nil {
 			return fmt.Errorf("error validating token: %w", err)
 		}
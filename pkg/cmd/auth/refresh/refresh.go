@@ -3,6 +3,7 @@ package refresh
 import (
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc"
@@ -37,6 +38,7 @@ type RefreshOptions struct {
 
 	Interactive     bool
 	InsecureStorage bool
+	Preview         bool
 }
 
 func NewCmdRefresh(f *cmdutil.Factory, runF func(*RefreshOptions) error) *cobra.Command {
@@ -85,6 +87,9 @@ func NewCmdRefresh(f *cmdutil.Factory, runF func(*RefreshOptions) error) *cobra.
 
 			$ gh auth refresh --reset-scopes
 			# => open a browser to re-authenticate with the default minimum scopes
+
+			$ gh auth refresh --scopes admin:org --preview --hostname github.com
+			# => print the scopes that would be added or removed, without starting the auth flow
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Interactive = opts.IO.CanPrompt()
@@ -111,6 +116,7 @@ func NewCmdRefresh(f *cmdutil.Factory, runF func(*RefreshOptions) error) *cobra.
 	_ = cmd.Flags().MarkHidden("secure-storage")
 
 	cmd.Flags().BoolVarP(&opts.InsecureStorage, "insecure-storage", "", false, "Save authentication credentials in plain text instead of credential store")
+	cmd.Flags().BoolVar(&opts.Preview, "preview", false, "Show the scopes that would be added or removed without starting the auth flow")
 
 	return cmd
 }
@@ -158,7 +164,7 @@ func refreshRun(opts *RefreshOptions) error {
 		return cmdutil.SilentError
 	}
 
-	additionalScopes := set.NewStringSet()
+	originalScopes := set.NewStringSet()
 
 	if !opts.ResetScopes {
 		if oldToken, _ := authCfg.ActiveToken(hostname); oldToken != "" {
@@ -166,13 +172,16 @@ func refreshRun(opts *RefreshOptions) error {
 				for _, s := range strings.Split(oldScopes, ",") {
 					s = strings.TrimSpace(s)
 					if s != "" {
-						additionalScopes.Add(s)
+						originalScopes.Add(s)
 					}
 				}
 			}
 		}
 	}
 
+	additionalScopes := set.NewStringSet()
+	additionalScopes.AddValues(originalScopes.ToSlice())
+
 	credentialFlow := &shared.GitCredentialFlow{
 		Prompter: opts.Prompter,
 		HelperConfig: &gitcredentials.HelperConfig{
@@ -195,6 +204,11 @@ func refreshRun(opts *RefreshOptions) error {
 
 	additionalScopes.RemoveValues(opts.RemoveScopes)
 
+	if opts.Preview {
+		printScopesDiff(opts.IO, hostname, originalScopes.ToSlice(), additionalScopes.ToSlice())
+		return nil
+	}
+
 	authedToken, authedUser, err := opts.AuthFlow(opts.IO, hostname, additionalScopes.ToSlice(), opts.Interactive)
 	if err != nil {
 		return err
@@ -220,3 +234,45 @@ func refreshRun(opts *RefreshOptions) error {
 
 	return nil
 }
+
+// printScopesDiff reports which scopes would be added or removed by a refresh, without
+// performing the refresh, so that automation can audit a scope escalation before running it.
+func printScopesDiff(io *iostreams.IOStreams, hostname string, originalScopes, requestedScopes []string) {
+	original := set.NewStringSet()
+	original.AddValues(originalScopes)
+	requested := set.NewStringSet()
+	requested.AddValues(requestedScopes)
+
+	var added, removed, unchanged []string
+	for _, s := range requestedScopes {
+		if original.Contains(s) {
+			unchanged = append(unchanged, s)
+		} else {
+			added = append(added, s)
+		}
+	}
+	for _, s := range originalScopes {
+		if !requested.Contains(s) {
+			removed = append(removed, s)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	sort.Strings(unchanged)
+
+	cs := io.ColorScheme()
+	w := io.Out
+	fmt.Fprintf(w, "Scopes for %s:\n", hostname)
+	for _, s := range added {
+		fmt.Fprintf(w, "  %s\n", cs.Green("+ "+s))
+	}
+	for _, s := range removed {
+		fmt.Fprintf(w, "  %s\n", cs.Red("- "+s))
+	}
+	for _, s := range unchanged {
+		fmt.Fprintf(w, "    %s\n", s)
+	}
+	if len(added) == 0 && len(removed) == 0 && len(unchanged) == 0 {
+		fmt.Fprintln(w, "  (no scopes)")
+	}
+}
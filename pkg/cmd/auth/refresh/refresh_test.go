@@ -130,6 +130,15 @@ func Test_NewCmdRefresh(t *testing.T) {
 				RemoveScopes: []string{"read:public_key"},
 			},
 		},
+		{
+			name: "preview",
+			cli:  "-h aline.cedrac --scopes admin:org --preview",
+			wants: RefreshOptions{
+				Hostname: "aline.cedrac",
+				Scopes:   []string{"admin:org"},
+				Preview:  true,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -166,6 +175,7 @@ func Test_NewCmdRefresh(t *testing.T) {
 			require.NoError(t, err)
 			require.Equal(t, tt.wants.Hostname, gotOpts.Hostname)
 			require.Equal(t, tt.wants.Scopes, gotOpts.Scopes)
+			require.Equal(t, tt.wants.Preview, gotOpts.Preview)
 		})
 	}
 }
@@ -194,6 +204,8 @@ func Test_refreshRun(t *testing.T) {
 		wantErr       string
 		nontty        bool
 		wantAuthArgs  authArgs
+		wantOut       string
+		wantNoAuth    bool
 	}{
 		{
 			name:    "no hosts configured",
@@ -411,6 +423,19 @@ func Test_refreshRun(t *testing.T) {
 				secureStorage: true,
 			},
 		},
+		{
+			name: "preview shows the scope diff without starting the auth flow",
+			cfgHosts: []string{
+				"github.com",
+			},
+			oldScopes: "repo, read:org, gist",
+			opts: &RefreshOptions{
+				Scopes:  []string{"admin:org"},
+				Preview: true,
+			},
+			wantNoAuth: true,
+			wantOut:    "Scopes for github.com:\n  + admin:org\n    gist\n    read:org\n    repo\n",
+		},
 		{
 			name: "errors when active user does not match user returned by auth flow",
 			cfgHosts: []string{
@@ -446,7 +471,7 @@ func Test_refreshRun(t *testing.T) {
 				return cfg, nil
 			}
 
-			ios, _, _, _ := iostreams.Test()
+			ios, _, stdout, _ := iostreams.Test()
 			ios.SetStdinTTY(!tt.nontty)
 			ios.SetStdoutTTY(!tt.nontty)
 			tt.opts.IO = ios
@@ -485,6 +510,15 @@ func Test_refreshRun(t *testing.T) {
 
 			require.NoError(t, err)
 
+			if tt.wantOut != "" {
+				require.Equal(t, tt.wantOut, stdout.String())
+			}
+
+			if tt.wantNoAuth {
+				require.Equal(t, authArgs{}, aa)
+				return
+			}
+
 			require.Equal(t, tt.wantAuthArgs.hostname, aa.hostname)
 			require.Equal(t, tt.wantAuthArgs.scopes, aa.scopes)
 			require.Equal(t, tt.wantAuthArgs.interactive, aa.interactive)
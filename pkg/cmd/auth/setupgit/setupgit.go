@@ -16,12 +16,20 @@ type gitCredentialsConfigurer interface {
 	ConfigureOurs(hostname string) error
 }
 
+type sshInsteadOfConfigurer interface {
+	Configure(hostname string) error
+	Unconfigure(hostname string) error
+}
+
 type SetupGitOptions struct {
 	IO                      *iostreams.IOStreams
 	Config                  func() (gh.Config, error)
 	Hostname                string
 	Force                   bool
+	SSHOverHTTPS            bool
+	Undo                    bool
 	CredentialsHelperConfig gitCredentialsConfigurer
+	SSHInsteadOfConfig      sshInsteadOfConfigurer
 }
 
 func NewCmdSetupGit(f *cmdutil.Factory, runF func(*SetupGitOptions) error) *cobra.Command {
@@ -50,15 +58,27 @@ func NewCmdSetupGit(f *cmdutil.Factory, runF func(*SetupGitOptions) error) *cobr
 
 			# Configure git to use GitHub CLI as the credential helper for enterprise.internal host
 			$ gh auth setup-git --hostname enterprise.internal
+
+			# Also rewrite git@github.com: SSH URLs to HTTPS, for networks that block port 22
+			$ gh auth setup-git --ssh-over-https
+
+			# Remove the SSH-to-HTTPS URL rewrite
+			$ gh auth setup-git --ssh-over-https --undo
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.CredentialsHelperConfig = &gitcredentials.HelperConfig{
 				SelfExecutablePath: f.Executable(),
 				GitClient:          f.GitClient,
 			}
+			opts.SSHInsteadOfConfig = &gitcredentials.SSHInsteadOfConfig{
+				GitClient: f.GitClient,
+			}
 			if opts.Hostname == "" && opts.Force {
 				return cmdutil.FlagErrorf("`--force` must be used in conjunction with `--hostname`")
 			}
+			if opts.Undo && !opts.SSHOverHTTPS {
+				return cmdutil.FlagErrorf("`--undo` must be used in conjunction with `--ssh-over-https`")
+			}
 			if runF != nil {
 				return runF(opts)
 			}
@@ -68,6 +88,8 @@ func NewCmdSetupGit(f *cmdutil.Factory, runF func(*SetupGitOptions) error) *cobr
 
 	cmd.Flags().StringVarP(&opts.Hostname, "hostname", "h", "", "The hostname to configure git for")
 	cmd.Flags().BoolVarP(&opts.Force, "force", "f", false, "Force setup even if the host is not known. Must be used in conjunction with `--hostname`")
+	cmd.Flags().BoolVar(&opts.SSHOverHTTPS, "ssh-over-https", false, "Rewrite git@<host>: SSH URLs to HTTPS, for networks that block port 22")
+	cmd.Flags().BoolVar(&opts.Undo, "undo", false, "Remove the SSH-to-HTTPS URL rewrite. Must be used in conjunction with `--ssh-over-https`")
 
 	return cmd
 }
@@ -96,6 +118,12 @@ func setupGitRun(opts *SetupGitOptions) error {
 			return fmt.Errorf("failed to set up git credential helper: %s", err)
 		}
 
+		if opts.SSHOverHTTPS {
+			if err := applySSHOverHTTPS(opts, opts.Hostname); err != nil {
+				return err
+			}
+		}
+
 		return nil
 	}
 
@@ -114,8 +142,28 @@ func setupGitRun(opts *SetupGitOptions) error {
 		if err := opts.CredentialsHelperConfig.ConfigureOurs(hostname); err != nil {
 			return fmt.Errorf("failed to set up git credential helper: %s", err)
 		}
+
+		if opts.SSHOverHTTPS {
+			if err := applySSHOverHTTPS(opts, hostname); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func applySSHOverHTTPS(opts *SetupGitOptions, hostname string) error {
+	if opts.Undo {
+		if err := opts.SSHInsteadOfConfig.Unconfigure(hostname); err != nil {
+			return fmt.Errorf("failed to remove SSH-to-HTTPS URL rewrite: %s", err)
+		}
+		return nil
 	}
 
+	if err := opts.SSHInsteadOfConfig.Configure(hostname); err != nil {
+		return fmt.Errorf("failed to set up SSH-to-HTTPS URL rewrite: %s", err)
+	}
 	return nil
 }
 
@@ -25,6 +25,22 @@ func (gf *gitCredentialsConfigurerSpy) ConfigureOurs(hostname string) error {
 	return gf.setupErr
 }
 
+type sshInsteadOfConfigurerSpy struct {
+	configuredHosts   []string
+	unconfiguredHosts []string
+	err               error
+}
+
+func (sf *sshInsteadOfConfigurerSpy) Configure(hostname string) error {
+	sf.configuredHosts = append(sf.configuredHosts, hostname)
+	return sf.err
+}
+
+func (sf *sshInsteadOfConfigurerSpy) Unconfigure(hostname string) error {
+	sf.unconfiguredHosts = append(sf.unconfiguredHosts, hostname)
+	return sf.err
+}
+
 func TestNewCmdSetupGit(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -43,6 +59,17 @@ func TestNewCmdSetupGit(t *testing.T) {
 			cli:      "--force --hostname ghe.io",
 			wantsErr: false,
 		},
+		{
+			name:     "--undo without --ssh-over-https",
+			cli:      "--undo",
+			wantsErr: true,
+			errMsg:   "`--undo` must be used in conjunction with `--ssh-over-https`",
+		},
+		{
+			name:     "no error when --undo used with --ssh-over-https",
+			cli:      "--ssh-over-https --undo",
+			wantsErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -76,13 +103,16 @@ func TestNewCmdSetupGit(t *testing.T) {
 }
 func Test_setupGitRun(t *testing.T) {
 	tests := []struct {
-		name               string
-		opts               *SetupGitOptions
-		setupErr           error
-		cfgStubs           func(*testing.T, gh.Config)
-		expectedHostsSetup []string
-		expectedErr        error
-		expectedErrOut     string
+		name                    string
+		opts                    *SetupGitOptions
+		setupErr                error
+		sshInsteadOfErr         error
+		cfgStubs                func(*testing.T, gh.Config)
+		expectedHostsSetup      []string
+		expectedSSHConfigured   []string
+		expectedSSHUnconfigured []string
+		expectedErr             error
+		expectedErrOut          string
 	}{
 		{
 			name: "opts.Config returns an error",
@@ -158,6 +188,45 @@ func Test_setupGitRun(t *testing.T) {
 			expectedErr:    errors.New("failed to set up git credential helper: broken"),
 			expectedErrOut: "",
 		},
+		{
+			name: "when --ssh-over-https is provided, the rewrite is configured for every host",
+			opts: &SetupGitOptions{
+				SSHOverHTTPS: true,
+			},
+			cfgStubs: func(t *testing.T, cfg gh.Config) {
+				login(t, cfg, "ghe.io", "test-user", "gho_ABCDEFG", "https", false)
+				login(t, cfg, "github.com", "test-user", "gho_ABCDEFG", "https", false)
+			},
+			expectedHostsSetup:    []string{"github.com", "ghe.io"},
+			expectedSSHConfigured: []string{"github.com", "ghe.io"},
+		},
+		{
+			name: "when --ssh-over-https and --undo are provided, the rewrite is removed",
+			opts: &SetupGitOptions{
+				Hostname:     "ghe.io",
+				SSHOverHTTPS: true,
+				Undo:         true,
+			},
+			cfgStubs: func(t *testing.T, cfg gh.Config) {
+				login(t, cfg, "ghe.io", "test-user", "gho_ABCDEFG", "https", false)
+			},
+			expectedHostsSetup:      []string{"ghe.io"},
+			expectedSSHUnconfigured: []string{"ghe.io"},
+		},
+		{
+			name: "when the SSH-to-HTTPS rewrite fails to configure, that error is bubbled",
+			opts: &SetupGitOptions{
+				Hostname:     "ghe.io",
+				SSHOverHTTPS: true,
+			},
+			sshInsteadOfErr: fmt.Errorf("broken"),
+			cfgStubs: func(t *testing.T, cfg gh.Config) {
+				login(t, cfg, "ghe.io", "test-user", "gho_ABCDEFG", "https", false)
+			},
+			expectedHostsSetup:    []string{"ghe.io"},
+			expectedSSHConfigured: []string{"ghe.io"},
+			expectedErr:           errors.New("failed to set up SSH-to-HTTPS URL rewrite: broken"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -183,6 +252,9 @@ func Test_setupGitRun(t *testing.T) {
 			credentialsConfigurerSpy := &gitCredentialsConfigurerSpy{setupErr: tt.setupErr}
 			tt.opts.CredentialsHelperConfig = credentialsConfigurerSpy
 
+			sshInsteadOfConfigurerSpy := &sshInsteadOfConfigurerSpy{err: tt.sshInsteadOfErr}
+			tt.opts.SSHInsteadOfConfig = sshInsteadOfConfigurerSpy
+
 			err := setupGitRun(tt.opts)
 			if tt.expectedErr != nil {
 				require.Equal(t, err, tt.expectedErr)
@@ -194,6 +266,9 @@ func Test_setupGitRun(t *testing.T) {
 				require.Equal(t, tt.expectedHostsSetup, credentialsConfigurerSpy.hosts)
 			}
 
+			require.Equal(t, tt.expectedSSHConfigured, sshInsteadOfConfigurerSpy.configuredHosts)
+			require.Equal(t, tt.expectedSSHUnconfigured, sshInsteadOfConfigurerSpy.unconfiguredHosts)
+
 			require.Equal(t, tt.expectedErrOut, stderr.String())
 		})
 	}
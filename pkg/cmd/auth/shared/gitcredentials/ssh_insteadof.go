@@ -0,0 +1,65 @@
+package gitcredentials
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cli/cli/v2/git"
+)
+
+// SSHInsteadOfConfig rewrites git@<hostname>: URLs to https://<hostname>/ so that git
+// uses HTTPS, and therefore gh's credential helper, instead of opening an SSH
+// connection. This is useful on networks that block outbound SSH (port 22).
+type SSHInsteadOfConfig struct {
+	GitClient *git.Client
+}
+
+// Configure sets up the url.insteadOf rewrite for hostname.
+func (sc *SSHInsteadOfConfig) Configure(hostname string) error {
+	ctx := context.TODO()
+	cmd, err := sc.GitClient.Command(ctx, "config", "--global", "--replace-all", insteadOfKeyFor(hostname), sshURLFor(hostname))
+	if err != nil {
+		return err
+	}
+	_, err = cmd.Output()
+	return err
+}
+
+// Unconfigure removes the url.insteadOf rewrite for hostname, if any is set.
+func (sc *SSHInsteadOfConfig) Unconfigure(hostname string) error {
+	ctx := context.TODO()
+	cmd, err := sc.GitClient.Command(ctx, "config", "--global", "--unset-all", insteadOfKeyFor(hostname))
+	if err != nil {
+		return err
+	}
+	if _, err := cmd.Output(); err != nil {
+		var gitErr *git.GitError
+		if errors.As(err, &gitErr) && gitErr.ExitCode == 5 {
+			// the key was never set; nothing to undo
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// Configured reports whether the SSH-to-HTTPS URL rewrite is currently configured for hostname.
+func (sc *SSHInsteadOfConfig) Configured(hostname string) (bool, error) {
+	ctx := context.TODO()
+	value, err := sc.GitClient.Config(ctx, insteadOfKeyFor(hostname))
+	if value == "" {
+		// an unset key is reported as an error by git, but that just means the
+		// rewrite isn't configured rather than something having gone wrong
+		return false, nil
+	}
+	return value == sshURLFor(hostname), err
+}
+
+func insteadOfKeyFor(hostname string) string {
+	return fmt.Sprintf("url.https://%s/.insteadOf", hostname)
+}
+
+func sshURLFor(hostname string) string {
+	return fmt.Sprintf("git@%s:", hostname)
+}
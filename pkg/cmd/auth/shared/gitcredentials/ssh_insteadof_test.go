@@ -0,0 +1,42 @@
+package gitcredentials_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/pkg/cmd/auth/shared/gitcredentials"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSSHInsteadOfConfig(t *testing.T) {
+	withIsolatedGitConfig(t)
+
+	gc := &git.Client{}
+	sc := &gitcredentials.SSHInsteadOfConfig{GitClient: gc}
+
+	configured, err := sc.Configured("github.com")
+	require.NoError(t, err)
+	require.False(t, configured)
+
+	require.NoError(t, sc.Configure("github.com"))
+
+	cmd, err := gc.Command(context.Background(), "config", "--get", "url.https://github.com/.insteadOf")
+	require.NoError(t, err)
+	output, err := cmd.Output()
+	require.NoError(t, err)
+	require.Equal(t, "git@github.com:\n", string(output))
+
+	configured, err = sc.Configured("github.com")
+	require.NoError(t, err)
+	require.True(t, configured)
+
+	require.NoError(t, sc.Unconfigure("github.com"))
+
+	configured, err = sc.Configured("github.com")
+	require.NoError(t, err)
+	require.False(t, configured)
+
+	// unconfiguring an already-unconfigured host is a no-op, not an error
+	require.NoError(t, sc.Unconfigure("github.com"))
+}
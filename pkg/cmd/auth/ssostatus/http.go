@@ -0,0 +1,72 @@
+package ssostatus
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/cli/cli/v2/api"
+)
+
+var ssoURLRE = regexp.MustCompile(`\burl=([^;]+)`)
+
+// OrgSSOStatus describes whether the authenticated token is SAML SSO authorized for a single
+// organization, as observed from that organization's "X-GitHub-SSO" response header.
+type OrgSSOStatus struct {
+	Org        string
+	Enforced   bool
+	Authorized bool
+	URL        string
+}
+
+// listUserOrgs returns the logins of every organization the authenticated user belongs to.
+func listUserOrgs(httpClient *http.Client, hostname string) ([]string, error) {
+	client := api.NewClientFromHTTP(httpClient)
+
+	var logins []string
+	path := "user/orgs?per_page=100"
+	for path != "" {
+		var page []struct {
+			Login string `json:"login"`
+		}
+		var err error
+		path, err = client.RESTWithNext(hostname, "GET", path, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list organizations: %w", err)
+		}
+		for _, org := range page {
+			logins = append(logins, org.Login)
+		}
+	}
+
+	return logins, nil
+}
+
+// checkOrgSSO probes a single organization's SAML SSO status for the authenticated token by
+// inspecting the "X-GitHub-SSO" header on the response to a minimal, always-permitted request.
+func checkOrgSSO(httpClient *http.Client, hostname, org string) (OrgSSOStatus, error) {
+	status := OrgSSOStatus{Org: org}
+
+	var ssoHeader string
+	wrapped := &http.Client{Transport: api.ExtractHeader("X-GitHub-SSO", &ssoHeader)(httpClient.Transport)}
+	client := api.NewClientFromHTTP(wrapped)
+
+	var data json.RawMessage
+	if err := client.REST(hostname, "GET", fmt.Sprintf("orgs/%s", org), nil, &data); err != nil {
+		return status, fmt.Errorf("failed to check %s: %w", org, err)
+	}
+
+	if ssoHeader == "" {
+		return status, nil
+	}
+
+	status.Enforced = true
+	if m := ssoURLRE.FindStringSubmatch(ssoHeader); m != nil {
+		status.URL = m[1]
+	} else {
+		status.Authorized = true
+	}
+
+	return status, nil
+}
@@ -0,0 +1,80 @@
+package ssostatus
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_listUserOrgs(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "user/orgs"),
+		httpmock.WithHeader(
+			httpmock.StringResponse(`[{"login":"github"}]`),
+			"Link",
+			`<https://api.github.com/user/orgs?page=2>; rel="next"`,
+		),
+	)
+	reg.Register(
+		httpmock.REST("GET", "user/orgs"),
+		httpmock.StringResponse(`[{"login":"cli"}]`),
+	)
+
+	orgs, err := listUserOrgs(&http.Client{Transport: reg}, "github.com")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"github", "cli"}, orgs)
+}
+
+func Test_checkOrgSSO(t *testing.T) {
+	tests := []struct {
+		name       string
+		ssoHeader  string
+		wantStatus OrgSSOStatus
+	}{
+		{
+			name:       "no SSO enforcement",
+			ssoHeader:  "",
+			wantStatus: OrgSSOStatus{Org: "github"},
+		},
+		{
+			name:      "authorized",
+			ssoHeader: "authorized",
+			wantStatus: OrgSSOStatus{
+				Org:        "github",
+				Enforced:   true,
+				Authorized: true,
+			},
+		},
+		{
+			name:      "not authorized",
+			ssoHeader: `required; url=https://github.com/orgs/github/sso?authorization_request=123`,
+			wantStatus: OrgSSOStatus{
+				Org:      "github",
+				Enforced: true,
+				URL:      "https://github.com/orgs/github/sso?authorization_request=123",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+
+			responder := httpmock.StringResponse(`{"login":"github"}`)
+			if tt.ssoHeader != "" {
+				responder = httpmock.WithHeader(responder, "X-GitHub-SSO", tt.ssoHeader)
+			}
+			reg.Register(httpmock.REST("GET", "orgs/github"), responder)
+
+			status, err := checkOrgSSO(&http.Client{Transport: reg}, "github.com", "github")
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, status)
+		})
+	}
+}
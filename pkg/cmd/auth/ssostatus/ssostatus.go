@@ -0,0 +1,103 @@
+package ssostatus
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type SSOStatusOptions struct {
+	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
+	HttpClient func() (*http.Client, error)
+}
+
+func NewCmdSSOStatus(f *cmdutil.Factory, runF func(*SSOStatusOptions) error) *cobra.Command {
+	opts := &SSOStatusOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "sso-status",
+		Short: "List organizations and their SAML SSO authorization status",
+		Long: heredoc.Doc(`
+			List every organization the authenticated user belongs to, along with whether that
+			organization enforces SAML single sign-on and, if so, whether the active token is
+			currently authorized for it.
+
+			For organizations that enforce SSO but aren't authorized, the URL to authorize is
+			included; visiting it in a browser and approving access is enough to fix subsequent
+			API calls without re-authenticating gh entirely.
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+			return ssoStatusRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func ssoStatusRun(opts *SSOStatusOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, _ := cfg.Authentication().DefaultHost()
+
+	orgs, err := listUserOrgs(httpClient, host)
+	if err != nil {
+		return err
+	}
+
+	if len(orgs) == 0 {
+		return cmdutil.NewNoResultsError("no organizations found for the authenticated user")
+	}
+
+	statuses := make([]OrgSSOStatus, 0, len(orgs))
+	for _, org := range orgs {
+		status, err := checkOrgSSO(httpClient, host, org)
+		if err != nil {
+			return err
+		}
+		statuses = append(statuses, status)
+	}
+
+	cs := opts.IO.ColorScheme()
+	t := tableprinter.New(opts.IO, tableprinter.WithHeader("ORG", "STATUS", "URL"))
+
+	for _, status := range statuses {
+		t.AddField(status.Org)
+		t.AddField(ssoStatusLabel(status, cs))
+		t.AddField(status.URL)
+		t.EndRow()
+	}
+
+	return t.Render()
+}
+
+func ssoStatusLabel(status OrgSSOStatus, cs *iostreams.ColorScheme) string {
+	if !status.Enforced {
+		return "no SSO"
+	}
+	if status.Authorized {
+		return fmt.Sprintf("%s authorized", cs.SuccessIcon())
+	}
+	return fmt.Sprintf("%s not authorized", cs.Red("X"))
+}
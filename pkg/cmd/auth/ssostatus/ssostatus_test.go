@@ -0,0 +1,73 @@
+package ssostatus
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSOStatusRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "user/orgs"),
+		httpmock.StringResponse(`[{"login":"github"},{"login":"cli"}]`))
+	reg.Register(
+		httpmock.REST("GET", "orgs/github"),
+		httpmock.WithHeader(
+			httpmock.StringResponse(`{"login":"github"}`),
+			"X-GitHub-SSO",
+			"required; url=https://github.com/orgs/github/sso?authorization_request=123",
+		))
+	reg.Register(
+		httpmock.REST("GET", "orgs/cli"),
+		httpmock.StringResponse(`{"login":"cli"}`))
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	opts := &SSOStatusOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+	}
+
+	assert.NoError(t, ssoStatusRun(opts))
+	assert.Contains(t, stdout.String(), "github")
+	assert.Contains(t, stdout.String(), "not authorized")
+	assert.Contains(t, stdout.String(), "no SSO")
+}
+
+func TestSSOStatusRun_noOrgs(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "user/orgs"),
+		httpmock.StringResponse(`[]`))
+
+	ios, _, _, _ := iostreams.Test()
+
+	opts := &SSOStatusOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+	}
+
+	err := ssoStatusRun(opts)
+	assert.Error(t, err)
+}
@@ -0,0 +1,147 @@
+package create
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/browser"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/pkg/cmd/auth/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type iprompter interface {
+	Password(string) (string, error)
+}
+
+type CreateOptions struct {
+	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
+	HttpClient func() (*http.Client, error)
+	Browser    browser.Browser
+	Prompter   iprompter
+
+	Hostname    string
+	Owner       string
+	Repos       []string
+	Permissions []string
+	ExpiresIn   string
+	Description string
+	Slot        string
+}
+
+func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
+	opts := &CreateOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+		Browser:    f.Browser,
+		Prompter:   f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a fine-grained personal access token and store it under a named slot",
+		Long: heredoc.Docf(`
+			Open GitHub's fine-grained personal access token creation page with the resource
+			owner, repository selection, permissions, and expiry pre-filled from flags, so that
+			finishing the token is a matter of reviewing and clicking "Generate token".
+
+			GitHub doesn't offer an API for creating fine-grained tokens, so gh can't capture the
+			result automatically: once you've generated the token in the browser, paste it back
+			when prompted. It's then stored in the encrypted credential store under %[1]s--slot%[1]s,
+			independent of any host's regular %[1]sgh auth login%[1]s session.
+
+			Set the %[1]sGH_TOKEN_SLOT%[1]s environment variable to the slot's name to have gh use
+			that token for API requests to %[1]s--hostname%[1]s instead of the active login. It has
+			no effect on requests to other hosts.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ gh auth token create --owner monalisa --repo monalisa/smile --permission contents:write --slot smile-ci
+			$ GH_TOKEN_SLOT=smile-ci gh api repos/monalisa/smile
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Slot == "" {
+				return cmdutil.FlagErrorf("`--slot` is required")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return createRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Hostname, "hostname", "", "The GitHub host to create the token on")
+	cmd.Flags().StringVar(&opts.Owner, "owner", "", "The resource owner (user or organization) the token is scoped to")
+	cmd.Flags().StringArrayVar(&opts.Repos, "repo", nil, "Repository to grant access to, as `owner/name` (can be used multiple times)")
+	cmd.Flags().StringArrayVar(&opts.Permissions, "permission", nil, "Permission to request, as `name:access` e.g. \"contents:write\" (can be used multiple times)")
+	cmd.Flags().StringVar(&opts.ExpiresIn, "expires-in", "", "Expiration for the token in days, or \"none\"")
+	cmd.Flags().StringVar(&opts.Description, "description", "", "Description for the token")
+	cmd.Flags().StringVar(&opts.Slot, "slot", "", "Name of the keyring slot to store the token under (required)")
+
+	return cmd
+}
+
+func createRun(opts *CreateOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	hostname := opts.Hostname
+	if hostname == "" {
+		hostname, _ = cfg.Authentication().DefaultHost()
+	}
+
+	u := newTokenURL(opts)
+
+	cs := opts.IO.ColorScheme()
+	if opts.IO.CanPrompt() {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Opening %s in your browser...\n", cs.SuccessIcon(), u)
+		if err := opts.Browser.Browse(u); err != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Failed to open browser: %s\n", cs.WarningIcon(), err)
+		}
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "Open this URL in your browser to create the token:\n  %s\n", u)
+	}
+
+	if !opts.IO.CanPrompt() {
+		return cmdutil.FlagErrorf("prompting is disabled, can't accept a pasted token; run this command interactively")
+	}
+
+	token, err := opts.Prompter.Password("Paste the generated token:")
+	if err != nil {
+		return fmt.Errorf("could not read token: %w", err)
+	}
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return cmdutil.FlagErrorf("a token is required")
+	}
+	opts.IO.AddSecret(token)
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	username, err := shared.GetCurrentLogin(httpClient, hostname, token)
+	if err != nil {
+		return fmt.Errorf("could not validate token: %w", err)
+	}
+
+	if err := cfg.Authentication().SetTokenInKeyringSlot(hostname, opts.Slot, token); err != nil {
+		return fmt.Errorf("failed to store token: %w", err)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "%s Stored token for %s on %s under slot %s\n", cs.SuccessIcon(), cs.Bold(username), ghinstance.HostPrefix(hostname), cs.Bold(opts.Slot))
+	fmt.Fprintf(opts.IO.Out, "Use %s to have gh authenticate with this token.\n", cs.Bold(fmt.Sprintf("GH_TOKEN_SLOT=%s", opts.Slot)))
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package create
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/browser"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/keyring"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubPrompter struct {
+	password string
+}
+
+func (p *stubPrompter) Password(string) (string, error) {
+	return p.password, nil
+}
+
+func TestCreateRun(t *testing.T) {
+	keyring.MockInit()
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query UserCurrent\b`),
+		httpmock.StringResponse(`{"data":{"viewer":{"login":"monalisa"}}}`))
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdinTTY(true)
+	ios.SetStdoutTTY(true)
+
+	b := &browser.Stub{}
+	cfg := config.NewBlankConfig()
+
+	opts := &CreateOptions{
+		IO: ios,
+		Config: func() (gh.Config, error) {
+			return cfg, nil
+		},
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Browser:  b,
+		Prompter: &stubPrompter{password: "new-token"},
+		Owner:    "monalisa",
+		Slot:     "ci",
+	}
+
+	assert.NoError(t, createRun(opts))
+	assert.Contains(t, b.BrowsedURL(), "settings/personal-access-tokens/new")
+	assert.Contains(t, stdout.String(), "ci")
+
+	token, err := cfg.Authentication().TokenFromKeyringSlot("github.com", "ci")
+	assert.NoError(t, err)
+	assert.Equal(t, "new-token", token)
+}
+
+func TestCreateRun_requiresPrompt(t *testing.T) {
+	ios, _, _, _ := iostreams.Test()
+
+	opts := &CreateOptions{
+		IO: ios,
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		Browser: &browser.Stub{},
+		Slot:    "ci",
+	}
+
+	err := createRun(opts)
+	assert.Error(t, err)
+}
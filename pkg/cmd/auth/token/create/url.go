@@ -0,0 +1,37 @@
+package create
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/cli/cli/v2/internal/ghinstance"
+)
+
+// newTokenURL builds the URL to GitHub's fine-grained personal access token creation page,
+// pre-filled from opts so the user only has to review and click "Generate token".
+//
+// GitHub doesn't offer an API for creating fine-grained tokens, or a way to hand a newly created
+// one back to a calling process, so the user still has to finish the flow in their browser and
+// paste the resulting token back into gh.
+func newTokenURL(opts *CreateOptions) string {
+	q := url.Values{}
+	if opts.Owner != "" {
+		q.Set("target_name", opts.Owner)
+	}
+	if len(opts.Repos) > 0 {
+		q.Set("repositories", strings.Join(opts.Repos, ","))
+	}
+	for _, perm := range opts.Permissions {
+		name, access, _ := strings.Cut(perm, ":")
+		q.Set(name, access)
+	}
+	if opts.ExpiresIn != "" {
+		q.Set("expires_in", opts.ExpiresIn)
+	}
+	if opts.Description != "" {
+		q.Set("description", opts.Description)
+	}
+
+	return fmt.Sprintf("%ssettings/personal-access-tokens/new?%s", ghinstance.HostPrefix(opts.Hostname), q.Encode())
+}
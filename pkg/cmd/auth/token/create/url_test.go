@@ -0,0 +1,20 @@
+package create
+
+import "testing"
+
+func TestNewTokenURL(t *testing.T) {
+	opts := &CreateOptions{
+		Hostname:    "github.com",
+		Owner:       "monalisa",
+		Repos:       []string{"monalisa/smile"},
+		Permissions: []string{"contents:write"},
+		ExpiresIn:   "30",
+		Description: "CI token",
+	}
+
+	got := newTokenURL(opts)
+	want := "https://github.com/settings/personal-access-tokens/new?contents=write&description=CI+token&expires_in=30&repositories=monalisa%2Fsmile&target_name=monalisa"
+	if got != want {
+		t.Errorf("newTokenURL() = %q, want %q", got, want)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	cmdDelete "github.com/cli/cli/v2/pkg/cmd/cache/delete"
 	cmdList "github.com/cli/cli/v2/pkg/cmd/cache/list"
+	cmdStats "github.com/cli/cli/v2/pkg/cmd/cache/stats"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/spf13/cobra"
 )
@@ -16,6 +17,7 @@ func NewCmdCache(f *cmdutil.Factory) *cobra.Command {
 		Example: heredoc.Doc(`
 			$ gh cache list
 			$ gh cache delete --all
+			$ gh cache stats
 		`),
 		GroupID: "actions",
 	}
@@ -24,6 +26,7 @@ func NewCmdCache(f *cmdutil.Factory) *cobra.Command {
 
 	cmd.AddCommand(cmdList.NewCmdList(f, nil))
 	cmd.AddCommand(cmdDelete.NewCmdDelete(f, nil))
+	cmd.AddCommand(cmdStats.NewCmdStats(f, nil))
 
 	return cmd
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/internal/text"
 	"github.com/cli/cli/v2/pkg/cmd/cache/shared"
@@ -21,15 +22,21 @@ type DeleteOptions struct {
 	BaseRepo   func() (ghrepo.Interface, error)
 	HttpClient func() (*http.Client, error)
 	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
+	Prompter   cmdutil.DeletionPrompter
 
-	DeleteAll  bool
-	Identifier string
+	DeleteAll    bool
+	Identifier   string
+	Confirmed    bool
+	ConfirmToken string
 }
 
 func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Command {
 	opts := &DeleteOptions{
 		IO:         f.IOStreams,
 		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		Prompter:   f.Prompter,
 	}
 
 	cmd := &cobra.Command{
@@ -82,6 +89,7 @@ func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Co
 	}
 
 	cmd.Flags().BoolVarP(&opts.DeleteAll, "all", "a", false, "Delete all caches")
+	cmdutil.AddConfirmDeletionFlags(cmd, &opts.Confirmed, &opts.ConfirmToken)
 
 	return cmd
 }
@@ -98,8 +106,10 @@ func deleteRun(opts *DeleteOptions) error {
 		return fmt.Errorf("failed to determine base repo: %w", err)
 	}
 
+	resourceName := opts.Identifier
 	var toDelete []string
 	if opts.DeleteAll {
+		resourceName = ghrepo.FullName(repo)
 		caches, err := shared.GetCaches(client, repo, shared.GetCachesOptions{Limit: -1})
 		if err != nil {
 			return err
@@ -114,6 +124,10 @@ func deleteRun(opts *DeleteOptions) error {
 		toDelete = append(toDelete, opts.Identifier)
 	}
 
+	if err := cmdutil.ConfirmDeletion(opts.IO, opts.Prompter, opts.Config, repo.RepoHost(), resourceName, opts.Confirmed, opts.ConfirmToken); err != nil {
+		return err
+	}
+
 	return deleteCaches(opts, client, repo, toDelete)
 }
 
@@ -8,12 +8,14 @@ import (
 	"time"
 
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/prompter"
 	"github.com/cli/cli/v2/pkg/cmd/cache/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/httpmock"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/google/shlex"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewCmdDelete(t *testing.T) {
@@ -202,6 +204,7 @@ func TestDeleteRun(t *testing.T) {
 			ios.SetStdinTTY(tt.tty)
 			ios.SetStderrTTY(tt.tty)
 			tt.opts.IO = ios
+			tt.opts.Confirmed = true
 			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
 				return ghrepo.New("OWNER", "REPO"), nil
 			}
@@ -222,3 +225,73 @@ func TestDeleteRun(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteRun_confirmation(t *testing.T) {
+	t.Run("non-interactive without --yes or --confirm-token errors", func(t *testing.T) {
+		ios, _, _, _ := iostreams.Test()
+		err := deleteRun(&DeleteOptions{
+			IO:         ios,
+			Identifier: "123",
+			HttpClient: func() (*http.Client, error) {
+				return &http.Client{Transport: &httpmock.Registry{}}, nil
+			},
+			BaseRepo: func() (ghrepo.Interface, error) {
+				return ghrepo.New("OWNER", "REPO"), nil
+			},
+		})
+		assert.EqualError(t, err, "--yes or --confirm-token required when not running interactively")
+	})
+
+	t.Run("interactive prompts to type the identifier", func(t *testing.T) {
+		reg := &httpmock.Registry{}
+		reg.Register(
+			httpmock.REST("DELETE", "repos/OWNER/REPO/actions/caches/123"),
+			httpmock.StatusStringResponse(204, ""),
+		)
+		defer reg.Verify(t)
+
+		ios, _, _, _ := iostreams.Test()
+		ios.SetStdinTTY(true)
+		ios.SetStdoutTTY(true)
+
+		pm := prompter.NewMockPrompter(t)
+		pm.RegisterConfirmDeletion("123", func(_ string) error { return nil })
+
+		err := deleteRun(&DeleteOptions{
+			IO:         ios,
+			Prompter:   pm,
+			Identifier: "123",
+			HttpClient: func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			},
+			BaseRepo: func() (ghrepo.Interface, error) {
+				return ghrepo.New("OWNER", "REPO"), nil
+			},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("confirm-token matching the identifier skips the prompt", func(t *testing.T) {
+		reg := &httpmock.Registry{}
+		reg.Register(
+			httpmock.REST("DELETE", "repos/OWNER/REPO/actions/caches/123"),
+			httpmock.StatusStringResponse(204, ""),
+		)
+		defer reg.Verify(t)
+
+		ios, _, _, _ := iostreams.Test()
+
+		err := deleteRun(&DeleteOptions{
+			IO:           ios,
+			Identifier:   "123",
+			ConfirmToken: "123",
+			HttpClient: func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			},
+			BaseRepo: func() (ghrepo.Interface, error) {
+				return ghrepo.New("OWNER", "REPO"), nil
+			},
+		})
+		require.NoError(t, err)
+	})
+}
@@ -95,3 +95,27 @@ pagination:
 func (c *Cache) ExportData(fields []string) map[string]interface{} {
 	return cmdutil.StructExportData(c, fields)
 }
+
+// CacheUsage is the total Actions cache storage used by a repository, as reported by the
+// cache usage endpoint.
+type CacheUsage struct {
+	FullName              string `json:"full_name"`
+	ActiveCachesSizeBytes int64  `json:"active_caches_size_in_bytes"`
+	ActiveCachesCount     int    `json:"active_caches_count"`
+}
+
+func (u *CacheUsage) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(u, fields)
+}
+
+// GetCacheUsage fetches the total cache storage used by a repository.
+func GetCacheUsage(client *api.Client, repo ghrepo.Interface) (*CacheUsage, error) {
+	path := fmt.Sprintf("repos/%s/actions/cache/usage", ghrepo.FullName(repo))
+
+	var usage CacheUsage
+	if err := client.REST(repo.RepoHost(), "GET", path, nil, &usage); err != nil {
+		return nil, err
+	}
+
+	return &usage, nil
+}
@@ -0,0 +1,124 @@
+package stats
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmd/cache/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+// actionsCacheQuotaBytes is GitHub's default per-repository Actions cache storage limit. There
+// is no API to look up a repository's actual quota, so this is used only to give the
+// percentage bar something to scale against.
+const actionsCacheQuotaBytes = 10 * 1024 * 1024 * 1024
+
+type StatsOptions struct {
+	BaseRepo   func() (ghrepo.Interface, error)
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Exporter   cmdutil.Exporter
+}
+
+func NewCmdStats(f *cmdutil.Factory, runF func(*StatsOptions) error) *cobra.Command {
+	opts := &StatsOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Show total Actions cache storage used by a repository",
+		Long: heredoc.Doc(`
+			Show the total size and count of active Actions caches for a repository,
+			along with a bar showing how much of GitHub's default 10 GiB per-repository
+			cache quota is in use.
+		`),
+		Example: heredoc.Doc(`
+			$ gh cache stats
+			$ gh cache stats --repo cli/cli
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return statsRun(opts)
+		},
+	}
+
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, []string{"fullName", "activeCachesCount", "activeCachesSizeBytes"})
+
+	return cmd
+}
+
+func statsRun(opts *StatsOptions) error {
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	opts.IO.StartProgressIndicator()
+	usage, err := shared.GetCacheUsage(client, repo)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("%s Failed to get cache usage: %w", opts.IO.ColorScheme().FailureIcon(), err)
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, usage)
+	}
+
+	cs := opts.IO.ColorScheme()
+	pct := float64(usage.ActiveCachesSizeBytes) / float64(actionsCacheQuotaBytes) * 100
+
+	fmt.Fprintf(opts.IO.Out, "%s in %s across %s\n",
+		humanFileSize(usage.ActiveCachesSizeBytes),
+		ghrepo.FullName(repo),
+		text.Pluralize(usage.ActiveCachesCount, "cache"))
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s %s of 10 GiB quota\n", cs.Cyan(text.PercentBar(pct, 20)), humanPercent(pct))
+	}
+
+	return nil
+}
+
+func humanPercent(pct float64) string {
+	return fmt.Sprintf("%.1f%%", pct)
+}
+
+func humanFileSize(s int64) string {
+	if s < 1024 {
+		return fmt.Sprintf("%d B", s)
+	}
+
+	kb := float64(s) / 1024
+	if kb < 1024 {
+		return fmt.Sprintf("%.2f KiB", kb)
+	}
+
+	mb := kb / 1024
+	if mb < 1024 {
+		return fmt.Sprintf("%.2f MiB", mb)
+	}
+
+	gb := mb / 1024
+	return fmt.Sprintf("%.2f GiB", gb)
+}
@@ -0,0 +1,75 @@
+package stats
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/cache/shared"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		tty        bool
+		wantStdout string
+	}{
+		{
+			name: "displays results tty",
+			tty:  true,
+			wantStdout: "1.00 GiB in OWNER/REPO across 3 caches\n" +
+				"██░░░░░░░░░░░░░░░░░░ 10.0% of 10 GiB quota\n",
+		},
+		{
+			name:       "displays results non-tty",
+			tty:        false,
+			wantStdout: "1.00 GiB in OWNER/REPO across 3 caches\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			reg.Register(
+				httpmock.REST("GET", "repos/OWNER/REPO/actions/cache/usage"),
+				httpmock.JSONResponse(shared.CacheUsage{
+					FullName:              "OWNER/REPO",
+					ActiveCachesSizeBytes: 1024 * 1024 * 1024,
+					ActiveCachesCount:     3,
+				}),
+			)
+
+			ios, _, stdout, _ := iostreams.Test()
+			ios.SetStdoutTTY(tt.tty)
+
+			opts := &StatsOptions{
+				IO:         ios,
+				HttpClient: func() (*http.Client, error) { return &http.Client{Transport: reg}, nil },
+				BaseRepo:   func() (ghrepo.Interface, error) { return ghrepo.New("OWNER", "REPO"), nil },
+			}
+
+			require.NoError(t, statsRun(opts))
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
+
+func Test_humanFileSize(t *testing.T) {
+	tests := []struct {
+		size int64
+		want string
+	}{
+		{size: 100, want: "100 B"},
+		{size: 1024, want: "1.00 KiB"},
+		{size: 1024 * 1024, want: "1.00 MiB"},
+		{size: 1024 * 1024 * 1024, want: "1.00 GiB"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, humanFileSize(tt.size))
+	}
+}
@@ -0,0 +1,28 @@
+package codescanning
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdDismiss "github.com/cli/cli/v2/pkg/cmd/codescanning/dismiss"
+	cmdList "github.com/cli/cli/v2/pkg/cmd/codescanning/list"
+	cmdView "github.com/cli/cli/v2/pkg/cmd/codescanning/view"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdCodeScanning(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "code-scanning <command>",
+		Short: "Manage code scanning alerts",
+		Long: heredoc.Doc(`
+			Work with code scanning alerts for a repository.
+		`),
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdView.NewCmdView(f, nil))
+	cmd.AddCommand(cmdDismiss.NewCmdDismiss(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,195 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmd/codescanning/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Exporter cmdutil.Exporter
+
+	State    string
+	Severity string
+	Ref      string
+	Path     string
+
+	Format     string
+	OutputFile string
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List code scanning alerts",
+		Long: heredoc.Doc(`
+			List code scanning alerts in a repository.
+
+			Alerts can be filtered by state, severity, ref, and path.
+
+			Pass --format sarif to export the matching alerts as a SARIF log instead
+			of the usual table.
+		`),
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.Format != "" && opts.Format != "sarif" {
+				return cmdutil.FlagErrorf("unsupported --format: %q (supported formats: sarif)", opts.Format)
+			}
+			if err := cmdutil.MutuallyExclusive("specify only one of `--format` or `--json`", opts.Format != "", opts.Exporter != nil); err != nil {
+				return err
+			}
+			if opts.OutputFile != "" && opts.Format == "" {
+				return cmdutil.FlagErrorf("`--output` requires `--format sarif`")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.State, "state", "", "Filter by state: {open|closed|dismissed|fixed}")
+	cmd.Flags().StringVar(&opts.Severity, "severity", "", "Filter by severity: {critical|high|medium|low|warning|note|error}")
+	cmd.Flags().StringVar(&opts.Ref, "ref", "", "Filter by ref, e.g. refs/heads/main")
+	cmd.Flags().StringVar(&opts.Path, "path", "", "Filter by the path of the file the alert was found in")
+	cmd.Flags().StringVar(&opts.Format, "format", "", "Output format: {sarif}")
+	cmd.Flags().StringVarP(&opts.OutputFile, "output", "O", "", "The `file` to write SARIF output to (use \"-\" to write to standard output)")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.AlertJSONFields)
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	client, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("could not create http client: %w", err)
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("repos/%s/code-scanning/alerts?per_page=100", ghrepo.FullName(baseRepo))
+	if opts.State != "" {
+		path += "&state=" + opts.State
+	}
+	if opts.Severity != "" {
+		path += "&severity=" + opts.Severity
+	}
+	if opts.Ref != "" {
+		path += "&ref=" + opts.Ref
+	}
+
+	apiClient := api.NewClientFromHTTP(client)
+
+	var alerts []shared.Alert
+	for path != "" {
+		var page []shared.Alert
+		path, err = apiClient.RESTWithNext(baseRepo.RepoHost(), "GET", path, nil, &page)
+		if err != nil {
+			return fmt.Errorf("failed to get alerts: %w", err)
+		}
+		alerts = append(alerts, page...)
+	}
+
+	// the alerts API has no server-side path filter, so apply it ourselves
+	// once all pages have been fetched.
+	if opts.Path != "" {
+		filtered := make([]shared.Alert, 0, len(alerts))
+		for _, alert := range alerts {
+			if alert.MostRecentInstance.Location.Path == opts.Path {
+				filtered = append(filtered, alert)
+			}
+		}
+		alerts = filtered
+	}
+
+	if len(alerts) == 0 && opts.Exporter == nil {
+		return cmdutil.NewNoResultsError("no code scanning alerts found")
+	}
+
+	if opts.Format == "sarif" {
+		return writeSARIF(opts, alerts)
+	}
+
+	if err := opts.IO.StartPager(); err == nil {
+		defer opts.IO.StopPager()
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, alerts)
+	}
+
+	cs := opts.IO.ColorScheme()
+	table := tableprinter.New(opts.IO, tableprinter.WithHeader("NUMBER", "RULE", "SEVERITY", "STATE", "PATH"))
+	for _, alert := range alerts {
+		table.AddField(fmt.Sprintf("#%d", alert.Number))
+		table.AddField(alert.Rule.ID)
+		table.AddField(colorizeSeverity(cs, alert.Rule.Severity))
+		table.AddField(alert.State)
+		table.AddField(alert.MostRecentInstance.Location.Path)
+		table.EndRow()
+	}
+
+	return table.Render()
+}
+
+// writeSARIF encodes alerts as a SARIF log and writes it to opts.OutputFile,
+// or to stdout when no output file (or "-") was given.
+func writeSARIF(opts *ListOptions, alerts []shared.Alert) error {
+	w := opts.IO.Out
+	if opts.OutputFile != "" && opts.OutputFile != "-" {
+		f, err := os.Create(opts.OutputFile)
+		if err != nil {
+			return fmt.Errorf("failed to open %s: %w", opts.OutputFile, err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(shared.ToSARIF(alerts)); err != nil {
+		return fmt.Errorf("failed to write SARIF output: %w", err)
+	}
+
+	return nil
+}
+
+func colorizeSeverity(cs *iostreams.ColorScheme, severity string) string {
+	switch severity {
+	case "critical", "high", "error":
+		return cs.Red(severity)
+	case "medium", "warning":
+		return cs.Yellow(severity)
+	default:
+		return severity
+	}
+}
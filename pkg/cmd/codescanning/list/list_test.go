@@ -0,0 +1,190 @@
+package list
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/codescanning/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdList(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		want    ListOptions
+		wantErr string
+	}{
+		{
+			name: "no flags",
+			args: "",
+			want: ListOptions{},
+		},
+		{
+			name: "path filter",
+			args: "--path src/main.go",
+			want: ListOptions{Path: "src/main.go"},
+		},
+		{
+			name: "sarif with output",
+			args: "--format sarif --output out.sarif",
+			want: ListOptions{Format: "sarif", OutputFile: "out.sarif"},
+		},
+		{
+			name:    "unsupported format",
+			args:    "--format json",
+			wantErr: `unsupported --format: "json" (supported formats: sarif)`,
+		},
+		{
+			name:    "format and json are mutually exclusive",
+			args:    "--format sarif --json number",
+			wantErr: "specify only one of `--format` or `--json`",
+		},
+		{
+			name:    "output requires sarif format",
+			args:    "--output out.sarif",
+			wantErr: "`--output` requires `--format sarif`",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{IOStreams: ios}
+
+			var gotOpts *ListOptions
+			cmd := NewCmdList(f, func(o *ListOptions) error {
+				gotOpts = o
+				return nil
+			})
+			cmd.PersistentFlags().StringP("repo", "R", "", "")
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.Path, gotOpts.Path)
+			assert.Equal(t, tt.want.Format, gotOpts.Format)
+			assert.Equal(t, tt.want.OutputFile, gotOpts.OutputFile)
+		})
+	}
+}
+
+func Test_listRun_pathFilter(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/code-scanning/alerts"),
+		httpmock.StringResponse(`[
+			{"number": 1, "state": "open", "rule": {"id": "go/sql-injection", "severity": "high"}, "most_recent_instance": {"location": {"path": "db/query.go"}}},
+			{"number": 2, "state": "open", "rule": {"id": "go/weak-crypto", "severity": "medium"}, "most_recent_instance": {"location": {"path": "crypto/hash.go"}}}
+		]`))
+
+	opts := &ListOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Path: "db/query.go",
+	}
+
+	err := listRun(opts)
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "db/query.go")
+	assert.NotContains(t, stdout.String(), "crypto/hash.go")
+}
+
+func Test_listRun_sarif(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/code-scanning/alerts"),
+		httpmock.StringResponse(`[
+			{"number": 1, "state": "open", "rule": {"id": "go/sql-injection", "severity": "high", "description": "SQL injection"}, "most_recent_instance": {"location": {"path": "db/query.go"}}}
+		]`))
+
+	opts := &ListOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Format: "sarif",
+	}
+
+	err := listRun(opts)
+	require.NoError(t, err)
+
+	var log shared.SARIFLog
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &log))
+	require.Len(t, log.Runs, 1)
+	require.Len(t, log.Runs[0].Results, 1)
+	assert.Equal(t, "go/sql-injection", log.Runs[0].Results[0].RuleID)
+	assert.Equal(t, "error", log.Runs[0].Results[0].Level)
+	assert.Equal(t, "db/query.go", log.Runs[0].Results[0].Locations[0].PhysicalLocation.ArtifactLocation.URI)
+}
+
+func Test_listRun_sarifToFile(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/code-scanning/alerts"),
+		httpmock.StringResponse(`[
+			{"number": 1, "state": "open", "rule": {"id": "go/sql-injection", "severity": "high"}, "most_recent_instance": {"location": {"path": "db/query.go"}}}
+		]`))
+
+	dir := t.TempDir()
+	outFile := dir + "/alerts.sarif"
+
+	opts := &ListOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Format:     "sarif",
+		OutputFile: outFile,
+	}
+
+	err := listRun(opts)
+	require.NoError(t, err)
+	assert.Empty(t, stdout.String(), "SARIF should be written to the file, not stdout")
+
+	var log shared.SARIFLog
+	contents, err := os.ReadFile(outFile)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(contents, &log))
+	require.Len(t, log.Runs[0].Results, 1)
+}
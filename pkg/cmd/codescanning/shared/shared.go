@@ -0,0 +1,146 @@
+package shared
+
+import (
+	"time"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+)
+
+type Alert struct {
+	Number int    `json:"number"`
+	State  string `json:"state"`
+	Rule   struct {
+		ID          string `json:"id"`
+		Severity    string `json:"severity"`
+		Description string `json:"description"`
+	} `json:"rule"`
+	MostRecentInstance struct {
+		Location struct {
+			Path string `json:"path"`
+		} `json:"location"`
+	} `json:"most_recent_instance"`
+	URL             string     `json:"html_url"`
+	CreatedAt       time.Time  `json:"created_at"`
+	DismissedAt     *time.Time `json:"dismissed_at"`
+	DismissedReason string     `json:"dismissed_reason"`
+}
+
+var AlertJSONFields = []string{
+	"number",
+	"state",
+	"rule",
+	"mostRecentInstance",
+	"url",
+	"createdAt",
+	"dismissedAt",
+	"dismissedReason",
+}
+
+func (a *Alert) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(a, fields)
+}
+
+// DismissReasons are the reasons accepted by the code scanning alerts API
+// when dismissing an alert.
+var DismissReasons = []string{
+	"false positive",
+	"won't fix",
+	"used in tests",
+}
+
+// SARIFLog is a minimal SARIF 2.1.0 log, just enough to round-trip a set of
+// alerts through tools that consume the format (e.g. editors, dashboards).
+// It is built from already-fetched alerts rather than fetched from the
+// analyses API, since the alerts endpoint is the one that understands
+// state/severity/ref/path filtering.
+type SARIFLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []SARIFRun `json:"runs"`
+}
+
+type SARIFRun struct {
+	Tool    SARIFTool     `json:"tool"`
+	Results []SARIFResult `json:"results"`
+}
+
+type SARIFTool struct {
+	Driver SARIFDriver `json:"driver"`
+}
+
+type SARIFDriver struct {
+	Name string `json:"name"`
+}
+
+type SARIFResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   SARIFMessage    `json:"message"`
+	Locations []SARIFLocation `json:"locations"`
+}
+
+type SARIFMessage struct {
+	Text string `json:"text"`
+}
+
+type SARIFLocation struct {
+	PhysicalLocation SARIFPhysicalLocation `json:"physicalLocation"`
+}
+
+type SARIFPhysicalLocation struct {
+	ArtifactLocation SARIFArtifactLocation `json:"artifactLocation"`
+}
+
+type SARIFArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// sarifLevelForSeverity maps a code scanning alert severity to the SARIF
+// result levels a consumer expects: "error", "warning", or "note".
+func sarifLevelForSeverity(severity string) string {
+	switch severity {
+	case "critical", "high", "error":
+		return "error"
+	case "medium", "warning":
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+// ToSARIF converts a set of alerts into a SARIF log carrying a single run
+// attributed to "GitHub Code Scanning".
+func ToSARIF(alerts []Alert) SARIFLog {
+	results := make([]SARIFResult, 0, len(alerts))
+	for _, alert := range alerts {
+		results = append(results, SARIFResult{
+			RuleID: alert.Rule.ID,
+			Level:  sarifLevelForSeverity(alert.Rule.Severity),
+			Message: SARIFMessage{
+				Text: alert.Rule.Description,
+			},
+			Locations: []SARIFLocation{
+				{
+					PhysicalLocation: SARIFPhysicalLocation{
+						ArtifactLocation: SARIFArtifactLocation{
+							URI: alert.MostRecentInstance.Location.Path,
+						},
+					},
+				},
+			},
+		})
+	}
+
+	return SARIFLog{
+		Schema:  "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []SARIFRun{
+			{
+				Tool: SARIFTool{
+					Driver: SARIFDriver{Name: "GitHub Code Scanning"},
+				},
+				Results: results,
+			},
+		},
+	}
+}
@@ -5,12 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/cli/cli/v2/internal/codespaces"
 	"github.com/cli/cli/v2/internal/codespaces/api"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/notify"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/spf13/cobra"
 )
@@ -78,6 +80,10 @@ type createOptions struct {
 	retentionPeriod   NullableDuration
 	displayName       string
 	useWeb            bool
+	notify            bool
+	rawFeatures       []string
+	features          map[string]string
+	dotfiles          string
 }
 
 func newCreateCmd(app *App) *cobra.Command {
@@ -88,11 +94,17 @@ func newCreateCmd(app *App) *cobra.Command {
 		Short: "Create a codespace",
 		Args:  noArgsConstraint,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
-			return cmdutil.MutuallyExclusive(
+			if err := cmdutil.MutuallyExclusive(
 				"using --web with --display-name, --idle-timeout, or --retention-period is not supported",
 				opts.useWeb,
 				opts.displayName != "" || opts.idleTimeout != 0 || opts.retentionPeriod.Duration != nil,
-			)
+			); err != nil {
+				return err
+			}
+
+			var err error
+			opts.features, err = parseFeatureOverrides(opts.rawFeatures)
+			return err
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return app.Create(cmd.Context(), opts)
@@ -115,10 +127,66 @@ func newCreateCmd(app *App) *cobra.Command {
 	createCmd.Flags().Var(&opts.retentionPeriod, "retention-period", "allowed time after shutting down before the codespace is automatically deleted (maximum 30 days), e.g. \"1h\", \"72h\"")
 	createCmd.Flags().StringVar(&opts.devContainerPath, "devcontainer-path", "", "path to the devcontainer.json file to use when creating codespace")
 	createCmd.Flags().StringVarP(&opts.displayName, "display-name", "d", "", fmt.Sprintf("display name for the codespace (%d characters or less)", displayNameMaxLength))
+	createCmd.Flags().BoolVar(&opts.notify, "notify", false, "Send a desktop notification when the codespace is ready")
+	createCmd.Flags().StringArrayVar(&opts.rawFeatures, "feature", nil, "override a devcontainer feature in `key=value` format, can be used multiple times")
+	createCmd.Flags().StringVar(&opts.dotfiles, "dotfiles", "", "dotfiles repository to apply to the codespace, as `owner/repo`")
+
+	if err := createCmd.RegisterFlagCompletionFunc("devcontainer-path", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return devContainerPathCompletions(cmd.Context(), app, opts, toComplete)
+	}); err != nil {
+		fmt.Fprintf(app.io.ErrOut, "%v\n", err)
+	}
 
 	return createCmd
 }
 
+// parseFeatureOverrides parses "--feature key=value" flags into a devcontainer feature override map.
+func parseFeatureOverrides(raw []string) (map[string]string, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	features := make(map[string]string, len(raw))
+	for _, f := range raw {
+		key, value, ok := strings.Cut(f, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid feature %q: must be in the format `key=value`", f)
+		}
+		features[key] = value
+	}
+	return features, nil
+}
+
+// devContainerPathCompletions lists the devcontainer.json paths available for the repository and
+// branch the user has already specified, for use as `--devcontainer-path` shell completion.
+func devContainerPathCompletions(ctx context.Context, app *App, opts createOptions, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if opts.repo == "" {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	repository, err := app.apiClient.GetRepository(ctx, opts.repo)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	branch := opts.branch
+	if branch == "" {
+		branch = repository.DefaultBranch
+	}
+
+	devcontainers, err := app.apiClient.ListDevContainers(ctx, repository.ID, branch, 100)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	var paths []string
+	for _, d := range devcontainers {
+		if strings.HasPrefix(d.Path, toComplete) {
+			paths = append(paths, d.Path)
+		}
+	}
+	return paths, cobra.ShellCompDirectiveNoFileComp
+}
+
 // Create creates a new Codespace
 func (a *App) Create(ctx context.Context, opts createOptions) error {
 	// Overrides for Codespace developers to target test environments
@@ -272,13 +340,22 @@ func (a *App) Create(ctx context.Context, opts createOptions) error {
 		}
 	}
 
-	machine := opts.machine
+	requestedMachine := opts.machine
+	if requestedMachine == "" {
+		// a prior 'gh codespace machines --recommend --set-default' for this repository
+		// takes precedence over the API's own default, but an explicit --machine always wins
+		if defaultMachine, err := getDefaultMachine(repository.FullName); err == nil {
+			requestedMachine = defaultMachine
+		}
+	}
+
+	machine := requestedMachine
 	// skip this if we have useWeb and no machine name provided,
 	// because web UI will select default machine type if none is provided
 	// web UI also provide a way to select machine type
 	// therefore we let the user choose from the web UI instead of prompting from CLI
-	if !(opts.useWeb && opts.machine == "") {
-		machine, err = getMachineName(ctx, a.apiClient, prompter, repository.ID, opts.machine, branch, userInputs.Location, devContainerPath)
+	if !(opts.useWeb && requestedMachine == "") {
+		machine, err = getMachineName(ctx, a.apiClient, prompter, repository.ID, requestedMachine, branch, userInputs.Location, devContainerPath)
 		if err != nil {
 			return fmt.Errorf("error getting machine type: %w", err)
 		}
@@ -303,6 +380,8 @@ func (a *App) Create(ctx context.Context, opts createOptions) error {
 		DevContainerPath:       devContainerPath,
 		PermissionsOptOut:      opts.permissionsOptOut,
 		DisplayName:            opts.displayName,
+		Features:               opts.features,
+		DotfilesRepository:     opts.dotfiles,
 	}
 
 	if opts.useWeb {
@@ -342,6 +421,16 @@ func (a *App) Create(ctx context.Context, opts createOptions) error {
 		fmt.Fprintln(a.io.ErrOut, cs.Yellow("Notice:"), codespace.IdleTimeoutNotice)
 	}
 
+	if opts.notify {
+		notifier := a.notifier
+		if notifier == nil {
+			notifier = notify.New()
+		}
+		if err := notifier.Notify("Codespace ready", fmt.Sprintf("%s is ready to use", codespace.Name)); err != nil {
+			fmt.Fprintf(a.io.ErrOut, "failed to send notification: %v\n", err)
+		}
+	}
+
 	return nil
 }
 
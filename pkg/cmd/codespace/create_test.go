@@ -10,6 +10,7 @@ import (
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/cli/cli/v2/internal/browser"
 	"github.com/cli/cli/v2/internal/codespaces/api"
+	"github.com/cli/cli/v2/internal/notify"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/google/shlex"
@@ -32,6 +33,11 @@ func TestCreateCmdFlagError(t *testing.T) {
 			args:     "--web --idle-timeout 30m",
 			wantsErr: fmt.Errorf("using --web with --display-name, --idle-timeout, or --retention-period is not supported"),
 		},
+		{
+			name:     "return error when feature override is missing an equals sign",
+			args:     "--feature nodejs",
+			wantsErr: fmt.Errorf(`invalid feature "nodejs": must be in the format` + " `key=value`"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -56,6 +62,19 @@ func TestCreateCmdFlagError(t *testing.T) {
 	}
 }
 
+func TestParseFeatureOverrides(t *testing.T) {
+	features, err := parseFeatureOverrides([]string{"node=18", "docker-in-docker=true"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"node": "18", "docker-in-docker": "true"}, features)
+
+	features, err = parseFeatureOverrides(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, features)
+
+	_, err = parseFeatureOverrides([]string{"node"})
+	assert.EqualError(t, err, "invalid feature \"node\": must be in the format `key=value`")
+}
+
 func TestApp_Create(t *testing.T) {
 	type fields struct {
 		apiClient apiClient
@@ -666,6 +685,33 @@ Alternatively, you can run "create" with the "--default-permissions" option to c
 	}
 }
 
+func TestApp_Create_notify(t *testing.T) {
+	ios, _, _, _ := iostreams.Test()
+
+	apiMock := apiCreateDefaults(&apiClientMock{
+		CreateCodespaceFunc: func(ctx context.Context, params *api.CreateCodespaceParams) (*api.Codespace, error) {
+			return &api.Codespace{
+				Name: "monalisa-dotfiles-abcd1234",
+			}, nil
+		},
+	})
+
+	notifier := &notify.Stub{}
+	a := &App{
+		io:        ios,
+		apiClient: apiMock,
+		notifier:  notifier,
+	}
+
+	err := a.Create(context.Background(), createOptions{
+		repo:    "monalisa/dotfiles",
+		machine: "GIGA",
+		notify:  true,
+	})
+	assert.NoError(t, err)
+	assert.True(t, notifier.Notified())
+}
+
 func TestBuildDisplayName(t *testing.T) {
 	tests := []struct {
 		name                 string
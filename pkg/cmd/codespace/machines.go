@@ -0,0 +1,202 @@
+package codespace
+
+// This file defines the 'gh cs machines' subcommand.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/codespaces/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+type machinesOptions struct {
+	repo             string
+	branch           string
+	location         string
+	devContainerPath string
+	recommend        bool
+	setDefault       bool
+}
+
+func newMachinesCmd(app *App) *cobra.Command {
+	opts := &machinesOptions{}
+
+	machinesCmd := &cobra.Command{
+		Use:   "machines",
+		Short: "List available machine types for a repository",
+		Long: heredoc.Doc(`
+			List the machine types available for creating a codespace for a repository, branch,
+			and devcontainer.
+
+			With --recommend, only the machine type that gh would pick by default (the first one
+			the API returns, the same one 'gh codespace create' selects when a machine type isn't
+			otherwise specified) is printed, which is convenient for scripting.
+
+			--set-default persists the recommended machine type for the repository, so that a
+			subsequent 'gh codespace create' for that repository uses it instead of prompting or
+			falling back to the API's default; it requires --recommend.
+
+			Hourly pricing isn't exposed by the codespaces API and isn't shown here; see
+			<https://docs.github.com/billing/managing-billing-for-github-codespaces/about-billing-for-github-codespaces>
+			for current rates per machine type.
+		`),
+		Args: noArgsConstraint,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if opts.repo == "" {
+				return cmdutil.FlagErrorf("`--repo` is required")
+			}
+			if opts.setDefault && !opts.recommend {
+				return cmdutil.FlagErrorf("`--set-default` requires `--recommend`")
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return app.Machines(cmd.Context(), opts)
+		},
+	}
+
+	machinesCmd.Flags().StringVarP(&opts.repo, "repo", "R", "", "Repository name with owner: user/repo")
+	if err := addDeprecatedRepoShorthand(machinesCmd, &opts.repo); err != nil {
+		fmt.Fprintf(app.io.ErrOut, "%v\n", err)
+	}
+	machinesCmd.Flags().StringVarP(&opts.branch, "branch", "b", "", "Branch to check devcontainer requirements against (default: the repository's default branch)")
+	machinesCmd.Flags().StringVarP(&opts.devContainerPath, "devcontainer-path", "", "", "Path to the devcontainer.json file to use")
+	machinesCmd.Flags().StringVarP(&opts.location, "location", "l", "", "Location to check availability for, e.g. WestUs2 (default: use the location closest to you)")
+	machinesCmd.Flags().BoolVar(&opts.recommend, "recommend", false, "Only print the machine type gh would pick by default")
+	machinesCmd.Flags().BoolVar(&opts.setDefault, "set-default", false, "Persist the recommended machine type as the default for this repository (requires --recommend)")
+
+	return machinesCmd
+}
+
+// Machines lists the machine types available to create a codespace for a repository,
+// or prints and optionally persists the one gh would pick by default.
+func (a *App) Machines(ctx context.Context, opts *machinesOptions) error {
+	var repository *api.Repository
+	err := a.RunWithProgress("Fetching repository", func() (err error) {
+		repository, err = a.apiClient.GetRepository(ctx, opts.repo)
+		return
+	})
+	if err != nil {
+		return fmt.Errorf("error getting repository: %w", err)
+	}
+
+	branch := opts.branch
+	if branch == "" {
+		branch = repository.DefaultBranch
+	}
+
+	var machines []*api.Machine
+	err = a.RunWithProgress("Fetching machine types", func() (err error) {
+		machines, err = a.apiClient.GetCodespacesMachines(ctx, repository.ID, branch, opts.location, opts.devContainerPath)
+		return
+	})
+	if err != nil {
+		return fmt.Errorf("error requesting machine instance types: %w", err)
+	}
+	if len(machines) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("no available machine types for %s", repository.FullName))
+	}
+
+	// The API returns the machine types in the order it recommends them, and
+	// 'gh codespace create' already relies on that ordering (see getMachineName):
+	// the first entry is the one it selects when the user doesn't specify one.
+	recommended := machines[0]
+
+	if opts.setDefault {
+		if err := setDefaultMachine(repository.FullName, recommended.Name); err != nil {
+			return fmt.Errorf("error persisting default machine type: %w", err)
+		}
+	}
+
+	if opts.recommend {
+		fmt.Fprintln(a.io.Out, recommended.Name)
+		return nil
+	}
+
+	if err := a.io.StartPager(); err != nil {
+		a.errLogger.Printf("error starting pager: %v", err)
+	}
+	defer a.io.StopPager()
+
+	cs := a.io.ColorScheme()
+	tp := tableprinter.New(a.io, tableprinter.WithHeader("NAME", "DISPLAY NAME", "PREBUILD AVAILABILITY", "RECOMMENDED"))
+	for _, m := range machines {
+		tp.AddField(m.Name)
+		tp.AddField(m.DisplayName)
+		tp.AddField(m.PrebuildAvailability)
+		if m.Name == recommended.Name {
+			tp.AddField("yes", tableprinter.WithColor(cs.Green))
+		} else {
+			tp.AddField("")
+		}
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
+
+// defaultMachinesFilePath returns the path to the local file that records the
+// persisted default machine type per repository, set via 'gh codespace machines --set-default'.
+func defaultMachinesFilePath() string {
+	return filepath.Join(config.StateDir(), "codespaces_default_machines.json")
+}
+
+// getDefaultMachine returns the machine type previously persisted for repoFullName via
+// --set-default, or the empty string if none has been set.
+func getDefaultMachine(repoFullName string) (string, error) {
+	defaults, err := readDefaultMachines()
+	if err != nil {
+		return "", err
+	}
+	return defaults[repoFullName], nil
+}
+
+// setDefaultMachine persists machine as the default machine type for repoFullName.
+func setDefaultMachine(repoFullName, machine string) error {
+	defaults, err := readDefaultMachines()
+	if err != nil {
+		return err
+	}
+
+	if defaults == nil {
+		defaults = make(map[string]string)
+	}
+	defaults[repoFullName] = machine
+
+	path := defaultMachinesFilePath()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(defaults, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0600)
+}
+
+// readDefaultMachines reads the persisted repo-to-machine-type defaults. A missing file is
+// treated as an empty set rather than an error.
+func readDefaultMachines() (map[string]string, error) {
+	data, err := os.ReadFile(defaultMachinesFilePath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var defaults map[string]string
+	if err := json.Unmarshal(data, &defaults); err != nil {
+		return nil, err
+	}
+	return defaults, nil
+}
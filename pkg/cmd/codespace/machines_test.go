@@ -0,0 +1,59 @@
+package codespace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/codespaces/api"
+	"github.com/cli/cli/v2/pkg/iostreams"
+)
+
+func TestMachines_recommendAndSetDefault(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	ios, _, stdout, _ := iostreams.Test()
+	apiMock := &apiClientMock{
+		GetRepositoryFunc: func(_ context.Context, nwo string) (*api.Repository, error) {
+			return &api.Repository{ID: 1, FullName: "hubot/robot-army", DefaultBranch: "main"}, nil
+		},
+		GetCodespacesMachinesFunc: func(_ context.Context, _ int, _, _, _ string) ([]*api.Machine, error) {
+			return []*api.Machine{
+				{Name: "smallMachine", DisplayName: "Small"},
+				{Name: "bigMachine", DisplayName: "Big"},
+			}, nil
+		},
+	}
+	a := NewApp(ios, nil, apiMock, nil, nil)
+
+	err := a.Machines(context.Background(), &machinesOptions{
+		repo:       "hubot/robot-army",
+		recommend:  true,
+		setDefault: true,
+	})
+	if err != nil {
+		t.Fatalf("Machines returned error: %v", err)
+	}
+	if got, want := stdout.String(), "smallMachine\n"; got != want {
+		t.Errorf("expected stdout %q, got %q", want, got)
+	}
+
+	got, err := getDefaultMachine("hubot/robot-army")
+	if err != nil {
+		t.Fatalf("getDefaultMachine returned error: %v", err)
+	}
+	if got != "smallMachine" {
+		t.Errorf("expected persisted default %q, got %q", "smallMachine", got)
+	}
+}
+
+func TestGetDefaultMachine_unset(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	got, err := getDefaultMachine("hubot/robot-army")
+	if err != nil {
+		t.Fatalf("getDefaultMachine returned error: %v", err)
+	}
+	if got != "" {
+		t.Errorf("expected no default machine, got %q", got)
+	}
+}
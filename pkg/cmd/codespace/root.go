@@ -29,6 +29,7 @@ func NewCmdCodespace(f *cmdutil.Factory) *cobra.Command {
 	root.AddCommand(newDeleteCmd(app))
 	root.AddCommand(newJupyterCmd(app))
 	root.AddCommand(newListCmd(app))
+	root.AddCommand(newMachinesCmd(app))
 	root.AddCommand(newViewCmd(app))
 	root.AddCommand(newLogsCmd(app))
 	root.AddCommand(newPortsCmd(app))
@@ -44,7 +44,8 @@ type sshOptions struct {
 	debugFile        string
 	stdio            bool
 	config           bool
-	scpArgs          []string // scp arguments, for 'cs cp' (nil for 'cs ssh')
+	scpArgs          []string // scp arguments, for 'cs cp' (nil for 'cs ssh' or 'cs cp --sync')
+	syncArgs         []string // rsync arguments, for 'cs cp --sync' (nil otherwise)
 }
 
 func newSSHCmd(app *App) *cobra.Command {
@@ -171,6 +172,8 @@ func (a *App) SSH(ctx context.Context, sshArgs []string, opts sshOptions) (err e
 	args := sshArgs
 	if opts.scpArgs != nil {
 		args = opts.scpArgs
+	} else if opts.syncArgs != nil {
+		args = opts.syncArgs
 	}
 
 	sshContext := ssh.Context{}
@@ -279,6 +282,9 @@ func (a *App) SSH(ctx context.Context, sshArgs []string, opts sshOptions) (err e
 		if opts.scpArgs != nil {
 			// args is the correct variable to use here, we just use scpArgs as the check for which command to run
 			shellClosed <- codespaces.Copy(ctx, args, localSSHServerPort, connectDestination)
+		} else if opts.syncArgs != nil {
+			// args is the correct variable to use here, we just use syncArgs as the check for which command to run
+			shellClosed <- codespaces.Sync(ctx, args, localSSHServerPort, connectDestination)
 		} else {
 			// Parse the ssh args to determine if the user specified a command
 			args, command, err := codespaces.ParseSSHArgs(args)
@@ -696,6 +702,8 @@ type cpOptions struct {
 	sshOptions
 	recursive bool // -r
 	expand    bool // -e
+	sync      bool // --sync: transfer only changed files, using rsync
+	delete    bool // --delete: remove files at the destination that are absent at the source (requires --sync)
 }
 
 func newCpCmd(app *App) *cobra.Command {
@@ -723,15 +731,29 @@ func newCpCmd(app *App) *cobra.Command {
 			environment variables, and backticks. For security, do not use this flag with arguments
 			provided by untrusted users; see <https://lwn.net/Articles/835962/> for discussion.
 			
-			By default, the %[1]scp%[1]s command will create a public/private ssh key pair to authenticate with 
+			By default, the %[1]scp%[1]s command will create a public/private ssh key pair to authenticate with
 			the codespace inside the %[1]s~/.ssh directory%[1]s.
+
+			With the %[1]s--sync%[1]s flag, %[1]scp%[1]s uses %[1]srsync%[1]s instead of %[1]sscp%[1]s, so that
+			only files that changed since the last copy are transferred; this is much faster for large
+			trees that are copied repeatedly. A %[1]s.ghignore%[1]s file in the current directory, if present,
+			is used to exclude files from the transfer, one pattern per line in %[1]s.gitignore%[1]s syntax.
+			The %[1]s--delete%[1]s flag additionally removes files at the destination that no longer exist
+			at the source.
 		`, "`"),
 		Example: heredoc.Doc(`
 			$ gh codespace cp -e README.md 'remote:/workspaces/$RepositoryName/'
 			$ gh codespace cp -e 'remote:~/*.go' ./gofiles/
 			$ gh codespace cp -e 'remote:/workspaces/myproj/go.{mod,sum}' ./gofiles/
 			$ gh codespace cp -e -- -F ~/.ssh/codespaces_config 'remote:~/*.go' ./gofiles/
+			$ gh codespace cp --sync --delete -r . 'remote:/workspaces/$RepositoryName/'
 		`),
+		PreRunE: func(c *cobra.Command, args []string) error {
+			if opts.delete && !opts.sync {
+				return cmdutil.FlagErrorf("`--delete` requires `--sync`")
+			}
+			return nil
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return app.Copy(cmd.Context(), args, opts)
 		},
@@ -741,26 +763,45 @@ func newCpCmd(app *App) *cobra.Command {
 	// We don't expose all sshOptions.
 	cpCmd.Flags().BoolVarP(&opts.recursive, "recursive", "r", false, "Recursively copy directories")
 	cpCmd.Flags().BoolVarP(&opts.expand, "expand", "e", false, "Expand remote file names on remote shell")
+	cpCmd.Flags().BoolVar(&opts.sync, "sync", false, "Only transfer files that changed since the last copy, using rsync")
+	cpCmd.Flags().BoolVar(&opts.delete, "delete", false, "Remove files at the destination that don't exist at the source (requires --sync)")
 	opts.selector = AddCodespaceSelector(cpCmd, app.apiClient)
 	cpCmd.Flags().StringVarP(&opts.profile, "profile", "p", "", "Name of the SSH profile to use")
 	return cpCmd
 }
 
 // Copy copies files between the local and remote file systems.
-// The mechanics are similar to 'ssh' but using 'scp'.
+// The mechanics are similar to 'ssh' but using 'scp', or 'rsync' when opts.sync is set.
 func (a *App) Copy(ctx context.Context, args []string, opts cpOptions) error {
 	if len(args) < 2 {
 		return fmt.Errorf("cp requires source and destination arguments")
 	}
-	if opts.recursive {
-		opts.scpArgs = append(opts.scpArgs, "-r")
+
+	var transferArgs []string
+	if opts.sync {
+		// -a (archive mode) implies -r, so --recursive is a no-op alongside it.
+		transferArgs = append(transferArgs, "-a")
+		if opts.delete {
+			transferArgs = append(transferArgs, "--delete")
+		}
+		if exclude := ghIgnoreExcludeArg(); exclude != "" {
+			transferArgs = append(transferArgs, exclude)
+		}
+		if a.io.IsStdoutTTY() {
+			transferArgs = append(transferArgs, "--info=progress2")
+		}
+	} else {
+		transferArgs = opts.scpArgs
+		if opts.recursive {
+			transferArgs = append(transferArgs, "-r")
+		}
 	}
 
 	hasRemote := false
 	for _, arg := range args {
 		if rest := strings.TrimPrefix(arg, "remote:"); rest != arg {
 			hasRemote = true
-			// scp treats each filename argument as a shell expression,
+			// scp and rsync both treat each filename argument as a shell expression,
 			// subjecting it to expansion of environment variables, braces,
 			// tilde, backticks, globs and so on. Because these present a
 			// security risk (see https://lwn.net/Articles/835962/), we
@@ -771,8 +812,8 @@ func (a *App) Copy(ctx context.Context, args []string, opts cpOptions) error {
 			}
 
 		} else if !filepath.IsAbs(arg) {
-			// scp treats a colon in the first path segment as a host identifier.
-			// Escape it by prepending "./".
+			// scp and rsync both treat a colon in the first path segment as a host
+			// identifier. Escape it by prepending "./".
 			// TODO(adonovan): test on Windows, including with a c:\\foo path.
 			const sep = string(os.PathSeparator)
 			first := strings.Split(filepath.ToSlash(arg), sep)[0]
@@ -780,10 +821,26 @@ func (a *App) Copy(ctx context.Context, args []string, opts cpOptions) error {
 				arg = "." + sep + arg
 			}
 		}
-		opts.scpArgs = append(opts.scpArgs, arg)
+		transferArgs = append(transferArgs, arg)
 	}
 	if !hasRemote {
 		return cmdutil.FlagErrorf("at least one argument must have a 'remote:' prefix")
 	}
+
+	if opts.sync {
+		opts.syncArgs = transferArgs
+	} else {
+		opts.scpArgs = transferArgs
+	}
 	return a.SSH(ctx, nil, opts.sshOptions)
 }
+
+// ghIgnoreExcludeArg returns an rsync --exclude-from argument for the ".ghignore" file in
+// the current directory, or the empty string if no such file exists.
+func ghIgnoreExcludeArg() string {
+	const ghIgnoreFile = ".ghignore"
+	if _, err := os.Stat(ghIgnoreFile); err != nil {
+		return ""
+	}
+	return "--exclude-from=" + ghIgnoreFile
+}
@@ -3,6 +3,7 @@ package codespace
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -263,6 +264,42 @@ func TestSelectSSHKeys(t *testing.T) {
 	}
 }
 
+func TestCpCmdFlagError(t *testing.T) {
+	cmd := newCpCmd(testingSSHApp())
+	cmd.SetArgs([]string{"--delete", "a", "remote:b"})
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "--delete` requires `--sync") {
+		t.Errorf("expected a --delete/--sync flag error, got: %v", err)
+	}
+}
+
+func TestGhIgnoreExcludeArg(t *testing.T) {
+	dir := t.TempDir()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := ghIgnoreExcludeArg(); got != "" {
+		t.Errorf("expected no exclude arg without a .ghignore file, got %q", got)
+	}
+
+	if err := os.WriteFile(".ghignore", []byte("node_modules/\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := ghIgnoreExcludeArg(), "--exclude-from=.ghignore"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
 func testingSSHApp() *App {
 	disabledCodespace := &api.Codespace{
 		Name:                           "disabledCodespace",
@@ -95,7 +95,10 @@ func Test_listRun(t *testing.T) {
 editor=/usr/bin/vim
 prompt=disabled
 prefer_editor_prompt=enabled
+accessible_prompter=disabled
 pager=less
+strict_deletion_confirmation=disabled
+telemetry=disabled
 http_unix_socket=
 browser=brave
 `,
@@ -0,0 +1,177 @@
+package dash
+
+import (
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/pkg/search"
+	"github.com/shurcooL/githubv4"
+)
+
+// checksSummary is the CI status of a pull request's head commit, reduced to
+// the small set of states the dashboard needs in order to render an icon and
+// offer to rerequest a failing check suite.
+type checksSummary struct {
+	// state is one of the GraphQL StatusState values (SUCCESS, FAILURE,
+	// ERROR, PENDING, EXPECTED), or "" when the commit has no checks.
+	state string
+
+	// repositoryID and failingCheckSuiteID are the node IDs needed to
+	// rerequest a check suite; failingCheckSuiteID is empty unless state is
+	// FAILURE or ERROR.
+	repositoryID        string
+	failingCheckSuiteID string
+}
+
+const dashChecksQuery = `
+query DashChecks($ids: [ID!]!) {
+	nodes(ids: $ids) {
+		... on PullRequest {
+			id
+			repository {
+				id
+			}
+			commits(last: 1) {
+				nodes {
+					commit {
+						statusCheckRollup {
+							state
+							contexts(first: 100) {
+								nodes {
+									... on CheckRun {
+										conclusion
+										checkSuite {
+											id
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}`
+
+type dashChecksResponse struct {
+	Nodes []struct {
+		ID         string `json:"id"`
+		Repository struct {
+			ID string `json:"id"`
+		} `json:"repository"`
+		Commits struct {
+			Nodes []struct {
+				Commit struct {
+					StatusCheckRollup *struct {
+						State    string `json:"state"`
+						Contexts struct {
+							Nodes []struct {
+								Conclusion string `json:"conclusion"`
+								CheckSuite struct {
+									ID string `json:"id"`
+								} `json:"checkSuite"`
+							} `json:"nodes"`
+						} `json:"contexts"`
+					} `json:"statusCheckRollup"`
+				} `json:"commit"`
+			} `json:"nodes"`
+		} `json:"commits"`
+	} `json:"nodes"`
+}
+
+// fetchChecksSummaries looks up the CI status of a batch of pull requests by
+// node ID in a single request, keyed by that same node ID, so the dashboard
+// doesn't issue one GraphQL call per row.
+func fetchChecksSummaries(client *api.Client, host string, issues []search.Issue) (map[string]checksSummary, error) {
+	summaries := make(map[string]checksSummary, len(issues))
+	if len(issues) == 0 {
+		return summaries, nil
+	}
+
+	ids := make([]string, len(issues))
+	for i, issue := range issues {
+		ids[i] = issue.ID
+	}
+
+	var resp dashChecksResponse
+	if err := client.GraphQL(host, dashChecksQuery, map[string]interface{}{"ids": ids}, &resp); err != nil {
+		return nil, err
+	}
+
+	for _, node := range resp.Nodes {
+		if len(node.Commits.Nodes) == 0 {
+			continue
+		}
+		rollup := node.Commits.Nodes[0].Commit.StatusCheckRollup
+		if rollup == nil {
+			continue
+		}
+
+		summary := checksSummary{state: rollup.State, repositoryID: node.Repository.ID}
+		if rollup.State == "FAILURE" || rollup.State == "ERROR" {
+			for _, ctx := range rollup.Contexts.Nodes {
+				if ctx.Conclusion == "FAILURE" && ctx.CheckSuite.ID != "" {
+					summary.failingCheckSuiteID = ctx.CheckSuite.ID
+					break
+				}
+			}
+		}
+		summaries[node.ID] = summary
+	}
+
+	return summaries, nil
+}
+
+// checksIcon renders a checksSummary as a short, color-tagged glyph suitable
+// for a tview list row.
+func checksIcon(summary checksSummary) string {
+	switch summary.state {
+	case "SUCCESS":
+		return "[green::]✓[-:-:-]"
+	case "FAILURE", "ERROR":
+		return "[red::]✗[-:-:-]"
+	case "PENDING", "EXPECTED":
+		return "[yellow::]●[-:-:-]"
+	default:
+		return " "
+	}
+}
+
+// approvePullRequest submits an approving review for the pull request with
+// the given node ID. It mirrors api.AddReview, minus the ghrepo.Interface
+// argument AddReview only uses for its hostname: dash's pull requests can
+// each belong to a different repository on the same host.
+func approvePullRequest(client *api.Client, host, pullRequestID string) error {
+	var mutation struct {
+		AddPullRequestReview struct {
+			ClientMutationID string
+		} `graphql:"addPullRequestReview(input:$input)"`
+	}
+
+	event := githubv4.PullRequestReviewEventApprove
+	variables := map[string]interface{}{
+		"input": githubv4.AddPullRequestReviewInput{
+			PullRequestID: githubv4.ID(pullRequestID),
+			Event:         &event,
+		},
+	}
+
+	return client.Mutate(host, "PullRequestReviewAdd", &mutation, variables)
+}
+
+// rerequestCheckSuite asks GitHub to rerun a failed check suite.
+func rerequestCheckSuite(client *api.Client, host, repositoryID, checkSuiteID string) error {
+	var mutation struct {
+		RerequestCheckSuite struct {
+			ClientMutationID string
+		} `graphql:"rerequestCheckSuite(input:$input)"`
+	}
+
+	variables := map[string]interface{}{
+		"input": githubv4.RerequestCheckSuiteInput{
+			RepositoryID: githubv4.ID(repositoryID),
+			CheckSuiteID: githubv4.ID(checkSuiteID),
+		},
+	}
+
+	return client.Mutate(host, "RerequestCheckSuite", &mutation, variables)
+}
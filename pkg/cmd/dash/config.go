@@ -0,0 +1,48 @@
+package dash
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/cli/cli/v2/internal/gh"
+)
+
+// dashHiddenSectionsKey stores which dashboard sections the user has hidden,
+// as a comma-separated list of section keys. It isn't one of the well-known
+// keys in internal/config.Options, since it's only ever read and written by
+// this command, not by `gh config get/set`.
+const dashHiddenSectionsKey = "dash_hidden_sections"
+
+// loadHiddenSections reads the persisted set of hidden section keys.
+func loadHiddenSections(cfg gh.Config) map[dashSectionKey]bool {
+	value := cfg.GetOrDefault("", dashHiddenSectionsKey).UnwrapOrZero().Value
+	return parseHiddenSections(value)
+}
+
+// saveHiddenSections persists the given set of hidden section keys.
+func saveHiddenSections(cfg gh.Config, hidden map[dashSectionKey]bool) error {
+	cfg.Set("", dashHiddenSectionsKey, formatHiddenSections(hidden))
+	return cfg.Write()
+}
+
+func parseHiddenSections(value string) map[dashSectionKey]bool {
+	hidden := map[dashSectionKey]bool{}
+	for _, key := range strings.Split(value, ",") {
+		key = strings.TrimSpace(key)
+		if key != "" {
+			hidden[dashSectionKey(key)] = true
+		}
+	}
+	return hidden
+}
+
+func formatHiddenSections(hidden map[dashSectionKey]bool) string {
+	keys := make([]string, 0, len(hidden))
+	for key, isHidden := range hidden {
+		if isHidden {
+			keys = append(keys, string(key))
+		}
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ",")
+}
@@ -0,0 +1,409 @@
+package dash
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/browser"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/search"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/spf13/cobra"
+)
+
+type DashOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
+	Browser    browser.Browser
+
+	Searcher func(host string, client *http.Client) search.Searcher
+}
+
+// dashSectionKey identifies one of the dashboard's panes, both for focus
+// tracking and for the persisted set of sections the user has hidden.
+type dashSectionKey string
+
+const (
+	sectionAuthored dashSectionKey = "authored"
+	sectionReview   dashSectionKey = "review"
+	sectionAssigned dashSectionKey = "assigned"
+)
+
+// dashSection is one pane of the dashboard: the search query that fills it,
+// the list widget it's rendered into, and the results of the most recent
+// refresh.
+type dashSection struct {
+	key   dashSectionKey
+	title string
+	query search.Query
+	list  *tview.List
+	items []search.Issue
+	// showChecks is true for sections listing pull requests, where a CI
+	// status icon and the approve/rerun actions are meaningful.
+	showChecks bool
+}
+
+func NewCmdDash(f *cmdutil.Factory, runF func(*DashOptions) error) *cobra.Command {
+	opts := &DashOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		Browser:    f.Browser,
+		Searcher: func(host string, client *http.Client) search.Searcher {
+			return search.NewSearcher(client, host)
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "dash",
+		Short: "Show a live dashboard of your pull requests and issues",
+		Long: heredoc.Doc(`
+			Open a full-screen dashboard summarizing pull requests that need your
+			attention: those you authored, those awaiting your review, and issues
+			assigned to you.
+
+			Press r to refresh, 1/2/3 to show or hide a section, Tab to switch
+			sections, o to open the selected item in a browser, v to approve the
+			selected pull request, x to rerun its failing checks, and q or Esc to
+			quit.
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !opts.IO.IsStdoutTTY() {
+				return cmdutil.FlagErrorf("gh dash requires an interactive terminal")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return dashRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+// newDashSections builds the dashboard's panes in the fixed order they're
+// displayed and toggled in: authored, awaiting review, assigned.
+// dashResultLimit caps how many items each section fetches, matching the
+// default page size `gh search` itself uses.
+const dashResultLimit = 30
+
+func newDashSections() []dashSection {
+	return []dashSection{
+		{
+			key:   sectionAuthored,
+			title: "Authored by you",
+			query: search.Query{
+				Kind:  search.KindIssues,
+				Limit: dashResultLimit,
+				Qualifiers: search.Qualifiers{
+					Type:   "pr",
+					Author: "@me",
+					State:  "open",
+				},
+			},
+			showChecks: true,
+		},
+		{
+			key:   sectionReview,
+			title: "Awaiting your review",
+			query: search.Query{
+				Kind:  search.KindIssues,
+				Limit: dashResultLimit,
+				Qualifiers: search.Qualifiers{
+					Type:            "pr",
+					ReviewRequested: "@me",
+					State:           "open",
+				},
+			},
+			showChecks: true,
+		},
+		{
+			key:   sectionAssigned,
+			title: "Assigned to you",
+			query: search.Query{
+				Kind:  search.KindIssues,
+				Limit: dashResultLimit,
+				Qualifiers: search.Qualifiers{
+					Type:     "issue",
+					Assignee: "@me",
+					State:    "open",
+				},
+			},
+		},
+	}
+}
+
+func dashRun(opts *DashOptions) error {
+	client, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, _ := cfg.Authentication().DefaultHost()
+	searcher := opts.Searcher(host, client)
+	apiClient := api.NewClientFromHTTP(client)
+
+	hidden := loadHiddenSections(cfg)
+
+	sections := newDashSections()
+	for i := range sections {
+		sections[i].list = tview.NewList().ShowSecondaryText(false)
+		sections[i].list.SetBorder(true).SetTitle(sections[i].title)
+	}
+
+	// checks caches the most recent CI status lookup, keyed by pull request
+	// node ID, so the rerun action can find a failing check suite to
+	// rerequest without making another request.
+	checks := map[string]checksSummary{}
+
+	app := tview.NewApplication()
+	app.EnableMouse(false)
+
+	header := tview.NewTextView().SetTextAlign(tview.AlignCenter).SetDynamicColors(true)
+	header.SetText("[::b]gh dash[::-]  -  r: refresh  1/2/3: toggle section  Tab: switch  o: open  v: approve  x: rerun  q: quit")
+
+	status := tview.NewTextView().SetTextAlign(tview.AlignCenter).SetDynamicColors(true)
+
+	body := tview.NewFlex()
+
+	focused := 0
+
+	visibleSections := func() []*dashSection {
+		var out []*dashSection
+		for i := range sections {
+			if !hidden[sections[i].key] {
+				out = append(out, &sections[i])
+			}
+		}
+		return out
+	}
+
+	rebuildBody := func() {
+		body.Clear()
+		for _, sec := range visibleSections() {
+			body.AddItem(sec.list, 0, 1, false)
+		}
+	}
+	rebuildBody()
+
+	focusSection := func(delta int) {
+		vis := visibleSections()
+		if len(vis) == 0 {
+			return
+		}
+		focused = ((focused+delta)%len(vis) + len(vis)) % len(vis)
+		app.SetFocus(vis[focused].list)
+	}
+
+	currentSection := func() *dashSection {
+		vis := visibleSections()
+		if len(vis) == 0 {
+			return nil
+		}
+		if focused >= len(vis) {
+			focused = 0
+		}
+		return vis[focused]
+	}
+
+	currentIssue := func() (*dashSection, *search.Issue) {
+		sec := currentSection()
+		if sec == nil {
+			return nil, nil
+		}
+		idx := sec.list.GetCurrentItem()
+		if idx < 0 || idx >= len(sec.items) {
+			return sec, nil
+		}
+		return sec, &sec.items[idx]
+	}
+
+	refresh := func() {
+		app.QueueUpdateDraw(func() {
+			status.SetText("Refreshing...")
+		})
+
+		fetched := make([][]search.Issue, len(sections))
+		for i := range sections {
+			result, err := searcher.Issues(sections[i].query)
+			if err != nil {
+				title := sections[i].title
+				app.QueueUpdateDraw(func() {
+					status.SetText(fmt.Sprintf("error fetching %q: %v", title, err))
+				})
+				return
+			}
+			fetched[i] = result.Items
+		}
+
+		var prs []search.Issue
+		for i := range sections {
+			if sections[i].showChecks {
+				prs = append(prs, fetched[i]...)
+			}
+		}
+		newChecks, checksErr := fetchChecksSummaries(apiClient, host, prs)
+
+		app.QueueUpdateDraw(func() {
+			if checksErr == nil {
+				checks = newChecks
+			}
+
+			for i := range sections {
+				sec := &sections[i]
+				sec.items = fetched[i]
+
+				sec.list.Clear()
+				for _, issue := range sec.items {
+					line := fmt.Sprintf("#%-6d %s", issue.Number, issue.Title)
+					if sec.showChecks {
+						line = checksIcon(checks[issue.ID]) + " " + line
+					}
+					sec.list.AddItem(line, "", 0, nil)
+				}
+			}
+
+			msg := fmt.Sprintf("%d authored, %d awaiting review, %d assigned",
+				len(sections[0].items), len(sections[1].items), len(sections[2].items))
+			if checksErr != nil {
+				msg += fmt.Sprintf(" (failed to fetch CI status: %v)", checksErr)
+			}
+			status.SetText(msg)
+
+			focusSection(0)
+		})
+	}
+
+	toggleSection := func(key dashSectionKey) {
+		hidden[key] = !hidden[key]
+		if err := saveHiddenSections(cfg, hidden); err != nil {
+			status.SetText(fmt.Sprintf("failed to save dashboard layout: %v", err))
+		}
+		focused = 0
+		rebuildBody()
+		focusSection(0)
+	}
+
+	openFocused := func() {
+		_, issue := currentIssue()
+		if issue == nil {
+			return
+		}
+		if err := opts.Browser.Browse(issue.URL); err != nil {
+			status.SetText(fmt.Sprintf("failed to open browser: %v", err))
+		}
+	}
+
+	approveFocused := func() {
+		sec, issue := currentIssue()
+		if issue == nil {
+			return
+		}
+		if sec.key != sectionReview {
+			status.SetText("approve only works on pull requests awaiting your review")
+			return
+		}
+		number, id := issue.Number, issue.ID
+		go func() {
+			err := approvePullRequest(apiClient, host, id)
+			app.QueueUpdateDraw(func() {
+				if err != nil {
+					status.SetText(fmt.Sprintf("failed to approve #%d: %v", number, err))
+					return
+				}
+				status.SetText(fmt.Sprintf("approved #%d", number))
+			})
+		}()
+	}
+
+	rerunFocused := func() {
+		sec, issue := currentIssue()
+		if issue == nil {
+			return
+		}
+		if !sec.showChecks {
+			status.SetText("rerun only works on pull requests")
+			return
+		}
+		summary, ok := checks[issue.ID]
+		if !ok || summary.failingCheckSuiteID == "" {
+			status.SetText(fmt.Sprintf("#%d has no failing checks to rerun", issue.Number))
+			return
+		}
+		number := issue.Number
+		go func() {
+			err := rerequestCheckSuite(apiClient, host, summary.repositoryID, summary.failingCheckSuiteID)
+			app.QueueUpdateDraw(func() {
+				if err != nil {
+					status.SetText(fmt.Sprintf("failed to rerun checks for #%d: %v", number, err))
+					return
+				}
+				status.SetText(fmt.Sprintf("requested a rerun of failing checks for #%d", number))
+			})
+		}()
+	}
+
+	root := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(header, 1, 0, false).
+		AddItem(body, 0, 1, true).
+		AddItem(status, 1, 0, false)
+
+	app.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Rune() {
+		case 'q':
+			app.Stop()
+			return nil
+		case 'r':
+			go refresh()
+			return nil
+		case '1':
+			toggleSection(sectionAuthored)
+			return nil
+		case '2':
+			toggleSection(sectionReview)
+			return nil
+		case '3':
+			toggleSection(sectionAssigned)
+			return nil
+		case 'o':
+			openFocused()
+			return nil
+		case 'v':
+			approveFocused()
+			return nil
+		case 'x':
+			rerunFocused()
+			return nil
+		}
+		switch event.Key() {
+		case tcell.KeyEscape:
+			app.Stop()
+			return nil
+		case tcell.KeyTab:
+			focusSection(1)
+			return nil
+		case tcell.KeyBacktab:
+			focusSection(-1)
+			return nil
+		}
+		return event
+	})
+
+	app.SetRoot(root, true)
+	focusSection(0)
+	go refresh()
+
+	return app.Run()
+}
@@ -0,0 +1,158 @@
+package dash
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/gh"
+	ghmock "github.com/cli/cli/v2/internal/gh/mock"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	o "github.com/cli/cli/v2/pkg/option"
+	"github.com/cli/cli/v2/pkg/search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDashSections(t *testing.T) {
+	sections := newDashSections()
+	require.Len(t, sections, 3)
+
+	assert.Equal(t, sectionAuthored, sections[0].key)
+	assert.Equal(t, "pr", sections[0].query.Qualifiers.Type)
+	assert.Equal(t, "@me", sections[0].query.Qualifiers.Author)
+	assert.Equal(t, dashResultLimit, sections[0].query.Limit)
+	assert.True(t, sections[0].showChecks)
+
+	assert.Equal(t, sectionReview, sections[1].key)
+	assert.Equal(t, "pr", sections[1].query.Qualifiers.Type)
+	assert.Equal(t, "@me", sections[1].query.Qualifiers.ReviewRequested)
+	assert.True(t, sections[1].showChecks)
+
+	assert.Equal(t, sectionAssigned, sections[2].key)
+	assert.Equal(t, "issue", sections[2].query.Qualifiers.Type)
+	assert.Equal(t, "@me", sections[2].query.Qualifiers.Assignee)
+	assert.False(t, sections[2].showChecks)
+}
+
+func TestHiddenSectionsRoundTrip(t *testing.T) {
+	hidden := parseHiddenSections("authored,assigned")
+	assert.True(t, hidden[sectionAuthored])
+	assert.False(t, hidden[sectionReview])
+	assert.True(t, hidden[sectionAssigned])
+
+	assert.Equal(t, "assigned,authored", formatHiddenSections(hidden))
+	assert.Equal(t, "", formatHiddenSections(parseHiddenSections("")))
+}
+
+func TestLoadSaveHiddenSections(t *testing.T) {
+	var saved string
+	cfg := &ghmock.ConfigMock{
+		GetOrDefaultFunc: func(hostname, key string) o.Option[gh.ConfigEntry] {
+			return o.None[gh.ConfigEntry]()
+		},
+		SetFunc: func(hostname, key, value string) {
+			assert.Equal(t, "", hostname)
+			assert.Equal(t, dashHiddenSectionsKey, key)
+			saved = value
+		},
+		WriteFunc: func() error { return nil },
+	}
+
+	hidden := loadHiddenSections(cfg)
+	assert.Empty(t, hidden)
+
+	hidden[sectionReview] = true
+	require.NoError(t, saveHiddenSections(cfg, hidden))
+	assert.Equal(t, "review", saved)
+}
+
+func TestChecksIcon(t *testing.T) {
+	assert.Equal(t, "[green::]✓[-:-:-]", checksIcon(checksSummary{state: "SUCCESS"}))
+	assert.Equal(t, "[red::]✗[-:-:-]", checksIcon(checksSummary{state: "FAILURE"}))
+	assert.Equal(t, "[yellow::]●[-:-:-]", checksIcon(checksSummary{state: "PENDING"}))
+	assert.Equal(t, " ", checksIcon(checksSummary{}))
+}
+
+func TestFetchChecksSummaries(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query DashChecks\b`),
+		httpmock.StringResponse(`{
+			"data": {
+				"nodes": [
+					{
+						"id": "PR_1",
+						"repository": { "id": "REPO_1" },
+						"commits": {
+							"nodes": [
+								{
+									"commit": {
+										"statusCheckRollup": {
+											"state": "FAILURE",
+											"contexts": {
+												"nodes": [
+													{ "conclusion": "FAILURE", "checkSuite": { "id": "SUITE_1" } }
+												]
+											}
+										}
+									}
+								}
+							]
+						}
+					}
+				]
+			}
+		}`),
+	)
+
+	client := api.NewClientFromHTTP(&http.Client{Transport: reg})
+	summaries, err := fetchChecksSummaries(client, "github.com", []search.Issue{{ID: "PR_1"}})
+	require.NoError(t, err)
+
+	summary := summaries["PR_1"]
+	assert.Equal(t, "FAILURE", summary.state)
+	assert.Equal(t, "REPO_1", summary.repositoryID)
+	assert.Equal(t, "SUITE_1", summary.failingCheckSuiteID)
+}
+
+func TestFetchChecksSummaries_noIssues(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	client := api.NewClientFromHTTP(&http.Client{Transport: reg})
+	summaries, err := fetchChecksSummaries(client, "github.com", nil)
+	require.NoError(t, err)
+	assert.Empty(t, summaries)
+}
+
+func TestApprovePullRequest(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`mutation PullRequestReviewAdd\b`),
+		httpmock.GraphQLMutation(`{"data": {}}`, func(inputs map[string]interface{}) {
+			assert.Equal(t, "PR_1", inputs["pullRequestId"])
+			assert.Equal(t, "APPROVE", inputs["event"])
+		}),
+	)
+
+	client := api.NewClientFromHTTP(&http.Client{Transport: reg})
+	require.NoError(t, approvePullRequest(client, "github.com", "PR_1"))
+}
+
+func TestRerequestCheckSuite(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`mutation RerequestCheckSuite\b`),
+		httpmock.GraphQLMutation(`{"data": {}}`, func(inputs map[string]interface{}) {
+			assert.Equal(t, "REPO_1", inputs["repositoryId"])
+			assert.Equal(t, "SUITE_1", inputs["checkSuiteId"])
+		}),
+	)
+
+	client := api.NewClientFromHTTP(&http.Client{Transport: reg})
+	require.NoError(t, rerequestCheckSuite(client, "github.com", "REPO_1", "SUITE_1"))
+}
@@ -0,0 +1,28 @@
+package alert
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdDismiss "github.com/cli/cli/v2/pkg/cmd/dependabot/alert/dismiss"
+	cmdList "github.com/cli/cli/v2/pkg/cmd/dependabot/alert/list"
+	cmdView "github.com/cli/cli/v2/pkg/cmd/dependabot/alert/view"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdAlert(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "alert <command>",
+		Short: "Manage Dependabot alerts",
+		Long: heredoc.Doc(`
+			Work with Dependabot alerts for a repository.
+		`),
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdView.NewCmdView(f, nil))
+	cmd.AddCommand(cmdDismiss.NewCmdDismiss(f, nil))
+
+	return cmd
+}
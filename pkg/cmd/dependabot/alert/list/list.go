@@ -0,0 +1,135 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmd/dependabot/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Exporter cmdutil.Exporter
+
+	State     string
+	Severity  string
+	Ecosystem string
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List Dependabot alerts",
+		Long: heredoc.Doc(`
+			List Dependabot alerts in a repository.
+
+			Alerts can be filtered by state, severity, and ecosystem.
+		`),
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.State, "state", "", "Filter by state: {open|dismissed|fixed|auto_dismissed}")
+	cmd.Flags().StringVar(&opts.Severity, "severity", "", "Filter by severity: {low|medium|high|critical}")
+	cmd.Flags().StringVar(&opts.Ecosystem, "ecosystem", "", "Filter by package ecosystem")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.AlertJSONFields)
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	client, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("could not create http client: %w", err)
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("repos/%s/dependabot/alerts?per_page=100", ghrepo.FullName(baseRepo))
+	if opts.State != "" {
+		path += "&state=" + opts.State
+	}
+	if opts.Severity != "" {
+		path += "&severity=" + opts.Severity
+	}
+	if opts.Ecosystem != "" {
+		path += "&ecosystem=" + opts.Ecosystem
+	}
+
+	apiClient := api.NewClientFromHTTP(client)
+
+	var alerts []shared.Alert
+	for path != "" {
+		var page []shared.Alert
+		path, err = apiClient.RESTWithNext(baseRepo.RepoHost(), "GET", path, nil, &page)
+		if err != nil {
+			return fmt.Errorf("failed to get alerts: %w", err)
+		}
+		alerts = append(alerts, page...)
+	}
+
+	if len(alerts) == 0 && opts.Exporter == nil {
+		return cmdutil.NewNoResultsError("no Dependabot alerts found")
+	}
+
+	if err := opts.IO.StartPager(); err == nil {
+		defer opts.IO.StopPager()
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, alerts)
+	}
+
+	cs := opts.IO.ColorScheme()
+	table := tableprinter.New(opts.IO, tableprinter.WithHeader("NUMBER", "PACKAGE", "SEVERITY", "STATE", "SUMMARY"))
+	for _, alert := range alerts {
+		table.AddField(fmt.Sprintf("#%d", alert.Number))
+		table.AddField(alert.Dependency.Package.Name)
+		table.AddField(colorizeSeverity(cs, alert.SecurityVulnerability.Severity))
+		table.AddField(alert.State)
+		table.AddField(alert.SecurityAdvisory.Summary)
+		table.EndRow()
+	}
+
+	return table.Render()
+}
+
+func colorizeSeverity(cs *iostreams.ColorScheme, severity string) string {
+	switch severity {
+	case "critical", "high":
+		return cs.Red(severity)
+	case "medium":
+		return cs.Yellow(severity)
+	default:
+		return severity
+	}
+}
@@ -0,0 +1,57 @@
+package list
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/dependabot/alerts"),
+		httpmock.JSONResponse([]map[string]interface{}{
+			{
+				"number": 1,
+				"state":  "open",
+				"dependency": map[string]interface{}{
+					"package": map[string]interface{}{
+						"name":      "lodash",
+						"ecosystem": "npm",
+					},
+				},
+				"security_advisory": map[string]interface{}{
+					"summary": "Prototype pollution",
+				},
+				"security_vulnerability": map[string]interface{}{
+					"severity": "high",
+				},
+			},
+		}),
+	)
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	opts := &ListOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	err := listRun(opts)
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "lodash")
+	assert.Contains(t, stdout.String(), "Prototype pollution")
+}
@@ -0,0 +1,25 @@
+package dependabot
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdAlert "github.com/cli/cli/v2/pkg/cmd/dependabot/alert"
+	cmdUpdate "github.com/cli/cli/v2/pkg/cmd/dependabot/update"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdDependabot(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dependabot <command>",
+		Short: "Manage Dependabot alerts and updates",
+		Long: heredoc.Docf(`
+			Work with Dependabot alerts and updates for a repository. Run
+			%[1]sgh dependabot alert list%[1]s to see open alerts.
+		`, "`"),
+	}
+
+	cmd.AddCommand(cmdAlert.NewCmdAlert(f))
+	cmd.AddCommand(cmdUpdate.NewCmdUpdate(f))
+
+	return cmd
+}
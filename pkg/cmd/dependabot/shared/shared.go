@@ -0,0 +1,56 @@
+package shared
+
+import (
+	"time"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+)
+
+type Alert struct {
+	Number     int    `json:"number"`
+	State      string `json:"state"`
+	Dependency struct {
+		Package struct {
+			Name      string `json:"name"`
+			Ecosystem string `json:"ecosystem"`
+		} `json:"package"`
+		ManifestPath string `json:"manifest_path"`
+	} `json:"dependency"`
+	SecurityAdvisory struct {
+		Summary  string `json:"summary"`
+		Severity string `json:"severity"`
+	} `json:"security_advisory"`
+	SecurityVulnerability struct {
+		Severity string `json:"severity"`
+	} `json:"security_vulnerability"`
+	URL             string     `json:"html_url"`
+	CreatedAt       time.Time  `json:"created_at"`
+	DismissedAt     *time.Time `json:"dismissed_at"`
+	DismissedReason string     `json:"dismissed_reason"`
+}
+
+var AlertJSONFields = []string{
+	"number",
+	"state",
+	"dependency",
+	"securityAdvisory",
+	"securityVulnerability",
+	"url",
+	"createdAt",
+	"dismissedAt",
+	"dismissedReason",
+}
+
+func (a *Alert) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(a, fields)
+}
+
+// DismissReasons are the reasons accepted by the Dependabot alerts API when
+// dismissing an alert.
+var DismissReasons = []string{
+	"fix_started",
+	"inaccurate",
+	"no_bandwidth",
+	"not_used",
+	"tolerable_risk",
+}
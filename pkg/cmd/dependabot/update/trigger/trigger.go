@@ -0,0 +1,78 @@
+package trigger
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type TriggerOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	EcosystemPath string
+}
+
+func NewCmdTrigger(f *cmdutil.Factory, runF func(*TriggerOptions) error) *cobra.Command {
+	opts := &TriggerOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "trigger <ecosystem-path>",
+		Short: "Trigger a Dependabot security update check",
+		Long: heredoc.Doc(`
+			Trigger a manual check for Dependabot version or security updates for a
+			given package-ecosystem directory (as configured in dependabot.yml).
+		`),
+		Example: heredoc.Doc(`
+			$ gh dependabot update trigger /
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.EcosystemPath = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return triggerRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func triggerRun(opts *TriggerOptions) error {
+	client, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("could not create http client: %w", err)
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("repos/%s/dependabot/updates/%s", ghrepo.FullName(baseRepo), opts.EcosystemPath)
+	if err := apiClient.REST(baseRepo.RepoHost(), "POST", path, nil, nil); err != nil {
+		return fmt.Errorf("failed to trigger Dependabot update: %w", err)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Triggered Dependabot update check for %s\n", cs.SuccessIcon(), opts.EcosystemPath)
+	}
+
+	return nil
+}
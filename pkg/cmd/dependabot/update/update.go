@@ -0,0 +1,24 @@
+package update
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdTrigger "github.com/cli/cli/v2/pkg/cmd/dependabot/update/trigger"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdUpdate(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update <command>",
+		Short: "Manage Dependabot updates",
+		Long: heredoc.Doc(`
+			Work with Dependabot version and security updates for a repository.
+		`),
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdTrigger.NewCmdTrigger(f, nil))
+
+	return cmd
+}
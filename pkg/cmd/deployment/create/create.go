@@ -0,0 +1,116 @@
+package create
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/deployment/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type CreateOptions struct {
+	HTTPClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Ref                   string
+	Task                  string
+	Environment           string
+	Description           string
+	Payload               string
+	AutoMerge             bool
+	TransientEnvironment  bool
+	ProductionEnvironment bool
+
+	ProductionEnvironmentChanged bool
+}
+
+func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
+	opts := &CreateOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create <ref>",
+		Short: "Create a deployment for a ref",
+		Long: heredoc.Docf(`
+			Create a deployment for the given ref, which may be a branch, tag, or full commit SHA.
+
+			Creating a deployment does not itself deploy anything; it records that a deployment was
+			requested so that integrations listening for the %[1]sdeployment%[1]s event can act on it.
+			Use %[1]sgh deployment status%[1]s to report on its progress.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ gh deployment create main --environment production
+			$ gh deployment create main --environment production --payload '{"version":"1.2.3"}'
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.Ref = args[0]
+			opts.ProductionEnvironmentChanged = cmd.Flags().Changed("production-environment")
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return createRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Environment, "environment", "e", "production", "Environment to deploy to")
+	cmd.Flags().StringVarP(&opts.Description, "description", "d", "", "Short description of the deployment")
+	cmd.Flags().StringVarP(&opts.Task, "task", "t", "", "Deployment task, e.g. \"deploy\" or \"deploy:migrations\"")
+	cmd.Flags().StringVarP(&opts.Payload, "payload", "p", "", "JSON payload with extra information about the deployment")
+	cmd.Flags().BoolVar(&opts.AutoMerge, "auto-merge", false, "Merge the default branch into the ref before deploying")
+	cmd.Flags().BoolVar(&opts.TransientEnvironment, "transient-environment", false, "Mark the environment as transient, e.g. a review app")
+	cmd.Flags().BoolVar(&opts.ProductionEnvironment, "production-environment", false, "Mark the environment as a production environment")
+
+	return cmd
+}
+
+func createRun(opts *CreateOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	createOpts := shared.CreateDeploymentOptions{
+		Ref:                  opts.Ref,
+		Task:                 opts.Task,
+		Environment:          opts.Environment,
+		Description:          opts.Description,
+		Payload:              opts.Payload,
+		AutoMerge:            opts.AutoMerge,
+		TransientEnvironment: opts.TransientEnvironment,
+		RequiredContexts:     []string{},
+	}
+	if opts.ProductionEnvironmentChanged {
+		createOpts.ProductionEnvironment = &opts.ProductionEnvironment
+	}
+
+	opts.IO.StartProgressIndicator()
+	deployment, err := shared.CreateDeployment(httpClient, repo, createOpts)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to create deployment: %w", err)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Created deployment %d for %s in %s\n", cs.SuccessIcon(), deployment.Id, opts.Ref, ghrepo.FullName(repo))
+	} else {
+		fmt.Fprintf(opts.IO.Out, "%d\n", deployment.Id)
+	}
+
+	return nil
+}
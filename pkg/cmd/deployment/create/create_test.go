@@ -0,0 +1,59 @@
+package create
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_createRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/deployments"),
+		httpmock.RESTPayload(201, `{"id": 99}`, func(payload map[string]interface{}) {
+			if ref := payload["ref"].(string); ref != "main" {
+				t.Errorf("POST ref %q, want %q", ref, "main")
+			}
+			if env := payload["environment"].(string); env != "production" {
+				t.Errorf("POST environment %q, want %q", env, "production")
+			}
+		}))
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	opts := &CreateOptions{
+		IO:          ios,
+		HTTPClient:  func() (*http.Client, error) { return &http.Client{Transport: reg}, nil },
+		BaseRepo:    func() (ghrepo.Interface, error) { return ghrepo.New("OWNER", "REPO"), nil },
+		Ref:         "main",
+		Environment: "production",
+	}
+
+	require.NoError(t, createRun(opts))
+	assert.Contains(t, stdout.String(), "Created deployment 99 for main in OWNER/REPO")
+}
+
+func Test_createRun_invalidPayload(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	ios, _, _, _ := iostreams.Test()
+
+	opts := &CreateOptions{
+		IO:          ios,
+		HTTPClient:  func() (*http.Client, error) { return &http.Client{Transport: reg}, nil },
+		BaseRepo:    func() (ghrepo.Interface, error) { return ghrepo.New("OWNER", "REPO"), nil },
+		Ref:         "main",
+		Environment: "production",
+		Payload:     "not json",
+	}
+
+	assert.Error(t, createRun(opts))
+}
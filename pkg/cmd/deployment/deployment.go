@@ -0,0 +1,31 @@
+package deployment
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdCreate "github.com/cli/cli/v2/pkg/cmd/deployment/create"
+	cmdList "github.com/cli/cli/v2/pkg/cmd/deployment/list"
+	cmdStatus "github.com/cli/cli/v2/pkg/cmd/deployment/status"
+	cmdView "github.com/cli/cli/v2/pkg/cmd/deployment/view"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdDeployment(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deployment <command>",
+		Short: "Work with GitHub deployments",
+		Long: heredoc.Doc(`
+			List, view, and create deployments, and report their status, as recorded by the GitHub
+			Deployments API.
+		`),
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdView.NewCmdView(f, nil))
+	cmd.AddCommand(cmdCreate.NewCmdCreate(f, nil))
+	cmd.AddCommand(cmdStatus.NewCmdStatus(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,124 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmd/deployment/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HTTPClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Exporter   cmdutil.Exporter
+	Now        time.Time
+
+	Environment string
+	Ref         string
+	Limit       int
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List deployments for a repository",
+		Example: heredoc.Doc(`
+			$ gh deployment list
+			$ gh deployment list --environment production
+		`),
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.Limit < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Environment, "environment", "e", "", "Filter by environment")
+	cmd.Flags().StringVarP(&opts.Ref, "ref", "r", "", "Filter by git ref")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of deployments to list")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.DeploymentFields)
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	deployments, err := shared.ListDeployments(httpClient, repo, shared.ListOptions{
+		Environment: opts.Environment,
+		Ref:         opts.Ref,
+		Limit:       opts.Limit,
+	})
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if len(deployments) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("no deployments found in %s", ghrepo.FullName(repo)))
+	}
+
+	if err := opts.IO.StartPager(); err == nil {
+		defer opts.IO.StopPager()
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, deployments)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "\nShowing %s in %s\n\n", text.Pluralize(len(deployments), "deployment"), ghrepo.FullName(repo))
+	}
+
+	if opts.Now.IsZero() {
+		opts.Now = time.Now()
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("ID", "REF", "ENVIRONMENT", "TASK", "CREATED"))
+
+	for _, d := range deployments {
+		tp.AddField(fmt.Sprintf("%d", d.Id), tableprinter.WithColor(cs.Cyan))
+		tp.AddField(d.Ref)
+		tp.AddField(d.Environment, tableprinter.WithColor(cs.Bold))
+		tp.AddField(d.Task)
+		tp.AddTimeField(opts.Now, d.CreatedAt, cs.Gray)
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
@@ -0,0 +1,57 @@
+package list
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_listRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/deployments"),
+		httpmock.StringResponse(`[
+			{"id": 1, "ref": "main", "environment": "production", "task": "deploy", "created_at": "2023-01-01T00:00:00Z"},
+			{"id": 2, "ref": "main", "environment": "staging", "task": "deploy", "created_at": "2023-02-01T00:00:00Z"}
+		]`))
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	opts := &ListOptions{
+		IO:         ios,
+		HTTPClient: func() (*http.Client, error) { return &http.Client{Transport: reg}, nil },
+		BaseRepo:   func() (ghrepo.Interface, error) { return ghrepo.New("OWNER", "REPO"), nil },
+		Limit:      30,
+	}
+
+	require.NoError(t, listRun(opts))
+	out := stdout.String()
+	assert.Contains(t, out, "production")
+	assert.Contains(t, out, "staging")
+}
+
+func Test_listRun_noDeployments(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/deployments"),
+		httpmock.StringResponse(`[]`))
+
+	ios, _, _, _ := iostreams.Test()
+
+	opts := &ListOptions{
+		IO:         ios,
+		HTTPClient: func() (*http.Client, error) { return &http.Client{Transport: reg}, nil },
+		BaseRepo:   func() (ghrepo.Interface, error) { return ghrepo.New("OWNER", "REPO"), nil },
+		Limit:      30,
+	}
+
+	assert.Error(t, listRun(opts))
+}
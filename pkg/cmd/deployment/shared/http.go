@@ -0,0 +1,183 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+type ListOptions struct {
+	Environment string
+	Ref         string
+	Limit       int
+}
+
+// ListDeployments returns up to opts.Limit deployments for repo, optionally filtered by
+// environment or ref.
+func ListDeployments(client *http.Client, repo ghrepo.Interface, opts ListOptions) ([]Deployment, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	perPage := 100
+	if opts.Limit > 0 && opts.Limit < 100 {
+		perPage = opts.Limit
+	}
+
+	path := fmt.Sprintf("repos/%s/deployments?per_page=%d", ghrepo.FullName(repo), perPage)
+	if opts.Environment != "" {
+		path += "&environment=" + opts.Environment
+	}
+	if opts.Ref != "" {
+		path += "&ref=" + opts.Ref
+	}
+
+	var deployments []Deployment
+	for path != "" {
+		var page []Deployment
+		var err error
+		path, err = apiClient.RESTWithNext(repo.RepoHost(), "GET", path, nil, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		deployments = append(deployments, page...)
+
+		if opts.Limit > 0 && len(deployments) >= opts.Limit {
+			deployments = deployments[:opts.Limit]
+			break
+		}
+	}
+
+	return deployments, nil
+}
+
+// GetDeployment fetches a single deployment by ID.
+func GetDeployment(client *http.Client, repo ghrepo.Interface, id int64) (*Deployment, error) {
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("repos/%s/deployments/%d", ghrepo.FullName(repo), id)
+
+	var deployment Deployment
+	if err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &deployment); err != nil {
+		return nil, err
+	}
+
+	return &deployment, nil
+}
+
+// ListStatuses returns the statuses recorded against a deployment, most recent first.
+func ListStatuses(client *http.Client, repo ghrepo.Interface, id int64) ([]Status, error) {
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("repos/%s/deployments/%d/statuses", ghrepo.FullName(repo), id)
+
+	var statuses []Status
+	if err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &statuses); err != nil {
+		return nil, err
+	}
+
+	return statuses, nil
+}
+
+type CreateDeploymentOptions struct {
+	Ref                   string
+	Task                  string
+	Environment           string
+	Description           string
+	Payload               string
+	AutoMerge             bool
+	ProductionEnvironment *bool
+	TransientEnvironment  bool
+	RequiredContexts      []string
+}
+
+// CreateDeployment creates a new deployment for a ref.
+func CreateDeployment(client *http.Client, repo ghrepo.Interface, opts CreateDeploymentOptions) (*Deployment, error) {
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("repos/%s/deployments", ghrepo.FullName(repo))
+
+	body := map[string]interface{}{
+		"ref":                   opts.Ref,
+		"auto_merge":            opts.AutoMerge,
+		"transient_environment": opts.TransientEnvironment,
+		"required_contexts":     opts.RequiredContexts,
+	}
+	if opts.Task != "" {
+		body["task"] = opts.Task
+	}
+	if opts.Environment != "" {
+		body["environment"] = opts.Environment
+	}
+	if opts.Description != "" {
+		body["description"] = opts.Description
+	}
+	if opts.Payload != "" {
+		var payload interface{}
+		if err := json.Unmarshal([]byte(opts.Payload), &payload); err != nil {
+			return nil, fmt.Errorf("could not parse --payload as JSON: %w", err)
+		}
+		body["payload"] = payload
+	}
+	if opts.ProductionEnvironment != nil {
+		body["production_environment"] = *opts.ProductionEnvironment
+	}
+
+	requestByte, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	var deployment Deployment
+	if err := apiClient.REST(repo.RepoHost(), "POST", path, bytes.NewReader(requestByte), &deployment); err != nil {
+		return nil, err
+	}
+
+	return &deployment, nil
+}
+
+type CreateStatusOptions struct {
+	State          string
+	Description    string
+	Environment    string
+	EnvironmentUrl string
+	LogUrl         string
+	AutoInactive   *bool
+}
+
+// CreateStatus records a new status against a deployment.
+func CreateStatus(client *http.Client, repo ghrepo.Interface, id int64, opts CreateStatusOptions) (*Status, error) {
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("repos/%s/deployments/%d/statuses", ghrepo.FullName(repo), id)
+
+	body := map[string]interface{}{
+		"state": opts.State,
+	}
+	if opts.Description != "" {
+		body["description"] = opts.Description
+	}
+	if opts.Environment != "" {
+		body["environment"] = opts.Environment
+	}
+	if opts.EnvironmentUrl != "" {
+		body["environment_url"] = opts.EnvironmentUrl
+	}
+	if opts.LogUrl != "" {
+		body["log_url"] = opts.LogUrl
+	}
+	if opts.AutoInactive != nil {
+		body["auto_inactive"] = *opts.AutoInactive
+	}
+
+	requestByte, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	var status Status
+	if err := apiClient.REST(repo.RepoHost(), "POST", path, bytes.NewReader(requestByte), &status); err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
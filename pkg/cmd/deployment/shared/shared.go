@@ -0,0 +1,75 @@
+package shared
+
+import (
+	"time"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+)
+
+// DeploymentFields lists the fields that `--json` accepts for `gh deployment list/view`.
+var DeploymentFields = []string{
+	"id",
+	"sha",
+	"ref",
+	"task",
+	"environment",
+	"productionEnvironment",
+	"transientEnvironment",
+	"description",
+	"creator",
+	"createdAt",
+	"updatedAt",
+}
+
+// StatusFields lists the fields that `--json` accepts for `gh deployment status`.
+var StatusFields = []string{
+	"id",
+	"state",
+	"description",
+	"environment",
+	"environmentUrl",
+	"logUrl",
+	"creator",
+	"createdAt",
+	"updatedAt",
+}
+
+var States = []string{"error", "failure", "inactive", "in_progress", "queued", "pending", "success"}
+
+type Creator struct {
+	Login string `json:"login"`
+}
+
+type Deployment struct {
+	Id                    int64     `json:"id"`
+	Sha                   string    `json:"sha"`
+	Ref                   string    `json:"ref"`
+	Task                  string    `json:"task"`
+	Environment           string    `json:"environment"`
+	ProductionEnvironment bool      `json:"production_environment"`
+	TransientEnvironment  bool      `json:"transient_environment"`
+	Description           string    `json:"description"`
+	Creator               Creator   `json:"creator"`
+	CreatedAt             time.Time `json:"created_at"`
+	UpdatedAt             time.Time `json:"updated_at"`
+}
+
+type Status struct {
+	Id             int64     `json:"id"`
+	State          string    `json:"state"`
+	Description    string    `json:"description"`
+	Environment    string    `json:"environment"`
+	EnvironmentUrl string    `json:"environment_url"`
+	LogUrl         string    `json:"log_url"`
+	Creator        Creator   `json:"creator"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+func (d *Deployment) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(d, fields)
+}
+
+func (s *Status) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(s, fields)
+}
@@ -0,0 +1,103 @@
+package status
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/deployment/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type StatusOptions struct {
+	HTTPClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	DeploymentID   int64
+	State          string
+	Description    string
+	Environment    string
+	EnvironmentUrl string
+	LogUrl         string
+}
+
+func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Command {
+	opts := &StatusOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "status <deployment-id>",
+		Short: "Mark a deployment's status",
+		Long: heredoc.Docf(`
+			Record a new status for a deployment, such as marking it %[1]sin_progress%[1]s, %[1]ssuccess%[1]s,
+			or %[1]sfailure%[1]s. Deployment integrations use this to report progress back to GitHub.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ gh deployment status 123456789 --state in_progress
+			$ gh deployment status 123456789 --state success --environment-url https://example.com
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return cmdutil.FlagErrorf("invalid deployment id: %q", args[0])
+			}
+
+			opts.BaseRepo = f.BaseRepo
+			opts.DeploymentID = id
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return statusRun(opts)
+		},
+	}
+
+	cmdutil.StringEnumFlag(cmd, &opts.State, "state", "s", "", shared.States, "State to record for the deployment")
+	cmd.Flags().StringVarP(&opts.Description, "description", "d", "", "Short description of the status")
+	cmd.Flags().StringVarP(&opts.Environment, "environment", "e", "", "Name of the environment, if it has changed since the deployment was created")
+	cmd.Flags().StringVar(&opts.EnvironmentUrl, "environment-url", "", "URL where the deployed application can be reached")
+	cmd.Flags().StringVar(&opts.LogUrl, "log-url", "", "URL to view the deployment's logs")
+	_ = cmd.MarkFlagRequired("state")
+
+	return cmd
+}
+
+func statusRun(opts *StatusOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	status, err := shared.CreateStatus(httpClient, repo, opts.DeploymentID, shared.CreateStatusOptions{
+		State:          opts.State,
+		Description:    opts.Description,
+		Environment:    opts.Environment,
+		EnvironmentUrl: opts.EnvironmentUrl,
+		LogUrl:         opts.LogUrl,
+	})
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to create deployment status: %w", err)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Marked deployment %d as %s\n", cs.SuccessIcon(), opts.DeploymentID, status.State)
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package status
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_statusRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/deployments/123/statuses"),
+		httpmock.RESTPayload(201, `{"state": "success"}`, func(payload map[string]interface{}) {
+			if state := payload["state"].(string); state != "success" {
+				t.Errorf("POST state %q, want %q", state, "success")
+			}
+		}))
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	opts := &StatusOptions{
+		IO:           ios,
+		HTTPClient:   func() (*http.Client, error) { return &http.Client{Transport: reg}, nil },
+		BaseRepo:     func() (ghrepo.Interface, error) { return ghrepo.New("OWNER", "REPO"), nil },
+		DeploymentID: 123,
+		State:        "success",
+	}
+
+	require.NoError(t, statusRun(opts))
+	assert.Contains(t, stdout.String(), "Marked deployment 123 as success")
+}
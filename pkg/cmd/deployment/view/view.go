@@ -0,0 +1,126 @@
+package view
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmd/deployment/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ViewOptions struct {
+	HTTPClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Exporter   cmdutil.Exporter
+
+	DeploymentID int64
+}
+
+func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
+	opts := &ViewOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view <deployment-id>",
+		Short: "View a deployment and its statuses",
+		Example: heredoc.Doc(`
+			$ gh deployment view 123456789
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return cmdutil.FlagErrorf("invalid deployment id: %q", args[0])
+			}
+
+			opts.BaseRepo = f.BaseRepo
+			opts.DeploymentID = id
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return viewRun(opts)
+		},
+	}
+
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.StatusFields)
+
+	return cmd
+}
+
+func viewRun(opts *ViewOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	deployment, err := shared.GetDeployment(httpClient, repo, opts.DeploymentID)
+	var statuses []shared.Status
+	if err == nil {
+		statuses, err = shared.ListStatuses(httpClient, repo, opts.DeploymentID)
+	}
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, statuses)
+	}
+
+	cs := opts.IO.ColorScheme()
+	out := opts.IO.Out
+
+	fmt.Fprintf(out, "%s\n", cs.Bold(fmt.Sprintf("#%d", deployment.Id)))
+	fmt.Fprintf(out, "Ref: %s\n", deployment.Ref)
+	fmt.Fprintf(out, "Environment: %s\n", deployment.Environment)
+	if deployment.Description != "" {
+		fmt.Fprintf(out, "Description: %s\n", deployment.Description)
+	}
+	fmt.Fprintln(out)
+
+	if len(statuses) == 0 {
+		fmt.Fprintln(out, "No statuses have been recorded for this deployment")
+		return nil
+	}
+
+	now := time.Now()
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("STATE", "DESCRIPTION", "CREATED"))
+	for _, s := range statuses {
+		tp.AddField(s.State, tableprinter.WithColor(stateColor(cs, s.State)))
+		tp.AddField(s.Description)
+		tp.AddTimeField(now, s.CreatedAt, cs.Gray)
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
+
+func stateColor(cs *iostreams.ColorScheme, state string) func(string) string {
+	switch state {
+	case "success":
+		return cs.Green
+	case "failure", "error":
+		return cs.Red
+	case "in_progress", "queued", "pending":
+		return cs.Yellow
+	default:
+		return cs.Gray
+	}
+}
@@ -0,0 +1,38 @@
+package view
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_viewRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/deployments/123"),
+		httpmock.StringResponse(`{"id": 123, "ref": "main", "environment": "production", "description": "ship it"}`))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/deployments/123/statuses"),
+		httpmock.StringResponse(`[{"state": "success", "description": "deployed", "created_at": "2023-01-01T00:00:00Z"}]`))
+
+	ios, _, stdout, _ := iostreams.Test()
+
+	opts := &ViewOptions{
+		IO:           ios,
+		HTTPClient:   func() (*http.Client, error) { return &http.Client{Transport: reg}, nil },
+		BaseRepo:     func() (ghrepo.Interface, error) { return ghrepo.New("OWNER", "REPO"), nil },
+		DeploymentID: 123,
+	}
+
+	require.NoError(t, viewRun(opts))
+	out := stdout.String()
+	assert.Contains(t, out, "#123")
+	assert.Contains(t, out, "ship it")
+	assert.Contains(t, out, "success")
+}
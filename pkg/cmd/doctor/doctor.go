@@ -0,0 +1,290 @@
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/pkg/cmd/auth/shared/gitcredentials"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/extensions"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+// Status is the outcome of a single diagnostic check.
+type Status string
+
+const (
+	StatusOK      Status = "ok"
+	StatusWarning Status = "warning"
+	StatusError   Status = "error"
+)
+
+// Check is the result of one diagnostic check run by `gh doctor`.
+type Check struct {
+	Name   string `json:"name"`
+	Status Status `json:"status"`
+	Detail string `json:"detail"`
+	Hint   string `json:"hint,omitempty"`
+}
+
+var CheckFields = []string{"name", "status", "detail", "hint"}
+
+type DoctorOptions struct {
+	IO               *iostreams.IOStreams
+	HttpClient       func() (*http.Client, error)
+	Config           func() (gh.Config, error)
+	GitClient        *git.Client
+	ExtensionManager extensions.ExtensionManager
+
+	Exporter cmdutil.Exporter
+
+	Now  func() time.Time
+	Dial func(network, address string) (net.Conn, error)
+}
+
+func NewCmdDoctor(f *cmdutil.Factory, runF func(*DoctorOptions) error) *cobra.Command {
+	opts := &DoctorOptions{
+		IO:               f.IOStreams,
+		HttpClient:       f.HttpClient,
+		Config:           f.Config,
+		GitClient:        f.GitClient,
+		ExtensionManager: f.ExtensionManager,
+		Now:              time.Now,
+		Dial:             (&net.Dialer{Timeout: 5 * time.Second}).Dial,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Check your gh environment for common problems",
+		Long: heredoc.Doc(`
+			Run a series of diagnostic checks covering authentication, git
+			installation and credential helper configuration, network
+			reachability, clock skew, configuration validity, and installed
+			extensions, printing remediation hints for anything that looks wrong.
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+			return doctorRun(opts)
+		},
+	}
+
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, CheckFields)
+
+	return cmd
+}
+
+func doctorRun(opts *DoctorOptions) error {
+	cfg, cfgErr := opts.Config()
+
+	var checks []Check
+	checks = append(checks, checkGit(opts)...)
+	checks = append(checks, checkConfig(cfgErr)...)
+	if cfgErr == nil {
+		checks = append(checks, checkAuth(cfg)...)
+		checks = append(checks, checkCredentialHelper(opts, cfg)...)
+		checks = append(checks, checkSSHOverHTTPS(opts, cfg)...)
+		checks = append(checks, checkNetwork(opts, cfg)...)
+		checks = append(checks, checkClockSkew(opts, cfg)...)
+	}
+	checks = append(checks, checkExtensions(opts)...)
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, checks)
+	}
+
+	cs := opts.IO.ColorScheme()
+	hasError := false
+	for _, check := range checks {
+		var icon string
+		switch check.Status {
+		case StatusOK:
+			icon = cs.SuccessIcon()
+		case StatusWarning:
+			icon = cs.WarningIcon()
+		case StatusError:
+			icon = cs.FailureIcon()
+			hasError = true
+		}
+		fmt.Fprintf(opts.IO.Out, "%s %s: %s\n", icon, check.Name, check.Detail)
+		if check.Hint != "" {
+			fmt.Fprintf(opts.IO.Out, "  %s\n", cs.Gray(check.Hint))
+		}
+	}
+
+	if hasError {
+		return cmdutil.SilentError
+	}
+	return nil
+}
+
+func checkGit(opts *DoctorOptions) []Check {
+	ctx := context.Background()
+	cmd, err := opts.GitClient.Command(ctx, "--version")
+	if err != nil {
+		return []Check{{Name: "git", Status: StatusError, Detail: fmt.Sprintf("could not run git: %s", err), Hint: "Install git and ensure it is on your PATH."}}
+	}
+
+	out, err := cmd.Output()
+	if err != nil {
+		return []Check{{Name: "git", Status: StatusError, Detail: fmt.Sprintf("could not run git: %s", err), Hint: "Install git and ensure it is on your PATH."}}
+	}
+
+	return []Check{{Name: "git", Status: StatusOK, Detail: fmt.Sprintf("found %s", strings.TrimSpace(string(out)))}}
+}
+
+func checkConfig(cfgErr error) []Check {
+	if cfgErr != nil {
+		return []Check{{Name: "config", Status: StatusError, Detail: fmt.Sprintf("could not read configuration: %s", cfgErr), Hint: "Check ~/.config/gh/config.yml for syntax errors."}}
+	}
+	return []Check{{Name: "config", Status: StatusOK, Detail: "configuration is valid"}}
+}
+
+func checkAuth(cfg gh.Config) []Check {
+	authCfg := cfg.Authentication()
+	hosts := authCfg.Hosts()
+	if len(hosts) == 0 {
+		return []Check{{Name: "auth", Status: StatusWarning, Detail: "not logged in to any GitHub hosts", Hint: "Run `gh auth login` to authenticate."}}
+	}
+
+	var checks []Check
+	for _, host := range hosts {
+		token, source := authCfg.ActiveToken(host)
+		if token == "" {
+			checks = append(checks, Check{Name: "auth: " + host, Status: StatusError, Detail: "no active token found", Hint: "Run `gh auth login --hostname " + host + "`."})
+			continue
+		}
+		checks = append(checks, Check{Name: "auth: " + host, Status: StatusOK, Detail: fmt.Sprintf("logged in via %s", source)})
+	}
+	return checks
+}
+
+func checkCredentialHelper(opts *DoctorOptions, cfg gh.Config) []Check {
+	hc := &gitcredentials.HelperConfig{GitClient: opts.GitClient}
+
+	var checks []Check
+	for _, host := range cfg.Authentication().Hosts() {
+		helper, err := hc.ConfiguredHelper(host)
+		if err != nil {
+			checks = append(checks, Check{Name: "credential helper: " + host, Status: StatusWarning, Detail: fmt.Sprintf("could not determine git credential helper: %s", err)})
+			continue
+		}
+		if !helper.IsConfigured() {
+			checks = append(checks, Check{Name: "credential helper: " + host, Status: StatusWarning, Detail: "no git credential helper is configured", Hint: "Run `gh auth setup-git` to let git use your gh credentials."})
+			continue
+		}
+		if !helper.IsOurs() {
+			checks = append(checks, Check{Name: "credential helper: " + host, Status: StatusWarning, Detail: fmt.Sprintf("git is using a different credential helper (%s)", helper.Cmd)})
+			continue
+		}
+		checks = append(checks, Check{Name: "credential helper: " + host, Status: StatusOK, Detail: "gh is configured as the git credential helper"})
+	}
+	return checks
+}
+
+func checkSSHOverHTTPS(opts *DoctorOptions, cfg gh.Config) []Check {
+	sc := &gitcredentials.SSHInsteadOfConfig{GitClient: opts.GitClient}
+
+	var checks []Check
+	for _, host := range cfg.Authentication().Hosts() {
+		configured, err := sc.Configured(host)
+		if err != nil {
+			checks = append(checks, Check{Name: "ssh-over-https: " + host, Status: StatusWarning, Detail: fmt.Sprintf("could not determine SSH-to-HTTPS URL rewrite: %s", err)})
+			continue
+		}
+		if !configured {
+			checks = append(checks, Check{Name: "ssh-over-https: " + host, Status: StatusOK, Detail: "git@" + host + ": SSH URLs are not rewritten to HTTPS"})
+			continue
+		}
+		checks = append(checks, Check{Name: "ssh-over-https: " + host, Status: StatusOK, Detail: "git@" + host + ": SSH URLs are rewritten to HTTPS", Hint: "Run `gh auth setup-git --ssh-over-https --undo` to remove the rewrite."})
+	}
+	return checks
+}
+
+func checkNetwork(opts *DoctorOptions, cfg gh.Config) []Check {
+	var checks []Check
+	for _, host := range cfg.Authentication().Hosts() {
+		checks = append(checks, dialCheck(opts, "API reachability: "+host, net.JoinHostPort(host, "443")))
+		checks = append(checks, dialCheck(opts, "SSH reachability: "+host, net.JoinHostPort(host, "22")))
+	}
+	return checks
+}
+
+func dialCheck(opts *DoctorOptions, name, address string) Check {
+	conn, err := opts.Dial("tcp", address)
+	if err != nil {
+		return Check{Name: name, Status: StatusError, Detail: fmt.Sprintf("could not reach %s: %s", address, err), Hint: "Check your network connection and any firewall or proxy settings."}
+	}
+	_ = conn.Close()
+	return Check{Name: name, Status: StatusOK, Detail: fmt.Sprintf("%s is reachable", address)}
+}
+
+func checkClockSkew(opts *DoctorOptions, cfg gh.Config) []Check {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return []Check{{Name: "clock skew", Status: StatusWarning, Detail: fmt.Sprintf("could not create http client: %s", err)}}
+	}
+
+	host, _ := cfg.Authentication().DefaultHost()
+	req, err := http.NewRequest(http.MethodHead, ghinstance.RESTPrefix(host), nil)
+	if err != nil {
+		return []Check{{Name: "clock skew", Status: StatusWarning, Detail: fmt.Sprintf("could not build request: %s", err)}}
+	}
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return []Check{{Name: "clock skew", Status: StatusWarning, Detail: fmt.Sprintf("could not reach %s: %s", host, err)}}
+	}
+	defer res.Body.Close()
+
+	serverDate := res.Header.Get("Date")
+	serverTime, err := http.ParseTime(serverDate)
+	if err != nil {
+		return []Check{{Name: "clock skew", Status: StatusWarning, Detail: "server did not return a usable Date header"}}
+	}
+
+	skew := opts.Now().Sub(serverTime)
+	if skew < 0 {
+		skew = -skew
+	}
+
+	const maxSkew = 5 * time.Minute
+	if skew > maxSkew {
+		return []Check{{
+			Name:   "clock skew",
+			Status: StatusError,
+			Detail: fmt.Sprintf("local clock is off from %s by %s", host, skew.Round(time.Second)),
+			Hint:   "Large clock skew can cause commit signing and token validation to fail; sync your system clock.",
+		}}
+	}
+
+	return []Check{{Name: "clock skew", Status: StatusOK, Detail: fmt.Sprintf("local clock is within %s of %s", skew.Round(time.Second), host)}}
+}
+
+func checkExtensions(opts *DoctorOptions) []Check {
+	if opts.ExtensionManager == nil {
+		return nil
+	}
+
+	var checks []Check
+	for _, ext := range opts.ExtensionManager.List() {
+		if _, err := os.Stat(ext.Path()); err != nil {
+			checks = append(checks, Check{Name: "extension: " + ext.Name(), Status: StatusError, Detail: fmt.Sprintf("executable not found: %s", err), Hint: "Run `gh extension upgrade " + ext.Name() + "` or remove and reinstall it."})
+			continue
+		}
+		checks = append(checks, Check{Name: "extension: " + ext.Name(), Status: StatusOK, Detail: fmt.Sprintf("installed at %s", ext.Path())})
+	}
+	return checks
+}
@@ -0,0 +1,100 @@
+package doctor
+
+import (
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/run"
+	"github.com/cli/cli/v2/pkg/extensions"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDoctorRun(t *testing.T) {
+	cs, restoreRun := run.Stub()
+	defer restoreRun(t)
+	cs.Register(`git --version`, 0, "git version 2.40.0\n")
+	cs.Register(`git config credential\.https://github\.com\.helper`, 1, "")
+	cs.Register(`git config credential\.helper`, 0, "!/path/to/gh auth git-credential\n")
+	cs.Register(`git config url\.https://github\.com/\.insteadOf`, 1, "")
+
+	cfg, _ := config.NewIsolatedTestConfig(t)
+	_, err := cfg.Authentication().Login("github.com", "monalisa", "TOKEN", "https", false)
+	require.NoError(t, err)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(httpmock.REST("HEAD", ""), httpmock.StatusStringResponse(200, ""))
+
+	ios, _, stdout, _ := iostreams.Test()
+
+	opts := &DoctorOptions{
+		IO:        ios,
+		GitClient: &git.Client{GitPath: "path/to/git"},
+		Config:    func() (gh.Config, error) { return cfg, nil },
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		ExtensionManager: &extensions.ExtensionManagerMock{
+			ListFunc: func() []extensions.Extension { return nil },
+		},
+		Now: func() time.Time { return time.Now() },
+		Dial: func(network, address string) (net.Conn, error) {
+			client, server := net.Pipe()
+			_ = server.Close()
+			return client, nil
+		},
+	}
+
+	err = doctorRun(opts)
+	require.NoError(t, err)
+
+	out := stdout.String()
+	assert.Contains(t, out, "git: found git version 2.40.0")
+	assert.Contains(t, out, "config: configuration is valid")
+	assert.Contains(t, out, "auth: github.com: logged in via")
+	assert.Contains(t, out, "credential helper: github.com: gh is configured as the git credential helper")
+	assert.Contains(t, out, "ssh-over-https: github.com: git@github.com: SSH URLs are not rewritten to HTTPS")
+}
+
+func TestDoctorRun_MissingGit(t *testing.T) {
+	cs, restoreRun := run.Stub()
+	defer restoreRun(t)
+	cs.Register(`git --version`, 1, "", func(args []string) {})
+
+	cfg, _ := config.NewIsolatedTestConfig(t)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	ios, _, stdout, _ := iostreams.Test()
+
+	opts := &DoctorOptions{
+		IO:        ios,
+		GitClient: &git.Client{GitPath: "path/to/git"},
+		Config:    func() (gh.Config, error) { return cfg, nil },
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		ExtensionManager: &extensions.ExtensionManagerMock{
+			ListFunc: func() []extensions.Extension { return nil },
+		},
+		Now: func() time.Time { return time.Now() },
+		Dial: func(network, address string) (net.Conn, error) {
+			client, server := net.Pipe()
+			_ = server.Close()
+			return client, nil
+		},
+	}
+
+	err := doctorRun(opts)
+	assert.Error(t, err)
+	assert.Contains(t, stdout.String(), "git: could not run git")
+}
@@ -0,0 +1,131 @@
+package create
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/environment/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type CreateOptions struct {
+	HTTPClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Name              string
+	WaitTimer         int
+	PreventSelfReview bool
+	ReviewerUsers     []int
+	ReviewerTeams     []int
+	BranchPolicy      string
+	BranchPatterns    []string
+}
+
+func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
+	opts := &CreateOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create a deployment environment",
+		Long: heredoc.Docf(`
+			Create a deployment environment for a repository, optionally configuring a wait timer,
+			required reviewers, and a deployment branch policy.
+
+			Reviewers are specified by numeric user or team ID, as accepted by the GitHub API;
+			use %[1]sgh api users/<login>%[1]s or %[1]sgh api orgs/<org>/teams/<slug>%[1]s to look one up.
+
+			The %[1]s--branch-policy%[1]s flag controls which branches may deploy to the environment:
+			%[1]sall%[1]s (the default), %[1]sprotected%[1]s for protected branches only, or %[1]scustom%[1]s
+			combined with one or more %[1]s--branch-pattern%[1]s flags.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# create an environment with a 10 minute wait timer
+			$ gh environment create staging --wait-timer 10
+
+			# create an environment that only allows deploys from the main branch
+			$ gh environment create production --branch-policy custom --branch-pattern main
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.Name = args[0]
+
+			if len(opts.BranchPatterns) > 0 && opts.BranchPolicy != "custom" {
+				return cmdutil.FlagErrorf("`--branch-pattern` can only be used with `--branch-policy custom`")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return createRun(opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.WaitTimer, "wait-timer", 0, "Minutes to wait before allowing deployments to proceed")
+	cmd.Flags().BoolVar(&opts.PreventSelfReview, "prevent-self-review", false, "Prevent users from approving deployments they triggered")
+	cmd.Flags().IntSliceVar(&opts.ReviewerUsers, "reviewer-user", nil, "User ID to require as a reviewer (can be used multiple times)")
+	cmd.Flags().IntSliceVar(&opts.ReviewerTeams, "reviewer-team", nil, "Team ID to require as a reviewer (can be used multiple times)")
+	cmdutil.StringEnumFlag(cmd, &opts.BranchPolicy, "branch-policy", "", "all", []string{"all", "protected", "custom"}, "Which branches can deploy to the environment")
+	cmd.Flags().StringArrayVar(&opts.BranchPatterns, "branch-pattern", nil, "Branch name pattern allowed to deploy, used with `--branch-policy custom` (can be used multiple times)")
+
+	return cmd
+}
+
+func createRun(opts *CreateOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	upsertOpts := shared.UpsertEnvironmentOptions{
+		WaitTimer:         &opts.WaitTimer,
+		PreventSelfReview: &opts.PreventSelfReview,
+	}
+
+	for _, id := range opts.ReviewerUsers {
+		upsertOpts.Reviewers = append(upsertOpts.Reviewers, shared.ReviewerInput{Type: "User", Id: id})
+	}
+	for _, id := range opts.ReviewerTeams {
+		upsertOpts.Reviewers = append(upsertOpts.Reviewers, shared.ReviewerInput{Type: "Team", Id: id})
+	}
+
+	switch opts.BranchPolicy {
+	case "protected":
+		upsertOpts.DeploymentBranchPolicy = &shared.DeploymentBranchPolicy{ProtectedBranches: true}
+	case "custom":
+		upsertOpts.DeploymentBranchPolicy = &shared.DeploymentBranchPolicy{CustomBranchPolicies: true}
+	}
+
+	opts.IO.StartProgressIndicator()
+	_, err = shared.PutEnvironment(httpClient, repo, opts.Name, upsertOpts)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to create environment: %w", err)
+	}
+
+	for _, pattern := range opts.BranchPatterns {
+		if err := shared.AddDeploymentBranchPolicy(httpClient, repo, opts.Name, pattern); err != nil {
+			return fmt.Errorf("failed to add branch pattern %q: %w", pattern, err)
+		}
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Created environment %q in %s\n", cs.SuccessIcon(), opts.Name, ghrepo.FullName(repo))
+	}
+
+	return nil
+}
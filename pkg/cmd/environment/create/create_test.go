@@ -0,0 +1,67 @@
+package create
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_createRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("PUT", "repos/OWNER/REPO/environments/staging"),
+		httpmock.RESTPayload(200, `{"name": "staging"}`, func(payload map[string]interface{}) {
+			if waitTimer := payload["wait_timer"].(float64); waitTimer != 10 {
+				t.Errorf("PUT wait_timer %v, want %v", waitTimer, 10)
+			}
+		}))
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	opts := &CreateOptions{
+		IO:           ios,
+		HTTPClient:   func() (*http.Client, error) { return &http.Client{Transport: reg}, nil },
+		BaseRepo:     func() (ghrepo.Interface, error) { return ghrepo.New("OWNER", "REPO"), nil },
+		Name:         "staging",
+		WaitTimer:    10,
+		BranchPolicy: "all",
+	}
+
+	require.NoError(t, createRun(opts))
+	assert.Contains(t, stdout.String(), `Created environment "staging" in OWNER/REPO`)
+}
+
+func Test_createRun_withBranchPattern(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("PUT", "repos/OWNER/REPO/environments/production"),
+		httpmock.StatusStringResponse(200, `{"name": "production"}`))
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/environments/production/deployment-branch-policies"),
+		httpmock.RESTPayload(201, `{}`, func(payload map[string]interface{}) {
+			if name := payload["name"].(string); name != "main" {
+				t.Errorf("POST name %q, want %q", name, "main")
+			}
+		}))
+
+	ios, _, _, _ := iostreams.Test()
+
+	opts := &CreateOptions{
+		IO:             ios,
+		HTTPClient:     func() (*http.Client, error) { return &http.Client{Transport: reg}, nil },
+		BaseRepo:       func() (ghrepo.Interface, error) { return ghrepo.New("OWNER", "REPO"), nil },
+		Name:           "production",
+		BranchPolicy:   "custom",
+		BranchPatterns: []string{"main"},
+	}
+
+	require.NoError(t, createRun(opts))
+}
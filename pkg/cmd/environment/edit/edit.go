@@ -0,0 +1,148 @@
+package edit
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/environment/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type EditOptions struct {
+	HTTPClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Name              string
+	WaitTimer         int
+	PreventSelfReview bool
+	ReviewerUsers     []int
+	ReviewerTeams     []int
+	BranchPolicy      string
+	BranchPatterns    []string
+
+	WaitTimerChanged         bool
+	PreventSelfReviewChanged bool
+	ReviewersChanged         bool
+	BranchPolicyChanged      bool
+}
+
+func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Command {
+	opts := &EditOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "edit <name>",
+		Short: "Edit the protection rules for an environment",
+		Long: heredoc.Docf(`
+			Update the wait timer, required reviewers, or deployment branch policy for an existing
+			environment. Flags that are not specified leave the corresponding setting unchanged.
+
+			Passing %[1]s--reviewer-user%[1]s or %[1]s--reviewer-team%[1]s replaces the full list of
+			required reviewers; combine both flags to require a mix of users and teams.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# change the wait timer for an environment
+			$ gh environment edit staging --wait-timer 30
+
+			# replace the required reviewers for an environment
+			$ gh environment edit production --reviewer-team 123
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.Name = args[0]
+
+			opts.WaitTimerChanged = cmd.Flags().Changed("wait-timer")
+			opts.PreventSelfReviewChanged = cmd.Flags().Changed("prevent-self-review")
+			opts.ReviewersChanged = cmd.Flags().Changed("reviewer-user") || cmd.Flags().Changed("reviewer-team")
+			opts.BranchPolicyChanged = cmd.Flags().Changed("branch-policy")
+
+			if len(opts.BranchPatterns) > 0 && !opts.BranchPolicyChanged {
+				return cmdutil.FlagErrorf("`--branch-pattern` can only be used together with `--branch-policy custom`")
+			}
+			if len(opts.BranchPatterns) > 0 && opts.BranchPolicy != "custom" {
+				return cmdutil.FlagErrorf("`--branch-pattern` can only be used with `--branch-policy custom`")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return editRun(opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.WaitTimer, "wait-timer", 0, "Minutes to wait before allowing deployments to proceed")
+	cmd.Flags().BoolVar(&opts.PreventSelfReview, "prevent-self-review", false, "Prevent users from approving deployments they triggered")
+	cmd.Flags().IntSliceVar(&opts.ReviewerUsers, "reviewer-user", nil, "User ID to require as a reviewer (can be used multiple times); replaces the existing list")
+	cmd.Flags().IntSliceVar(&opts.ReviewerTeams, "reviewer-team", nil, "Team ID to require as a reviewer (can be used multiple times); replaces the existing list")
+	cmdutil.StringEnumFlag(cmd, &opts.BranchPolicy, "branch-policy", "", "", []string{"all", "protected", "custom"}, "Which branches can deploy to the environment")
+	cmd.Flags().StringArrayVar(&opts.BranchPatterns, "branch-pattern", nil, "Branch name pattern to allow, used with `--branch-policy custom` (can be used multiple times)")
+
+	return cmd
+}
+
+func editRun(opts *EditOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	var upsertOpts shared.UpsertEnvironmentOptions
+	if opts.WaitTimerChanged {
+		upsertOpts.WaitTimer = &opts.WaitTimer
+	}
+	if opts.PreventSelfReviewChanged {
+		upsertOpts.PreventSelfReview = &opts.PreventSelfReview
+	}
+	if opts.ReviewersChanged {
+		upsertOpts.Reviewers = []shared.ReviewerInput{}
+		for _, id := range opts.ReviewerUsers {
+			upsertOpts.Reviewers = append(upsertOpts.Reviewers, shared.ReviewerInput{Type: "User", Id: id})
+		}
+		for _, id := range opts.ReviewerTeams {
+			upsertOpts.Reviewers = append(upsertOpts.Reviewers, shared.ReviewerInput{Type: "Team", Id: id})
+		}
+	}
+	if opts.BranchPolicyChanged {
+		switch opts.BranchPolicy {
+		case "protected":
+			upsertOpts.DeploymentBranchPolicy = &shared.DeploymentBranchPolicy{ProtectedBranches: true}
+		case "custom":
+			upsertOpts.DeploymentBranchPolicy = &shared.DeploymentBranchPolicy{CustomBranchPolicies: true}
+		default:
+			upsertOpts.DeploymentBranchPolicy = &shared.DeploymentBranchPolicy{}
+		}
+	}
+
+	opts.IO.StartProgressIndicator()
+	_, err = shared.PutEnvironment(httpClient, repo, opts.Name, upsertOpts)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to edit environment: %w", err)
+	}
+
+	for _, pattern := range opts.BranchPatterns {
+		if err := shared.AddDeploymentBranchPolicy(httpClient, repo, opts.Name, pattern); err != nil {
+			return fmt.Errorf("failed to add branch pattern %q: %w", pattern, err)
+		}
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Edited environment %q in %s\n", cs.SuccessIcon(), opts.Name, ghrepo.FullName(repo))
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package edit
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_editRun_onlyChangedFieldsSent(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("PUT", "repos/OWNER/REPO/environments/staging"),
+		httpmock.RESTPayload(200, `{"name": "staging"}`, func(payload map[string]interface{}) {
+			if _, ok := payload["wait_timer"]; !ok {
+				t.Errorf("expected wait_timer in payload")
+			}
+			if _, ok := payload["reviewers"]; ok {
+				t.Errorf("did not expect reviewers in payload")
+			}
+		}))
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	opts := &EditOptions{
+		IO:               ios,
+		HTTPClient:       func() (*http.Client, error) { return &http.Client{Transport: reg}, nil },
+		BaseRepo:         func() (ghrepo.Interface, error) { return ghrepo.New("OWNER", "REPO"), nil },
+		Name:             "staging",
+		WaitTimer:        20,
+		WaitTimerChanged: true,
+	}
+
+	require.NoError(t, editRun(opts))
+	assert.Contains(t, stdout.String(), `Edited environment "staging" in OWNER/REPO`)
+}
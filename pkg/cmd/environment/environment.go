@@ -0,0 +1,35 @@
+package environment
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdCreate "github.com/cli/cli/v2/pkg/cmd/environment/create"
+	cmdEdit "github.com/cli/cli/v2/pkg/cmd/environment/edit"
+	cmdList "github.com/cli/cli/v2/pkg/cmd/environment/list"
+	cmdView "github.com/cli/cli/v2/pkg/cmd/environment/view"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdEnvironment(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "environment <command>",
+		Short: "Manage deployment environments",
+		Long: heredoc.Doc(`
+			Work with GitHub deployment environments, including their protection rules (required
+			reviewers, wait timers) and deployment branch policies.
+
+			Environment secrets and variables are managed separately with
+			"gh secret set --env" and "gh variable set --env".
+		`),
+		Aliases: []string{"env"},
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdView.NewCmdView(f, nil))
+	cmd.AddCommand(cmdCreate.NewCmdCreate(f, nil))
+	cmd.AddCommand(cmdEdit.NewCmdEdit(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,119 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmd/environment/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HTTPClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Exporter   cmdutil.Exporter
+	Now        time.Time
+
+	Limit int
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List environments for a repository",
+		Long: heredoc.Doc(`
+			List the deployment environments configured for a repository.
+		`),
+		Example: heredoc.Doc(`
+			$ gh environment list
+			$ gh environment list --repo owner/repo
+		`),
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.Limit < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of environments to list")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.EnvironmentFields)
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	environments, err := shared.ListEnvironments(httpClient, repo, opts.Limit)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if len(environments) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("no environments found in %s", ghrepo.FullName(repo)))
+	}
+
+	if err := opts.IO.StartPager(); err == nil {
+		defer opts.IO.StopPager()
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, environments)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "\nShowing %s in %s\n\n", text.Pluralize(len(environments), "environment"), ghrepo.FullName(repo))
+	}
+
+	if opts.Now.IsZero() {
+		opts.Now = time.Now()
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("NAME", "WAIT TIMER", "REVIEWERS", "BRANCH POLICY", "UPDATED"))
+
+	for _, env := range environments {
+		tp.AddField(env.Name, tableprinter.WithColor(cs.Bold))
+		tp.AddField(fmt.Sprintf("%dm", env.WaitTimer()))
+		tp.AddField(fmt.Sprintf("%d", env.RequiredReviewers()))
+		tp.AddField(env.BranchPolicySummary())
+		tp.AddTimeField(opts.Now, env.UpdatedAt, cs.Gray)
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
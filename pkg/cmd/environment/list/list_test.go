@@ -0,0 +1,60 @@
+package list
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_listRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/environments"),
+		httpmock.StringResponse(`{
+			"total_count": 2,
+			"environments": [
+				{"name": "staging", "updated_at": "2023-01-01T00:00:00Z", "deployment_branch_policy": null},
+				{"name": "production", "updated_at": "2023-02-01T00:00:00Z", "protection_rules": [{"type": "wait_timer", "wait_timer": 30}], "deployment_branch_policy": {"protected_branches": true}}
+			]
+		}`))
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	opts := &ListOptions{
+		IO:         ios,
+		HTTPClient: func() (*http.Client, error) { return &http.Client{Transport: reg}, nil },
+		BaseRepo:   func() (ghrepo.Interface, error) { return ghrepo.New("OWNER", "REPO"), nil },
+		Limit:      30,
+	}
+
+	require.NoError(t, listRun(opts))
+	assert.Contains(t, stdout.String(), "staging")
+	assert.Contains(t, stdout.String(), "production")
+}
+
+func Test_listRun_noEnvironments(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/environments"),
+		httpmock.StringResponse(`{"total_count": 0, "environments": []}`))
+
+	ios, _, _, _ := iostreams.Test()
+
+	opts := &ListOptions{
+		IO:         ios,
+		HTTPClient: func() (*http.Client, error) { return &http.Client{Transport: reg}, nil },
+		BaseRepo:   func() (ghrepo.Interface, error) { return ghrepo.New("OWNER", "REPO"), nil },
+		Limit:      30,
+	}
+
+	err := listRun(opts)
+	assert.Error(t, err)
+}
@@ -0,0 +1,121 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+type environmentList struct {
+	TotalCount   int           `json:"total_count"`
+	Environments []Environment `json:"environments"`
+}
+
+// ListEnvironments returns up to limit environments configured for repo.
+func ListEnvironments(client *http.Client, repo ghrepo.Interface, limit int) ([]Environment, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	perPage := 100
+	if limit > 0 && limit < 100 {
+		perPage = limit
+	}
+
+	path := fmt.Sprintf("repos/%s/environments?per_page=%d", ghrepo.FullName(repo), perPage)
+
+	var environments []Environment
+	for path != "" {
+		var result environmentList
+		var err error
+		path, err = apiClient.RESTWithNext(repo.RepoHost(), "GET", path, nil, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		environments = append(environments, result.Environments...)
+
+		if limit > 0 && len(environments) >= limit {
+			environments = environments[:limit]
+			break
+		}
+	}
+
+	return environments, nil
+}
+
+// GetEnvironment fetches a single environment by name.
+func GetEnvironment(client *http.Client, repo ghrepo.Interface, name string) (*Environment, error) {
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("repos/%s/environments/%s", ghrepo.FullName(repo), name)
+
+	var environment Environment
+	if err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &environment); err != nil {
+		return nil, err
+	}
+
+	return &environment, nil
+}
+
+type ReviewerInput struct {
+	Type string `json:"type"`
+	Id   int    `json:"id"`
+}
+
+// UpsertEnvironmentOptions describes the protection rules to apply when creating or updating an
+// environment. A nil field is left unchanged by the API when updating an existing environment.
+type UpsertEnvironmentOptions struct {
+	WaitTimer              *int
+	PreventSelfReview      *bool
+	Reviewers              []ReviewerInput
+	DeploymentBranchPolicy *DeploymentBranchPolicy
+}
+
+// PutEnvironment creates the named environment if it doesn't already exist, or updates its
+// protection rules if it does; the underlying GitHub API uses the same endpoint for both.
+func PutEnvironment(client *http.Client, repo ghrepo.Interface, name string, opts UpsertEnvironmentOptions) (*Environment, error) {
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("repos/%s/environments/%s", ghrepo.FullName(repo), name)
+
+	body := map[string]interface{}{}
+	if opts.WaitTimer != nil {
+		body["wait_timer"] = *opts.WaitTimer
+	}
+	if opts.PreventSelfReview != nil {
+		body["prevent_self_review"] = *opts.PreventSelfReview
+	}
+	if opts.Reviewers != nil {
+		body["reviewers"] = opts.Reviewers
+	}
+	if opts.DeploymentBranchPolicy != nil {
+		body["deployment_branch_policy"] = opts.DeploymentBranchPolicy
+	}
+
+	requestByte, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	var environment Environment
+	if err := apiClient.REST(repo.RepoHost(), "PUT", path, bytes.NewReader(requestByte), &environment); err != nil {
+		return nil, err
+	}
+
+	return &environment, nil
+}
+
+// AddDeploymentBranchPolicy registers a branch name pattern that is allowed to deploy to the
+// named environment; it only applies when the environment's deployment branch policy is custom.
+func AddDeploymentBranchPolicy(client *http.Client, repo ghrepo.Interface, name, pattern string) error {
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("repos/%s/environments/%s/deployment-branch-policies", ghrepo.FullName(repo), name)
+
+	requestByte, err := json.Marshal(map[string]string{"name": pattern})
+	if err != nil {
+		return err
+	}
+
+	return apiClient.REST(repo.RepoHost(), "POST", path, bytes.NewReader(requestByte), nil)
+}
@@ -0,0 +1,91 @@
+package shared
+
+import (
+	"time"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+)
+
+// EnvironmentFields lists the fields that `--json` accepts for `gh environment view/list`.
+var EnvironmentFields = []string{
+	"name",
+	"url",
+	"htmlUrl",
+	"createdAt",
+	"updatedAt",
+	"canAdminsBypass",
+	"protectionRules",
+	"deploymentBranchPolicy",
+}
+
+type Reviewer struct {
+	Type string `json:"type"`
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type DeploymentBranchPolicy struct {
+	ProtectedBranches    bool `json:"protected_branches"`
+	CustomBranchPolicies bool `json:"custom_branch_policies"`
+}
+
+type ProtectionRule struct {
+	Id        int    `json:"id"`
+	Type      string `json:"type"`
+	WaitTimer int    `json:"wait_timer,omitempty"`
+	Reviewers []struct {
+		Type     string `json:"type"`
+		Reviewer struct {
+			Id    int    `json:"id"`
+			Login string `json:"login"`
+			Slug  string `json:"slug"`
+		} `json:"reviewer"`
+	} `json:"reviewers,omitempty"`
+}
+
+type Environment struct {
+	Id                     int                     `json:"id"`
+	Name                   string                  `json:"name"`
+	Url                    string                  `json:"url"`
+	HtmlUrl                string                  `json:"html_url"`
+	CreatedAt              time.Time               `json:"created_at"`
+	UpdatedAt              time.Time               `json:"updated_at"`
+	CanAdminsBypass        bool                    `json:"can_admins_bypass"`
+	ProtectionRules        []ProtectionRule        `json:"protection_rules"`
+	DeploymentBranchPolicy *DeploymentBranchPolicy `json:"deployment_branch_policy"`
+}
+
+func (e *Environment) WaitTimer() int {
+	for _, rule := range e.ProtectionRules {
+		if rule.Type == "wait_timer" {
+			return rule.WaitTimer
+		}
+	}
+	return 0
+}
+
+func (e *Environment) RequiredReviewers() int {
+	for _, rule := range e.ProtectionRules {
+		if rule.Type == "required_reviewers" {
+			return len(rule.Reviewers)
+		}
+	}
+	return 0
+}
+
+func (e *Environment) BranchPolicySummary() string {
+	if e.DeploymentBranchPolicy == nil {
+		return "all branches"
+	}
+	if e.DeploymentBranchPolicy.CustomBranchPolicies {
+		return "custom branches"
+	}
+	if e.DeploymentBranchPolicy.ProtectedBranches {
+		return "protected branches"
+	}
+	return "all branches"
+}
+
+func (e *Environment) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(e, fields)
+}
@@ -0,0 +1,43 @@
+package view
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_viewRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/environments/production"),
+		httpmock.StringResponse(`{
+			"name": "production",
+			"protection_rules": [
+				{"type": "wait_timer", "wait_timer": 15},
+				{"type": "required_reviewers", "reviewers": [{"type": "User", "reviewer": {"login": "octocat"}}]}
+			],
+			"deployment_branch_policy": {"custom_branch_policies": true}
+		}`))
+
+	ios, _, stdout, _ := iostreams.Test()
+
+	opts := &ViewOptions{
+		IO:         ios,
+		HTTPClient: func() (*http.Client, error) { return &http.Client{Transport: reg}, nil },
+		BaseRepo:   func() (ghrepo.Interface, error) { return ghrepo.New("OWNER", "REPO"), nil },
+		Name:       "production",
+	}
+
+	require.NoError(t, viewRun(opts))
+	out := stdout.String()
+	assert.Contains(t, out, "production")
+	assert.Contains(t, out, "Wait timer: 15m")
+	assert.Contains(t, out, "Required reviewers: 1")
+	assert.Contains(t, out, "custom branches")
+}
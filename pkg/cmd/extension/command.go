@@ -15,6 +15,7 @@ import (
 	"github.com/cli/cli/v2/internal/tableprinter"
 	"github.com/cli/cli/v2/internal/text"
 	"github.com/cli/cli/v2/pkg/cmd/extension/browse"
+	extRelease "github.com/cli/cli/v2/pkg/cmd/extension/release"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/extensions"
 	"github.com/cli/cli/v2/pkg/search"
@@ -634,6 +635,7 @@ func NewCmdExtension(f *cmdutil.Factory) *cobra.Command {
 			cmd.Flags().StringVar(&flagType, "precompiled", "", "Create a precompiled extension. Possible values: go, other")
 			return cmd
 		}(),
+		extRelease.NewCmdExtensionRelease(f, nil),
 	)
 
 	return &extCmd
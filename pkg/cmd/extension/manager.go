@@ -17,8 +17,10 @@ import (
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/extensionrpc"
 	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/prompter"
 	"github.com/cli/cli/v2/pkg/extensions"
 	"github.com/cli/cli/v2/pkg/findsh"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -39,6 +41,7 @@ type Manager struct {
 	gitClient  gitClient
 	config     gh.Config
 	io         *iostreams.IOStreams
+	prompter   prompter.Prompter
 	dryRunMode bool
 }
 
@@ -68,6 +71,10 @@ func (m *Manager) SetClient(client *http.Client) {
 	m.client = client
 }
 
+func (m *Manager) SetPrompter(p prompter.Prompter) {
+	m.prompter = p
+}
+
 func (m *Manager) EnableDryRunMode() {
 	m.dryRunMode = true
 }
@@ -114,6 +121,19 @@ func (m *Manager) Dispatch(args []string, stdin io.Reader, stdout, stderr io.Wri
 	externalCmd.Stdin = stdin
 	externalCmd.Stdout = stdout
 	externalCmd.Stderr = stderr
+
+	if m.io != nil && m.prompter != nil {
+		rpcServer := extensionrpc.NewServer(m.io, m.prompter)
+		socketPath, closeRPC, err := rpcServer.Listen()
+		if err != nil {
+			return true, err
+		}
+		defer closeRPC()
+		if socketPath != "" {
+			externalCmd.Env = append(os.Environ(), extensionrpc.SocketEnvVar+"="+socketPath)
+		}
+	}
+
 	return true, externalCmd.Run()
 }
 
@@ -533,6 +553,9 @@ var scriptTmpl string
 //go:embed ext_tmpls/buildScript.sh
 var buildScript []byte
 
+//go:embed ext_tmpls/goreleaser.yml
+var goreleaserConfig []byte
+
 func (m *Manager) Create(name string, tmplType extensions.ExtTemplateType) error {
 	if _, err := m.gitClient.CommandOutput([]string{"init", "--quiet", name}); err != nil {
 		return err
@@ -601,6 +624,10 @@ func (m *Manager) goBinScaffolding(name string) error {
 		return err
 	}
 
+	if err := writeFile(filepath.Join(name, ".goreleaser.yml"), goreleaserConfig, 0644); err != nil {
+		return err
+	}
+
 	host, _ := m.config.Authentication().DefaultHost()
 
 	currentUser, err := api.CurrentLoginName(api.NewClientFromHTTP(m.client), host)
@@ -1055,7 +1055,7 @@ func TestManager_Create_go_binary(t *testing.T) {
 
 	files, err := os.ReadDir("gh-test")
 	require.NoError(t, err)
-	assert.Equal(t, []string{".github", ".gitignore", "main.go"}, fileNames(files))
+	assert.Equal(t, []string{".github", ".gitignore", ".goreleaser.yml", "main.go"}, fileNames(files))
 
 	gitignore, err := os.ReadFile(filepath.Join("gh-test", ".gitignore"))
 	require.NoError(t, err)
@@ -0,0 +1,250 @@
+package release
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/release/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+// platforms mirrors the build matrix in ext_tmpls/goreleaser.yml, so that a
+// release built here lines up with what the release.yml workflow produces.
+var platforms = []struct{ goos, goarch string }{
+	{"darwin", "amd64"},
+	{"darwin", "arm64"},
+	{"linux", "386"},
+	{"linux", "amd64"},
+	{"linux", "arm"},
+	{"linux", "arm64"},
+	{"windows", "386"},
+	{"windows", "amd64"},
+	{"freebsd", "386"},
+	{"freebsd", "amd64"},
+}
+
+type ReleaseOptions struct {
+	IO         *iostreams.IOStreams
+	HttpClient func() (*http.Client, error)
+	GitClient  *git.Client
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Tag   string
+	Draft bool
+}
+
+func NewCmdExtensionRelease(f *cmdutil.Factory, runF func(*ReleaseOptions) error) *cobra.Command {
+	opts := &ReleaseOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		GitClient:  f.GitClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "release <tag>",
+		Short: "Build and publish a release for a precompiled extension",
+		Long: heredoc.Doc(`
+			Cross-compile the Go extension in the current directory for every
+			platform gh supports, tag the current commit, push the tag, and
+			publish a GitHub release with the resulting binaries attached.
+
+			This is meant for extensions scaffolded by 'gh extension create
+			--precompiled=go'; it builds from main.go the same way the generated
+			.goreleaser.yml does, without requiring goreleaser to be installed.
+
+			Build provenance attestations for the uploaded binaries are not
+			generated by this command. They're produced by the generated
+			.github/workflows/release.yml, which runs in CI when the pushed tag
+			triggers it.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Tag = args[0]
+			opts.BaseRepo = f.BaseRepo
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return releaseRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Draft, "draft", false, "Save the release as a draft instead of publishing it")
+
+	return cmd
+}
+
+func releaseRun(opts *ReleaseOptions) error {
+	ctx := context.Background()
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+
+	opts.IO.StartProgressIndicator()
+	distDir, binPaths, err := buildBinaries(baseRepo.RepoName())
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to build release binaries: %w", err)
+	}
+	defer os.RemoveAll(distDir)
+
+	tagCmd, err := opts.GitClient.Command(ctx, "tag", opts.Tag)
+	if err != nil {
+		return err
+	}
+	if err := tagCmd.Run(); err != nil {
+		return fmt.Errorf("failed to tag %s: %w", opts.Tag, err)
+	}
+
+	if err := opts.GitClient.Push(ctx, "origin", opts.Tag); err != nil {
+		return fmt.Errorf("failed to push tag %s: %w", opts.Tag, err)
+	}
+
+	assets, err := shared.AssetsFromArgs(binPaths)
+	if err != nil {
+		return err
+	}
+
+	release, err := createRelease(httpClient, baseRepo, map[string]interface{}{
+		"tag_name": opts.Tag,
+		"draft":    true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create release: %w", err)
+	}
+
+	uploadURL := release.UploadURL
+	if idx := strings.IndexByte(uploadURL, '{'); idx != -1 {
+		uploadURL = uploadURL[:idx]
+	}
+	if err := shared.ConcurrentUpload(httpClient, uploadURL, 5, assets); err != nil {
+		return fmt.Errorf("failed to upload release assets: %w", err)
+	}
+
+	if !opts.Draft {
+		if release, err = publishRelease(httpClient, release.APIURL); err != nil {
+			return fmt.Errorf("failed to publish release: %w", err)
+		}
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s Released %s\n", cs.SuccessIcon(), release.URL)
+	} else {
+		fmt.Fprintln(opts.IO.Out, release.URL)
+	}
+
+	return nil
+}
+
+// buildBinaries cross-compiles binName for every platform gh runs on,
+// writing them to a temporary directory which the caller is responsible for
+// removing, and returns the paths to the binaries it produced.
+func buildBinaries(binName string) (distDir string, binPaths []string, err error) {
+	distDir, err = os.MkdirTemp("", "gh-extension-release-*")
+	if err != nil {
+		return "", nil, err
+	}
+
+	for _, p := range platforms {
+		out := fmt.Sprintf("%s-%s-%s", binName, p.goos, p.goarch)
+		if p.goos == "windows" {
+			out += ".exe"
+		}
+		outPath := filepath.Join(distDir, out)
+
+		cmd := exec.Command("go", "build", "-trimpath", "-ldflags=-s -w", "-o", outPath)
+		cmd.Env = append(os.Environ(), "GOOS="+p.goos, "GOARCH="+p.goarch, "CGO_ENABLED=0")
+		if output, buildErr := cmd.CombinedOutput(); buildErr != nil {
+			return "", nil, fmt.Errorf("go build for %s/%s: %w\n%s", p.goos, p.goarch, buildErr, output)
+		}
+
+		binPaths = append(binPaths, outPath)
+	}
+
+	return distDir, binPaths, nil
+}
+
+func createRelease(httpClient *http.Client, repo ghrepo.Interface, params map[string]interface{}) (*shared.Release, error) {
+	bodyBytes, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("repos/%s/%s/releases", repo.RepoOwner(), repo.RepoName())
+	url := ghinstance.RESTPrefix(repo.RepoHost()) + path
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(bodyBytes))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var release shared.Release
+	err = json.Unmarshal(b, &release)
+	return &release, err
+}
+
+func publishRelease(httpClient *http.Client, releaseURL string) (*shared.Release, error) {
+	req, err := http.NewRequest("PATCH", releaseURL, bytes.NewBufferString(`{"draft":false}`))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var release shared.Release
+	err = json.Unmarshal(b, &release)
+	return &release, err
+}
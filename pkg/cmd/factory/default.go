@@ -38,7 +38,7 @@ func New(appVersion string) *cmdutil.Factory {
 	f.BaseRepo = BaseRepoFunc(f)                 // Depends on Remotes
 	f.Prompter = newPrompter(f)                  // Depends on Config and IOStreams
 	f.Browser = newBrowser(f)                    // Depends on Config, and IOStreams
-	f.ExtensionManager = extensionManager(f)     // Depends on Config, HttpClient, and IOStreams
+	f.ExtensionManager = extensionManager(f)     // Depends on Config, HttpClient, IOStreams, and Prompter
 	f.Branch = branchFunc(f)                     // Depends on GitClient
 
 	return f
@@ -132,7 +132,18 @@ func newBrowser(f *cmdutil.Factory) browser.Browser {
 func newPrompter(f *cmdutil.Factory) prompter.Prompter {
 	editor, _ := cmdutil.DetermineEditor(f.Config)
 	io := f.IOStreams
-	return prompter.New(editor, io.In, io.Out, io.ErrOut)
+	p := prompter.New(editor, io.In, io.Out, io.ErrOut)
+
+	if answersFile := os.Getenv("GH_PROMPT_ANSWERS"); answersFile != "" {
+		wrapped, err := prompter.WithAnswers(p, answersFile)
+		if err != nil {
+			fmt.Fprintf(io.ErrOut, "warning: ignoring GH_PROMPT_ANSWERS: %v\n", err)
+			return p
+		}
+		return wrapped
+	}
+
+	return p
 }
 
 func configFunc() func() (gh.Config, error) {
@@ -159,6 +170,7 @@ func branchFunc(f *cmdutil.Factory) func() (string, error) {
 
 func extensionManager(f *cmdutil.Factory) *extension.Manager {
 	em := extension.NewManager(f.IOStreams, f.GitClient)
+	em.SetPrompter(f.Prompter)
 
 	cfg, err := f.Config()
 	if err != nil {
@@ -199,6 +211,10 @@ func ioStreams(f *cmdutil.Factory) *iostreams.IOStreams {
 		io.SetPager(pager.Value)
 	}
 
+	if accessiblePrompter := cfg.AccessiblePrompter(""); accessiblePrompter.Value == "enabled" {
+		io.SetAccessiblePrompterEnabled(true)
+	}
+
 	return io
 }
 
@@ -0,0 +1,216 @@
+package feed
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+const defaultInterval time.Duration = 30 * time.Second
+
+type FeedOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (gh.Config, error)
+	IO         *iostreams.IOStreams
+	Exporter   cmdutil.Exporter
+
+	EventType string
+	Repo      string
+	Limit     int
+	Watch     bool
+	Interval  time.Duration
+
+	Now func() time.Time
+}
+
+func NewCmdFeed(f *cmdutil.Factory, runF func(*FeedOptions) error) *cobra.Command {
+	var interval int
+	opts := &FeedOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		Interval:   defaultInterval,
+		Now:        time.Now,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "feed",
+		Short: "Show your personalized GitHub activity feed",
+		Long: heredoc.Doc(`
+			Show the feed of events GitHub has recorded for things you watch:
+			releases from repositories you have starred, new followers, and more.
+
+			Use --type to only show one kind of event ("release", "star", "fork",
+			"follow", "push", "issue", or "pr") and --repo to only show events for
+			a single repository.
+		`),
+		Example: heredoc.Doc(`
+			$ gh interest feed
+			$ gh interest feed --type release
+			$ gh interest feed --repo cli/cli --watch
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Limit < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
+			}
+
+			intervalChanged := cmd.Flags().Changed("interval")
+			if !opts.Watch && intervalChanged {
+				return cmdutil.FlagErrorf("cannot use `--interval` flag without `--watch` flag")
+			}
+			if intervalChanged {
+				opts.Interval = time.Duration(interval) * time.Second
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return feedRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.EventType, "type", "T", "", "Only show events of a given type (e.g. `release`, `star`, `fork`, `follow`, `push`)")
+	cmd.Flags().StringVarP(&opts.Repo, "repo", "R", "", "Only show events for a specific `owner/repo`")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of events to fetch")
+	cmd.Flags().BoolVarP(&opts.Watch, "watch", "w", false, "Poll for new events")
+	cmd.Flags().IntVarP(&interval, "interval", "i", 30, "Refresh interval in seconds when using `--watch` flag")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, eventFields)
+
+	return cmd
+}
+
+func feedRun(opts *FeedOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, _ := cfg.Authentication().DefaultHost()
+
+	username, err := api.CurrentLoginName(apiClient, host)
+	if err != nil {
+		return err
+	}
+
+	wantType := normalizeEventType(opts.EventType)
+
+	events, err := fetchFilteredEvents(apiClient, host, username, opts.Limit, wantType, opts.Repo)
+	if err != nil {
+		return err
+	}
+
+	if !opts.Watch {
+		return renderEvents(opts, events)
+	}
+
+	seen := map[string]bool{}
+	for _, e := range events {
+		seen[e.ID] = true
+	}
+
+	if err := renderEvents(opts, events); err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	for {
+		time.Sleep(opts.Interval)
+
+		events, err := fetchFilteredEvents(apiClient, host, username, opts.Limit, wantType, opts.Repo)
+		if err != nil {
+			return err
+		}
+
+		var fresh []*event
+		for _, e := range events {
+			if !seen[e.ID] {
+				fresh = append(fresh, e)
+				seen[e.ID] = true
+			}
+		}
+
+		// Print oldest-first so the feed reads top-to-bottom like a log.
+		for i := len(fresh) - 1; i >= 0; i-- {
+			e := fresh[i]
+			fmt.Fprintf(opts.IO.Out, "%s %s\n", cs.Gray(e.CreatedAt.Local().Format(time.Kitchen)), summarize(e))
+		}
+	}
+}
+
+func fetchFilteredEvents(client *api.Client, host, username string, limit int, wantType, wantRepo string) ([]*event, error) {
+	// Received events aren't filterable server-side, so overfetch a bit and
+	// trim down to `limit` matches locally.
+	fetchLimit := limit
+	if wantType != "" || wantRepo != "" {
+		fetchLimit = limit * 3
+		if fetchLimit > 300 {
+			fetchLimit = 300
+		}
+	}
+
+	events, err := getReceivedEvents(client, host, username, fetchLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	if wantType == "" && wantRepo == "" {
+		return events, nil
+	}
+
+	var filtered []*event
+	for _, e := range events {
+		if wantType != "" && e.Type != wantType {
+			continue
+		}
+		if wantRepo != "" && e.Repo.Name != wantRepo {
+			continue
+		}
+		filtered = append(filtered, e)
+		if len(filtered) >= limit {
+			break
+		}
+	}
+
+	return filtered, nil
+}
+
+func renderEvents(opts *FeedOptions, events []*event) error {
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, events)
+	}
+
+	if len(events) == 0 {
+		return cmdutil.NewNoResultsError("no events found in your feed")
+	}
+
+	if err := opts.IO.StartPager(); err != nil {
+		fmt.Fprintf(opts.IO.ErrOut, "error starting pager: %v\n", err)
+	}
+	defer opts.IO.StopPager()
+
+	cs := opts.IO.ColorScheme()
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("EVENT", "REPOSITORY", "SUMMARY", "AGE"))
+	for _, e := range events {
+		tp.AddField(e.Type, tableprinter.WithColor(cs.Bold))
+		tp.AddField(e.Repo.Name)
+		tp.AddField(summarize(e))
+		tp.AddTimeField(opts.Now(), e.CreatedAt, cs.Gray)
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
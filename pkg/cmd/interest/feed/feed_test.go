@@ -0,0 +1,130 @@
+package feed
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeedRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       FeedOptions
+		stubs      func(*httpmock.Registry)
+		wantErr    bool
+		wantErrMsg string
+		wantStdout string
+	}{
+		{
+			name: "shows the feed",
+			opts: FeedOptions{Limit: 30},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query UserCurrent\b`),
+					httpmock.StringResponse(`{ "data": { "viewer": { "login": "monalisa" } } }`))
+				reg.Register(
+					httpmock.REST("GET", "users/monalisa/received_events"),
+					httpmock.JSONResponse([]map[string]interface{}{
+						{
+							"id":   "1",
+							"type": "WatchEvent",
+							"actor": map[string]string{
+								"login": "hubot",
+							},
+							"repo": map[string]string{
+								"name": "cli/cli",
+							},
+							"created_at": "2021-01-01T00:00:00Z",
+						},
+					}))
+			},
+			wantStdout: "WatchEvent\tcli/cli\thubot starred cli/cli\t2021-01-01T00:00:00Z\n",
+		},
+		{
+			name: "filters by event type",
+			opts: FeedOptions{Limit: 30, EventType: "release"},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query UserCurrent\b`),
+					httpmock.StringResponse(`{ "data": { "viewer": { "login": "monalisa" } } }`))
+				reg.Register(
+					httpmock.REST("GET", "users/monalisa/received_events"),
+					httpmock.JSONResponse([]map[string]interface{}{
+						{
+							"id":   "1",
+							"type": "WatchEvent",
+							"actor": map[string]string{
+								"login": "hubot",
+							},
+							"repo": map[string]string{
+								"name": "cli/cli",
+							},
+							"created_at": "2021-01-01T00:00:00Z",
+						},
+						{
+							"id":   "2",
+							"type": "ReleaseEvent",
+							"actor": map[string]string{
+								"login": "hubot",
+							},
+							"repo": map[string]string{
+								"name": "cli/cli",
+							},
+							"created_at": "2021-01-01T00:00:00Z",
+						},
+					}))
+			},
+			wantStdout: "ReleaseEvent\tcli/cli\thubot published a release in cli/cli\t2021-01-01T00:00:00Z\n",
+		},
+		{
+			name: "no events",
+			opts: FeedOptions{Limit: 30},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query UserCurrent\b`),
+					httpmock.StringResponse(`{ "data": { "viewer": { "login": "monalisa" } } }`))
+				reg.Register(
+					httpmock.REST("GET", "users/monalisa/received_events"),
+					httpmock.JSONResponse([]map[string]interface{}{}))
+			},
+			wantErr:    true,
+			wantErrMsg: "no events found in your feed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			tt.stubs(reg)
+			defer reg.Verify(t)
+
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			tt.opts.Config = func() (gh.Config, error) {
+				return config.NewBlankConfig(), nil
+			}
+
+			ios, _, stdout, _ := iostreams.Test()
+			tt.opts.IO = ios
+			tt.opts.Now = func() time.Time {
+				t, _ := time.Parse(time.RFC3339, "2021-01-01T01:00:00Z")
+				return t
+			}
+
+			err := feedRun(&tt.opts)
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.wantErrMsg)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package feed
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+)
+
+var eventFields = []string{
+	"type",
+	"actor",
+	"repo",
+	"createdAt",
+	"summary",
+}
+
+// event is a received GitHub Event, as returned by the
+// /users/{username}/received_events API.
+type event struct {
+	ID    string `json:"id"`
+	Type  string `json:"type"`
+	Actor struct {
+		Login string `json:"login"`
+	} `json:"actor"`
+	Repo struct {
+		Name string `json:"name"`
+	} `json:"repo"`
+	Payload   json.RawMessage `json:"payload"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+func (e *event) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "type":
+			data[f] = e.Type
+		case "actor":
+			data[f] = e.Actor.Login
+		case "repo":
+			data[f] = e.Repo.Name
+		case "createdAt":
+			data[f] = e.CreatedAt
+		case "summary":
+			data[f] = summarize(e)
+		}
+	}
+	return data
+}
+
+// getReceivedEvents fetches the most recent events GitHub has recorded for
+// things username watches, such as releases from starred repositories and
+// new followers.
+func getReceivedEvents(client *api.Client, host, username string, limit int) ([]*event, error) {
+	perPage := limit
+	if perPage > 100 || perPage <= 0 {
+		perPage = 100
+	}
+
+	path := fmt.Sprintf("users/%s/received_events?per_page=%d", username, perPage)
+
+	var events []*event
+pagination:
+	for path != "" {
+		var page []*event
+		var err error
+		path, err = client.RESTWithNext(host, "GET", path, nil, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, page...)
+		if len(events) >= limit {
+			events = events[:limit]
+			break pagination
+		}
+	}
+
+	return events, nil
+}
+
+// summarize renders a short, human-readable description of an event, in the
+// same spirit as the activity feed on github.com.
+func summarize(e *event) string {
+	switch e.Type {
+	case "WatchEvent":
+		return fmt.Sprintf("%s starred %s", e.Actor.Login, e.Repo.Name)
+	case "ReleaseEvent":
+		return fmt.Sprintf("%s published a release in %s", e.Actor.Login, e.Repo.Name)
+	case "ForkEvent":
+		return fmt.Sprintf("%s forked %s", e.Actor.Login, e.Repo.Name)
+	case "FollowEvent":
+		return fmt.Sprintf("%s followed a new user", e.Actor.Login)
+	case "PushEvent":
+		return fmt.Sprintf("%s pushed to %s", e.Actor.Login, e.Repo.Name)
+	case "PublicEvent":
+		return fmt.Sprintf("%s made %s public", e.Actor.Login, e.Repo.Name)
+	case "IssuesEvent":
+		return fmt.Sprintf("%s acted on an issue in %s", e.Actor.Login, e.Repo.Name)
+	case "PullRequestEvent":
+		return fmt.Sprintf("%s acted on a pull request in %s", e.Actor.Login, e.Repo.Name)
+	default:
+		return fmt.Sprintf("%s: %s in %s", e.Actor.Login, e.Type, e.Repo.Name)
+	}
+}
+
+// eventTypeAliases maps short, memorable names to the event type strings
+// GitHub's Events API actually uses.
+var eventTypeAliases = map[string]string{
+	"star":    "WatchEvent",
+	"stars":   "WatchEvent",
+	"watch":   "WatchEvent",
+	"release": "ReleaseEvent",
+	"fork":    "ForkEvent",
+	"follow":  "FollowEvent",
+	"push":    "PushEvent",
+	"public":  "PublicEvent",
+	"issue":   "IssuesEvent",
+	"pr":      "PullRequestEvent",
+}
+
+func normalizeEventType(t string) string {
+	if canonical, ok := eventTypeAliases[t]; ok {
+		return canonical
+	}
+	return t
+}
@@ -0,0 +1,24 @@
+package interest
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdFeed "github.com/cli/cli/v2/pkg/cmd/interest/feed"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdInterest(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "interest <command>",
+		Short: "View your personalized GitHub activity feed",
+		Long: heredoc.Doc(`
+			Work with the feed of events GitHub has recorded for things you're
+			interested in, such as releases from repositories you have starred.
+		`),
+		GroupID: "core",
+	}
+
+	cmd.AddCommand(cmdFeed.NewCmdFeed(f, nil))
+
+	return cmd
+}
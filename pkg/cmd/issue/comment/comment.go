@@ -41,7 +41,7 @@ func NewCmdComment(f *cmdutil.Factory, runF func(*prShared.CommentableOptions) e
 					return nil, nil, err
 				}
 				fields := []string{"id", "url"}
-				if opts.EditLast {
+				if opts.EditLast || opts.DeleteLast {
 					fields = append(fields, "comments")
 				}
 				return issueShared.IssueFromArgWithFields(httpClient, f.BaseRepo, args[0], fields)
@@ -69,6 +69,7 @@ func NewCmdComment(f *cmdutil.Factory, runF func(*prShared.CommentableOptions) e
 	cmd.Flags().BoolP("editor", "e", false, "Skip prompts and open the text editor to write the body in")
 	cmd.Flags().BoolP("web", "w", false, "Open the web browser to write the comment")
 	cmd.Flags().BoolVar(&opts.EditLast, "edit-last", false, "Edit the last comment of the same author")
+	cmd.Flags().BoolVar(&opts.DeleteLast, "delete-last", false, "Delete the last comment of the same author")
 
 	return cmd
 }
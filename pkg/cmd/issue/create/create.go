@@ -1,19 +1,23 @@
 package create
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/browser"
 	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/internal/text"
 	prShared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	searchshared "github.com/cli/cli/v2/pkg/cmd/search/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/search"
 	"github.com/spf13/cobra"
 )
 
@@ -24,6 +28,7 @@ type CreateOptions struct {
 	BaseRepo         func() (ghrepo.Interface, error)
 	Browser          browser.Browser
 	Prompter         prShared.Prompt
+	Searcher         func() (search.Searcher, error)
 	TitledEditSurvey func(string, string) (string, string, error)
 
 	RootDirOverride string
@@ -42,6 +47,8 @@ type CreateOptions struct {
 	Projects  []string
 	Milestone string
 	Template  string
+	RawFields []string
+	Fields    map[string]string
 }
 
 func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
@@ -51,6 +58,7 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 		Config:           f.Config,
 		Browser:          f.Browser,
 		Prompter:         f.Prompter,
+		Searcher:         func() (search.Searcher, error) { return searchshared.Searcher(f) },
 		TitledEditSurvey: prShared.TitledEditSurvey(&prShared.UserEditor{Config: f.Config, IO: f.IOStreams}),
 	}
 
@@ -64,6 +72,15 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 
 			Adding an issue to projects requires authorization with the %[1]sproject%[1]s scope.
 			To authorize, run %[1]sgh auth refresh -s project%[1]s.
+
+			When %[1]s--template%[1]s names a YAML issue form, its fields are rendered as
+			prompts (or validated against %[1]s--field id=value%[1]s answers when running
+			non-interactively) and assembled into the issue body the same way the web UI
+			would.
+
+			A file passed to %[1]s--body-file%[1]s may begin with a YAML front matter block
+			(%[1]stitle%[1]s, %[1]slabels%[1]s, %[1]sassignees%[1]s, %[1]smilestone%[1]s, %[1]sprojects%[1]s) to set those
+			fields from the file as well; any matching flag takes precedence over the front matter.
 		`, "`"),
 		Example: heredoc.Doc(`
 			$ gh issue create --title "I found a bug" --body "Nothing works"
@@ -73,6 +90,7 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 			$ gh issue create --assignee "@me"
 			$ gh issue create --project "Roadmap"
 			$ gh issue create --template "bug_report.md"
+			$ gh issue create --template "Bug report" --title "I found a bug" --field version=1.0 --field severity=high
 		`),
 		Args:    cmdutil.NoArgsQuoteReminder,
 		Aliases: []string{"new"},
@@ -104,6 +122,26 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 				}
 				opts.Body = string(b)
 				bodyProvided = true
+
+				if fm, rest, ok := prShared.ExtractFrontmatter(opts.Body); ok {
+					opts.Body = rest
+					if !titleProvided && fm.Title != "" {
+						opts.Title = fm.Title
+						titleProvided = true
+					}
+					if !cmd.Flags().Changed("assignee") && len(fm.Assignees) > 0 {
+						opts.Assignees = fm.Assignees
+					}
+					if !cmd.Flags().Changed("label") && len(fm.Labels) > 0 {
+						opts.Labels = fm.Labels
+					}
+					if !cmd.Flags().Changed("project") && len(fm.Projects) > 0 {
+						opts.Projects = fm.Projects
+					}
+					if !cmd.Flags().Changed("milestone") && fm.Milestone != "" {
+						opts.Milestone = fm.Milestone
+					}
+				}
 			}
 
 			if !opts.IO.CanPrompt() && opts.RecoverFile != "" {
@@ -114,7 +152,16 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 				return errors.New("`--template` is not supported when using `--body` or `--body-file`")
 			}
 
-			opts.Interactive = !opts.EditorMode && !(titleProvided && bodyProvided)
+			opts.Fields, err = parseFormFields(opts.RawFields)
+			if err != nil {
+				return cmdutil.FlagErrorf("%w", err)
+			}
+			if len(opts.Fields) > 0 && opts.Template == "" {
+				return cmdutil.FlagErrorf("`--field` can only be used together with `--template`")
+			}
+
+			hasFormInputs := opts.Template != "" && len(opts.Fields) > 0
+			opts.Interactive = !opts.EditorMode && !(titleProvided && (bodyProvided || hasFormInputs))
 
 			if opts.Interactive && !opts.IO.CanPrompt() {
 				return cmdutil.FlagErrorf("must provide `--title` and `--body` when not running interactively")
@@ -141,6 +188,7 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 	cmd.Flags().StringVarP(&opts.Milestone, "milestone", "m", "", "Add the issue to a milestone by `name`")
 	cmd.Flags().StringVar(&opts.RecoverFile, "recover", "", "Recover input from a failed run of create")
 	cmd.Flags().StringVarP(&opts.Template, "template", "T", "", "Template `file` to use as starting body text")
+	cmd.Flags().StringArrayVar(&opts.RawFields, "field", nil, "Answer an issue form field in `id=value` format; requires `--template`")
 
 	return cmd
 }
@@ -237,6 +285,10 @@ func createRun(opts *CreateOptions) (err error) {
 			if err != nil {
 				return
 			}
+
+			if err = checkSimilarIssues(opts, baseRepo, tb.Title); err != nil {
+				return
+			}
 		}
 
 		if opts.Body == "" {
@@ -260,14 +312,31 @@ func createRun(opts *CreateOptions) (err error) {
 				if template != nil {
 					templateContent = string(template.Body())
 					templateNameForSubmit = template.NameForSubmit()
+
+					if form, ok := resolveIssueForm(formDir(opts), httpClient, baseRepo, template.Name()); ok {
+						fields := opts.Fields
+						if len(fields) == 0 {
+							fields, err = surveyFormFields(opts.Prompter, form)
+							if err != nil {
+								return
+							}
+						}
+						if err = validateFormFields(form, fields); err != nil {
+							return
+						}
+						tb.Body = renderFormBody(form, fields)
+						templateContent = ""
+					}
 				} else {
 					templateContent = string(tpl.LegacyBody())
 				}
 			}
 
-			err = prShared.BodySurvey(opts.Prompter, &tb, templateContent)
-			if err != nil {
-				return
+			if tb.Body == "" {
+				err = prShared.BodySurvey(opts.Prompter, &tb, templateContent)
+				if err != nil {
+					return
+				}
 			}
 		}
 
@@ -325,6 +394,25 @@ func createRun(opts *CreateOptions) (err error) {
 			if err != nil {
 				return
 			}
+		} else if opts.Template != "" && tb.Body == "" {
+			var template prShared.Template
+			template, err = tpl.Select(opts.Template)
+			if err != nil {
+				return
+			}
+			templateNameForSubmit = template.NameForSubmit()
+
+			if form, ok := resolveIssueForm(formDir(opts), httpClient, baseRepo, template.Name()); ok {
+				if err = validateFormFields(form, opts.Fields); err != nil {
+					return
+				}
+				tb.Body = renderFormBody(form, opts.Fields)
+			} else if len(opts.Fields) > 0 {
+				err = fmt.Errorf("template %q is not an issue form; `--field` is only supported with issue forms", opts.Template)
+				return
+			} else {
+				tb.Body = string(template.Body())
+			}
 		}
 		if tb.Title == "" {
 			err = fmt.Errorf("title can't be blank")
@@ -365,6 +453,25 @@ func createRun(opts *CreateOptions) (err error) {
 	return
 }
 
+// formDir resolves the local checkout directory to search for issue form YAML
+// files, mirroring the gating that the template manager itself uses for its
+// filesystem fallback: skip the local filesystem entirely when `-R/--repo` was
+// used to target a repo that may not be the current checkout.
+func formDir(opts *CreateOptions) string {
+	if opts.HasRepoOverride {
+		return ""
+	}
+	if opts.RootDirOverride != "" {
+		return opts.RootDirOverride
+	}
+	gitClient := &git.Client{}
+	dir, err := gitClient.ToplevelDir(context.Background())
+	if err != nil {
+		return ""
+	}
+	return dir
+}
+
 func generatePreviewURL(apiClient *api.Client, baseRepo ghrepo.Interface, tb prShared.IssueMetadataState) (string, error) {
 	openURL := ghrepo.GenerateRepoURL(baseRepo, "issues/new")
 	return prShared.WithPrAndIssueQueryParams(apiClient, baseRepo, openURL, tb)
@@ -0,0 +1,357 @@
+package create
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	prShared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"gopkg.in/yaml.v3"
+)
+
+// issueForm is the schema of a GitHub issue form (a YAML issue template),
+// parsed just deeply enough to validate field values and render a body.
+type issueForm struct {
+	Name string             `yaml:"name"`
+	Body []issueFormElement `yaml:"body"`
+}
+
+type issueFormElement struct {
+	Type        string                 `yaml:"type"`
+	ID          string                 `yaml:"id"`
+	Attributes  map[string]interface{} `yaml:"attributes"`
+	Validations map[string]interface{} `yaml:"validations"`
+}
+
+func (el issueFormElement) label() string {
+	if label, ok := el.Attributes["label"].(string); ok && label != "" {
+		return label
+	}
+	return el.ID
+}
+
+func (el issueFormElement) required() bool {
+	required, _ := el.Validations["required"].(bool)
+	return required
+}
+
+func (el issueFormElement) dropdownOptions() []string {
+	if el.Type != "dropdown" {
+		return nil
+	}
+	raw, ok := el.Attributes["options"].([]interface{})
+	if !ok {
+		return nil
+	}
+	options := make([]string, 0, len(raw))
+	for _, o := range raw {
+		if s, ok := o.(string); ok {
+			options = append(options, s)
+		}
+	}
+	return options
+}
+
+// checkboxOptions returns the labels of a checkboxes element's options; unlike
+// dropdown options, these are declared as a list of `{label, required}` maps.
+func (el issueFormElement) checkboxOptions() []string {
+	if el.Type != "checkboxes" {
+		return nil
+	}
+	raw, ok := el.Attributes["options"].([]interface{})
+	if !ok {
+		return nil
+	}
+	options := make([]string, 0, len(raw))
+	for _, o := range raw {
+		m, ok := o.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if label, ok := m["label"].(string); ok {
+			options = append(options, label)
+		}
+	}
+	return options
+}
+
+// splitSelections splits a comma-separated list of checkbox selections, as
+// stored in a field value, trimming surrounding whitespace from each entry.
+func splitSelections(value string) []string {
+	if value == "" {
+		return nil
+	}
+	parts := strings.Split(value, ",")
+	selections := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			selections = append(selections, p)
+		}
+	}
+	return selections
+}
+
+// fields returns the fillable (non-markdown) elements of the form, in declaration order.
+func (f *issueForm) fields() []issueFormElement {
+	var els []issueFormElement
+	for _, el := range f.Body {
+		if el.Type == "markdown" || el.ID == "" {
+			continue
+		}
+		els = append(els, el)
+	}
+	return els
+}
+
+func (f *issueForm) field(id string) (issueFormElement, bool) {
+	for _, el := range f.fields() {
+		if el.ID == id {
+			return el, true
+		}
+	}
+	return issueFormElement{}, false
+}
+
+// findIssueForm locates and parses a YAML issue form in the local checkout whose
+// `name` front matter matches templateName. It returns ok=false, rather than an
+// error, when no such form exists so callers can fall back to treating the
+// template as a plain body template.
+func findIssueForm(dir, templateName string) (*issueForm, bool) {
+	if dir == "" || templateName == "" {
+		return nil, false
+	}
+
+	candidateDir := filepath.Join(dir, ".github", "ISSUE_TEMPLATE")
+	entries, err := os.ReadDir(candidateDir)
+	if err != nil {
+		return nil, false
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !(strings.HasSuffix(name, ".yml") || strings.HasSuffix(name, ".yaml")) {
+			continue
+		}
+
+		contents, err := os.ReadFile(filepath.Join(candidateDir, name))
+		if err != nil {
+			continue
+		}
+
+		var form issueForm
+		if err := yaml.Unmarshal(contents, &form); err != nil || len(form.Body) == 0 {
+			continue
+		}
+		if form.Name == templateName {
+			return &form, true
+		}
+	}
+
+	return nil, false
+}
+
+// resolveIssueForm finds an issue form named templateName, preferring a local
+// checkout (cheap, no API calls) and falling back to fetching the template
+// YAML from the repository on the server, which is needed when there is no
+// local checkout to search (e.g. `-R/--repo` was used).
+func resolveIssueForm(dir string, httpClient *http.Client, repo ghrepo.Interface, templateName string) (*issueForm, bool) {
+	if form, ok := findIssueForm(dir, templateName); ok {
+		return form, true
+	}
+	return fetchIssueForm(httpClient, repo, templateName)
+}
+
+// fetchIssueForm downloads and parses issue form YAML files from the
+// repository's default branch via the REST contents API.
+func fetchIssueForm(httpClient *http.Client, repo ghrepo.Interface, templateName string) (*issueForm, bool) {
+	if templateName == "" {
+		return nil, false
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("repos/%s/contents/.github/ISSUE_TEMPLATE", ghrepo.FullName(repo))
+
+	var entries []struct {
+		Name        string `json:"name"`
+		DownloadURL string `json:"download_url"`
+	}
+	if err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &entries); err != nil {
+		return nil, false
+	}
+
+	for _, entry := range entries {
+		if entry.DownloadURL == "" || !(strings.HasSuffix(entry.Name, ".yml") || strings.HasSuffix(entry.Name, ".yaml")) {
+			continue
+		}
+		form, ok := downloadIssueForm(httpClient, entry.DownloadURL)
+		if ok && form.Name == templateName {
+			return form, true
+		}
+	}
+
+	return nil, false
+}
+
+func downloadIssueForm(httpClient *http.Client, url string) (*issueForm, bool) {
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return nil, false
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, false
+	}
+
+	contents, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, false
+	}
+
+	var form issueForm
+	if err := yaml.Unmarshal(contents, &form); err != nil || len(form.Body) == 0 {
+		return nil, false
+	}
+	return &form, true
+}
+
+// parseFormFields turns a list of `id=value` strings, as supplied via repeated
+// `--field` flags, into a lookup map.
+func parseFormFields(raw []string) (map[string]string, error) {
+	fields := make(map[string]string, len(raw))
+	for _, f := range raw {
+		id, value, ok := strings.Cut(f, "=")
+		if !ok || id == "" {
+			return nil, fmt.Errorf("invalid field %q: must be in the format `id=value`", f)
+		}
+		fields[id] = value
+	}
+	return fields, nil
+}
+
+// validateFormFields checks supplied field values against the form schema: every
+// field must be recognized, required fields must be present, and dropdown values
+// must match one of the declared options.
+func validateFormFields(form *issueForm, fields map[string]string) error {
+	for id := range fields {
+		if _, ok := form.field(id); !ok {
+			return fmt.Errorf("%q is not a field of the %q issue form", id, form.Name)
+		}
+	}
+
+	for _, el := range form.fields() {
+		value := strings.TrimSpace(fields[el.ID])
+		if value == "" {
+			if el.required() {
+				return fmt.Errorf("missing required field %q (%s)", el.ID, el.label())
+			}
+			continue
+		}
+		if options := el.dropdownOptions(); options != nil && !stringSliceContains(options, value) {
+			return fmt.Errorf("invalid value %q for field %q: must be one of: %s", value, el.ID, strings.Join(options, ", "))
+		}
+		if options := el.checkboxOptions(); options != nil {
+			for _, selection := range splitSelections(value) {
+				if !stringSliceContains(options, selection) {
+					return fmt.Errorf("invalid selection %q for field %q: must be one of: %s", selection, el.ID, strings.Join(options, ", "))
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func stringSliceContains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// renderFormBody produces the markdown issue body GitHub generates from a
+// submitted form: each field becomes a heading followed by its value, with
+// checkboxes rendered as a GitHub-flavored Markdown task list.
+func renderFormBody(form *issueForm, fields map[string]string) string {
+	var b strings.Builder
+	for _, el := range form.fields() {
+		value := strings.TrimSpace(fields[el.ID])
+
+		var rendered string
+		if options := el.checkboxOptions(); options != nil {
+			selected := make(map[string]bool, len(options))
+			for _, s := range splitSelections(value) {
+				selected[s] = true
+			}
+			lines := make([]string, len(options))
+			for i, option := range options {
+				mark := " "
+				if selected[option] {
+					mark = "x"
+				}
+				lines[i] = fmt.Sprintf("- [%s] %s", mark, option)
+			}
+			rendered = strings.Join(lines, "\n")
+		} else if value != "" {
+			rendered = value
+		} else {
+			rendered = "_No response_"
+		}
+
+		fmt.Fprintf(&b, "### %s\n\n%s\n\n", el.label(), rendered)
+	}
+	return strings.TrimRight(b.String(), "\n") + "\n"
+}
+
+// surveyFormFields prompts for a value for each field of the form and returns
+// the collected answers.
+func surveyFormFields(p prShared.Prompt, form *issueForm) (map[string]string, error) {
+	fields := make(map[string]string, len(form.fields()))
+	for _, el := range form.fields() {
+		prompt := el.label()
+		if el.required() {
+			prompt += " (required)"
+		}
+
+		if options := el.dropdownOptions(); options != nil {
+			selected, err := p.Select(prompt, "", options)
+			if err != nil {
+				return nil, err
+			}
+			fields[el.ID] = options[selected]
+			continue
+		}
+
+		if options := el.checkboxOptions(); options != nil {
+			selected, err := p.MultiSelect(prompt, nil, options)
+			if err != nil {
+				return nil, err
+			}
+			chosen := make([]string, len(selected))
+			for i, idx := range selected {
+				chosen[i] = options[idx]
+			}
+			if len(chosen) == 0 && el.required() {
+				return nil, fmt.Errorf("%q is required", el.label())
+			}
+			fields[el.ID] = strings.Join(chosen, ",")
+			continue
+		}
+
+		answer, err := p.Input(prompt, "")
+		if err != nil {
+			return nil, err
+		}
+		if answer == "" && el.required() {
+			return nil, fmt.Errorf("%q is required", el.label())
+		}
+		fields[el.ID] = answer
+	}
+	return fields, nil
+}
@@ -0,0 +1,168 @@
+package create
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const testForm = `
+name: Bug report
+body:
+  - type: markdown
+    attributes:
+      value: Thanks for taking the time to fill this out!
+  - type: input
+    id: version
+    attributes:
+      label: Version
+    validations:
+      required: true
+  - type: dropdown
+    id: severity
+    attributes:
+      label: Severity
+      options:
+        - low
+        - high
+    validations:
+      required: false
+`
+
+func TestFindIssueForm(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ".github", "ISSUE_TEMPLATE"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".github", "ISSUE_TEMPLATE", "bug_report.yml"), []byte(testForm), 0600))
+
+	form, ok := findIssueForm(dir, "Bug report")
+	require.True(t, ok)
+	assert.Equal(t, []string{"version", "severity"}, func() []string {
+		var ids []string
+		for _, el := range form.fields() {
+			ids = append(ids, el.ID)
+		}
+		return ids
+	}())
+
+	_, ok = findIssueForm(dir, "Feature request")
+	assert.False(t, ok)
+
+	_, ok = findIssueForm(dir, "")
+	assert.False(t, ok)
+}
+
+func TestValidateFormFields(t *testing.T) {
+	var form issueForm
+	require.NoError(t, yaml.Unmarshal([]byte(testForm), &form))
+
+	tests := []struct {
+		name    string
+		fields  map[string]string
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			fields: map[string]string{"version": "1.0", "severity": "low"},
+		},
+		{
+			name:    "missing required field",
+			fields:  map[string]string{"severity": "low"},
+			wantErr: `missing required field "version" (Version)`,
+		},
+		{
+			name:    "unknown field",
+			fields:  map[string]string{"version": "1.0", "color": "red"},
+			wantErr: `"color" is not a field of the "Bug report" issue form`,
+		},
+		{
+			name:    "invalid dropdown value",
+			fields:  map[string]string{"version": "1.0", "severity": "medium"},
+			wantErr: `invalid value "medium" for field "severity": must be one of: low, high`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateFormFields(&form, tt.fields)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRenderFormBody(t *testing.T) {
+	var form issueForm
+	require.NoError(t, yaml.Unmarshal([]byte(testForm), &form))
+
+	body := renderFormBody(&form, map[string]string{"version": "1.0"})
+	assert.Equal(t, "### Version\n\n1.0\n\n### Severity\n\n_No response_\n", body)
+}
+
+const testFormWithCheckboxes = `
+name: Bug report
+body:
+  - type: checkboxes
+    id: checks
+    attributes:
+      label: Checks
+      options:
+        - label: I searched for existing issues
+        - label: I can reproduce this reliably
+    validations:
+      required: true
+`
+
+func TestValidateAndRenderCheckboxes(t *testing.T) {
+	var form issueForm
+	require.NoError(t, yaml.Unmarshal([]byte(testFormWithCheckboxes), &form))
+
+	require.NoError(t, validateFormFields(&form, map[string]string{"checks": "I searched for existing issues"}))
+
+	err := validateFormFields(&form, map[string]string{"checks": "not a real option"})
+	assert.EqualError(t, err, `invalid selection "not a real option" for field "checks": must be one of: I searched for existing issues, I can reproduce this reliably`)
+
+	body := renderFormBody(&form, map[string]string{"checks": "I can reproduce this reliably"})
+	assert.Equal(t, "### Checks\n\n- [ ] I searched for existing issues\n- [x] I can reproduce this reliably\n", body)
+}
+
+func TestFetchIssueForm(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/contents/.github/ISSUE_TEMPLATE"),
+		httpmock.StringResponse(`[{"name": "bug_report.yml", "download_url": "https://raw.githubusercontent.com/OWNER/REPO/main/.github/ISSUE_TEMPLATE/bug_report.yml"}]`),
+	)
+	reg.Register(
+		httpmock.REST("GET", "OWNER/REPO/main/.github/ISSUE_TEMPLATE/bug_report.yml"),
+		httpmock.StringResponse(testForm),
+	)
+
+	httpClient := &http.Client{Transport: reg}
+	repo := ghrepo.New("OWNER", "REPO")
+
+	form, ok := fetchIssueForm(httpClient, repo, "Bug report")
+	require.True(t, ok)
+	assert.Equal(t, "Bug report", form.Name)
+
+	_, ok = fetchIssueForm(httpClient, repo, "Nonexistent")
+	assert.False(t, ok)
+}
+
+func TestParseFormFields(t *testing.T) {
+	fields, err := parseFormFields([]string{"version=1.0", "severity=low"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"version": "1.0", "severity": "low"}, fields)
+
+	_, err = parseFormFields([]string{"version"})
+	assert.EqualError(t, err, `invalid field "version": must be in the format `+"`id=value`")
+}
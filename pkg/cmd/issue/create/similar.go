@@ -0,0 +1,72 @@
+package create
+
+import (
+	"fmt"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/search"
+)
+
+// similarIssuesLimit caps how many similar issues are shown; this is meant to surface the most
+// obvious duplicates, not to be an exhaustive search.
+const similarIssuesLimit = 5
+
+// checkSimilarIssues searches for open issues whose title resembles title and, if any are found,
+// gives the user a chance to comment on one of them instead of continuing to create a new issue.
+// Any error from the search itself is treated as "nothing to report" rather than failing the
+// command, since this is a convenience on top of issue creation, not a requirement for it.
+func checkSimilarIssues(opts *CreateOptions, repo ghrepo.Interface, title string) error {
+	if title == "" || !opts.IO.CanPrompt() {
+		return nil
+	}
+
+	searcher, err := opts.Searcher()
+	if err != nil {
+		return nil
+	}
+
+	matches, err := searchSimilarIssues(searcher, repo, title, similarIssuesLimit)
+	if err != nil || len(matches) == 0 {
+		return nil
+	}
+
+	cs := opts.IO.ColorScheme()
+	fmt.Fprintf(opts.IO.ErrOut, "\n%s Found %d similar open issue(s):\n", cs.WarningIcon(), len(matches))
+	for _, m := range matches {
+		fmt.Fprintf(opts.IO.ErrOut, "  #%d  %s\n", m.Number, m.Title)
+	}
+	fmt.Fprintln(opts.IO.ErrOut)
+
+	options := make([]string, len(matches)+1)
+	options[0] = "Continue creating a new issue"
+	for i, m := range matches {
+		options[i+1] = fmt.Sprintf("Comment on #%d instead", m.Number)
+	}
+
+	selected, err := opts.Prompter.Select("What would you like to do?", options[0], options)
+	if err != nil || selected == 0 {
+		return nil
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "Comment on %s to continue the discussion there.\n", matches[selected-1].URL)
+	return cmdutil.SilentError
+}
+
+// searchSimilarIssues returns up to limit open issues in repo whose title resembles title.
+func searchSimilarIssues(searcher search.Searcher, repo ghrepo.Interface, title string, limit int) ([]search.Issue, error) {
+	result, err := searcher.Issues(search.Query{
+		Kind:     search.KindIssues,
+		Limit:    limit,
+		Keywords: []string{title},
+		Qualifiers: search.Qualifiers{
+			Type:  "issue",
+			State: "open",
+			Repo:  []string{ghrepo.FullName(repo)},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Items, nil
+}
@@ -0,0 +1,88 @@
+package create
+
+import (
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/prompter"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_searchSimilarIssues(t *testing.T) {
+	searcher := &search.SearcherMock{
+		IssuesFunc: func(query search.Query) (search.IssuesResult, error) {
+			assert.Equal(t, []string{"login bug"}, query.Keywords)
+			assert.Equal(t, []string{"OWNER/REPO"}, query.Qualifiers.Repo)
+			return search.IssuesResult{
+				Items: []search.Issue{
+					{Number: 1, Title: "login is broken"},
+				},
+			}, nil
+		},
+	}
+
+	matches, err := searchSimilarIssues(searcher, ghrepo.New("OWNER", "REPO"), "login bug", 5)
+	require.NoError(t, err)
+	assert.Equal(t, []search.Issue{{Number: 1, Title: "login is broken"}}, matches)
+}
+
+func Test_checkSimilarIssues(t *testing.T) {
+	tests := []struct {
+		name       string
+		selected   int
+		wantErr    string
+		wantErrOut string
+	}{
+		{
+			name:     "continues when no similar issue is selected",
+			selected: 0,
+		},
+		{
+			name:       "aborts when an existing issue is selected",
+			selected:   1,
+			wantErr:    cmdutil.SilentError.Error(),
+			wantErrOut: "Comment on https://github.com/OWNER/REPO/issues/1 to continue the discussion there.\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, stderr := iostreams.Test()
+			ios.SetStdinTTY(true)
+			ios.SetStdoutTTY(true)
+
+			pm := &prompter.PrompterMock{
+				SelectFunc: func(string, string, []string) (int, error) {
+					return tt.selected, nil
+				},
+			}
+
+			opts := &CreateOptions{
+				IO:       ios,
+				Prompter: pm,
+				Searcher: func() (search.Searcher, error) {
+					return &search.SearcherMock{
+						IssuesFunc: func(search.Query) (search.IssuesResult, error) {
+							return search.IssuesResult{
+								Items: []search.Issue{
+									{Number: 1, Title: "login is broken", URL: "https://github.com/OWNER/REPO/issues/1"},
+								},
+							}, nil
+						},
+					}, nil
+				},
+			}
+
+			err := checkSimilarIssues(opts, ghrepo.New("OWNER", "REPO"), "login bug")
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+			assert.Contains(t, stderr.String(), tt.wantErrOut)
+		})
+	}
+}
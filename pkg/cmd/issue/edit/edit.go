@@ -56,6 +56,10 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 
 			Editing issues' projects requires authorization with the %[1]sproject%[1]s scope.
 			To authorize, run %[1]sgh auth refresh -s project%[1]s.
+
+			A file passed to %[1]s--body-file%[1]s may begin with a YAML front matter block
+			(%[1]stitle%[1]s, %[1]slabels%[1]s, %[1]sassignees%[1]s, %[1]smilestone%[1]s, %[1]sprojects%[1]s) to add those
+			fields from the file as well; any matching flag takes precedence over the front matter.
 		`, "`"),
 		Example: heredoc.Doc(`
 			$ gh issue edit 23 --title "I found a bug" --body "Nothing works"
@@ -94,6 +98,30 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 						return err
 					}
 					opts.Editable.Body.Value = string(b)
+
+					if fm, rest, ok := prShared.ExtractFrontmatter(opts.Editable.Body.Value); ok {
+						opts.Editable.Body.Value = rest
+						if !flags.Changed("title") && fm.Title != "" {
+							opts.Editable.Title.Value = fm.Title
+							opts.Editable.Title.Edited = true
+						}
+						if !flags.Changed("add-assignee") && len(fm.Assignees) > 0 {
+							opts.Editable.Assignees.Add = fm.Assignees
+							opts.Editable.Assignees.Edited = true
+						}
+						if !flags.Changed("add-label") && len(fm.Labels) > 0 {
+							opts.Editable.Labels.Add = fm.Labels
+							opts.Editable.Labels.Edited = true
+						}
+						if !flags.Changed("add-project") && len(fm.Projects) > 0 {
+							opts.Editable.Projects.Add = fm.Projects
+							opts.Editable.Projects.Edited = true
+						}
+						if !flags.Changed("milestone") && !removeMilestone && fm.Milestone != "" {
+							opts.Editable.Milestone.Value = fm.Milestone
+							opts.Editable.Milestone.Edited = true
+						}
+					}
 				}
 			}
 
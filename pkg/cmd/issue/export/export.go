@@ -0,0 +1,138 @@
+package export
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	prShared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ExportOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Assignee     string
+	Labels       []string
+	State        string
+	Author       string
+	Mention      string
+	Milestone    string
+	Search       string
+	Since        string
+	Format       string
+	LimitResults int
+	Output       *cmdutil.OutputFileFlag
+}
+
+func NewCmdExport(f *cmdutil.Factory, runF func(*ExportOptions) error) *cobra.Command {
+	opts := &ExportOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export issues to NDJSON or CSV",
+		Long: heredoc.Doc(`
+			Export issues matching a filter to NDJSON or CSV, including their comments and
+			reactions, for data warehousing or migration tooling.
+
+			Use "--since" to only export issues updated on or after the given date
+			(YYYY-MM-DD), for incremental exports.
+		`),
+		Example: heredoc.Doc(`
+			$ gh issue export --state all > issues.ndjson
+			$ gh issue export --format csv --since 2024-01-01 > issues.csv
+		`),
+		Args: cmdutil.NoArgsQuoteReminder,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.LimitResults < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.LimitResults)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return exportRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Assignee, "assignee", "a", "", "Filter by assignee")
+	cmd.Flags().StringSliceVarP(&opts.Labels, "label", "l", nil, "Filter by label")
+	cmdutil.StringEnumFlag(cmd, &opts.State, "state", "s", "all", []string{"open", "closed", "all"}, "Filter by state")
+	cmd.Flags().StringVarP(&opts.Author, "author", "A", "", "Filter by author")
+	cmd.Flags().StringVar(&opts.Mention, "mention", "", "Filter by mention")
+	cmd.Flags().StringVarP(&opts.Milestone, "milestone", "m", "", "Filter by milestone number or title")
+	cmd.Flags().StringVarP(&opts.Search, "search", "S", "", "Filter issues with `query`")
+	cmd.Flags().StringVar(&opts.Since, "since", "", "Only export issues updated on or after `YYYY-MM-DD`")
+	cmdutil.StringEnumFlag(cmd, &opts.Format, "format", "", "ndjson", []string{"ndjson", "csv"}, "Output format")
+	cmd.Flags().IntVarP(&opts.LimitResults, "limit", "L", 10000, "Maximum number of issues to export")
+	opts.Output = cmdutil.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func exportRun(opts *ExportOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	filters := prShared.FilterOptions{
+		Entity:    "issue",
+		State:     opts.State,
+		Assignee:  opts.Assignee,
+		Labels:    opts.Labels,
+		Author:    opts.Author,
+		Mention:   opts.Mention,
+		Milestone: opts.Milestone,
+		Search:    opts.Search,
+		Fields:    issueExportFields,
+	}
+	if opts.Since != "" {
+		filters.Search = fmt.Sprintf("%s updated:>=%s", filters.Search, opts.Since)
+	}
+
+	issues, err := exportIssues(apiClient, baseRepo, filters, opts.LimitResults)
+	if err != nil {
+		return err
+	}
+
+	records := make([]prShared.ExportRecord, len(issues))
+	for i, issue := range issues {
+		records[i] = prShared.NewIssueExportRecord(issue)
+	}
+
+	out, err := opts.Output.Open(opts.IO.Out)
+	if err != nil {
+		return err
+	}
+
+	if opts.Format == "csv" {
+		err = prShared.WriteCSVExport(out, records)
+	} else {
+		err = prShared.WriteNDJSONExport(out, records)
+	}
+	if err != nil {
+		out.Discard()
+		return err
+	}
+
+	return out.Close()
+}
@@ -0,0 +1,98 @@
+package export
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/test"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func runCommand(rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	ios, _, stdout, stderr := iostreams.Test()
+
+	factory := &cmdutil.Factory{
+		IOStreams: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: rt}, nil
+		},
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	cmd := NewCmdExport(factory, nil)
+
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+
+	_, err = cmd.ExecuteC()
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+func TestIssueExport_ndjson(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueExport\b`),
+		httpmock.StringResponse(`{"data":{"repository":{"hasIssuesEnabled":true},"search":{"nodes":[
+			{"number":1,"title":"a bug","url":"https://github.com/OWNER/REPO/issues/1","state":"OPEN","author":{"login":"monalisa"}}
+		],"pageInfo":{"hasNextPage":false,"endCursor":""}}}}`))
+
+	output, err := runCommand(http, "")
+	if err != nil {
+		t.Fatalf("error running command `issue export`: %v", err)
+	}
+
+	assert.Equal(t, "", output.Stderr())
+	assert.JSONEq(t, `{"type":"issue","number":1,"title":"a bug","body":"","state":"OPEN","url":"https://github.com/OWNER/REPO/issues/1","author":"monalisa","createdAt":"0001-01-01T00:00:00Z","updatedAt":"0001-01-01T00:00:00Z","events":[{"type":"created","createdAt":"0001-01-01T00:00:00Z"}]}`, output.String())
+}
+
+func TestIssueExport_issuesDisabled(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueExport\b`),
+		httpmock.StringResponse(`{"data":{"repository":{"hasIssuesEnabled":false},"search":{"nodes":[],"pageInfo":{"hasNextPage":false,"endCursor":""}}}}`))
+
+	_, err := runCommand(http, "")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	assert.Equal(t, "the 'OWNER/REPO' repository has disabled issues", err.Error())
+}
+
+func TestIssueExport_invalidLimit(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	_, err := runCommand(http, "--limit 0")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	assert.Equal(t, "invalid limit: 0", err.Error())
+}
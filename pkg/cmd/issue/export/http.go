@@ -0,0 +1,91 @@
+package export
+
+import (
+	"fmt"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	prShared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
+)
+
+// issueExportFields requests everything NewIssueExportRecord needs, on top of the fields
+// `gh issue list` asks for by default.
+var issueExportFields = []string{
+	"number", "title", "url", "state", "body", "author", "createdAt", "updatedAt", "closedAt",
+	"labels", "assignees", "milestone", "comments", "reactionGroups",
+}
+
+// exportIssues fetches every issue matching filters, up to limit, always going through the
+// search API so that the full set of filters (including milestone by title) is supported
+// uniformly; `gh issue export` is a bulk, infrequent operation, so the lower search API rate
+// limit isn't a practical concern the way it would be for `gh issue list`.
+func exportIssues(client *api.Client, repo ghrepo.Interface, filters prShared.FilterOptions, limit int) ([]api.Issue, error) {
+	fragments := "fragment issue on Issue {" + api.IssueGraphQL(filters.Fields) + "}"
+	query := fragments +
+		`query IssueExport($repo: String!, $owner: String!, $type: SearchType!, $limit: Int, $after: String, $query: String!) {
+			repository(name: $repo, owner: $owner) {
+				hasIssuesEnabled
+			}
+			search(type: $type, first: $limit, after: $after, query: $query) {
+				nodes { ...issue }
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}`
+
+	type response struct {
+		Repository struct {
+			HasIssuesEnabled bool
+		}
+		Search struct {
+			Nodes    []api.Issue
+			PageInfo struct {
+				HasNextPage bool
+				EndCursor   string
+			}
+		}
+	}
+
+	filters.Repo = ghrepo.FullName(repo)
+	q := prShared.SearchQueryBuild(filters)
+
+	variables := map[string]interface{}{
+		"owner": repo.RepoOwner(),
+		"repo":  repo.RepoName(),
+		"type":  "ISSUE",
+		"query": q,
+	}
+
+	var issues []api.Issue
+	for {
+		variables["limit"] = min(limit-len(issues), 100)
+
+		var resp response
+		if err := client.GraphQL(repo.RepoHost(), query, variables, &resp); err != nil {
+			return nil, err
+		}
+		if !resp.Repository.HasIssuesEnabled {
+			return nil, fmt.Errorf("the '%s' repository has disabled issues", ghrepo.FullName(repo))
+		}
+
+		issues = append(issues, resp.Search.Nodes...)
+		if len(issues) >= limit || !resp.Search.PageInfo.HasNextPage {
+			break
+		}
+		variables["after"] = resp.Search.PageInfo.EndCursor
+	}
+
+	if len(issues) > limit {
+		issues = issues[:limit]
+	}
+	return issues, nil
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
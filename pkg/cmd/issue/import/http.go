@@ -0,0 +1,193 @@
+package cmdimport
+
+import (
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	prShared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
+)
+
+// importMarkerPrefix tags a created issue's body with the external id it was imported from, so
+// that re-running the import against the same file is idempotent.
+const importMarkerPrefix = "<!-- gh-import-id: "
+
+// importExternalID returns the identifier used to detect whether record was already imported. A
+// record's url survives a round trip through `gh issue export`, so it's preferred when present;
+// freshly authored records fall back to a hash of their title and body.
+func importExternalID(record prShared.ExportRecord) string {
+	if record.URL != "" {
+		return record.URL
+	}
+	sum := sha256.Sum256([]byte(record.Title + "\x00" + record.Body))
+	return hex.EncodeToString(sum[:])
+}
+
+func importMarker(externalID string) string {
+	return fmt.Sprintf("%s%s -->", importMarkerPrefix, externalID)
+}
+
+// issueExists reports whether the repository already has an issue tagged with externalID's
+// import marker.
+func issueExists(client *api.Client, repo ghrepo.Interface, externalID string) (bool, error) {
+	query := `query ImportIssueExists($q: String!) {
+		search(type: ISSUE, first: 1, query: $q) {
+			issueCount
+		}
+	}`
+	variables := map[string]interface{}{
+		"q": fmt.Sprintf("repo:%s in:body %q", ghrepo.FullName(repo), importMarker(externalID)),
+	}
+
+	var resp struct {
+		Search struct {
+			IssueCount int
+		}
+	}
+	if err := client.GraphQL(repo.RepoHost(), query, variables, &resp); err != nil {
+		return false, err
+	}
+	return resp.Search.IssueCount > 0, nil
+}
+
+// createImportedIssue creates an issue from record, tagging its body with externalID's import
+// marker, and returns the created issue's URL.
+func createImportedIssue(client *api.Client, baseRepo ghrepo.Interface, repo *api.Repository, record prShared.ExportRecord, externalID string) (string, error) {
+	body := record.Body
+	if body != "" {
+		body += "\n\n"
+	}
+	body += importMarker(externalID)
+
+	tb := prShared.IssueMetadataState{
+		Type:       prShared.IssueMetadata,
+		Title:      record.Title,
+		Body:       body,
+		Labels:     record.Labels,
+		Assignees:  record.Assignees,
+		Milestones: milestoneSlice(record.Milestone),
+	}
+
+	params := map[string]interface{}{
+		"title": tb.Title,
+		"body":  tb.Body,
+	}
+	if err := prShared.AddMetadataToIssueParams(client, baseRepo, params, &tb); err != nil {
+		return "", err
+	}
+
+	issue, err := api.IssueCreate(client, repo, params)
+	if err != nil {
+		return "", err
+	}
+	return issue.URL, nil
+}
+
+func milestoneSlice(milestone string) []string {
+	if milestone == "" {
+		return nil
+	}
+	return []string{milestone}
+}
+
+func readImportRecords(filename, format string) ([]prShared.ExportRecord, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if format == "csv" {
+		return readCSVImportRecords(f)
+	}
+	return readNDJSONImportRecords(f)
+}
+
+func readNDJSONImportRecords(f *os.File) ([]prShared.ExportRecord, error) {
+	var records []prShared.ExportRecord
+	dec := json.NewDecoder(f)
+	for dec.More() {
+		var record prShared.ExportRecord
+		if err := dec.Decode(&record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// readCSVImportRecords parses rows written by `gh issue export --format csv`, keyed by the
+// header row so that column order doesn't matter.
+func readCSVImportRecords(f *os.File) ([]prShared.ExportRecord, error) {
+	cr := csv.NewReader(f)
+	header, err := cr.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, nil
+		}
+		return nil, err
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[name] = i
+	}
+	column := func(row []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(row) {
+			return ""
+		}
+		return row[i]
+	}
+
+	var records []prShared.ExportRecord
+	for {
+		row, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var labels, assignees []string
+		if v := column(row, "labels"); v != "" {
+			if err := json.Unmarshal([]byte(v), &labels); err != nil {
+				return nil, fmt.Errorf("invalid labels column: %w", err)
+			}
+		}
+		if v := column(row, "assignees"); v != "" {
+			if err := json.Unmarshal([]byte(v), &assignees); err != nil {
+				return nil, fmt.Errorf("invalid assignees column: %w", err)
+			}
+		}
+
+		records = append(records, prShared.ExportRecord{
+			Title:     column(row, "title"),
+			Body:      column(row, "body"),
+			URL:       column(row, "url"),
+			Labels:    labels,
+			Assignees: assignees,
+			Milestone: column(row, "milestone"),
+		})
+	}
+	return records, nil
+}
+
+func writeImportRecords(filename, format string, records []prShared.ExportRecord) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if format == "csv" {
+		return prShared.WriteCSVExport(f, records)
+	}
+	return prShared.WriteNDJSONExport(f, records)
+}
@@ -0,0 +1,169 @@
+package cmdimport
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	prShared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ImportOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	InputFile    string
+	Format       string
+	Delay        time.Duration
+	DryRun       bool
+	FailuresFile string
+}
+
+func NewCmdImport(f *cmdutil.Factory, runF func(*ImportOptions) error) *cobra.Command {
+	opts := &ImportOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Import issues from NDJSON or CSV",
+		Long: heredoc.Docf(`
+			Create issues from a file produced by %[1]sgh issue export%[1]s (or matching its
+			schema): a %[1]stitle%[1]s, %[1]sbody%[1]s, %[1]slabels%[1]s, %[1]sassignees%[1]s, and
+			%[1]smilestone%[1]s per record, in NDJSON or CSV.
+
+			Each created issue's body is tagged with a hidden marker derived from the record's
+			%[1]surl%[1]s field, or from its title and body when no %[1]surl%[1]s is present.
+			Re-running the import against the same file skips records that were already created.
+
+			Issues are created one at a time, waiting %[1]s--delay%[1]s between requests to stay
+			under secondary rate limits. Records that fail to import are reported at the end and,
+			if %[1]s--failures-file%[1]s is set, written back out in the input's format so the
+			failed records can be retried on their own.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ gh issue export --state all > issues.ndjson
+			$ gh issue import issues.ndjson --failures-file retry.ndjson
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+			opts.InputFile = args[0]
+
+			if opts.Format == "" {
+				format, err := detectFormat(opts.InputFile)
+				if err != nil {
+					return cmdutil.FlagErrorf("%s", err)
+				}
+				opts.Format = format
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return importRun(opts)
+		},
+	}
+
+	cmdutil.StringEnumFlag(cmd, &opts.Format, "format", "", "", []string{"ndjson", "csv"}, "Input format (default: detected from the file extension)")
+	cmd.Flags().DurationVar(&opts.Delay, "delay", 500*time.Millisecond, "Wait `duration` between creating issues")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Report what would be imported without creating any issues")
+	cmd.Flags().StringVar(&opts.FailuresFile, "failures-file", "", "Write records that failed to import to `file`, in the input's format, for retrying")
+
+	return cmd
+}
+
+func detectFormat(filename string) (string, error) {
+	switch {
+	case strings.HasSuffix(filename, ".csv"):
+		return "csv", nil
+	case strings.HasSuffix(filename, ".ndjson"), strings.HasSuffix(filename, ".jsonl"):
+		return "ndjson", nil
+	default:
+		return "", fmt.Errorf("could not detect the format of %q; use `--format` to specify it explicitly", filename)
+	}
+}
+
+func importRun(opts *ImportOptions) error {
+	records, err := readImportRecords(opts.InputFile, opts.Format)
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	repo, err := api.GitHubRepo(apiClient, baseRepo)
+	if err != nil {
+		return err
+	}
+	if !repo.HasIssuesEnabled {
+		return fmt.Errorf("the '%s' repository has disabled issues", ghrepo.FullName(baseRepo))
+	}
+
+	var created, skipped int
+	var failures []prShared.ExportRecord
+
+	for i, record := range records {
+		if i > 0 {
+			time.Sleep(opts.Delay)
+		}
+
+		externalID := importExternalID(record)
+		exists, err := issueExists(apiClient, baseRepo, externalID)
+		if err != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "could not check for an existing issue for %q: %v\n", record.Title, err)
+			failures = append(failures, record)
+			continue
+		}
+		if exists {
+			skipped++
+			continue
+		}
+
+		if opts.DryRun {
+			created++
+			continue
+		}
+
+		url, err := createImportedIssue(apiClient, baseRepo, repo, record, externalID)
+		if err != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "could not create issue %q: %v\n", record.Title, err)
+			failures = append(failures, record)
+			continue
+		}
+
+		fmt.Fprintln(opts.IO.Out, url)
+		created++
+	}
+
+	if len(failures) > 0 && opts.FailuresFile != "" {
+		if err := writeImportRecords(opts.FailuresFile, opts.Format, failures); err != nil {
+			return fmt.Errorf("could not write failures file: %w", err)
+		}
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "%d created, %d skipped (already imported), %d failed\n", created, skipped, len(failures))
+	if len(failures) > 0 {
+		return cmdutil.SilentError
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package cmdimport
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/test"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runCommand(rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	ios, _, stdout, stderr := iostreams.Test()
+
+	factory := &cmdutil.Factory{
+		IOStreams: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: rt}, nil
+		},
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	cmd := NewCmdImport(factory, nil)
+
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+
+	_, err = cmd.ExecuteC()
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+func TestImportRun_createsNewIssue(t *testing.T) {
+	dir := t.TempDir()
+	inputFile := filepath.Join(dir, "issues.ndjson")
+	require.NoError(t, os.WriteFile(inputFile, []byte(`{"title":"a bug","body":"it broke"}`+"\n"), 0600))
+
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`{"data":{"repository":{"id":"REPOID","hasIssuesEnabled":true}}}`))
+	http.Register(
+		httpmock.GraphQL(`query ImportIssueExists\b`),
+		httpmock.StringResponse(`{"data":{"search":{"issueCount":0}}}`))
+	http.Register(
+		httpmock.GraphQL(`mutation IssueCreate\b`),
+		httpmock.StringResponse(`{"data":{"createIssue":{"issue":{"id":"ISSUEID","url":"https://github.com/OWNER/REPO/issues/1"}}}}`))
+
+	output, err := runCommand(http, inputFile+" --delay 0")
+	if err != nil {
+		t.Fatalf("error running command `issue import`: %v", err)
+	}
+
+	assert.Contains(t, output.String(), "https://github.com/OWNER/REPO/issues/1")
+	assert.Contains(t, output.Stderr(), "1 created, 0 skipped (already imported), 0 failed")
+}
+
+func TestImportRun_skipsAlreadyImported(t *testing.T) {
+	dir := t.TempDir()
+	inputFile := filepath.Join(dir, "issues.ndjson")
+	require.NoError(t, os.WriteFile(inputFile, []byte(`{"title":"a bug","body":"it broke","url":"https://github.com/OWNER/REPO/issues/9"}`+"\n"), 0600))
+
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`{"data":{"repository":{"id":"REPOID","hasIssuesEnabled":true}}}`))
+	http.Register(
+		httpmock.GraphQL(`query ImportIssueExists\b`),
+		httpmock.StringResponse(`{"data":{"search":{"issueCount":1}}}`))
+
+	output, err := runCommand(http, inputFile+" --delay 0")
+	if err != nil {
+		t.Fatalf("error running command `issue import`: %v", err)
+	}
+
+	assert.Contains(t, output.Stderr(), "0 created, 1 skipped (already imported), 0 failed")
+}
+
+func TestDetectFormat(t *testing.T) {
+	format, err := detectFormat("issues.csv")
+	require.NoError(t, err)
+	assert.Equal(t, "csv", format)
+
+	format, err = detectFormat("issues.ndjson")
+	require.NoError(t, err)
+	assert.Equal(t, "ndjson", format)
+
+	_, err = detectFormat("issues.txt")
+	assert.Error(t, err)
+}
@@ -8,10 +8,13 @@ import (
 	cmdDelete "github.com/cli/cli/v2/pkg/cmd/issue/delete"
 	cmdDevelop "github.com/cli/cli/v2/pkg/cmd/issue/develop"
 	cmdEdit "github.com/cli/cli/v2/pkg/cmd/issue/edit"
+	cmdExport "github.com/cli/cli/v2/pkg/cmd/issue/export"
+	cmdImport "github.com/cli/cli/v2/pkg/cmd/issue/import"
 	cmdList "github.com/cli/cli/v2/pkg/cmd/issue/list"
 	cmdLock "github.com/cli/cli/v2/pkg/cmd/issue/lock"
 	cmdPin "github.com/cli/cli/v2/pkg/cmd/issue/pin"
 	cmdReopen "github.com/cli/cli/v2/pkg/cmd/issue/reopen"
+	cmdSearchSimilar "github.com/cli/cli/v2/pkg/cmd/issue/searchsimilar"
 	cmdStatus "github.com/cli/cli/v2/pkg/cmd/issue/status"
 	cmdTransfer "github.com/cli/cli/v2/pkg/cmd/issue/transfer"
 	cmdUnpin "github.com/cli/cli/v2/pkg/cmd/issue/unpin"
@@ -29,6 +32,7 @@ func NewCmdIssue(f *cmdutil.Factory) *cobra.Command {
 			$ gh issue list
 			$ gh issue create --label bug
 			$ gh issue view 123 --web
+			$ gh issue search-similar "login button is broken"
 		`),
 		Annotations: map[string]string{
 			"help:arguments": heredoc.Doc(`
@@ -46,6 +50,7 @@ func NewCmdIssue(f *cmdutil.Factory) *cobra.Command {
 		cmdList.NewCmdList(f, nil),
 		cmdCreate.NewCmdCreate(f, nil),
 		cmdStatus.NewCmdStatus(f, nil),
+		cmdSearchSimilar.NewCmdSearchSimilar(f, nil),
 	)
 
 	cmdutil.AddGroup(cmd, "Targeted commands",
@@ -61,6 +66,8 @@ func NewCmdIssue(f *cmdutil.Factory) *cobra.Command {
 		cmdUnpin.NewCmdUnpin(f, nil),
 		cmdTransfer.NewCmdTransfer(f, nil),
 		cmdDelete.NewCmdDelete(f, nil),
+		cmdExport.NewCmdExport(f, nil),
+		cmdImport.NewCmdImport(f, nil),
 	)
 
 	return cmd
@@ -13,6 +13,8 @@ import (
 	fd "github.com/cli/cli/v2/internal/featuredetection"
 	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/prompter"
+	"github.com/cli/cli/v2/internal/tableprinter"
 	"github.com/cli/cli/v2/internal/text"
 	issueShared "github.com/cli/cli/v2/pkg/cmd/issue/shared"
 	prShared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
@@ -27,18 +29,23 @@ type ListOptions struct {
 	Config     func() (gh.Config, error)
 	IO         *iostreams.IOStreams
 	BaseRepo   func() (ghrepo.Interface, error)
+	BaseRepos  func() ([]ghrepo.Interface, error)
 	Browser    browser.Browser
-
-	Assignee     string
-	Labels       []string
-	State        string
-	LimitResults int
-	Author       string
-	Mention      string
-	Milestone    string
-	Search       string
-	WebMode      bool
-	Exporter     cmdutil.Exporter
+	Prompter   prompter.Prompter
+
+	Assignee          string
+	Labels            []string
+	State             string
+	LimitResults      int
+	Author            string
+	Mention           string
+	Milestone         string
+	MilestoneProgress bool
+	Search            string
+	WebMode           bool
+	Interactive       bool
+	Exporter          cmdutil.Exporter
+	FailFast          bool
 
 	Detector fd.Detector
 	Now      func() time.Time
@@ -50,6 +57,7 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 		HttpClient: f.HttpClient,
 		Config:     f.Config,
 		Browser:    f.Browser,
+		Prompter:   f.Prompter,
 		Now:        time.Now,
 	}
 
@@ -63,6 +71,10 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 
 			The search query syntax is documented here:
 			<https://docs.github.com/en/search-github/searching-on-github/searching-issues-and-pull-requests>
+
+			Pass ` + "`-R/--repo`" + ` more than once, or give it a comma-separated list, to list and
+			merge issues from several repositories at once. The merged table adds a REPO column,
+			and ` + "`--web`" + `, ` + "`--interactive`" + `, and ` + "`--json`" + ` aren't supported in that mode.
 		`),
 		Example: heredoc.Doc(`
 			$ gh issue list --label "bug" --label "help wanted"
@@ -70,12 +82,15 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 			$ gh issue list --assignee "@me"
 			$ gh issue list --milestone "The big 1.0"
 			$ gh issue list --search "error no:assignee sort:created-asc"
+			$ gh issue list -R cli/cli -R cli/go-gh
 		`),
 		Aliases: []string{"ls"},
 		Args:    cmdutil.NoArgsQuoteReminder,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// support `-R, --repo` override
 			opts.BaseRepo = f.BaseRepo
+			repoOverrides, _ := cmd.Flags().GetStringArray("repo")
+			opts.BaseRepos = cmdutil.BaseReposOverride(f, repoOverrides)
 
 			if opts.LimitResults < 1 {
 				return cmdutil.FlagErrorf("invalid limit: %v", opts.LimitResults)
@@ -89,6 +104,14 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 				opts.Author = fmt.Sprintf("app/%s", appAuthor)
 			}
 
+			if opts.Interactive && opts.WebMode {
+				return cmdutil.FlagErrorf("specify only one of `--interactive` or `--web`")
+			}
+
+			if opts.Interactive && opts.Exporter != nil {
+				return cmdutil.FlagErrorf("specify only one of `--interactive` or `--json`")
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -97,6 +120,7 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	}
 
 	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "List issues in the web browser")
+	cmd.Flags().BoolVarP(&opts.Interactive, "interactive", "i", false, "Filter and select an issue to view in the browser")
 	cmd.Flags().StringVarP(&opts.Assignee, "assignee", "a", "", "Filter by assignee")
 	cmd.Flags().StringSliceVarP(&opts.Labels, "label", "l", nil, "Filter by label")
 	cmdutil.StringEnumFlag(cmd, &opts.State, "state", "s", "open", []string{"open", "closed", "all"}, "Filter by state")
@@ -105,8 +129,10 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	cmd.Flags().StringVar(&appAuthor, "app", "", "Filter by GitHub App author")
 	cmd.Flags().StringVar(&opts.Mention, "mention", "", "Filter by mention")
 	cmd.Flags().StringVarP(&opts.Milestone, "milestone", "m", "", "Filter by milestone number or title")
+	cmd.Flags().BoolVar(&opts.MilestoneProgress, "milestone-progress", false, "Show each issue's milestone progress")
 	cmd.Flags().StringVarP(&opts.Search, "search", "S", "", "Search issues with `query`")
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, api.IssueFields)
+	cmdutil.AddFailFastFlag(cmd, &opts.FailFast)
 
 	return cmd
 }
@@ -118,6 +144,7 @@ var defaultFields = []string{
 	"state",
 	"updatedAt",
 	"labels",
+	"isPinned",
 }
 
 func listRun(opts *ListOptions) error {
@@ -126,11 +153,27 @@ func listRun(opts *ListOptions) error {
 		return err
 	}
 
-	baseRepo, err := opts.BaseRepo()
+	if opts.BaseRepos == nil {
+		opts.BaseRepos = func() ([]ghrepo.Interface, error) {
+			repo, err := opts.BaseRepo()
+			if err != nil {
+				return nil, err
+			}
+			return []ghrepo.Interface{repo}, nil
+		}
+	}
+
+	baseRepos, err := opts.BaseRepos()
 	if err != nil {
 		return err
 	}
 
+	if len(baseRepos) > 1 {
+		return listRunMultiRepo(opts, httpClient, baseRepos)
+	}
+
+	baseRepo := baseRepos[0]
+
 	issueState := strings.ToLower(opts.State)
 	if issueState == "open" && prShared.QueryHasStateClause(opts.Search) {
 		issueState = ""
@@ -146,7 +189,10 @@ func listRun(opts *ListOptions) error {
 	}
 	fields := defaultFields
 	if features.StateReason {
-		fields = append(defaultFields, "stateReason")
+		fields = append(fields, "stateReason")
+	}
+	if opts.MilestoneProgress {
+		fields = append(fields, "milestoneProgress")
 	}
 
 	filterOptions := prShared.FilterOptions{
@@ -180,12 +226,16 @@ func listRun(opts *ListOptions) error {
 		filterOptions.Fields = opts.Exporter.Fields()
 	}
 
-	listResult, err := issueList(httpClient, baseRepo, filterOptions, opts.LimitResults)
+	listResult, err := issueList(httpClient, baseRepo, filterOptions, opts.LimitResults, opts.IO, opts.Prompter)
 	if err != nil {
 		return err
 	}
 	if len(listResult.Issues) == 0 && opts.Exporter == nil {
-		return prShared.ListNoResults(ghrepo.FullName(baseRepo), "issue", !filterOptions.IsDefault())
+		return prShared.ListNoResults(ghrepo.FullName(baseRepo), "issue", !filterOptions.IsDefault(), opts.FailFast)
+	}
+
+	if opts.Interactive {
+		return interactiveOpen(opts, listResult.Issues)
 	}
 
 	if err := opts.IO.StartPager(); err == nil {
@@ -206,21 +256,148 @@ func listRun(opts *ListOptions) error {
 		fmt.Fprintf(opts.IO.Out, "\n%s\n\n", title)
 	}
 
-	issueShared.PrintIssues(opts.IO, opts.Now(), "", len(listResult.Issues), listResult.Issues)
+	issueShared.PrintIssues(opts.IO, opts.Now(), "", len(listResult.Issues), listResult.Issues, opts.MilestoneProgress)
 
 	return nil
 }
 
-func issueList(client *http.Client, repo ghrepo.Interface, filters prShared.FilterOptions, limit int) (*api.IssuesAndTotalCount, error) {
+// listRunMultiRepo fetches and merges issues across more than one repository, given via repeated
+// or comma-separated `-R/--repo` flags, adding a REPO column so the results stay attributable.
+func listRunMultiRepo(opts *ListOptions, httpClient *http.Client, baseRepos []ghrepo.Interface) error {
+	if opts.WebMode {
+		return cmdutil.FlagErrorf("multiple `--repo` values aren't supported with `--web`")
+	}
+	if opts.Interactive {
+		return cmdutil.FlagErrorf("multiple `--repo` values aren't supported with `--interactive`")
+	}
+	if opts.Exporter != nil {
+		return cmdutil.FlagErrorf("multiple `--repo` values aren't supported with `--json`")
+	}
+
+	issueState := strings.ToLower(opts.State)
+	if issueState == "open" && prShared.QueryHasStateClause(opts.Search) {
+		issueState = ""
+	}
+
+	filterOptions := prShared.FilterOptions{
+		Entity:    "issue",
+		State:     issueState,
+		Assignee:  opts.Assignee,
+		Labels:    opts.Labels,
+		Author:    opts.Author,
+		Mention:   opts.Mention,
+		Milestone: opts.Milestone,
+		Search:    opts.Search,
+		Fields:    defaultFields,
+	}
+
+	type repoIssue struct {
+		repo  ghrepo.Interface
+		issue api.Issue
+	}
+
+	var merged []repoIssue
+	for _, baseRepo := range baseRepos {
+		listResult, err := issueList(httpClient, baseRepo, filterOptions, opts.LimitResults, opts.IO, opts.Prompter)
+		if err != nil {
+			return fmt.Errorf("failed to list issues for %s: %w", ghrepo.FullName(baseRepo), err)
+		}
+		for _, issue := range listResult.Issues {
+			merged = append(merged, repoIssue{repo: baseRepo, issue: issue})
+		}
+	}
+
+	if len(merged) == 0 {
+		return prShared.ListNoResults(strings.Join(repoNames(baseRepos), ", "), "issue", !filterOptions.IsDefault(), opts.FailFast)
+	}
+
+	if err := opts.IO.StartPager(); err == nil {
+		defer opts.IO.StopPager()
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+	}
+
+	isTerminal := opts.IO.IsStdoutTTY()
+	cs := opts.IO.ColorScheme()
+	headers := []string{"REPO", "ID"}
+	if !isTerminal {
+		headers = append(headers, "STATE")
+	}
+	headers = append(headers, "TITLE", "LABELS", "UPDATED")
+
+	table := tableprinter.New(opts.IO, tableprinter.WithHeader(headers...))
+	for _, ri := range merged {
+		table.AddField(ghrepo.FullName(ri.repo), tableprinter.WithColor(cs.Gray))
+		issueNum := strconv.Itoa(ri.issue.Number)
+		if isTerminal {
+			issueNum = "#" + issueNum
+		}
+		table.AddField(issueNum, tableprinter.WithColor(cs.ColorFromString(prShared.ColorForIssueState(ri.issue))))
+		if !isTerminal {
+			table.AddField(ri.issue.State)
+		}
+		table.AddField(text.RemoveExcessiveWhitespace(ri.issue.Title))
+		table.AddField(labelList(ri.issue))
+		table.AddTimeField(opts.Now(), ri.issue.UpdatedAt, cs.Gray)
+		table.EndRow()
+	}
+
+	return table.Render()
+}
+
+// labelList joins an issue's labels for the multi-repo table, without per-label color since that
+// table already colors the REPO and ID columns.
+func labelList(issue api.Issue) string {
+	labelNames := make([]string, 0, len(issue.Labels.Nodes))
+	for _, label := range issue.Labels.Nodes {
+		labelNames = append(labelNames, label.Name)
+	}
+	return strings.Join(labelNames, ", ")
+}
+
+func repoNames(repos []ghrepo.Interface) []string {
+	names := make([]string, len(repos))
+	for i, repo := range repos {
+		names[i] = ghrepo.FullName(repo)
+	}
+	return names
+}
+
+// interactiveOpen lets the user filter and pick a single issue from labels,
+// then opens it in the web browser, so they don't have to copy a number out
+// of the list first.
+func interactiveOpen(opts *ListOptions, issues []api.Issue) error {
+	labels := make([]string, len(issues))
+	for i, issue := range issues {
+		labels[i] = fmt.Sprintf("#%d %s", issue.Number, issue.Title)
+	}
+
+	index, err := cmdutil.SelectFromList(opts.IO, opts.Prompter, "Select an issue", labels)
+	if err != nil {
+		return err
+	}
+
+	return opts.Browser.Browse(issues[index].URL)
+}
+
+func issueList(client *http.Client, repo ghrepo.Interface, filters prShared.FilterOptions, limit int, io *iostreams.IOStreams, p prompter.Prompter) (*api.IssuesAndTotalCount, error) {
 	apiClient := api.NewClientFromHTTP(client)
 
 	if filters.Search != "" || len(filters.Labels) > 0 || filters.Milestone != "" {
-		if milestoneNumber, err := strconv.ParseInt(filters.Milestone, 10, 32); err == nil {
-			milestone, err := milestoneByNumber(client, repo, int32(milestoneNumber))
-			if err != nil {
-				return nil, err
+		if filters.Milestone != "" {
+			if milestoneNumber, err := strconv.ParseInt(filters.Milestone, 10, 32); err == nil {
+				milestone, err := milestoneByNumber(client, repo, int32(milestoneNumber))
+				if err != nil {
+					return nil, err
+				}
+				filters.Milestone = milestone.Title
+			} else {
+				milestone, err := milestoneByFuzzyTitle(apiClient, repo, io, p, filters.Milestone)
+				if err != nil {
+					return nil, err
+				}
+				filters.Milestone = milestone.Title
 			}
-			filters.Milestone = milestone.Title
 		}
 
 		return searchIssues(apiClient, repo, filters, limit)
@@ -267,3 +444,44 @@ func milestoneByNumber(client *http.Client, repo ghrepo.Interface, number int32)
 
 	return query.Repository.Milestone, nil
 }
+
+// milestoneByFuzzyTitle resolves a partial, case-insensitive milestone title to a single
+// milestone, prompting the user to disambiguate when the title matches more than one.
+func milestoneByFuzzyTitle(client *api.Client, repo ghrepo.Interface, io *iostreams.IOStreams, p prompter.Prompter, query string) (*api.RepoMilestone, error) {
+	milestones, err := api.RepoMilestones(client, repo, "all")
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []api.RepoMilestone
+	for _, m := range milestones {
+		if strings.EqualFold(m.Title, query) {
+			return &m, nil
+		}
+		if strings.Contains(strings.ToLower(m.Title), strings.ToLower(query)) {
+			matches = append(matches, m)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no milestone found with title matching %q", query)
+	case 1:
+		return &matches[0], nil
+	}
+
+	titles := make([]string, len(matches))
+	for i, m := range matches {
+		titles[i] = m.Title
+	}
+
+	if !io.CanPrompt() {
+		return nil, fmt.Errorf("multiple milestones match %q, specify one of: %s", query, strings.Join(titles, ", "))
+	}
+
+	selected, err := p.Select("Which milestone did you mean?", "", titles)
+	if err != nil {
+		return nil, err
+	}
+	return &matches[selected], nil
+}
@@ -85,7 +85,7 @@ func TestIssueList_nontty(t *testing.T) {
 	assert.Equal(t, "", output.Stderr())
 	//nolint:staticcheck // prefer exact matchers over ExpectLines
 	test.ExpectLines(t, output.String(),
-		`1[\t]+number won[\t]+label[\t]+\d+`,
+		`1[\t]+number won \(Pinned\)[\t]+label[\t]+\d+`,
 		`2[\t]+number too[\t]+label[\t]+\d+`,
 		`4[\t]+number fore[\t]+label[\t]+\d+`)
 }
@@ -107,10 +107,10 @@ func TestIssueList_tty(t *testing.T) {
 
 		Showing 3 of 3 open issues in OWNER/REPO
 
-		ID  TITLE        LABELS  UPDATED
-		#1  number won   label   about 1 day ago
-		#2  number too   label   about 1 month ago
-		#4  number fore  label   about 2 years ago
+		ID  TITLE                LABELS  UPDATED
+		#1  number won (Pinned)  label   about 1 day ago
+		#2  number too           label   about 1 month ago
+		#4  number fore          label   about 2 years ago
 	`), output.String())
 	assert.Equal(t, ``, output.Stderr())
 }
@@ -160,6 +160,48 @@ func TestIssueList_tty_withAppFlag(t *testing.T) {
 	assert.Equal(t, "", output.Stderr())
 }
 
+func TestIssueList_tty_withMilestoneProgress(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query RepositoryMilestoneList\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "milestones": {
+			"nodes": [ { "title": "1.0", "id": "MI_10" } ],
+			"pageInfo": { "hasNextPage": false }
+		} } } }
+		`))
+	http.Register(
+		httpmock.GraphQL(`query IssueSearch\b`),
+		httpmock.StringResponse(`
+		{ "data": {
+			"repository": { "hasIssuesEnabled": true },
+			"search": {
+				"issueCount": 1,
+				"nodes": [
+					{
+						"number": 1,
+						"title": "number won",
+						"milestone": {
+							"title": "1.0",
+							"closedIssues": { "totalCount": 2 },
+							"allIssues": { "totalCount": 4 }
+						}
+					}
+				]
+			}
+		} }`))
+
+	output, err := runCommand(http, true, `--milestone "1.0" --milestone-progress`)
+	if err != nil {
+		t.Errorf("error running command `issue list`: %v", err)
+	}
+
+	assert.Contains(t, output.String(), "1.0 [#####-----] 2/4")
+	assert.Equal(t, ``, output.Stderr())
+}
+
 func TestIssueList_withInvalidLimitFlag(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -228,6 +270,88 @@ func TestIssueList_web(t *testing.T) {
 	browser.Verify(t, "https://github.com/OWNER/REPO/issues?q=assignee%3Apeter+author%3Ajohn+label%3Abug+label%3Adocs+mentions%3Afrank+milestone%3Av1.1+type%3Aissue")
 }
 
+// runMultiRepoCommand is like runCommand, but also enables the `-R/--repo` override flag the way
+// the real `issue` parent command does, since `list` on its own never registers it.
+func runMultiRepoCommand(rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	ios, _, stdout, stderr := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	ios.SetStdinTTY(true)
+	ios.SetStderrTTY(true)
+
+	factory := &cmdutil.Factory{
+		IOStreams: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: rt}, nil
+		},
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	cmd := NewCmdList(factory, func(opts *ListOptions) error {
+		opts.Now = func() time.Time {
+			return time.Date(2022, time.August, 25, 23, 50, 0, 0, time.UTC)
+		}
+		return listRun(opts)
+	})
+	cmdutil.EnableRepoOverride(cmd, factory)
+
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+
+	_, err = cmd.ExecuteC()
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+func TestIssueList_multiRepo(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueList\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": { "hasIssuesEnabled": true, "issues": { "totalCount": 1,
+				"nodes": [ { "number": 1, "title": "from owner/one", "updatedAt": "2022-08-24T22:01:12Z",
+					"labels": { "nodes": [], "totalCount": 0 } } ] } } } }`))
+	http.Register(
+		httpmock.GraphQL(`query IssueList\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": { "hasIssuesEnabled": true, "issues": { "totalCount": 1,
+				"nodes": [ { "number": 9, "title": "from owner/two", "updatedAt": "2022-07-20T19:01:12Z",
+					"labels": { "nodes": [], "totalCount": 0 } } ] } } } }`))
+
+	output, err := runMultiRepoCommand(http, `-R owner/one -R owner/two`)
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		REPO       ID  TITLE           LABELS  UPDATED
+		owner/one  #1  from owner/one          about 1 day ago
+		owner/two  #9  from owner/two          about 1 month ago
+	`), output.String())
+	assert.Equal(t, "", output.Stderr())
+}
+
+func TestIssueList_multiRepo_webModeUnsupported(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	_, err := runMultiRepoCommand(http, `-R owner/one -R owner/two --web`)
+	assert.EqualError(t, err, "multiple `--repo` values aren't supported with `--web`")
+}
+
 func Test_issueList(t *testing.T) {
 	type args struct {
 		repo    ghrepo.Interface
@@ -318,6 +442,14 @@ func Test_issueList(t *testing.T) {
 				},
 			},
 			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryMilestoneList\b`),
+					httpmock.StringResponse(`
+					{ "data": { "repository": { "milestones": {
+						"nodes": [ { "title": "1.x", "id": "MI_1x" } ],
+						"pageInfo": { "hasNextPage": false }
+					} } } }
+					`))
 				reg.Register(
 					httpmock.GraphQL(`query IssueSearch\b`),
 					httpmock.GraphQLQuery(`
@@ -338,6 +470,72 @@ func Test_issueList(t *testing.T) {
 					}))
 			},
 		},
+		{
+			name: "milestone by fuzzy title",
+			args: args{
+				limit: 30,
+				repo:  ghrepo.New("OWNER", "REPO"),
+				filters: prShared.FilterOptions{
+					Entity:    "issue",
+					State:     "open",
+					Milestone: "big",
+				},
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryMilestoneList\b`),
+					httpmock.StringResponse(`
+					{ "data": { "repository": { "milestones": {
+						"nodes": [ { "title": "The big 1.0", "id": "MI_big" } ],
+						"pageInfo": { "hasNextPage": false }
+					} } } }
+					`))
+				reg.Register(
+					httpmock.GraphQL(`query IssueSearch\b`),
+					httpmock.GraphQLQuery(`
+					{ "data": {
+						"repository": { "hasIssuesEnabled": true },
+						"search": {
+							"issueCount": 0,
+							"nodes": []
+						}
+					} }`, func(_ string, params map[string]interface{}) {
+						assert.Equal(t, map[string]interface{}{
+							"owner": "OWNER",
+							"repo":  "REPO",
+							"limit": float64(30),
+							"query": "milestone:\"The big 1.0\" repo:OWNER/REPO state:open type:issue",
+							"type":  "ISSUE",
+						}, params)
+					}))
+			},
+		},
+		{
+			name: "milestone by ambiguous fuzzy title",
+			args: args{
+				limit: 30,
+				repo:  ghrepo.New("OWNER", "REPO"),
+				filters: prShared.FilterOptions{
+					Entity:    "issue",
+					State:     "open",
+					Milestone: "1.",
+				},
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryMilestoneList\b`),
+					httpmock.StringResponse(`
+					{ "data": { "repository": { "milestones": {
+						"nodes": [
+							{ "title": "1.0", "id": "MI_10" },
+							{ "title": "1.1", "id": "MI_11" }
+						],
+						"pageInfo": { "hasNextPage": false }
+					} } } }
+					`))
+			},
+			wantErr: true,
+		},
 		{
 			name: "@me syntax",
 			args: args{
@@ -450,7 +648,8 @@ func Test_issueList(t *testing.T) {
 				tt.httpStubs(httpreg)
 			}
 			client := &http.Client{Transport: httpreg}
-			_, err := issueList(client, tt.args.repo, tt.args.filters, tt.args.limit)
+			ios, _, _, _ := iostreams.Test()
+			_, err := issueList(client, tt.args.repo, tt.args.filters, tt.args.limit, ios, nil)
 			if tt.wantErr {
 				assert.Error(t, err)
 			} else {
@@ -505,6 +704,7 @@ func TestIssueList_withProjectItems(t *testing.T) {
 		}))
 
 	client := &http.Client{Transport: reg}
+	ios, _, _, _ := iostreams.Test()
 	issuesAndTotalCount, err := issueList(
 		client,
 		ghrepo.New("OWNER", "REPO"),
@@ -512,6 +712,8 @@ func TestIssueList_withProjectItems(t *testing.T) {
 			Entity: "issue",
 		},
 		30,
+		ios,
+		nil,
 	)
 
 	require.NoError(t, err)
@@ -579,6 +781,7 @@ func TestIssueList_Search_withProjectItems(t *testing.T) {
 		}))
 
 	client := &http.Client{Transport: reg}
+	ios, _, _, _ := iostreams.Test()
 	issuesAndTotalCount, err := issueList(
 		client,
 		ghrepo.New("OWNER", "REPO"),
@@ -587,6 +790,8 @@ func TestIssueList_Search_withProjectItems(t *testing.T) {
 			Search: "just used to force the search API branch",
 		},
 		30,
+		ios,
+		nil,
 	)
 
 	require.NoError(t, err)
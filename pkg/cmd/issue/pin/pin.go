@@ -38,6 +38,10 @@ func NewCmdPin(f *cmdutil.Factory, runF func(*PinOptions) error) *cobra.Command
 			Pin an issue to a repository.
 
 			The issue can be specified by issue number or URL.
+
+			A repository can have a maximum of three pinned issues. Pinned issues are shown on the
+			repository's issues page in the order they were pinned; GitHub doesn't offer an API to
+			reorder them, so to change the order, unpin and re-pin the issues in the order you want.
 		`),
 		Example: heredoc.Doc(`
 			# Pin an issue to the current repository
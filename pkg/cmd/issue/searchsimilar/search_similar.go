@@ -0,0 +1,109 @@
+package searchsimilar
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/internal/text"
+	searchshared "github.com/cli/cli/v2/pkg/cmd/search/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/search"
+	"github.com/spf13/cobra"
+)
+
+type SearchSimilarOptions struct {
+	IO       *iostreams.IOStreams
+	BaseRepo func() (ghrepo.Interface, error)
+	Searcher func() (search.Searcher, error)
+
+	Text         string
+	LimitResults int
+}
+
+func NewCmdSearchSimilar(f *cmdutil.Factory, runF func(*SearchSimilarOptions) error) *cobra.Command {
+	opts := &SearchSimilarOptions{
+		IO: f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "search-similar <text>",
+		Short: "Search open issues for ones similar to the given text",
+		Long: heredoc.Doc(`
+			Search the current repository's open issues for ones whose title resembles the
+			given text, to help spot likely duplicates before filing a new issue.
+		`),
+		Example: heredoc.Doc(`
+			$ gh issue search-similar "login button is broken on mobile"
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+			opts.Text = args[0]
+
+			opts.Searcher = func() (search.Searcher, error) { return searchshared.Searcher(f) }
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return searchSimilarRun(opts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&opts.LimitResults, "limit", "L", 10, "Maximum number of issues to fetch")
+
+	return cmd
+}
+
+func searchSimilarRun(opts *SearchSimilarOptions) error {
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	searcher, err := opts.Searcher()
+	if err != nil {
+		return err
+	}
+
+	query := search.Query{
+		Kind:     search.KindIssues,
+		Limit:    opts.LimitResults,
+		Keywords: []string{opts.Text},
+		Qualifiers: search.Qualifiers{
+			Type:  "issue",
+			State: "open",
+			Repo:  []string{ghrepo.FullName(baseRepo)},
+		},
+	}
+
+	io := opts.IO
+	io.StartProgressIndicator()
+	result, err := searcher.Issues(query)
+	io.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if len(result.Items) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("no open issues in %s matched %q", ghrepo.FullName(baseRepo), opts.Text))
+	}
+
+	cs := io.ColorScheme()
+	tp := tableprinter.New(io, tableprinter.WithHeader("ID", "Title"))
+	for _, issue := range result.Items {
+		issueNum := strconv.Itoa(issue.Number)
+		if tp.IsTTY() {
+			issueNum = "#" + issueNum
+		}
+		tp.AddField(issueNum, tableprinter.WithColor(cs.Green))
+		tp.AddField(text.RemoveExcessiveWhitespace(issue.Title))
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
@@ -0,0 +1,133 @@
+package searchsimilar
+
+import (
+	"io"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/search"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdSearchSimilar(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantText string
+		wantLim  int
+		wantErr  bool
+	}{
+		{
+			name:     "text argument",
+			input:    `"login bug"`,
+			wantText: "login bug",
+			wantLim:  10,
+		},
+		{
+			name:     "limit flag",
+			input:    `"login bug" -L 5`,
+			wantText: "login bug",
+			wantLim:  5,
+		},
+		{
+			name:    "no argument",
+			input:   "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{IOStreams: ios}
+
+			var gotOpts *SearchSimilarOptions
+			cmd := NewCmdSearchSimilar(f, func(opts *SearchSimilarOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			argv, err := shlex.Split(tt.input)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantText, gotOpts.Text)
+			assert.Equal(t, tt.wantLim, gotOpts.LimitResults)
+		})
+	}
+}
+
+func Test_searchSimilarRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		tty        bool
+		searcher   *search.SearcherMock
+		wantErr    string
+		wantStdout string
+	}{
+		{
+			name: "displays results tty",
+			tty:  true,
+			searcher: &search.SearcherMock{
+				IssuesFunc: func(query search.Query) (search.IssuesResult, error) {
+					assert.Equal(t, []string{"login bug"}, query.Keywords)
+					assert.Equal(t, []string{"OWNER/REPO"}, query.Qualifiers.Repo)
+					assert.Equal(t, "open", query.Qualifiers.State)
+					return search.IssuesResult{
+						Items: []search.Issue{
+							{Number: 1, Title: "login button is broken"},
+							{Number: 2, Title: "login fails on mobile"},
+						},
+					}, nil
+				},
+			},
+			wantStdout: "ID  TITLE\n#1  login button is broken\n#2  login fails on mobile\n",
+		},
+		{
+			name: "no matches",
+			tty:  true,
+			searcher: &search.SearcherMock{
+				IssuesFunc: func(query search.Query) (search.IssuesResult, error) {
+					return search.IssuesResult{}, nil
+				},
+			},
+			wantErr: `no open issues in OWNER/REPO matched "login bug"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, stdout, _ := iostreams.Test()
+			ios.SetStdoutTTY(tt.tty)
+
+			opts := &SearchSimilarOptions{
+				IO: ios,
+				BaseRepo: func() (ghrepo.Interface, error) {
+					return ghrepo.New("OWNER", "REPO"), nil
+				},
+				Searcher:     func() (search.Searcher, error) { return tt.searcher, nil },
+				Text:         "login bug",
+				LimitResults: 10,
+			}
+
+			err := searchSimilarRun(opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				var nr cmdutil.NoResultsError
+				assert.ErrorAs(t, err, &nr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
@@ -13,7 +13,7 @@ import (
 	"github.com/cli/cli/v2/pkg/iostreams"
 )
 
-func PrintIssues(io *iostreams.IOStreams, now time.Time, prefix string, totalCount int, issues []api.Issue) {
+func PrintIssues(io *iostreams.IOStreams, now time.Time, prefix string, totalCount int, issues []api.Issue, showMilestoneProgress bool) {
 	cs := io.ColorScheme()
 	isTTY := io.IsStdoutTTY()
 	headers := []string{"ID"}
@@ -23,8 +23,11 @@ func PrintIssues(io *iostreams.IOStreams, now time.Time, prefix string, totalCou
 	headers = append(headers,
 		"TITLE",
 		"LABELS",
-		"UPDATED",
 	)
+	if showMilestoneProgress {
+		headers = append(headers, "MILESTONE")
+	}
+	headers = append(headers, "UPDATED")
 	table := tableprinter.New(io, tableprinter.WithHeader(headers...))
 	for _, issue := range issues {
 		issueNum := strconv.Itoa(issue.Number)
@@ -36,8 +39,15 @@ func PrintIssues(io *iostreams.IOStreams, now time.Time, prefix string, totalCou
 		if !isTTY {
 			table.AddField(issue.State)
 		}
-		table.AddField(text.RemoveExcessiveWhitespace(issue.Title))
+		title := text.RemoveExcessiveWhitespace(issue.Title)
+		if issue.IsPinned {
+			title = fmt.Sprintf("%s %s", title, cs.Gray("(Pinned)"))
+		}
+		table.AddField(title)
 		table.AddField(issueLabelList(&issue, cs, isTTY))
+		if showMilestoneProgress {
+			table.AddField(milestoneProgress(issue.Milestone))
+		}
 		table.AddTimeField(now, issue.UpdatedAt, cs.Gray)
 		table.EndRow()
 	}
@@ -48,6 +58,26 @@ func PrintIssues(io *iostreams.IOStreams, now time.Time, prefix string, totalCou
 	}
 }
 
+// milestoneProgress renders a milestone's title alongside a closed/total issue count and a
+// bar showing the fraction of issues closed, e.g. "1.0 [###-------] 3/10".
+func milestoneProgress(milestone *api.Milestone) string {
+	if milestone == nil {
+		return ""
+	}
+	closed, total, ok := milestone.Progress()
+	if !ok {
+		return milestone.Title
+	}
+	if total == 0 {
+		return fmt.Sprintf("%s [----------] 0/0", milestone.Title)
+	}
+
+	const barWidth = 10
+	filled := closed * barWidth / total
+	bar := strings.Repeat("#", filled) + strings.Repeat("-", barWidth-filled)
+	return fmt.Sprintf("%s [%s] %d/%d", milestone.Title, bar, closed, total)
+}
+
 func issueLabelList(issue *api.Issue, cs *iostreams.ColorScheme, colorize bool) string {
 	if len(issue.Labels.Nodes) == 0 {
 		return ""
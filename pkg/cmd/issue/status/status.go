@@ -22,6 +22,7 @@ type StatusOptions struct {
 	BaseRepo   func() (ghrepo.Interface, error)
 
 	Exporter cmdutil.Exporter
+	Refresh  bool
 }
 
 func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Command {
@@ -46,6 +47,7 @@ func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Co
 		},
 	}
 
+	cmd.Flags().BoolVar(&opts.Refresh, "refresh", false, "Bypass the local cache and fetch fresh data")
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, api.IssueFields)
 
 	return cmd
@@ -72,6 +74,11 @@ func statusRun(opts *StatusOptions) error {
 		return err
 	}
 
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
 	currentUser, err := api.CurrentLoginName(apiClient, baseRepo.RepoHost())
 	if err != nil {
 		return err
@@ -84,7 +91,12 @@ func statusRun(opts *StatusOptions) error {
 	if opts.Exporter != nil {
 		options.Fields = opts.Exporter.Fields()
 	}
-	issuePayload, err := api.IssueStatus(apiClient, baseRepo, options)
+
+	statusClient := apiClient
+	if !opts.Refresh {
+		statusClient = api.NewClientFromHTTP(api.NewConditionalCacheHTTPClient(httpClient, cfg.CacheDir()))
+	}
+	issuePayload, err := api.IssueStatus(statusClient, baseRepo, options)
 	if err != nil {
 		return err
 	}
@@ -112,7 +124,7 @@ func statusRun(opts *StatusOptions) error {
 
 	prShared.PrintHeader(opts.IO, "Issues assigned to you")
 	if issuePayload.Assigned.TotalCount > 0 {
-		issueShared.PrintIssues(opts.IO, time.Now(), "  ", issuePayload.Assigned.TotalCount, issuePayload.Assigned.Issues)
+		issueShared.PrintIssues(opts.IO, time.Now(), "  ", issuePayload.Assigned.TotalCount, issuePayload.Assigned.Issues, false)
 	} else {
 		message := "  There are no issues assigned to you"
 		prShared.PrintMessage(opts.IO, message)
@@ -121,7 +133,7 @@ func statusRun(opts *StatusOptions) error {
 
 	prShared.PrintHeader(opts.IO, "Issues mentioning you")
 	if issuePayload.Mentioned.TotalCount > 0 {
-		issueShared.PrintIssues(opts.IO, time.Now(), "  ", issuePayload.Mentioned.TotalCount, issuePayload.Mentioned.Issues)
+		issueShared.PrintIssues(opts.IO, time.Now(), "  ", issuePayload.Mentioned.TotalCount, issuePayload.Mentioned.Issues, false)
 	} else {
 		prShared.PrintMessage(opts.IO, "  There are no issues mentioning you")
 	}
@@ -129,7 +141,7 @@ func statusRun(opts *StatusOptions) error {
 
 	prShared.PrintHeader(opts.IO, "Issues opened by you")
 	if issuePayload.Authored.TotalCount > 0 {
-		issueShared.PrintIssues(opts.IO, time.Now(), "  ", issuePayload.Authored.TotalCount, issuePayload.Authored.Issues)
+		issueShared.PrintIssues(opts.IO, time.Now(), "  ", issuePayload.Authored.TotalCount, issuePayload.Authored.Issues, false)
 	} else {
 		prShared.PrintMessage(opts.IO, "  There are no issues opened by you")
 	}
@@ -78,7 +78,7 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 
 var defaultFields = []string{
 	"number", "url", "state", "createdAt", "title", "body", "author", "milestone",
-	"assignees", "labels", "projectCards", "reactionGroups", "lastComment", "stateReason",
+	"assignees", "labels", "projectCards", "reactionGroups", "lastComment", "stateReason", "isPinned",
 }
 
 func viewRun(opts *ViewOptions) error {
@@ -181,6 +181,7 @@ func printRawIssuePreview(out io.Writer, issue *api.Issue) error {
 	}
 	fmt.Fprintf(out, "milestone:\t%s\n", milestoneTitle)
 	fmt.Fprintf(out, "number:\t%d\n", issue.Number)
+	fmt.Fprintf(out, "pinned:\t%t\n", issue.IsPinned)
 	fmt.Fprintln(out, "--")
 	fmt.Fprintln(out, issue.Body)
 	return nil
@@ -191,7 +192,11 @@ func printHumanIssuePreview(opts *ViewOptions, baseRepo ghrepo.Interface, issue
 	cs := opts.IO.ColorScheme()
 
 	// Header (Title and State)
-	fmt.Fprintf(out, "%s %s#%d\n", cs.Bold(issue.Title), ghrepo.FullName(baseRepo), issue.Number)
+	title := cs.Bold(issue.Title)
+	if issue.IsPinned {
+		title = fmt.Sprintf("%s %s", title, cs.Gray("(Pinned)"))
+	}
+	fmt.Fprintf(out, "%s %s#%d\n", title, ghrepo.FullName(baseRepo), issue.Number)
 	fmt.Fprintf(out,
 		"%s • %s opened %s • %s\n",
 		issueStateTitleWithColor(cs, issue),
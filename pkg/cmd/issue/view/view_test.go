@@ -26,6 +26,7 @@ func TestJSONFields(t *testing.T) {
 	jsonfieldstest.ExpectCommandToSupportJSONFields(t, NewCmdView, []string{
 		"assignees",
 		"author",
+		"authorAssociation",
 		"body",
 		"closed",
 		"comments",
@@ -43,6 +44,7 @@ func TestJSONFields(t *testing.T) {
 		"updatedAt",
 		"url",
 		"isPinned",
+		"milestoneProgress",
 		"stateReason",
 	})
 }
@@ -0,0 +1,28 @@
+package lfs
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	locksCmd "github.com/cli/cli/v2/pkg/cmd/lfs/locks"
+	usageCmd "github.com/cli/cli/v2/pkg/cmd/lfs/usage"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdLFS(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lfs <command>",
+		Short: "Manage Git LFS files",
+		Long:  "Work with Git LFS file locks and storage usage.",
+		Example: heredoc.Doc(`
+			$ gh lfs locks list
+			$ gh lfs usage
+		`),
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(locksCmd.NewCmdLocks(f))
+	cmd.AddCommand(usageCmd.NewCmdUsage(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,45 @@
+package create
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_createRun(t *testing.T) {
+	ios, _, stdout, stderr := iostreams.Test()
+	ios.SetStdinTTY(false)
+	ios.SetStdoutTTY(true)
+	ios.SetStderrTTY(true)
+
+	tr := httpmock.Registry{}
+	defer tr.Verify(t)
+
+	tr.Register(
+		httpmock.REST("POST", "OWNER/REPO.git/info/lfs/locks"),
+		httpmock.JSONResponse(map[string]interface{}{
+			"lock": map[string]interface{}{
+				"id":   "123",
+				"path": "assets/character.psd",
+			},
+		}))
+
+	err := createRun(&CreateOptions{
+		IO: ios,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: &tr}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Path: "assets/character.psd",
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "", stderr.String())
+	assert.Equal(t, "✓ Locked assets/character.psd (id 123)\n", stdout.String())
+}
@@ -0,0 +1,70 @@
+package delete
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_deleteRun(t *testing.T) {
+	ios, _, stdout, stderr := iostreams.Test()
+	ios.SetStdinTTY(false)
+	ios.SetStdoutTTY(true)
+	ios.SetStderrTTY(true)
+
+	tr := httpmock.Registry{}
+	defer tr.Verify(t)
+
+	tr.Register(
+		httpmock.REST("POST", "OWNER/REPO.git/info/lfs/locks/123/unlock"),
+		httpmock.RESTPayload(200, `{"lock": {"id": "123", "path": "assets/character.psd"}}`, func(payload map[string]interface{}) {
+			assert.Equal(t, false, payload["force"])
+		}))
+
+	err := deleteRun(&DeleteOptions{
+		IO: ios,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: &tr}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		ID: "123",
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "", stderr.String())
+	assert.Equal(t, "✓ Deleted lock on assets/character.psd\n", stdout.String())
+}
+
+func Test_deleteRun_force(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	tr := httpmock.Registry{}
+	defer tr.Verify(t)
+
+	tr.Register(
+		httpmock.REST("POST", "OWNER/REPO.git/info/lfs/locks/123/unlock"),
+		httpmock.RESTPayload(200, `{"lock": {"id": "123", "path": "assets/character.psd"}}`, func(payload map[string]interface{}) {
+			assert.Equal(t, true, payload["force"])
+		}))
+
+	err := deleteRun(&DeleteOptions{
+		IO: ios,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: &tr}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		ID:    "123",
+		Force: true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Deleted lock on assets/character.psd\n", stdout.String())
+}
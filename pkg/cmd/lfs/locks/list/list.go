@@ -0,0 +1,105 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmd/lfs/locks/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+	Exporter   cmdutil.Exporter
+
+	Path  string
+	Limit int
+	Now   time.Time
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+		Now:        time.Now(),
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List Git LFS file locks",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Example: heredoc.Doc(`
+			$ gh lfs locks list
+			$ gh lfs locks list --path assets/character.psd
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.Limit < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Path, "path", "p", "", "Filter locks by file `path`")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of locks to fetch")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.LockFields)
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	locks, err := shared.ListLocks(httpClient, repo, opts.Path, opts.Limit)
+	if err != nil {
+		return err
+	}
+
+	if len(locks) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("no Git LFS locks found in %s", ghrepo.FullName(repo)))
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, locks)
+	}
+
+	t := tableprinter.New(opts.IO, tableprinter.WithHeader("ID", "PATH", "OWNER", "LOCKED AT"))
+	cs := opts.IO.ColorScheme()
+
+	for _, lock := range locks {
+		t.AddField(lock.ID)
+		t.AddField(lock.Path)
+		owner := ""
+		if lock.Owner != nil {
+			owner = lock.Owner.Name
+		}
+		t.AddField(owner)
+		t.AddTimeField(opts.Now, lock.LockedAt, cs.Gray)
+		t.EndRow()
+	}
+
+	return t.Render()
+}
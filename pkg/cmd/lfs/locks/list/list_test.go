@@ -0,0 +1,75 @@
+package list
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_listRun(t *testing.T) {
+	ios, _, stdout, stderr := iostreams.Test()
+	ios.SetStdinTTY(false)
+	ios.SetStdoutTTY(true)
+	ios.SetStderrTTY(true)
+
+	tr := httpmock.Registry{}
+	defer tr.Verify(t)
+
+	tr.Register(
+		httpmock.REST("GET", "OWNER/REPO.git/info/lfs/locks"),
+		httpmock.JSONResponse(map[string]interface{}{
+			"locks": []map[string]interface{}{
+				{
+					"id":       "1",
+					"path":     "assets/character.psd",
+					"lockedAt": "2023-01-01T00:00:00Z",
+					"owner":    map[string]string{"name": "monalisa"},
+				},
+			},
+		}))
+
+	err := listRun(&ListOptions{
+		IO: ios,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: &tr}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Limit: 30,
+		Now:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "", stderr.String())
+	assert.Equal(t, "ID  PATH                  OWNER     LOCKED AT\n1   assets/character.psd  monalisa  about 1 year ago\n", stdout.String())
+}
+
+func Test_listRun_noLocks(t *testing.T) {
+	ios, _, _, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	tr := httpmock.Registry{}
+	defer tr.Verify(t)
+
+	tr.Register(
+		httpmock.REST("GET", "OWNER/REPO.git/info/lfs/locks"),
+		httpmock.JSONResponse(map[string]interface{}{"locks": []map[string]interface{}{}}))
+
+	err := listRun(&ListOptions{
+		IO: ios,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: &tr}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Limit: 30,
+	})
+	assert.EqualError(t, err, "no Git LFS locks found in OWNER/REPO")
+}
@@ -0,0 +1,29 @@
+package locks
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdCreate "github.com/cli/cli/v2/pkg/cmd/lfs/locks/create"
+	cmdDelete "github.com/cli/cli/v2/pkg/cmd/lfs/locks/delete"
+	cmdList "github.com/cli/cli/v2/pkg/cmd/lfs/locks/list"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdLocks(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "locks <command>",
+		Short: "Manage Git LFS file locks",
+		Long:  "Work with Git LFS file locks on a repository.",
+		Example: heredoc.Doc(`
+			$ gh lfs locks list
+			$ gh lfs locks create path/to/file.psd
+			$ gh lfs locks delete 123
+		`),
+	}
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdCreate.NewCmdCreate(f, nil))
+	cmd.AddCommand(cmdDelete.NewCmdDelete(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,148 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+)
+
+// lfsJSON is the content type used by the Git LFS File Locking API
+// (https://github.com/git-lfs/git-lfs/blob/main/docs/api/locking.md), which GitHub implements
+// under the repository's .git URL rather than under the REST API host.
+const lfsJSON = "application/vnd.git-lfs+json"
+
+// Lock is a Git LFS file lock.
+type Lock struct {
+	ID       string     `json:"id"`
+	Path     string     `json:"path"`
+	LockedAt time.Time  `json:"lockedAt"`
+	Owner    *LockOwner `json:"owner"`
+}
+
+type LockOwner struct {
+	Name string `json:"name"`
+}
+
+var LockFields = []string{
+	"id",
+	"path",
+	"lockedAt",
+	"owner",
+}
+
+func (l *Lock) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(l, fields)
+}
+
+func locksURL(repo ghrepo.Interface) string {
+	return fmt.Sprintf("%s%s.git/info/lfs/locks", ghinstance.HostPrefix(repo.RepoHost()), ghrepo.FullName(repo))
+}
+
+// ListLocks returns up to limit locks on repo, optionally filtered to a single path. Pass a
+// non-positive limit to fetch every lock.
+func ListLocks(httpClient *http.Client, repo ghrepo.Interface, path string, limit int) ([]Lock, error) {
+	baseURL := locksURL(repo)
+	if path != "" {
+		baseURL += "?path=" + url.QueryEscape(path)
+	}
+
+	var locks []Lock
+	cursor := ""
+	for {
+		reqURL := baseURL
+		if cursor != "" {
+			sep := "?"
+			if path != "" {
+				sep = "&"
+			}
+			reqURL += sep + "cursor=" + url.QueryEscape(cursor)
+		}
+
+		var page struct {
+			Locks      []Lock `json:"locks"`
+			NextCursor string `json:"next_cursor"`
+		}
+		if err := lfsRequest(httpClient, "GET", reqURL, nil, &page); err != nil {
+			return nil, err
+		}
+
+		locks = append(locks, page.Locks...)
+		if limit > 0 && len(locks) >= limit {
+			return locks[:limit], nil
+		}
+		if page.NextCursor == "" {
+			return locks, nil
+		}
+		cursor = page.NextCursor
+	}
+}
+
+// CreateLock locks path on repo on behalf of the authenticated user.
+func CreateLock(httpClient *http.Client, repo ghrepo.Interface, path string) (*Lock, error) {
+	body, err := json.Marshal(map[string]string{"path": path})
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Lock Lock `json:"lock"`
+	}
+	if err := lfsRequest(httpClient, "POST", locksURL(repo), bytes.NewReader(body), &result); err != nil {
+		return nil, err
+	}
+	return &result.Lock, nil
+}
+
+// DeleteLock releases the lock identified by id. Force releases a lock held by another user.
+func DeleteLock(httpClient *http.Client, repo ghrepo.Interface, id string, force bool) (*Lock, error) {
+	body, err := json.Marshal(map[string]bool{"force": force})
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Lock Lock `json:"lock"`
+	}
+	unlockURL := fmt.Sprintf("%s/%s/unlock", locksURL(repo), id)
+	if err := lfsRequest(httpClient, "POST", unlockURL, bytes.NewReader(body), &result); err != nil {
+		return nil, err
+	}
+	return &result.Lock, nil
+}
+
+func lfsRequest(httpClient *http.Client, method, reqURL string, body io.Reader, data interface{}) error {
+	req, err := http.NewRequest(method, reqURL, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", lfsJSON)
+	if body != nil {
+		req.Header.Set("Content-Type", lfsJSON)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return api.HandleHTTPError(resp)
+	}
+
+	if data == nil {
+		_, err = io.Copy(io.Discard, resp.Body)
+		return err
+	}
+
+	return json.NewDecoder(resp.Body).Decode(data)
+}
@@ -0,0 +1,121 @@
+package usage
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type UsageOptions struct {
+	IO         *iostreams.IOStreams
+	HttpClient func() (*http.Client, error)
+	Config     func() (gh.Config, error)
+	Exporter   cmdutil.Exporter
+
+	Org string
+}
+
+// SharedStorageUsage is the estimated Git LFS and Packages storage usage for an account,
+// as returned by the GitHub shared storage billing API.
+type SharedStorageUsage struct {
+	DaysLeftInBillingCycle       int     `json:"days_left_in_billing_cycle"`
+	EstimatedPaidStorageForMonth float64 `json:"estimated_paid_storage_for_month"`
+	EstimatedStorageForMonth     float64 `json:"estimated_storage_for_month"`
+}
+
+var usageFields = []string{
+	"daysLeftInBillingCycle",
+	"estimatedPaidStorageForMonth",
+	"estimatedStorageForMonth",
+}
+
+func (u *SharedStorageUsage) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(u, fields)
+}
+
+func NewCmdUsage(f *cmdutil.Factory, runF func(*UsageOptions) error) *cobra.Command {
+	opts := &UsageOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "usage",
+		Short: "View Git LFS storage usage",
+		Long: heredoc.Doc(`
+			View estimated Git LFS (and Packages, which share the same storage quota) usage for
+			the current month against the account's included storage.
+
+			Without --org, usage is shown for the currently authenticated user.
+		`),
+		Args: cobra.NoArgs,
+		Example: heredoc.Doc(`
+			$ gh lfs usage
+			$ gh lfs usage --org my-org
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+			return usageRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Org, "org", "", "Show usage for an organization instead of the current user")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, usageFields)
+
+	return cmd
+}
+
+func usageRun(opts *UsageOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, _ := cfg.Authentication().DefaultHost()
+
+	account := opts.Org
+	var path string
+	if account != "" {
+		path = fmt.Sprintf("orgs/%s/settings/billing/shared-storage", account)
+	} else {
+		account, err = api.CurrentLoginName(client, host)
+		if err != nil {
+			return fmt.Errorf("failed to determine current user: %w", err)
+		}
+		path = fmt.Sprintf("users/%s/settings/billing/shared-storage", account)
+	}
+
+	var usage SharedStorageUsage
+	if err := client.REST(host, "GET", path, nil, &usage); err != nil {
+		return fmt.Errorf("failed to get storage usage: %w", err)
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, &usage)
+	}
+
+	cs := opts.IO.ColorScheme()
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "Estimated Git LFS and Packages storage for %s this billing cycle:\n\n", cs.Bold(account))
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Estimated storage used: %.2f GB\n", usage.EstimatedStorageForMonth)
+	fmt.Fprintf(opts.IO.Out, "Estimated paid storage: %.2f GB\n", usage.EstimatedPaidStorageForMonth)
+	fmt.Fprintf(opts.IO.Out, "Days left in billing cycle: %d\n", usage.DaysLeftInBillingCycle)
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package usage
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_usageRun_user(t *testing.T) {
+	ios, _, stdout, stderr := iostreams.Test()
+	ios.SetStdinTTY(false)
+	ios.SetStdoutTTY(true)
+	ios.SetStderrTTY(true)
+
+	tr := httpmock.Registry{}
+	defer tr.Verify(t)
+
+	tr.Register(
+		httpmock.GraphQL(`query UserCurrent\b`),
+		httpmock.StringResponse(`{"data":{"viewer":{"login":"monalisa"}}}`))
+	tr.Register(
+		httpmock.REST("GET", "users/monalisa/settings/billing/shared-storage"),
+		httpmock.JSONResponse(map[string]interface{}{
+			"days_left_in_billing_cycle":       10,
+			"estimated_paid_storage_for_month": 1.5,
+			"estimated_storage_for_month":      2.5,
+		}))
+
+	err := usageRun(&UsageOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: &tr}, nil
+		},
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "", stderr.String())
+	assert.Equal(t, "Estimated Git LFS and Packages storage for monalisa this billing cycle:\n\nEstimated storage used: 2.50 GB\nEstimated paid storage: 1.50 GB\nDays left in billing cycle: 10\n", stdout.String())
+}
+
+func Test_usageRun_org(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	tr := httpmock.Registry{}
+	defer tr.Verify(t)
+
+	tr.Register(
+		httpmock.REST("GET", "orgs/my-org/settings/billing/shared-storage"),
+		httpmock.JSONResponse(map[string]interface{}{
+			"days_left_in_billing_cycle":       20,
+			"estimated_paid_storage_for_month": 0,
+			"estimated_storage_for_month":      1,
+		}))
+
+	err := usageRun(&UsageOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: &tr}, nil
+		},
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		Org: "my-org",
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Estimated Git LFS and Packages storage for my-org this billing cycle:\n\nEstimated storage used: 1.00 GB\nEstimated paid storage: 0.00 GB\nDays left in billing cycle: 20\n", stdout.String())
+}
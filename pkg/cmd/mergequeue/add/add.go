@@ -0,0 +1,84 @@
+package add
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type AddOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+
+	Finder shared.PRFinder
+
+	SelectorArg string
+}
+
+func NewCmdAdd(f *cmdutil.Factory, runF func(*AddOptions) error) *cobra.Command {
+	opts := &AddOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "add {<number> | <url> | <branch>}",
+		Short: "Add a pull request to its base branch's merge queue",
+		Long: heredoc.Doc(`
+			Add a pull request to its base branch's merge queue.
+
+			The repository must have a merge queue enabled for the pull request's base
+			branch, and the pull request must meet the branch's merge queue requirements.
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot add pull request to merge queue: number, url, or branch required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Finder = shared.NewFinder(f)
+			opts.SelectorArg = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return addRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func addRun(opts *AddOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	findOptions := shared.FindOptions{
+		Selector: opts.SelectorArg,
+		Fields:   []string{"id", "number", "title", "state"},
+	}
+	pr, baseRepo, err := opts.Finder.Find(findOptions)
+	if err != nil {
+		return err
+	}
+
+	if pr.State != "OPEN" {
+		return fmt.Errorf("can't add pull request #%d (%s) to the merge queue because it is not open", pr.Number, pr.Title)
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	if err := api.EnqueuePullRequest(client, baseRepo, pr); err != nil {
+		return fmt.Errorf("failed to add pull request to the merge queue: %w", err)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "%s Added pull request %s#%d to the merge queue\n", cs.SuccessIconWithColor(cs.Green), ghrepo.FullName(baseRepo), pr.Number)
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package add
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/test"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runCommand(rt http.RoundTripper, isTTY bool, cli string) (*test.CmdOut, error) {
+	ios, _, stdout, stderr := iostreams.Test()
+	ios.SetStdoutTTY(isTTY)
+	ios.SetStdinTTY(isTTY)
+	ios.SetStderrTTY(isTTY)
+
+	factory := &cmdutil.Factory{
+		IOStreams: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: rt}, nil
+		},
+	}
+
+	cmd := NewCmdAdd(factory, nil)
+
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+
+	_, err = cmd.ExecuteC()
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+func TestNoArgs(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	_, err := runCommand(http, true, "")
+	assert.EqualError(t, err, "cannot add pull request to merge queue: number, url, or branch required")
+}
+
+func TestAddRun_notOpen(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	baseRepo, err := ghrepo.FromFullName("OWNER/REPO")
+	require.NoError(t, err)
+	pr := &api.PullRequest{Number: 96, Title: "The title of the PR", State: "CLOSED"}
+	shared.RunCommandFinder("96", pr, baseRepo)
+
+	_, err = runCommand(http, true, "96")
+	assert.EqualError(t, err, "can't add pull request #96 (The title of the PR) to the merge queue because it is not open")
+}
+
+func TestAddRun(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	baseRepo, err := ghrepo.FromFullName("OWNER/REPO")
+	require.NoError(t, err)
+	pr := &api.PullRequest{ID: "PR-ID", Number: 96, Title: "The title of the PR", State: "OPEN"}
+	shared.RunCommandFinder("96", pr, baseRepo)
+
+	http.Register(
+		httpmock.GraphQL(`mutation EnqueuePullRequest\b`),
+		httpmock.StringResponse(`{"data":{"enqueuePullRequest":{"mergeQueueEntry":{"id":"ENTRY-1"}}}}`),
+	)
+
+	output, err := runCommand(http, true, "96")
+	require.NoError(t, err)
+	assert.Equal(t, "✓ Added pull request OWNER/REPO#96 to the merge queue\n", output.String())
+}
@@ -0,0 +1,119 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmd/mergequeue/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Exporter   cmdutil.Exporter
+
+	Branch string
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the pull requests in a branch's merge queue",
+		Long: heredoc.Doc(`
+			List the pull requests currently waiting in a branch's merge queue, in queue
+			order, along with each entry's state and estimated time to merge.
+		`),
+		Example: heredoc.Doc(`
+			$ gh merge-queue list
+			$ gh merge-queue list --branch main
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Branch, "branch", "B", "", "Base branch to inspect (default: the repository's default branch)")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.EntryFields)
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	branch, err := resolveBranch(client, repo, opts.Branch)
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	entries, err := shared.GetEntries(client, repo, branch)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to get merge queue entries: %w", err)
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, entries)
+	}
+
+	if len(entries) == 0 {
+		fmt.Fprintf(opts.IO.Out, "No pull requests in the merge queue for %s\n", branch)
+		return nil
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("#", "TITLE", "STATE", "ETA"))
+	for _, e := range entries {
+		tp.AddField(fmt.Sprintf("%d", e.Position))
+		tp.AddField(fmt.Sprintf("#%d %s", e.PRNumber, e.PRTitle), tableprinter.WithColor(cs.Bold))
+		tp.AddField(e.State)
+		tp.AddField(formatETA(e.EstimatedTimeToMerge))
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
+
+func resolveBranch(client *api.Client, repo ghrepo.Interface, branch string) (string, error) {
+	if branch != "" {
+		return branch, nil
+	}
+	return api.RepoDefaultBranch(client, repo)
+}
+
+func formatETA(seconds int) string {
+	if seconds <= 0 {
+		return "-"
+	}
+	return (time.Duration(seconds) * time.Second).String()
+}
@@ -0,0 +1,93 @@
+package list
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/test"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runCommand(rt http.RoundTripper, isTTY bool, cli string) (*test.CmdOut, error) {
+	ios, _, stdout, stderr := iostreams.Test()
+	ios.SetStdoutTTY(isTTY)
+	ios.SetStdinTTY(isTTY)
+	ios.SetStderrTTY(isTTY)
+
+	factory := &cmdutil.Factory{
+		IOStreams: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: rt}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+	}
+
+	cmd := NewCmdList(factory, nil)
+
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+
+	_, err = cmd.ExecuteC()
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+func TestListRun_empty(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`{"data":{"repository":{"id":"THE-REPO-ID","name":"REPO","owner":{"login":"OWNER"},"defaultBranchRef":{"name":"main"}}}}`),
+	)
+	http.Register(
+		httpmock.GraphQL(`query MergeQueueEntries\b`),
+		httpmock.StringResponse(`{"data":{"repository":{"ref":{"mergeQueue":{"entries":{"nodes":[]}}}}}}`),
+	)
+
+	output, err := runCommand(http, true, "")
+	require.NoError(t, err)
+	assert.Equal(t, "No pull requests in the merge queue for main\n", output.String())
+}
+
+func TestListRun_withEntries(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query MergeQueueEntries\b`),
+		httpmock.StringResponse(`{"data":{"repository":{"ref":{"mergeQueue":{"entries":{"nodes":[
+			{"id":"ENTRY-1","position":1,"state":"QUEUED","estimatedTimeToMerge":120,"pullRequest":{"number":96,"title":"Fix bug","headRefName":"fix-bug"}}
+		]}}}}}}`),
+	)
+
+	output, err := runCommand(http, true, "--branch main")
+	require.NoError(t, err)
+	assert.Contains(t, output.String(), "#96 Fix bug")
+	assert.Contains(t, output.String(), "QUEUED")
+}
+
+func TestFormatETA(t *testing.T) {
+	assert.Equal(t, "-", formatETA(0))
+	assert.Equal(t, "-", formatETA(-5))
+	assert.Equal(t, "2m0s", formatETA(120))
+}
@@ -0,0 +1,33 @@
+package mergequeue
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdAdd "github.com/cli/cli/v2/pkg/cmd/mergequeue/add"
+	cmdList "github.com/cli/cli/v2/pkg/cmd/mergequeue/list"
+	cmdRemove "github.com/cli/cli/v2/pkg/cmd/mergequeue/remove"
+	cmdWatch "github.com/cli/cli/v2/pkg/cmd/mergequeue/watch"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdMergeQueue(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "merge-queue <command>",
+		Short: "Manage merge queues",
+		Long: heredoc.Doc(`
+			Work with the pull requests waiting in a branch's merge queue.
+
+			A branch must have a merge queue enabled in its repository settings for these
+			commands to return any results.
+		`),
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdAdd.NewCmdAdd(f, nil))
+	cmd.AddCommand(cmdRemove.NewCmdRemove(f, nil))
+	cmd.AddCommand(cmdWatch.NewCmdWatch(f, nil))
+
+	return cmd
+}
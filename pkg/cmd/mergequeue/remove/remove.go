@@ -0,0 +1,79 @@
+package remove
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/mergequeue/shared"
+	prShared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type RemoveOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+
+	Finder prShared.PRFinder
+
+	SelectorArg string
+}
+
+func NewCmdRemove(f *cmdutil.Factory, runF func(*RemoveOptions) error) *cobra.Command {
+	opts := &RemoveOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "remove {<number> | <url> | <branch>}",
+		Short: "Remove a pull request from its base branch's merge queue",
+		Args:  cmdutil.ExactArgs(1, "cannot remove pull request from merge queue: number, url, or branch required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Finder = prShared.NewFinder(f)
+			opts.SelectorArg = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return removeRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func removeRun(opts *RemoveOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	findOptions := prShared.FindOptions{
+		Selector: opts.SelectorArg,
+		Fields:   []string{"id", "number", "title", "baseRefName"},
+	}
+	pr, baseRepo, err := opts.Finder.Find(findOptions)
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	entry, err := shared.EntryForPR(client, baseRepo, pr.BaseRefName, pr.Number)
+	if err != nil {
+		return err
+	}
+
+	if err := api.DequeuePullRequest(client, baseRepo, entry.ID); err != nil {
+		return fmt.Errorf("failed to remove pull request from the merge queue: %w", err)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "%s Removed pull request %s#%d from the merge queue\n", cs.SuccessIconWithColor(cs.Green), ghrepo.FullName(baseRepo), pr.Number)
+
+	return nil
+}
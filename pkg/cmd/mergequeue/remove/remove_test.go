@@ -0,0 +1,102 @@
+package remove
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/test"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runCommand(rt http.RoundTripper, isTTY bool, cli string) (*test.CmdOut, error) {
+	ios, _, stdout, stderr := iostreams.Test()
+	ios.SetStdoutTTY(isTTY)
+	ios.SetStdinTTY(isTTY)
+	ios.SetStderrTTY(isTTY)
+
+	factory := &cmdutil.Factory{
+		IOStreams: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: rt}, nil
+		},
+	}
+
+	cmd := NewCmdRemove(factory, nil)
+
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+
+	_, err = cmd.ExecuteC()
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+func TestNoArgs(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	_, err := runCommand(http, true, "")
+	assert.EqualError(t, err, "cannot remove pull request from merge queue: number, url, or branch required")
+}
+
+func TestRemoveRun_notQueued(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	baseRepo, err := ghrepo.FromFullName("OWNER/REPO")
+	require.NoError(t, err)
+	pr := &api.PullRequest{Number: 96, Title: "The title of the PR", BaseRefName: "main"}
+	shared.RunCommandFinder("96", pr, baseRepo)
+
+	http.Register(
+		httpmock.GraphQL(`query MergeQueueEntries\b`),
+		httpmock.StringResponse(`{"data":{"repository":{"ref":{"mergeQueue":{"entries":{"nodes":[]}}}}}}`),
+	)
+
+	_, err = runCommand(http, true, "96")
+	assert.EqualError(t, err, `pull request #96 is not in the merge queue for "main"`)
+}
+
+func TestRemoveRun(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	baseRepo, err := ghrepo.FromFullName("OWNER/REPO")
+	require.NoError(t, err)
+	pr := &api.PullRequest{Number: 96, Title: "The title of the PR", BaseRefName: "main"}
+	shared.RunCommandFinder("96", pr, baseRepo)
+
+	http.Register(
+		httpmock.GraphQL(`query MergeQueueEntries\b`),
+		httpmock.StringResponse(`{"data":{"repository":{"ref":{"mergeQueue":{"entries":{"nodes":[
+			{"id":"ENTRY-1","position":1,"state":"QUEUED","estimatedTimeToMerge":120,"pullRequest":{"number":96,"title":"The title of the PR","headRefName":"feature"}}
+		]}}}}}}`),
+	)
+	http.Register(
+		httpmock.GraphQL(`mutation DequeuePullRequest\b`),
+		httpmock.StringResponse(`{"data":{"dequeuePullRequest":{"mergeQueueEntry":{"id":"ENTRY-1"}}}}`),
+	)
+
+	output, err := runCommand(http, true, "96")
+	require.NoError(t, err)
+	assert.Equal(t, "✓ Removed pull request OWNER/REPO#96 from the merge queue\n", output.String())
+}
@@ -0,0 +1,120 @@
+package shared
+
+import (
+	"fmt"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+)
+
+// Entry is a single pull request waiting in a branch's merge queue.
+type Entry struct {
+	ID                   string
+	Position             int
+	State                string
+	EstimatedTimeToMerge int
+	PRNumber             int
+	PRTitle              string
+	PRHeadRefName        string
+}
+
+func (e Entry) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(e, fields)
+}
+
+var EntryFields = []string{"id", "position", "state", "estimatedTimeToMerge", "prNumber", "prTitle", "prHeadRefName"}
+
+// GetEntries fetches the current contents of the merge queue for the given base branch, ordered
+// by position.
+func GetEntries(client *api.Client, repo ghrepo.Interface, branch string) ([]Entry, error) {
+	query := `
+	query MergeQueueEntries($owner: String!, $repo: String!, $branch: String!) {
+		repository(owner: $owner, name: $repo) {
+			ref(qualifiedName: $branch) {
+				mergeQueue {
+					entries(first: 100) {
+						nodes {
+							id
+							position
+							state
+							estimatedTimeToMerge
+							pullRequest {
+								number
+								title
+								headRefName
+							}
+						}
+					}
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner":  repo.RepoOwner(),
+		"repo":   repo.RepoName(),
+		"branch": branch,
+	}
+
+	type node struct {
+		ID                   string
+		Position             int
+		State                string
+		EstimatedTimeToMerge int
+		PullRequest          struct {
+			Number      int
+			Title       string
+			HeadRefName string
+		}
+	}
+
+	var result struct {
+		Repository struct {
+			Ref struct {
+				MergeQueue struct {
+					Entries struct {
+						Nodes []node
+					}
+				}
+			}
+		}
+	}
+
+	if err := client.GraphQL(repo.RepoHost(), query, variables, &result); err != nil {
+		return nil, err
+	}
+
+	nodes := result.Repository.Ref.MergeQueue.Entries.Nodes
+	entries := make([]Entry, len(nodes))
+	for i, n := range nodes {
+		entries[i] = Entry{
+			ID:                   n.ID,
+			Position:             n.Position,
+			State:                n.State,
+			EstimatedTimeToMerge: n.EstimatedTimeToMerge,
+			PRNumber:             n.PullRequest.Number,
+			PRTitle:              n.PullRequest.Title,
+			PRHeadRefName:        n.PullRequest.HeadRefName,
+		}
+	}
+
+	return entries, nil
+}
+
+// EntryForPR returns the merge queue entry for the given pull request number, or an error if the
+// pull request isn't currently queued on branch.
+func EntryForPR(client *api.Client, repo ghrepo.Interface, branch string, prNumber int) (*Entry, error) {
+	entries, err := GetEntries(client, repo, branch)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range entries {
+		if e.PRNumber == prNumber {
+			return &e, nil
+		}
+	}
+
+	return nil, fmt.Errorf("pull request #%d is not in the merge queue for %q", prNumber, branch)
+}
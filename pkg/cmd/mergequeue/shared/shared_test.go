@@ -0,0 +1,66 @@
+package shared
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEntries(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query MergeQueueEntries\b`),
+		httpmock.StringResponse(`{"data":{"repository":{"ref":{"mergeQueue":{"entries":{"nodes":[
+			{"id":"ENTRY-1","position":1,"state":"QUEUED","estimatedTimeToMerge":120,"pullRequest":{"number":96,"title":"Fix bug","headRefName":"fix-bug"}},
+			{"id":"ENTRY-2","position":2,"state":"QUEUED","estimatedTimeToMerge":0,"pullRequest":{"number":97,"title":"Add feature","headRefName":"add-feature"}}
+		]}}}}}}`),
+	)
+
+	httpClient := &http.Client{Transport: reg}
+	client := api.NewClientFromHTTP(httpClient)
+	repo, err := ghrepo.FromFullName("OWNER/REPO")
+	require.NoError(t, err)
+
+	entries, err := GetEntries(client, repo, "main")
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, Entry{
+		ID:                   "ENTRY-1",
+		Position:             1,
+		State:                "QUEUED",
+		EstimatedTimeToMerge: 120,
+		PRNumber:             96,
+		PRTitle:              "Fix bug",
+		PRHeadRefName:        "fix-bug",
+	}, entries[0])
+}
+
+func TestEntryForPR(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	entriesResponse := `{"data":{"repository":{"ref":{"mergeQueue":{"entries":{"nodes":[
+		{"id":"ENTRY-1","position":1,"state":"QUEUED","estimatedTimeToMerge":120,"pullRequest":{"number":96,"title":"Fix bug","headRefName":"fix-bug"}}
+	]}}}}}}`
+	reg.Register(httpmock.GraphQL(`query MergeQueueEntries\b`), httpmock.StringResponse(entriesResponse))
+	reg.Register(httpmock.GraphQL(`query MergeQueueEntries\b`), httpmock.StringResponse(entriesResponse))
+
+	httpClient := &http.Client{Transport: reg}
+	client := api.NewClientFromHTTP(httpClient)
+	repo, err := ghrepo.FromFullName("OWNER/REPO")
+	require.NoError(t, err)
+
+	entry, err := EntryForPR(client, repo, "main", 96)
+	require.NoError(t, err)
+	assert.Equal(t, "ENTRY-1", entry.ID)
+
+	_, err = EntryForPR(client, repo, "main", 1)
+	assert.Error(t, err)
+}
@@ -0,0 +1,147 @@
+package watch
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmd/mergequeue/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+const defaultInterval int = 5
+
+type WatchOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Branch   string
+	Interval int
+}
+
+func NewCmdWatch(f *cmdutil.Factory, runF func(*WatchOptions) error) *cobra.Command {
+	opts := &WatchOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "watch",
+		Short: "Watch a branch's merge queue until it's empty",
+		Long: heredoc.Doc(`
+			Watch a branch's merge queue, refreshing until every pull request has
+			merged or left the queue.
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return watchRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Branch, "branch", "B", "", "Base branch to watch (default: the repository's default branch)")
+	cmd.Flags().IntVarP(&opts.Interval, "interval", "i", defaultInterval, "Refresh interval in seconds")
+
+	return cmd
+}
+
+func watchRun(opts *WatchOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	branch := opts.Branch
+	if branch == "" {
+		branch, err = api.RepoDefaultBranch(client, repo)
+		if err != nil {
+			return err
+		}
+	}
+
+	cs := opts.IO.ColorScheme()
+	duration := time.Duration(opts.Interval) * time.Second
+
+	out := &bytes.Buffer{}
+	opts.IO.StartAlternateScreenBuffer()
+	var entries []shared.Entry
+	for {
+		entries, err = shared.GetEntries(client, repo, branch)
+		if err != nil {
+			break
+		}
+
+		renderEntries(out, opts.IO, entries, branch)
+
+		if len(entries) == 0 {
+			break
+		}
+
+		opts.IO.RefreshScreen()
+		fmt.Fprintln(opts.IO.Out, cs.Boldf("Refreshing merge queue status every %d seconds. Press Ctrl+C to quit.", opts.Interval))
+		fmt.Fprintln(opts.IO.Out)
+
+		if _, err = io.Copy(opts.IO.Out, out); err != nil {
+			break
+		}
+		out.Reset()
+
+		time.Sleep(duration)
+	}
+	opts.IO.StopAlternateScreenBuffer()
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(opts.IO.Out, out); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(opts.IO.Out, "%s The merge queue for %s is empty\n", cs.SuccessIconWithColor(cs.Green), branch)
+
+	return nil
+}
+
+func renderEntries(out io.Writer, io *iostreams.IOStreams, entries []shared.Entry, branch string) {
+	if len(entries) == 0 {
+		return
+	}
+
+	cs := io.ColorScheme()
+	tp := tableprinter.NewWithWriter(out, io.IsStdoutTTY(), io.TerminalWidth(), cs, tableprinter.WithHeader("#", "TITLE", "STATE", "ETA"))
+	for _, e := range entries {
+		tp.AddField(fmt.Sprintf("%d", e.Position))
+		tp.AddField(fmt.Sprintf("#%d %s", e.PRNumber, e.PRTitle), tableprinter.WithColor(cs.Bold))
+		tp.AddField(e.State)
+		tp.AddField(formatETA(e.EstimatedTimeToMerge))
+		tp.EndRow()
+	}
+	_ = tp.Render()
+}
+
+func formatETA(seconds int) string {
+	if seconds <= 0 {
+		return "-"
+	}
+	return (time.Duration(seconds) * time.Second).String()
+}
@@ -0,0 +1,48 @@
+package watch
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query MergeQueueEntries\b`),
+		httpmock.StringResponse(`{"data":{"repository":{"ref":{"mergeQueue":{"entries":{"nodes":[
+			{"id":"ENTRY-1","position":1,"state":"QUEUED","estimatedTimeToMerge":120,"pullRequest":{"number":96,"title":"The title of the PR","headRefName":"feature"}}
+		]}}}}}}`),
+	)
+	reg.Register(
+		httpmock.GraphQL(`query MergeQueueEntries\b`),
+		httpmock.StringResponse(`{"data":{"repository":{"ref":{"mergeQueue":{"entries":{"nodes":[]}}}}}}`),
+	)
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	opts := &WatchOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+		Branch:   "main",
+		Interval: 0,
+	}
+
+	err := watchRun(opts)
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "#96 The title of the PR")
+	assert.Contains(t, stdout.String(), "The merge queue for main is empty")
+}
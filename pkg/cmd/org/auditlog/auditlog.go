@@ -0,0 +1,155 @@
+package auditlog
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type AuditLogOptions struct {
+	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
+	HttpClient func() (*http.Client, error)
+
+	Org     string
+	Phrase  string
+	Actor   string
+	Before  string
+	After   string
+	Include string
+	Limit   int
+	Resume  bool
+}
+
+func NewCmdAuditLog(f *cmdutil.Factory, runF func(*AuditLogOptions) error) *cobra.Command {
+	opts := &AuditLogOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "audit-log <organization>",
+		Short: "Export an organization's audit log",
+		Long: heredoc.Doc(`
+			Query an organization's audit log and print matching events as newline-delimited
+			JSON, one event per line, suitable for piping into jq or another log pipeline.
+
+			--phrase accepts the audit log's own search syntax (e.g. "action:repo.destroy
+			actor:monalisa"); --actor, --before, and --after add to it without requiring that
+			syntax. Matching pages are fetched automatically; use --limit to cap the number of
+			events returned.
+
+			With --resume, the timestamp of the most recent event returned is persisted locally
+			per organization and phrase, and automatically excluded from the next --resume run
+			of the same query, so a scheduled job only sees new events each time it runs.
+		`),
+		Example: heredoc.Doc(`
+			# One-off investigation
+			$ gh org audit-log github --phrase "action:repo.destroy" --limit 100
+
+			# A scheduled job that only ever sees events it hasn't seen before
+			$ gh org audit-log github --actor monalisa --resume >> events.ndjson
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Org = args[0]
+
+			if err := cmdutil.MutuallyExclusive(
+				"using `--resume` with `--after` is not supported, since `--resume` supplies its own lower bound",
+				opts.Resume,
+				opts.After != "",
+			); err != nil {
+				return err
+			}
+
+			if opts.Limit < 0 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return auditLogRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Phrase, "phrase", "", "Search phrase using the audit log's search syntax")
+	cmd.Flags().StringVar(&opts.Actor, "actor", "", "Filter to events performed by `login`")
+	cmd.Flags().StringVar(&opts.Before, "before", "", "Filter to events before this `time` (RFC 3339)")
+	cmd.Flags().StringVar(&opts.After, "after", "", "Filter to events after this `time` (RFC 3339)")
+	cmdutil.StringEnumFlag(cmd, &opts.Include, "include", "", "", []string{"web", "git", "all"}, "Categories of events to include (default: web)")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 0, "Maximum number of events to fetch (0 means no limit)")
+	cmd.Flags().BoolVar(&opts.Resume, "resume", false, "Resume from, and update, the last cursor saved for this organization and phrase")
+
+	return cmd
+}
+
+func auditLogRun(opts *AuditLogOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, _ := cfg.Authentication().DefaultHost()
+
+	phrase := buildPhrase(opts)
+
+	cursorKey := opts.Org + "\x00" + opts.Phrase + "\x00" + opts.Actor
+	if opts.Resume {
+		if cursor, err := getCursor(cursorKey); err != nil {
+			return fmt.Errorf("error reading saved cursor: %w", err)
+		} else if cursor != "" {
+			phrase = addPhraseTerm(phrase, "created:>"+cursor)
+		}
+	}
+
+	entries, latest, err := fetchAuditLog(httpClient, host, opts.Org, phrase, opts.Include, opts.Limit)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		fmt.Fprintln(opts.IO.Out, string(entry))
+	}
+
+	if opts.Resume && latest != "" {
+		if err := setCursor(cursorKey, latest); err != nil {
+			return fmt.Errorf("error saving cursor: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// buildPhrase combines --phrase with the --actor, --before, and --after shorthands into a
+// single audit log search phrase.
+func buildPhrase(opts *AuditLogOptions) string {
+	phrase := opts.Phrase
+	if opts.Actor != "" {
+		phrase = addPhraseTerm(phrase, "actor:"+opts.Actor)
+	}
+	if opts.After != "" {
+		phrase = addPhraseTerm(phrase, "created:>"+opts.After)
+	}
+	if opts.Before != "" {
+		phrase = addPhraseTerm(phrase, "created:<"+opts.Before)
+	}
+	return phrase
+}
+
+func addPhraseTerm(phrase, term string) string {
+	if phrase == "" {
+		return term
+	}
+	return phrase + " " + term
+}
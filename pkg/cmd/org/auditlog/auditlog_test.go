@@ -0,0 +1,132 @@
+package auditlog
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildPhrase(t *testing.T) {
+	tests := []struct {
+		name string
+		opts AuditLogOptions
+		want string
+	}{
+		{
+			name: "empty",
+			opts: AuditLogOptions{},
+			want: "",
+		},
+		{
+			name: "phrase only",
+			opts: AuditLogOptions{Phrase: "action:repo.create"},
+			want: "action:repo.create",
+		},
+		{
+			name: "actor adds to an empty phrase",
+			opts: AuditLogOptions{Actor: "monalisa"},
+			want: "actor:monalisa",
+		},
+		{
+			name: "phrase, actor, before, and after all combine",
+			opts: AuditLogOptions{Phrase: "action:repo.create", Actor: "monalisa", After: "2024-01-01T00:00:00Z", Before: "2024-02-01T00:00:00Z"},
+			want: "action:repo.create actor:monalisa created:>2024-01-01T00:00:00Z created:<2024-02-01T00:00:00Z",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := buildPhrase(&tt.opts); got != tt.want {
+				t.Errorf("buildPhrase() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewCmdAuditLog(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wantsErr string
+	}{
+		{
+			name: "org only",
+			cli:  "github",
+		},
+		{
+			name:     "resume with after is rejected",
+			cli:      "github --resume --after 2024-01-01T00:00:00Z",
+			wantsErr: "using `--resume` with `--after` is not supported, since `--resume` supplies its own lower bound",
+		},
+		{
+			name:     "negative limit is rejected",
+			cli:      "github --limit -1",
+			wantsErr: "invalid limit: -1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			cmd := NewCmdAuditLog(f, func(opts *AuditLogOptions) error {
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				assert.EqualError(t, err, tt.wantsErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestAuditLogRun(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "orgs/github/audit-log"),
+		httpmock.StringResponse(`[{"action":"repo.create","@timestamp":1000}]`))
+
+	ios, _, stdout, _ := iostreams.Test()
+	opts := &AuditLogOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		Org:    "github",
+		Resume: true,
+	}
+
+	if err := auditLogRun(opts); err != nil {
+		t.Fatalf("auditLogRun returned error: %v", err)
+	}
+
+	assert.JSONEq(t, `{"action":"repo.create","@timestamp":1000}`, stdout.String())
+
+	cursor, err := getCursor("github\x00\x00")
+	assert.NoError(t, err)
+	assert.Equal(t, "1970-01-01T00:00:01Z", cursor)
+}
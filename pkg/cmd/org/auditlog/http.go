@@ -0,0 +1,133 @@
+package auditlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+)
+
+// fetchAuditLog fetches every page of the audit log matching phrase and include, up to limit
+// events (0 meaning no limit), and returns the raw event objects along with the RFC 3339
+// timestamp of the most recent event among them (the empty string if there were none).
+func fetchAuditLog(httpClient *http.Client, hostname, org, phrase, include string, limit int) ([]json.RawMessage, string, error) {
+	client := api.NewClientFromHTTP(httpClient)
+
+	path := fmt.Sprintf("orgs/%s/audit-log?per_page=100", org)
+	if phrase != "" {
+		path += "&phrase=" + url.QueryEscape(phrase)
+	}
+	if include != "" {
+		path += "&include=" + url.QueryEscape(include)
+	}
+
+	var entries []json.RawMessage
+	var latest time.Time
+	for path != "" {
+		var page []json.RawMessage
+		var err error
+		path, err = client.RESTWithNext(hostname, "GET", path, nil, &page)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to fetch audit log: %w", err)
+		}
+
+		for _, entry := range page {
+			if t, ok := entryTimestamp(entry); ok && t.After(latest) {
+				latest = t
+			}
+
+			entries = append(entries, entry)
+			if limit != 0 && len(entries) == limit {
+				path = ""
+				break
+			}
+		}
+	}
+
+	var latestStr string
+	if !latest.IsZero() {
+		latestStr = latest.UTC().Format(time.RFC3339)
+	}
+	return entries, latestStr, nil
+}
+
+// entryTimestamp extracts the event time from an audit log entry. Entries carry it either as
+// "@timestamp" (milliseconds since the epoch) or, for some event types, "created_at" (RFC 3339).
+func entryTimestamp(entry json.RawMessage) (time.Time, bool) {
+	var fields struct {
+		Timestamp *int64 `json:"@timestamp"`
+		CreatedAt string `json:"created_at"`
+	}
+	if err := json.Unmarshal(entry, &fields); err != nil {
+		return time.Time{}, false
+	}
+
+	if fields.Timestamp != nil {
+		return time.UnixMilli(*fields.Timestamp), true
+	}
+	if fields.CreatedAt != "" {
+		if t, err := time.Parse(time.RFC3339, fields.CreatedAt); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// cursorsFilePath returns the path to the local file that records the last cursor seen per
+// organization/phrase/actor combination, set via 'gh org audit-log --resume'.
+func cursorsFilePath() string {
+	return filepath.Join(config.StateDir(), "org_audit_log_cursors.json")
+}
+
+func getCursor(key string) (string, error) {
+	cursors, err := readCursors()
+	if err != nil {
+		return "", err
+	}
+	return cursors[key], nil
+}
+
+func setCursor(key, value string) error {
+	cursors, err := readCursors()
+	if err != nil {
+		return err
+	}
+
+	if cursors == nil {
+		cursors = make(map[string]string)
+	}
+	cursors[key] = value
+
+	path := cursorsFilePath()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(cursors, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0600)
+}
+
+func readCursors() (map[string]string, error) {
+	data, err := os.ReadFile(cursorsFilePath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var cursors map[string]string
+	if err := json.Unmarshal(data, &cursors); err != nil {
+		return nil, err
+	}
+	return cursors, nil
+}
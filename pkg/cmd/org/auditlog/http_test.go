@@ -0,0 +1,82 @@
+package auditlog
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/httpmock"
+)
+
+func Test_fetchAuditLog(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "orgs/github/audit-log"),
+		httpmock.WithHeader(
+			httpmock.StringResponse(`[{"action":"repo.create","@timestamp":1000,"actor":"monalisa"},{"action":"repo.destroy","@timestamp":3000,"actor":"monalisa"}]`),
+			"Link",
+			`<https://api.github.com/orgs/github/audit-log?page=2>; rel="next"`,
+		),
+	)
+	reg.Register(
+		httpmock.REST("GET", "orgs/github/audit-log"),
+		httpmock.StringResponse(`[{"action":"repo.create","@timestamp":2000,"actor":"hubot"}]`),
+	)
+
+	httpClient := &http.Client{Transport: reg}
+	entries, latest, err := fetchAuditLog(httpClient, "github.com", "github", "actor:monalisa", "", 0)
+	if err != nil {
+		t.Fatalf("fetchAuditLog returned error: %v", err)
+	}
+
+	if len(entries) != 3 {
+		t.Fatalf("expected 3 entries across both pages, got %d", len(entries))
+	}
+	if latest != "1970-01-01T00:00:03Z" {
+		t.Errorf("expected latest cursor %q, got %q", "1970-01-01T00:00:03Z", latest)
+	}
+}
+
+func Test_fetchAuditLog_limit(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "orgs/github/audit-log"),
+		httpmock.StringResponse(`[{"action":"repo.create","@timestamp":1000},{"action":"repo.destroy","@timestamp":2000}]`),
+	)
+
+	httpClient := &http.Client{Transport: reg}
+	entries, _, err := fetchAuditLog(httpClient, "github.com", "github", "", "", 1)
+	if err != nil {
+		t.Fatalf("fetchAuditLog returned error: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(entries))
+	}
+}
+
+func Test_cursorRoundTrip(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	got, err := getCursor("github\x00\x00")
+	if err != nil {
+		t.Fatalf("getCursor returned error: %v", err)
+	}
+	if got != "" {
+		t.Errorf("expected no cursor yet, got %q", got)
+	}
+
+	if err := setCursor("github\x00\x00", "2024-01-01T00:00:00Z"); err != nil {
+		t.Fatalf("setCursor returned error: %v", err)
+	}
+
+	got, err = getCursor("github\x00\x00")
+	if err != nil {
+		t.Fatalf("getCursor returned error: %v", err)
+	}
+	if got != "2024-01-01T00:00:00Z" {
+		t.Errorf("expected persisted cursor, got %q", got)
+	}
+}
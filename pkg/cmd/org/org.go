@@ -2,7 +2,9 @@ package org
 
 import (
 	"github.com/MakeNowJust/heredoc"
+	orgAuditLogCmd "github.com/cli/cli/v2/pkg/cmd/org/auditlog"
 	orgListCmd "github.com/cli/cli/v2/pkg/cmd/org/list"
+	orgRepoDefaultsCmd "github.com/cli/cli/v2/pkg/cmd/org/repodefaults"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/spf13/cobra"
 )
@@ -19,6 +21,7 @@ func NewCmdOrg(f *cmdutil.Factory) *cobra.Command {
 	}
 
 	cmdutil.AddGroup(cmd, "General commands", orgListCmd.NewCmdList(f, nil))
+	cmdutil.AddGroup(cmd, "Targeted commands", orgAuditLogCmd.NewCmdAuditLog(f, nil), orgRepoDefaultsCmd.NewCmdRepoDefaults(f))
 
 	return cmd
 }
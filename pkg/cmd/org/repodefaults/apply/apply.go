@@ -0,0 +1,331 @@
+package apply
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	protectionShared "github.com/cli/cli/v2/pkg/cmd/repo/protection/shared"
+	"github.com/cli/cli/v2/pkg/cmd/repo/topics/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ApplyOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
+
+	Exporter cmdutil.Exporter
+
+	Org         string
+	ConfigFile  string
+	Match       string
+	Concurrency int
+	DryRun      bool
+}
+
+var reportFields = []string{
+	"repository",
+	"labelsApplied",
+	"branchProtectionApplied",
+	"actionsPermissionsApplied",
+	"missingSecrets",
+	"error",
+}
+
+// RepoReport describes what was found and, unless --dry-run was set, changed
+// for a single repository.
+type RepoReport struct {
+	Repository                string   `json:"repository"`
+	LabelsApplied             []string `json:"labelsApplied"`
+	BranchProtectionApplied   bool     `json:"branchProtectionApplied"`
+	ActionsPermissionsApplied bool     `json:"actionsPermissionsApplied"`
+	MissingSecrets            []string `json:"missingSecrets"`
+	Error                     string   `json:"error,omitempty"`
+}
+
+func (r *RepoReport) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(r, fields)
+}
+
+func NewCmdApply(f *cmdutil.Factory, runF func(*ApplyOptions) error) *cobra.Command {
+	opts := &ApplyOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "apply <organization>",
+		Short: "Apply a default configuration bundle to an organization's repositories",
+		Long: heredoc.Docf(`
+			Apply a bundle of labels, a branch protection template, Actions
+			permissions, and a list of required secret names across every repository
+			in an organization, or a subset narrowed with %[1]s--match%[1]s.
+
+			Each repository is checked against the bundle before anything is changed,
+			and the report shows what drifted: labels that were missing or out of
+			date, whether branch protection or Actions permissions needed updating,
+			and which required secrets are absent (secret values can't be read back,
+			so this only reports presence).
+
+			Use %[1]s--dry-run%[1]s to see the report without applying any changes.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ gh org repo-defaults apply my-org --config defaults.yml
+			$ gh org repo-defaults apply my-org --config defaults.yml --match "service-*" --dry-run
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Org = args[0]
+
+			if opts.ConfigFile == "" {
+				return cmdutil.FlagErrorf("`--config` is required")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return applyRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.ConfigFile, "config", "c", "", "Path to a YAML file describing the default configuration bundle, or `-` to read from standard input")
+	cmd.Flags().StringVar(&opts.Match, "match", "", "Glob `pattern` limiting which repositories in the organization are affected")
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", 5, "Number of repositories to process at once")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Report drift without applying any changes")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, reportFields)
+
+	return cmd
+}
+
+func applyRun(opts *ApplyOptions) error {
+	raw, err := cmdutil.ReadFile(opts.ConfigFile, opts.IO.In)
+	if err != nil {
+		return err
+	}
+	cfg, err := parseConfig(raw)
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	ghCfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	hostname, _ := ghCfg.Authentication().DefaultHost()
+
+	repos, err := shared.ListOrgRepos(apiClient, hostname, opts.Org, opts.Match)
+	if err != nil {
+		return fmt.Errorf("failed to list repositories for %s: %w", opts.Org, err)
+	}
+	if len(repos) == 0 {
+		return fmt.Errorf("no repositories in %s matched", opts.Org)
+	}
+
+	var mu sync.Mutex
+	reports := make(map[string]*RepoReport, len(repos))
+	results := shared.BulkApply(repos, opts.Concurrency, func(repo ghrepo.Interface) error {
+		report, err := applyToRepo(httpClient, repo, cfg, opts.DryRun)
+		mu.Lock()
+		reports[ghrepo.FullName(repo)] = report
+		mu.Unlock()
+		return err
+	})
+
+	ordered := make([]*RepoReport, 0, len(results))
+	for _, result := range results {
+		report := reports[ghrepo.FullName(result.Repo)]
+		if result.Err != nil {
+			report.Error = result.Err.Error()
+		}
+		ordered = append(ordered, report)
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, ordered)
+	}
+
+	cs := opts.IO.ColorScheme()
+	for _, report := range ordered {
+		printReport(opts.IO, cs, report)
+	}
+
+	for _, report := range ordered {
+		if report.Error != "" {
+			return cmdutil.SilentError
+		}
+	}
+	return nil
+}
+
+// applyToRepo checks repo against cfg and, unless dryRun is set, applies any
+// section of cfg that has drifted. Errors from individual sections are
+// collected onto the report rather than aborting the remaining sections, so
+// that one failing check doesn't hide the rest of the drift for a repo.
+func applyToRepo(httpClient *http.Client, repo ghrepo.Interface, cfg *Config, dryRun bool) (*RepoReport, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	report := &RepoReport{Repository: ghrepo.FullName(repo)}
+	var errs []string
+
+	for _, label := range cfg.Labels {
+		drifted, err := labelDrifted(apiClient, repo, label)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("label %s: %s", label.Name, err))
+			continue
+		}
+		if !drifted {
+			continue
+		}
+		if dryRun {
+			report.LabelsApplied = append(report.LabelsApplied, label.Name)
+			continue
+		}
+		if err := applyLabel(apiClient, repo, label); err != nil {
+			errs = append(errs, fmt.Sprintf("label %s: %s", label.Name, err))
+			continue
+		}
+		report.LabelsApplied = append(report.LabelsApplied, label.Name)
+	}
+
+	if bp := cfg.BranchProtection; bp != nil {
+		branch := bp.Branch
+		if branch == "" {
+			var err error
+			branch, err = api.RepoDefaultBranch(apiClient, repo)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("branch protection: %s", err))
+			}
+		}
+		if branch != "" {
+			drifted, err := branchProtectionDrifted(httpClient, repo, branch, bp)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("branch protection: %s", err))
+			} else if drifted {
+				if dryRun {
+					report.BranchProtectionApplied = true
+				} else if err := applyBranchProtection(apiClient, repo, branch, bp); err != nil {
+					errs = append(errs, fmt.Sprintf("branch protection: %s", err))
+				} else {
+					report.BranchProtectionApplied = true
+				}
+			}
+		}
+	}
+
+	if ap := cfg.ActionsPermissions; ap != nil {
+		current, err := getActionsPermissions(apiClient, repo)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("actions permissions: %s", err))
+		} else if current.Enabled != ap.Enabled || (ap.AllowedActions != "" && current.AllowedActions != ap.AllowedActions) {
+			if dryRun {
+				report.ActionsPermissionsApplied = true
+			} else if err := applyActionsPermissions(apiClient, repo, ap); err != nil {
+				errs = append(errs, fmt.Sprintf("actions permissions: %s", err))
+			} else {
+				report.ActionsPermissionsApplied = true
+			}
+		}
+	}
+
+	for _, name := range cfg.RequiredSecrets {
+		exists, err := secretExists(apiClient, repo, name)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("secret %s: %s", name, err))
+			continue
+		}
+		if !exists {
+			report.MissingSecrets = append(report.MissingSecrets, name)
+		}
+	}
+
+	if len(errs) > 0 {
+		return report, fmt.Errorf("%s", joinErrors(errs))
+	}
+	return report, nil
+}
+
+// branchProtectionDrifted reports whether branch's current protection rule
+// differs from desired on any of the fields this command bundle manages. A
+// repository with no protection rule at all counts as drifted.
+func branchProtectionDrifted(httpClient *http.Client, repo ghrepo.Interface, branch string, desired *BranchProtectionDefault) (bool, error) {
+	current, err := protectionShared.GetProtection(httpClient, repo, branch)
+	if err != nil {
+		if httpErr, ok := err.(api.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			return true, nil
+		}
+		return false, err
+	}
+
+	if current.EnforceAdmins.Enabled != desired.EnforceAdmins {
+		return true, nil
+	}
+	if current.RequiredLinearHistory.Enabled != desired.RequiredLinearHistory {
+		return true, nil
+	}
+
+	desiredReviewCount := desired.RequiredApprovingReviewCount
+	currentReviewCount := 0
+	if current.RequiredPullRequestReviews != nil {
+		currentReviewCount = current.RequiredPullRequestReviews.RequiredApprovingReviewCount
+	}
+	if desiredReviewCount != currentReviewCount {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func joinErrors(errs []string) string {
+	out := errs[0]
+	for _, e := range errs[1:] {
+		out += "; " + e
+	}
+	return out
+}
+
+func printReport(io *iostreams.IOStreams, cs *iostreams.ColorScheme, report *RepoReport) {
+	if report.Error != "" {
+		fmt.Fprintf(io.Out, "%s %s: %s\n", cs.FailureIcon(), report.Repository, report.Error)
+		return
+	}
+
+	if len(report.LabelsApplied) == 0 && !report.BranchProtectionApplied && !report.ActionsPermissionsApplied && len(report.MissingSecrets) == 0 {
+		fmt.Fprintf(io.Out, "%s %s: already up to date\n", cs.SuccessIcon(), report.Repository)
+		return
+	}
+
+	fmt.Fprintf(io.Out, "%s %s\n", cs.SuccessIcon(), report.Repository)
+	if len(report.LabelsApplied) > 0 {
+		fmt.Fprintf(io.Out, "  labels applied: %s\n", joinNames(report.LabelsApplied))
+	}
+	if report.BranchProtectionApplied {
+		fmt.Fprintln(io.Out, "  branch protection updated")
+	}
+	if report.ActionsPermissionsApplied {
+		fmt.Fprintln(io.Out, "  actions permissions updated")
+	}
+	if len(report.MissingSecrets) > 0 {
+		fmt.Fprintf(io.Out, "  %s missing secrets: %s\n", cs.WarningIcon(), joinNames(report.MissingSecrets))
+	}
+}
+
+func joinNames(names []string) string {
+	out := names[0]
+	for _, n := range names[1:] {
+		out += ", " + n
+	}
+	return out
+}
@@ -0,0 +1,214 @@
+package apply
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdApply(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wants    ApplyOptions
+		wantsErr bool
+	}{
+		{
+			name:     "missing config",
+			cli:      "my-org",
+			wantsErr: true,
+		},
+		{
+			name: "config and match",
+			cli:  "my-org --config defaults.yml --match \"service-*\"",
+			wants: ApplyOptions{
+				Org:        "my-org",
+				ConfigFile: "defaults.yml",
+				Match:      "service-*",
+			},
+		},
+		{
+			name: "dry run",
+			cli:  "my-org --config defaults.yml --dry-run",
+			wants: ApplyOptions{
+				Org:        "my-org",
+				ConfigFile: "defaults.yml",
+				DryRun:     true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *ApplyOptions
+			cmd := NewCmdApply(f, func(opts *ApplyOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wants.Org, gotOpts.Org)
+			assert.Equal(t, tt.wants.ConfigFile, gotOpts.ConfigFile)
+			assert.Equal(t, tt.wants.Match, gotOpts.Match)
+			assert.Equal(t, tt.wants.DryRun, gotOpts.DryRun)
+		})
+	}
+}
+
+func TestApplyRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/repos"),
+		httpmock.StringResponse(`[{"name": "repo", "owner": {"login": "my-org"}}]`),
+	)
+	reg.Register(
+		httpmock.REST("GET", "repos/my-org/repo/labels/bug"),
+		httpmock.StatusStringResponse(404, `{"message": "Not Found"}`),
+	)
+	reg.Register(
+		httpmock.REST("POST", "repos/my-org/repo/labels"),
+		httpmock.StatusStringResponse(201, `{}`),
+	)
+	reg.Register(
+		httpmock.REST("GET", "repos/my-org/repo/branches/main/protection"),
+		httpmock.StringResponse(`{
+			"enforce_admins": {"enabled": true},
+			"required_linear_history": {"enabled": true},
+			"required_pull_request_reviews": {"required_approving_review_count": 1}
+		}`),
+	)
+	reg.Register(
+		httpmock.REST("GET", "repos/my-org/repo/actions/permissions"),
+		httpmock.StringResponse(`{"enabled": false, "allowed_actions": "all"}`),
+	)
+	reg.Register(
+		httpmock.REST("PUT", "repos/my-org/repo/actions/permissions"),
+		httpmock.StatusStringResponse(204, ``),
+	)
+	reg.Register(
+		httpmock.REST("GET", "repos/my-org/repo/actions/secrets/DEPLOY_KEY"),
+		httpmock.StatusStringResponse(404, `{"message": "Not Found"}`),
+	)
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(false)
+
+	configFile := writeTempConfig(t, `
+labels:
+  - name: bug
+    color: d73a4a
+    description: Something isn't working
+branch_protection:
+  branch: main
+  enforce_admins: true
+  required_linear_history: true
+  required_approving_review_count: 1
+actions_permissions:
+  enabled: true
+required_secrets:
+  - DEPLOY_KEY
+`)
+
+	opts := &ApplyOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		Org:         "my-org",
+		ConfigFile:  configFile,
+		Concurrency: 1,
+	}
+
+	err := applyRun(opts)
+	require.NoError(t, err)
+
+	out := stdout.String()
+	assert.Contains(t, out, "my-org/repo")
+	assert.Contains(t, out, "labels applied: bug")
+	assert.Contains(t, out, "actions permissions updated")
+	assert.Contains(t, out, "missing secrets: DEPLOY_KEY")
+	assert.NotContains(t, out, "branch protection updated")
+}
+
+func TestApplyRun_dryRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/repos"),
+		httpmock.StringResponse(`[{"name": "repo", "owner": {"login": "my-org"}}]`),
+	)
+	reg.Register(
+		httpmock.REST("GET", "repos/my-org/repo/actions/secrets/DEPLOY_KEY"),
+		httpmock.StatusStringResponse(404, `{"message": "Not Found"}`),
+	)
+
+	ios, _, stdout, _ := iostreams.Test()
+
+	configFile := writeTempConfig(t, `
+required_secrets:
+  - DEPLOY_KEY
+`)
+
+	opts := &ApplyOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		Org:         "my-org",
+		ConfigFile:  configFile,
+		Concurrency: 1,
+		DryRun:      true,
+	}
+
+	err := applyRun(opts)
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "missing secrets: DEPLOY_KEY")
+}
+
+func writeTempConfig(t *testing.T, contents string) string {
+	t.Helper()
+	f, err := os.CreateTemp(t.TempDir(), "repo-defaults-*.yml")
+	require.NoError(t, err)
+	_, err = f.WriteString(contents)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	return f.Name()
+}
@@ -0,0 +1,50 @@
+package apply
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config describes the default configuration bundle to apply across an
+// organization's repositories. Every section is optional; sections left out
+// of the file are skipped entirely rather than reset to zero values.
+type Config struct {
+	Labels             []LabelDefault             `yaml:"labels"`
+	BranchProtection   *BranchProtectionDefault   `yaml:"branch_protection"`
+	ActionsPermissions *ActionsPermissionsDefault `yaml:"actions_permissions"`
+	RequiredSecrets    []string                   `yaml:"required_secrets"`
+}
+
+type LabelDefault struct {
+	Name        string `yaml:"name"`
+	Color       string `yaml:"color"`
+	Description string `yaml:"description"`
+}
+
+type BranchProtectionDefault struct {
+	// Branch defaults to each repository's own default branch when empty.
+	Branch                       string                        `yaml:"branch"`
+	EnforceAdmins                bool                          `yaml:"enforce_admins"`
+	RequiredLinearHistory        bool                          `yaml:"required_linear_history"`
+	RequiredApprovingReviewCount int                           `yaml:"required_approving_review_count"`
+	RequiredStatusChecks         *BranchProtectionStatusChecks `yaml:"required_status_checks"`
+}
+
+type BranchProtectionStatusChecks struct {
+	Strict   bool     `yaml:"strict"`
+	Contexts []string `yaml:"contexts"`
+}
+
+type ActionsPermissionsDefault struct {
+	Enabled        bool   `yaml:"enabled"`
+	AllowedActions string `yaml:"allowed_actions"`
+}
+
+func parseConfig(raw []byte) (*Config, error) {
+	var cfg Config
+	if err := yaml.Unmarshal(raw, &cfg); err != nil {
+		return nil, fmt.Errorf("could not parse config file: %w", err)
+	}
+	return &cfg, nil
+}
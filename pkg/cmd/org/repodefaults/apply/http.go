@@ -0,0 +1,135 @@
+package apply
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	protectionShared "github.com/cli/cli/v2/pkg/cmd/repo/protection/shared"
+)
+
+// labelDrifted reports whether repo's label named label.Name is missing, or
+// present with a different color or description than desired.
+func labelDrifted(apiClient *api.Client, repo ghrepo.Interface, label LabelDefault) (bool, error) {
+	path := fmt.Sprintf("repos/%s/labels/%s", ghrepo.FullName(repo), label.Name)
+	var current struct {
+		Color       string `json:"color"`
+		Description string `json:"description"`
+	}
+	err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &current)
+	if err != nil {
+		if httpErr, ok := err.(api.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			return true, nil
+		}
+		return false, err
+	}
+	return !strings.EqualFold(current.Color, label.Color) || current.Description != label.Description, nil
+}
+
+// applyLabel creates label in repo, or updates its color and description if
+// a label with that name already exists.
+func applyLabel(apiClient *api.Client, repo ghrepo.Interface, label LabelDefault) error {
+	path := fmt.Sprintf("repos/%s/labels", ghrepo.FullName(repo))
+	body, err := json.Marshal(map[string]string{
+		"name":        label.Name,
+		"color":       label.Color,
+		"description": label.Description,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = apiClient.REST(repo.RepoHost(), "POST", path, bytes.NewReader(body), nil)
+	if err == nil {
+		return nil
+	}
+	if !isLabelAlreadyExistsError(err) {
+		return err
+	}
+
+	updatePath := fmt.Sprintf("repos/%s/labels/%s", ghrepo.FullName(repo), label.Name)
+	updateBody, err := json.Marshal(map[string]string{
+		"color":       label.Color,
+		"description": label.Description,
+	})
+	if err != nil {
+		return err
+	}
+	return apiClient.REST(repo.RepoHost(), "PATCH", updatePath, bytes.NewReader(updateBody), nil)
+}
+
+func isLabelAlreadyExistsError(err error) bool {
+	httpErr, ok := err.(api.HTTPError)
+	return ok && httpErr.StatusCode == 422 && len(httpErr.Errors) == 1 &&
+		httpErr.Errors[0].Field == "name" && httpErr.Errors[0].Code == "already_exists"
+}
+
+func applyBranchProtection(apiClient *api.Client, repo ghrepo.Interface, branch string, bp *BranchProtectionDefault) error {
+	input := protectionShared.UpdateInput{
+		EnforceAdmins:         bp.EnforceAdmins,
+		RequiredLinearHistory: bp.RequiredLinearHistory,
+	}
+	if bp.RequiredApprovingReviewCount > 0 {
+		input.RequiredPullRequestReviews = &struct {
+			RequiredApprovingReviewCount int `json:"required_approving_review_count"`
+		}{RequiredApprovingReviewCount: bp.RequiredApprovingReviewCount}
+	}
+	if bp.RequiredStatusChecks != nil {
+		input.RequiredStatusChecks = &struct {
+			Strict   bool     `json:"strict"`
+			Contexts []string `json:"contexts"`
+		}{Strict: bp.RequiredStatusChecks.Strict, Contexts: bp.RequiredStatusChecks.Contexts}
+	}
+
+	body, err := json.Marshal(input)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("repos/%s/branches/%s/protection", ghrepo.FullName(repo), branch)
+	return apiClient.REST(repo.RepoHost(), "PUT", path, bytes.NewReader(body), nil)
+}
+
+type actionsPermissions struct {
+	Enabled        bool   `json:"enabled"`
+	AllowedActions string `json:"allowed_actions,omitempty"`
+}
+
+func getActionsPermissions(apiClient *api.Client, repo ghrepo.Interface) (*actionsPermissions, error) {
+	path := fmt.Sprintf("repos/%s/actions/permissions", ghrepo.FullName(repo))
+	var perms actionsPermissions
+	if err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &perms); err != nil {
+		return nil, err
+	}
+	return &perms, nil
+}
+
+func applyActionsPermissions(apiClient *api.Client, repo ghrepo.Interface, desired *ActionsPermissionsDefault) error {
+	body, err := json.Marshal(actionsPermissions{Enabled: desired.Enabled, AllowedActions: desired.AllowedActions})
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("repos/%s/actions/permissions", ghrepo.FullName(repo))
+	return apiClient.REST(repo.RepoHost(), "PUT", path, bytes.NewReader(body), nil)
+}
+
+// secretExists reports whether a repository-level Actions secret with the
+// given name exists. Secret values are write-only, so this is the closest
+// the API gets to a presence check.
+func secretExists(apiClient *api.Client, repo ghrepo.Interface, name string) (bool, error) {
+	path := fmt.Sprintf("repos/%s/actions/secrets/%s", ghrepo.FullName(repo), name)
+	err := apiClient.REST(repo.RepoHost(), "GET", path, nil, nil)
+	if err == nil {
+		return true, nil
+	}
+
+	if httpErr, ok := err.(api.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	return false, err
+}
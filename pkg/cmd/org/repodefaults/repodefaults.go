@@ -0,0 +1,23 @@
+package repodefaults
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdApply "github.com/cli/cli/v2/pkg/cmd/org/repodefaults/apply"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdRepoDefaults(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "repo-defaults <command>",
+		Short: "Apply a default configuration bundle across repositories",
+		Long:  "Apply a shared bundle of labels, branch protection, Actions permissions, and required secrets across an organization's repositories.",
+		Example: heredoc.Doc(`
+			$ gh org repo-defaults apply my-org --config defaults.yml
+		`),
+	}
+
+	cmd.AddCommand(cmdApply.NewCmdApply(f, nil))
+
+	return cmd
+}
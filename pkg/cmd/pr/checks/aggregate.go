@@ -19,6 +19,7 @@ type check struct {
 	Event       string    `json:"event"`
 	Workflow    string    `json:"workflow"`
 	Description string    `json:"description"`
+	RollupState string    `json:"rollupState"`
 }
 
 type checkCounts struct {
@@ -27,6 +28,7 @@ type checkCounts struct {
 	Pending  int
 	Skipping int
 	Canceled int
+	Neutral  int
 }
 
 func (ch *check) ExportData(fields []string) map[string]interface{} {
@@ -76,6 +78,9 @@ func aggregateChecks(checkContexts []api.CheckContext, requiredChecks bool) (che
 		case "SKIPPED", "NEUTRAL":
 			item.Bucket = "skipping"
 			counts.Skipping++
+			if state == "NEUTRAL" {
+				counts.Neutral++
+			}
 		case "ERROR", "FAILURE", "TIMED_OUT", "ACTION_REQUIRED":
 			item.Bucket = "fail"
 			counts.Failed++
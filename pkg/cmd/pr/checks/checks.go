@@ -11,6 +11,7 @@ import (
 	"github.com/cli/cli/v2/internal/browser"
 	fd "github.com/cli/cli/v2/internal/featuredetection"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/notify"
 	"github.com/cli/cli/v2/internal/text"
 	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -30,8 +31,11 @@ var prCheckFields = []string{
 	"event",
 	"workflow",
 	"description",
+	"rollupState",
 }
 
+var validFailOnConditions = []string{"failure", "pending", "neutral"}
+
 type ChecksOptions struct {
 	HttpClient func() (*http.Client, error)
 	IO         *iostreams.IOStreams
@@ -40,6 +44,7 @@ type ChecksOptions struct {
 
 	Finder   shared.PRFinder
 	Detector fd.Detector
+	Notifier notify.Notifier
 
 	SelectorArg string
 	WebMode     bool
@@ -47,10 +52,13 @@ type ChecksOptions struct {
 	Watch       bool
 	FailFast    bool
 	Required    bool
+	Notify      bool
+	FailOn      []string
 }
 
 func NewCmdChecks(f *cmdutil.Factory, runF func(*ChecksOptions) error) *cobra.Command {
 	var interval int
+	var requiredDeprecated bool
 	opts := &ChecksOptions{
 		HttpClient: f.HttpClient,
 		IO:         f.IOStreams,
@@ -66,6 +74,10 @@ func NewCmdChecks(f *cmdutil.Factory, runF func(*ChecksOptions) error) *cobra.Co
 
 			Without an argument, the pull request that belongs to the current branch
 			is selected.
+
+			Exit status is governed by --fail-on, which defaults to "failure,pending" and
+			accepts any combination of "failure", "pending", and "neutral" so that a merge
+			script can gate on exactly the conditions branch protection uses.
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -84,6 +96,10 @@ func NewCmdChecks(f *cmdutil.Factory, runF func(*ChecksOptions) error) *cobra.Co
 				return cmdutil.FlagErrorf("cannot use `--interval` flag without `--watch` flag")
 			}
 
+			if opts.Notify && !opts.Watch {
+				return cmdutil.FlagErrorf("cannot use `--notify` flag without `--watch` flag")
+			}
+
 			if intervalChanged {
 				var err error
 				opts.Interval, err = time.ParseDuration(fmt.Sprintf("%ds", interval))
@@ -92,6 +108,10 @@ func NewCmdChecks(f *cmdutil.Factory, runF func(*ChecksOptions) error) *cobra.Co
 				}
 			}
 
+			if requiredDeprecated {
+				opts.Required = true
+			}
+
 			if len(args) > 0 {
 				opts.SelectorArg = args[0]
 			}
@@ -108,7 +128,11 @@ func NewCmdChecks(f *cmdutil.Factory, runF func(*ChecksOptions) error) *cobra.Co
 	cmd.Flags().BoolVarP(&opts.Watch, "watch", "", false, "Watch checks until they finish")
 	cmd.Flags().BoolVarP(&opts.FailFast, "fail-fast", "", false, "Exit watch mode on first check failure")
 	cmd.Flags().IntVarP(&interval, "interval", "i", 10, "Refresh interval in seconds when using `--watch` flag")
-	cmd.Flags().BoolVar(&opts.Required, "required", false, "Only show checks that are required")
+	cmd.Flags().BoolVar(&opts.Required, "required-only", false, "Only show checks that are required")
+	cmd.Flags().BoolVar(&requiredDeprecated, "required", false, "Only show checks that are required")
+	_ = cmd.Flags().MarkDeprecated("required", "use `--required-only` instead")
+	cmdutil.StringSliceEnumFlag(cmd, &opts.FailOn, "fail-on", "", nil, validFailOnConditions, "Conditions that cause a non-zero exit status (default: failure,pending)")
+	cmd.Flags().BoolVar(&opts.Notify, "notify", false, "Send a desktop notification when watched checks finish, requires `--watch` flag")
 
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, prCheckFields)
 
@@ -177,6 +201,10 @@ func checksRun(opts *ChecksOptions) error {
 	}
 
 	if opts.Exporter != nil {
+		state := rollupState(counts)
+		for i := range checks {
+			checks[i].RollupState = state
+		}
 		return opts.Exporter.Write(opts.IO, checks)
 	}
 
@@ -233,13 +261,43 @@ func checksRun(opts *ChecksOptions) error {
 		if err != nil {
 			return err
 		}
+
+		if opts.Notify {
+			notifier := opts.Notifier
+			if notifier == nil {
+				notifier = notify.New()
+			}
+			if err := notifier.Notify(fmt.Sprintf("Checks finished for #%d", pr.Number), checksSummaryLine(counts)); err != nil {
+				fmt.Fprintf(opts.IO.ErrOut, "failed to send notification: %v\n", err)
+			}
+		}
+	}
+
+	return checkFailOn(opts.FailOn, counts)
+}
+
+// checkFailOn reports whether the conditions selected via --fail-on were met by counts, returning the
+// appropriate exit error if so. An empty failOn falls back to the historical default of failing on
+// check failures and pending checks.
+func checkFailOn(failOn []string, counts checkCounts) error {
+	if len(failOn) == 0 {
+		failOn = []string{"failure", "pending"}
 	}
 
-	if counts.Failed > 0 {
+	conditions := map[string]bool{}
+	for _, c := range failOn {
+		conditions[c] = true
+	}
+
+	if conditions["failure"] && counts.Failed > 0 {
 		return cmdutil.SilentError
-	} else if counts.Pending > 0 {
+	}
+	if conditions["pending"] && counts.Pending > 0 {
 		return cmdutil.PendingError
 	}
+	if conditions["neutral"] && counts.Neutral > 0 {
+		return cmdutil.SilentError
+	}
 
 	return nil
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/cli/cli/v2/internal/browser"
 	fd "github.com/cli/cli/v2/internal/featuredetection"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/notify"
 	"github.com/cli/cli/v2/internal/run"
 	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -86,6 +87,41 @@ func TestNewCmdChecks(t *testing.T) {
 				Interval: time.Duration(10000000000),
 			},
 		},
+		{
+			name: "required-only flag",
+			cli:  "--required-only",
+			wants: ChecksOptions{
+				Required: true,
+				Interval: time.Duration(10000000000),
+			},
+		},
+		{
+			name: "fail-on flag",
+			cli:  "--fail-on failure --fail-on neutral",
+			wants: ChecksOptions{
+				FailOn:   []string{"failure", "neutral"},
+				Interval: time.Duration(10000000000),
+			},
+		},
+		{
+			name:       "invalid fail-on flag",
+			cli:        "--fail-on bogus",
+			wantsError: `invalid argument "bogus" for "--fail-on" flag: valid values are {failure|pending|neutral}`,
+		},
+		{
+			name: "watch with notify flag",
+			cli:  "--watch --notify",
+			wants: ChecksOptions{
+				Watch:    true,
+				Notify:   true,
+				Interval: time.Duration(10000000000),
+			},
+		},
+		{
+			name:       "notify flag without watch flag",
+			cli:        "--notify",
+			wantsError: "cannot use `--notify` flag without `--watch` flag",
+		},
 	}
 
 	for _, tt := range tests {
@@ -119,6 +155,10 @@ func TestNewCmdChecks(t *testing.T) {
 			assert.Equal(t, tt.wants.Interval, gotOpts.Interval)
 			assert.Equal(t, tt.wants.Required, gotOpts.Required)
 			assert.Equal(t, tt.wants.FailFast, gotOpts.FailFast)
+			assert.Equal(t, tt.wants.Notify, gotOpts.Notify)
+			if tt.wants.FailOn != nil {
+				assert.Equal(t, tt.wants.FailOn, gotOpts.FailOn)
+			}
 		})
 	}
 }
@@ -661,6 +701,102 @@ func TestChecksRun_web(t *testing.T) {
 	}
 }
 
+func TestChecksRun_notify(t *testing.T) {
+	ios, _, _, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query PullRequestStatusChecks\b`),
+		httpmock.FileResponse("./fixtures/allPassing.json"),
+	)
+
+	notifier := &notify.Stub{}
+
+	opts := &ChecksOptions{
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		IO:          ios,
+		SelectorArg: "123",
+		Finder:      shared.NewMockFinder("123", &api.PullRequest{Number: 123, HeadRefName: "trunk"}, ghrepo.New("OWNER", "REPO")),
+		Detector:    &fd.EnabledDetectorMock{},
+		Watch:       true,
+		Notify:      true,
+		Notifier:    notifier,
+	}
+
+	err := checksRun(opts)
+	assert.NoError(t, err)
+	assert.True(t, notifier.Notified())
+}
+
+func TestCheckFailOn(t *testing.T) {
+	tests := []struct {
+		name    string
+		failOn  []string
+		counts  checkCounts
+		wantErr error
+	}{
+		{
+			name:    "default fails on failure",
+			counts:  checkCounts{Failed: 1},
+			wantErr: cmdutil.SilentError,
+		},
+		{
+			name:    "default fails on pending",
+			counts:  checkCounts{Pending: 1},
+			wantErr: cmdutil.PendingError,
+		},
+		{
+			name:   "default ignores neutral",
+			counts: checkCounts{Neutral: 1},
+		},
+		{
+			name:    "fail-on neutral",
+			failOn:  []string{"neutral"},
+			counts:  checkCounts{Neutral: 1},
+			wantErr: cmdutil.SilentError,
+		},
+		{
+			name:   "fail-on neutral ignores pending",
+			failOn: []string{"neutral"},
+			counts: checkCounts{Pending: 1},
+		},
+		{
+			name:   "all successful",
+			counts: checkCounts{Passed: 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkFailOn(tt.failOn, tt.counts)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestRollupState(t *testing.T) {
+	tests := []struct {
+		name   string
+		counts checkCounts
+		want   string
+	}{
+		{name: "all passed", counts: checkCounts{Passed: 2}, want: "SUCCESS"},
+		{name: "some failed", counts: checkCounts{Passed: 1, Failed: 1}, want: "FAILURE"},
+		{name: "some cancelled", counts: checkCounts{Passed: 1, Canceled: 1}, want: "FAILURE"},
+		{name: "some pending", counts: checkCounts{Passed: 1, Pending: 1}, want: "PENDING"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, rollupState(tt.counts))
+		})
+	}
+}
+
 func TestEliminateDuplicates(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -66,6 +66,29 @@ func addRow(tp *tableprinter.TablePrinter, io *iostreams.IOStreams, o check) {
 	tp.EndRow()
 }
 
+// checksSummaryLine describes the check counts in a single sentence, suitable for a desktop
+// notification body where a multi-line summary wouldn't render well.
+func checksSummaryLine(counts checkCounts) string {
+	if counts.Failed > 0 {
+		return "Some checks were not successful"
+	} else if counts.Pending > 0 {
+		return "Some checks are still pending"
+	} else if counts.Canceled > 0 {
+		return "Some checks were cancelled"
+	}
+	return "All checks were successful"
+}
+
+// rollupState summarizes the overall check status as a single value, for use in --json output.
+func rollupState(counts checkCounts) string {
+	if counts.Failed > 0 || counts.Canceled > 0 {
+		return "FAILURE"
+	} else if counts.Pending > 0 {
+		return "PENDING"
+	}
+	return "SUCCESS"
+}
+
 func printSummary(io *iostreams.IOStreams, counts checkCounts) {
 	summary := ""
 	if counts.Failed+counts.Passed+counts.Skipping+counts.Pending > 0 {
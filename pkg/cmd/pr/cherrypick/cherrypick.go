@@ -0,0 +1,196 @@
+package cherrypick
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+// backportLabelPrefix is the convention used to drive backport targets from PR labels when
+// `--to` isn't given, e.g. a label named "backport release-1.2" targets the "release-1.2" branch.
+const backportLabelPrefix = "backport "
+
+type CherryPickOptions struct {
+	HttpClient func() (*http.Client, error)
+	GitClient  *git.Client
+	IO         *iostreams.IOStreams
+
+	Finder shared.PRFinder
+
+	SelectorArg string
+	To          []string
+}
+
+func NewCmdCherryPick(f *cmdutil.Factory, runF func(*CherryPickOptions) error) *cobra.Command {
+	opts := &CherryPickOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		GitClient:  f.GitClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "cherry-pick {<number> | <url> | <branch>}",
+		Short: "Cherry-pick a merged pull request onto other branches",
+		Long: heredoc.Doc(`
+			Cherry-pick a merged pull request's commit onto one or more target branches,
+			opening a backport pull request against each one.
+
+			Target branches are taken from one or more --to flags. If no --to flag is
+			given, targets are derived from labels on the pull request of the form
+			"backport <branch>", e.g. a label named "backport release-1.2" backports to
+			the "release-1.2" branch.
+
+			Each backport is created on a "backport/<target>/<number>" branch.
+		`),
+		Example: heredoc.Doc(`
+			# Cherry-pick PR 123 onto two release branches
+			$ gh pr cherry-pick 123 --to release-1.2 --to release-1.3
+
+			# Cherry-pick PR 123 onto whatever branches its backport labels specify
+			$ gh pr cherry-pick 123
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot cherry-pick pull request: number, url, or branch required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Finder = shared.NewFinder(f)
+			opts.SelectorArg = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return cherryPickRun(opts)
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&opts.To, "to", nil, "Branch to cherry-pick onto (can be specified multiple times)")
+
+	return cmd
+}
+
+func cherryPickRun(opts *CherryPickOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	findOptions := shared.FindOptions{
+		Selector: opts.SelectorArg,
+		Fields:   []string{"number", "title", "state", "baseRefName", "headRefName", "mergeCommit", "url", "labels"},
+	}
+	pr, baseRepo, err := opts.Finder.Find(findOptions)
+	if err != nil {
+		return err
+	}
+
+	if pr.State != "MERGED" {
+		return fmt.Errorf("can't cherry-pick pull request #%d (%s) because it was not merged", pr.Number, pr.Title)
+	}
+	if pr.MergeCommit == nil || pr.MergeCommit.OID == "" {
+		return fmt.Errorf("can't cherry-pick pull request #%d (%s): no merge commit found", pr.Number, pr.Title)
+	}
+
+	targets := opts.To
+	if len(targets) == 0 {
+		targets = backportTargetsFromLabels(pr.Labels.Names())
+	}
+	if len(targets) == 0 {
+		return fmt.Errorf("no target branches specified: use --to, or add a label of the form %q", backportLabelPrefix+"<branch>")
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	repo, err := api.GitHubRepo(client, baseRepo)
+	if err != nil {
+		return err
+	}
+
+	var failed bool
+	for _, target := range targets {
+		opts.IO.StartProgressIndicator()
+		backportPR, err := cherryPickOntoBranch(opts.GitClient, client, repo, baseRepo, pr, target)
+		opts.IO.StopProgressIndicator()
+
+		if err != nil {
+			failed = true
+			fmt.Fprintf(opts.IO.ErrOut, "%s Failed to cherry-pick #%d onto %s: %s\n", cs.FailureIcon(), pr.Number, target, err)
+			continue
+		}
+
+		fmt.Fprintf(opts.IO.Out, "%s Created backport pull request %s for %s onto %s\n", cs.SuccessIconWithColor(cs.Green), cs.Cyanf("#%d", backportPR.Number), cs.Cyanf("#%d", pr.Number), target)
+		fmt.Fprintln(opts.IO.Out, backportPR.URL)
+	}
+
+	if failed {
+		return cmdutil.SilentError
+	}
+
+	return nil
+}
+
+// backportTargetsFromLabels extracts target branch names from labels of the form
+// "backport <branch>".
+func backportTargetsFromLabels(labels []string) []string {
+	var targets []string
+	for _, l := range labels {
+		if branch, ok := strings.CutPrefix(l, backportLabelPrefix); ok && branch != "" {
+			targets = append(targets, branch)
+		}
+	}
+	return targets
+}
+
+func cherryPickOntoBranch(gitClient *git.Client, client *api.Client, repo *api.Repository, baseRepo ghrepo.Interface, pr *api.PullRequest, target string) (*api.PullRequest, error) {
+	ctx := context.Background()
+
+	if err := gitClient.Fetch(ctx, "origin", target); err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", target, err)
+	}
+
+	backportBranch := fmt.Sprintf("backport/%s/%d", target, pr.Number)
+
+	checkoutCmd, err := gitClient.Command(ctx, "checkout", "-b", backportBranch, fmt.Sprintf("origin/%s", target))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := checkoutCmd.Output(); err != nil {
+		return nil, fmt.Errorf("failed to create branch %s: %w", backportBranch, err)
+	}
+
+	pickCmd, err := gitClient.Command(ctx, "cherry-pick", "-x", "-m", "1", pr.MergeCommit.OID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := pickCmd.Output(); err != nil {
+		// The merge commit might not actually be a merge (e.g. a squash merge), in
+		// which case `-m 1` is invalid and a plain cherry-pick is what's needed instead.
+		plainPickCmd, err := gitClient.Command(ctx, "cherry-pick", "-x", pr.MergeCommit.OID)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := plainPickCmd.Output(); err != nil {
+			return nil, fmt.Errorf("failed to cherry-pick commit %s onto %s: %w", pr.MergeCommit.OID, target, err)
+		}
+	}
+
+	if err := gitClient.Push(ctx, "origin", fmt.Sprintf("HEAD:%s", backportBranch)); err != nil {
+		return nil, fmt.Errorf("failed to push branch %s: %w", backportBranch, err)
+	}
+
+	params := map[string]interface{}{
+		"title":       fmt.Sprintf("[Backport %s] %s", target, pr.Title),
+		"body":        fmt.Sprintf("Backports #%d onto `%s`.", pr.Number, target),
+		"baseRefName": target,
+		"headRefName": backportBranch,
+	}
+	return api.CreatePullRequest(client, repo, params)
+}
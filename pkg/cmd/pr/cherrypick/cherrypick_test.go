@@ -0,0 +1,141 @@
+package cherrypick
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/run"
+	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/test"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func runCommand(rt http.RoundTripper, isTTY bool, cli string) (*test.CmdOut, error) {
+	ios, _, stdout, stderr := iostreams.Test()
+	ios.SetStdoutTTY(isTTY)
+	ios.SetStdinTTY(isTTY)
+	ios.SetStderrTTY(isTTY)
+
+	factory := &cmdutil.Factory{
+		IOStreams: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: rt}, nil
+		},
+		GitClient: &git.Client{GitPath: "some/path/git"},
+	}
+
+	cmd := NewCmdCherryPick(factory, nil)
+
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+
+	_, err = cmd.ExecuteC()
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+func TestNoArgs(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	_, err := runCommand(http, true, "")
+
+	assert.EqualError(t, err, "cannot cherry-pick pull request: number, url, or branch required")
+}
+
+func TestCherryPickRun_notMerged(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	baseRepo, err := ghrepo.FromFullName("OWNER/REPO")
+	assert.NoError(t, err)
+	pr := &api.PullRequest{Number: 96, Title: "The title of the PR", State: "OPEN"}
+	shared.RunCommandFinder("96", pr, baseRepo)
+
+	_, err = runCommand(http, true, "96 --to release-1.2")
+	assert.EqualError(t, err, "can't cherry-pick pull request #96 (The title of the PR) because it was not merged")
+}
+
+func TestCherryPickRun_noTargets(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	baseRepo, err := ghrepo.FromFullName("OWNER/REPO")
+	assert.NoError(t, err)
+	pr := &api.PullRequest{
+		Number:      96,
+		Title:       "The title of the PR",
+		State:       "MERGED",
+		BaseRefName: "main",
+		HeadRefName: "feature",
+		MergeCommit: &api.Commit{OID: "abc123"},
+	}
+	shared.RunCommandFinder("96", pr, baseRepo)
+
+	_, err = runCommand(http, true, "96")
+	assert.EqualError(t, err, `no target branches specified: use --to, or add a label of the form "backport <branch>"`)
+}
+
+func TestCherryPickRun_toFlag(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	baseRepo, err := ghrepo.FromFullName("OWNER/REPO")
+	assert.NoError(t, err)
+	pr := &api.PullRequest{
+		Number:      96,
+		Title:       "The title of the PR",
+		State:       "MERGED",
+		BaseRefName: "main",
+		HeadRefName: "feature",
+		MergeCommit: &api.Commit{OID: "abc123"},
+	}
+	shared.RunCommandFinder("96", pr, baseRepo)
+
+	http.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`{"data":{"repository":{"id":"THE-REPO-ID","name":"REPO","owner":{"login":"OWNER"},"defaultBranchRef":{"name":"main"}}}}`),
+	)
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestCreate\b`),
+		httpmock.GraphQLMutation(`{"data":{"createPullRequest":{"pullRequest":{"id":"NEW-ID","number":98,"url":"https://github.com/OWNER/REPO/pull/98"}}}}`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "release-1.2", inputs["baseRefName"])
+				assert.Equal(t, "backport/release-1.2/96", inputs["headRefName"])
+			}),
+	)
+
+	cs, restoreRun := run.Stub()
+	defer restoreRun(t)
+	cs.Register(`git fetch origin release-1\.2`, 0, "")
+	cs.Register(`git checkout -b backport/release-1\.2/96 origin/release-1\.2`, 0, "")
+	cs.Register(`git cherry-pick -x -m 1 abc123`, 0, "")
+	cs.Register(`git push --set-upstream origin HEAD:backport/release-1\.2/96`, 0, "")
+
+	output, err := runCommand(http, true, "96 --to release-1.2")
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Created backport pull request #98 for #96 onto release-1.2\nhttps://github.com/OWNER/REPO/pull/98\n", output.String())
+}
+
+func TestBackportTargetsFromLabels(t *testing.T) {
+	targets := backportTargetsFromLabels([]string{"bug", "backport release-1.2", "backport release-1.3", "enhancement"})
+	assert.Equal(t, []string{"release-1.2", "release-1.3"}, targets)
+}
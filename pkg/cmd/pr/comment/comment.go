@@ -42,7 +42,7 @@ func NewCmdComment(f *cmdutil.Factory, runF func(*shared.CommentableOptions) err
 				selector = args[0]
 			}
 			fields := []string{"id", "url"}
-			if opts.EditLast {
+			if opts.EditLast || opts.DeleteLast {
 				fields = append(fields, "comments")
 			}
 			finder := shared.NewFinder(f)
@@ -75,6 +75,7 @@ func NewCmdComment(f *cmdutil.Factory, runF func(*shared.CommentableOptions) err
 	cmd.Flags().BoolP("editor", "e", false, "Skip prompts and open the text editor to write the body in")
 	cmd.Flags().BoolP("web", "w", false, "Open the web browser to write the comment")
 	cmd.Flags().BoolVar(&opts.EditLast, "edit-last", false, "Edit the last comment of the same author")
+	cmd.Flags().BoolVar(&opts.DeleteLast, "delete-last", false, "Delete the last comment of the same author")
 
 	return cmd
 }
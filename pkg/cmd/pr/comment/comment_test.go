@@ -245,6 +245,17 @@ func Test_commentRun(t *testing.T) {
 			},
 			stdout: "https://github.com/OWNER/REPO/pull/123#issuecomment-111\n",
 		},
+		{
+			name: "delete last",
+			input: &shared.CommentableOptions{
+				Interactive: false,
+				DeleteLast:  true,
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				mockCommentDelete(t, reg)
+			},
+			stdout: "Deleted comment https://github.com/OWNER/REPO/pull/123#issuecomment-111\n",
+		},
 		{
 			name: "non-interactive web",
 			input: &shared.CommentableOptions{
@@ -372,6 +383,17 @@ func mockCommentCreate(t *testing.T, reg *httpmock.Registry) {
 	)
 }
 
+func mockCommentDelete(t *testing.T, reg *httpmock.Registry) {
+	reg.Register(
+		httpmock.GraphQL(`mutation CommentDelete\b`),
+		httpmock.GraphQLMutation(`
+		{ "data": { "deleteIssueComment": { "clientMutationId": "" } } }`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "id1", inputs["id"])
+			}),
+	)
+}
+
 func mockCommentUpdate(t *testing.T, reg *httpmock.Registry) {
 	reg.Register(
 		httpmock.GraphQL(`mutation CommentUpdate\b`),
@@ -0,0 +1,112 @@
+package create
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/cli/cli/v2/git"
+)
+
+// releaseBranchPattern matches remote-tracking branches that look like release branches, e.g.
+// "origin/release/3.2" or "origin/v3.2". These are the most common case of a branch that a PR
+// author forked from instead of the repository's default base branch.
+var releaseBranchPattern = regexp.MustCompile(`(?i)/(releases?/|v?\d+\.\d+)`)
+
+// checkBaseBranch looks for two problems with the chosen base branch before the title/body survey
+// is shown: whether the head branch would conflict with it, and whether the head branch was more
+// likely forked from a release branch than from the current base. When a more appropriate base is
+// found and the user is prompted interactively, they're offered the chance to switch to it.
+//
+// Both checks are best-effort: any git error is treated as "nothing to report" rather than failing
+// the command, since this is a convenience on top of pull request creation, not a requirement for it.
+func checkBaseBranch(ctx *CreateContext, opts *CreateOptions) {
+	remote, _, ok := strings.Cut(ctx.BaseTrackingBranch, "/")
+	if !ok {
+		return
+	}
+
+	gitClient := ctx.GitClient
+	cs := opts.IO.ColorScheme()
+
+	if conflicts, err := gitClient.HasMergeConflicts(context.Background(), ctx.BaseTrackingBranch, ctx.HeadBranch); err == nil && conflicts {
+		fmt.Fprintf(opts.IO.ErrOut, "%s %s has conflicts with %s; you may want to resolve them before continuing\n",
+			cs.WarningIcon(), ctx.HeadBranch, ctx.BaseBranch)
+	}
+
+	candidate, err := suggestedBaseBranch(gitClient, remote, ctx.BaseTrackingBranch, ctx.HeadBranch)
+	if err != nil || candidate == "" {
+		return
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "%s %s looks like it was forked from %s, not %s\n",
+		cs.WarningIcon(), ctx.HeadBranch, candidate, ctx.BaseBranch)
+
+	if !opts.IO.CanPrompt() {
+		return
+	}
+
+	switchBase, err := opts.Prompter.Confirm(fmt.Sprintf("Use %q as the base branch instead?", candidate), false)
+	if err != nil || !switchBase {
+		return
+	}
+
+	ctx.BaseBranch = candidate
+	ctx.BaseTrackingBranch = remote + "/" + candidate
+}
+
+// suggestedBaseBranch looks among the release-looking branches tracked from remote for one that the
+// head branch was forked from more recently than it was forked from base, and returns its short
+// branch name. It returns an empty string when no such branch is found.
+func suggestedBaseBranch(gitClient *git.Client, remote, base, head string) (string, error) {
+	ctx := context.Background()
+
+	candidates, err := releaseBranches(gitClient, remote)
+	if err != nil || len(candidates) == 0 {
+		return "", err
+	}
+
+	baseMergeBase, err := gitClient.MergeBase(ctx, base, head)
+	if err != nil {
+		return "", err
+	}
+
+	for _, candidate := range candidates {
+		if candidate == base {
+			continue
+		}
+
+		candidateMergeBase, err := gitClient.MergeBase(ctx, candidate, head)
+		if err != nil || candidateMergeBase == baseMergeBase {
+			continue
+		}
+
+		if newer, err := gitClient.IsAncestor(ctx, baseMergeBase, candidateMergeBase); err == nil && newer {
+			return strings.TrimPrefix(candidate, remote+"/"), nil
+		}
+	}
+
+	return "", nil
+}
+
+// releaseBranches returns the remote-tracking branches of remote whose name looks like a release
+// branch.
+func releaseBranches(gitClient *git.Client, remote string) ([]string, error) {
+	cmd, err := gitClient.Command(context.Background(), "for-each-ref", "--format=%(refname:short)", "refs/remotes/"+remote+"/")
+	if err != nil {
+		return nil, err
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	var branches []string
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		if line != "" && releaseBranchPattern.MatchString(line) {
+			branches = append(branches, line)
+		}
+	}
+	return branches, nil
+}
@@ -0,0 +1,96 @@
+package create
+
+import (
+	"testing"
+
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/prompter"
+	"github.com/cli/cli/v2/internal/run"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_checkBaseBranch(t *testing.T) {
+	tests := []struct {
+		name            string
+		cmdStubs        func(*run.CommandStubber)
+		confirmSwitch   bool
+		wantBaseBranch  string
+		wantErrContains string
+	}{
+		{
+			name: "no conflicts and no better base",
+			cmdStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git merge-tree --write-tree origin/master feature`, 0, "")
+				cs.Register(`git for-each-ref --format=%\(refname:short\) refs/remotes/origin/`, 0, "")
+			},
+			wantBaseBranch: "master",
+		},
+		{
+			name: "offers and accepts a release branch as a better base",
+			cmdStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git merge-tree --write-tree origin/master feature`, 0, "")
+				cs.Register(`git for-each-ref --format=%\(refname:short\) refs/remotes/origin/`, 0, "origin/master\norigin/release/1.2\n")
+				cs.Register(`git merge-base origin/master feature`, 0, "aaa\n")
+				cs.Register(`git merge-base origin/release/1.2 feature`, 0, "bbb\n")
+				cs.Register(`git merge-base --is-ancestor aaa bbb`, 0, "")
+			},
+			confirmSwitch:   true,
+			wantBaseBranch:  "release/1.2",
+			wantErrContains: "feature looks like it was forked from release/1.2, not master",
+		},
+		{
+			name: "offers but declines a release branch as a better base",
+			cmdStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git merge-tree --write-tree origin/master feature`, 0, "")
+				cs.Register(`git for-each-ref --format=%\(refname:short\) refs/remotes/origin/`, 0, "origin/master\norigin/release/1.2\n")
+				cs.Register(`git merge-base origin/master feature`, 0, "aaa\n")
+				cs.Register(`git merge-base origin/release/1.2 feature`, 0, "bbb\n")
+				cs.Register(`git merge-base --is-ancestor aaa bbb`, 0, "")
+			},
+			confirmSwitch:   false,
+			wantBaseBranch:  "master",
+			wantErrContains: "feature looks like it was forked from release/1.2, not master",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs, cmdTeardown := run.Stub()
+			defer cmdTeardown(t)
+			tt.cmdStubs(cs)
+
+			ios, _, _, stderr := iostreams.Test()
+			ios.SetStdinTTY(true)
+			ios.SetStdoutTTY(true)
+
+			pm := &prompter.PrompterMock{
+				ConfirmFunc: func(string, bool) (bool, error) {
+					return tt.confirmSwitch, nil
+				},
+			}
+
+			ctx := &CreateContext{
+				BaseBranch:         "master",
+				BaseTrackingBranch: "origin/master",
+				HeadBranch:         "feature",
+				GitClient: &git.Client{
+					GhPath:  "some/path/gh",
+					GitPath: "some/path/git",
+				},
+			}
+			opts := &CreateOptions{
+				IO:       ios,
+				Prompter: pm,
+			}
+
+			checkBaseBranch(ctx, opts)
+
+			assert.Equal(t, tt.wantBaseBranch, ctx.BaseBranch)
+			if tt.wantErrContains == "" {
+				assert.Empty(t, stderr.String())
+			} else {
+				assert.Contains(t, stderr.String(), tt.wantErrContains)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"os"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -46,11 +47,12 @@ type CreateOptions struct {
 	RootDirOverride string
 	RepoOverride    string
 
-	Autofill    bool
-	FillVerbose bool
-	FillFirst   bool
-	WebMode     bool
-	RecoverFile string
+	Autofill      bool
+	FillVerbose   bool
+	FillFirst     bool
+	FillChangelog bool
+	WebMode       bool
+	RecoverFile   string
 
 	IsDraft    bool
 	Title      string
@@ -125,6 +127,10 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 
 			Adding a pull request to projects requires authorization with the %[1]sproject%[1]s scope.
 			To authorize, run %[1]sgh auth refresh -s project%[1]s.
+
+			A file passed to %[1]s--body-file%[1]s may begin with a YAML front matter block
+			(%[1]stitle%[1]s, %[1]slabels%[1]s, %[1]sassignees%[1]s, %[1]smilestone%[1]s, %[1]sprojects%[1]s) to set those
+			fields from the file as well; any matching flag takes precedence over the front matter.
 		`, "`"),
 		Example: heredoc.Doc(`
 			$ gh pr create --title "The bug is fixed" --body "Everything works again"
@@ -177,6 +183,10 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 				return cmdutil.FlagErrorf("`--fill-verbose` is not supported with `--fill`")
 			}
 
+			if opts.FillChangelog && (opts.FillVerbose || opts.Autofill || opts.FillFirst) {
+				return cmdutil.FlagErrorf("`--fill-changelog` is not supported with `--fill-verbose`, `--fill`, or `--fill-first`")
+			}
+
 			opts.BodyProvided = cmd.Flags().Changed("body")
 			if bodyFile != "" {
 				b, err := cmdutil.ReadFile(bodyFile, opts.IO.In)
@@ -185,13 +195,33 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 				}
 				opts.Body = string(b)
 				opts.BodyProvided = true
+
+				if fm, rest, ok := shared.ExtractFrontmatter(opts.Body); ok {
+					opts.Body = rest
+					if !opts.TitleProvided && fm.Title != "" {
+						opts.Title = fm.Title
+						opts.TitleProvided = true
+					}
+					if !cmd.Flags().Changed("assignee") && len(fm.Assignees) > 0 {
+						opts.Assignees = fm.Assignees
+					}
+					if !cmd.Flags().Changed("label") && len(fm.Labels) > 0 {
+						opts.Labels = fm.Labels
+					}
+					if !cmd.Flags().Changed("project") && len(fm.Projects) > 0 {
+						opts.Projects = fm.Projects
+					}
+					if !cmd.Flags().Changed("milestone") && fm.Milestone != "" {
+						opts.Milestone = fm.Milestone
+					}
+				}
 			}
 
 			if opts.Template != "" && opts.BodyProvided {
 				return cmdutil.FlagErrorf("`--template` is not supported when using `--body` or `--body-file`")
 			}
 
-			if !opts.IO.CanPrompt() && !opts.WebMode && !(opts.FillVerbose || opts.Autofill || opts.FillFirst) && (!opts.TitleProvided || !opts.BodyProvided) {
+			if !opts.IO.CanPrompt() && !opts.WebMode && !(opts.FillVerbose || opts.Autofill || opts.FillFirst || opts.FillChangelog) && (!opts.TitleProvided || !opts.BodyProvided) {
 				return cmdutil.FlagErrorf("must provide `--title` and `--body` (or `--fill` or `fill-first` or `--fillverbose`) when not running interactively")
 			}
 
@@ -217,6 +247,7 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 	fl.BoolVarP(&opts.FillVerbose, "fill-verbose", "", false, "Use commits msg+body for description")
 	fl.BoolVarP(&opts.Autofill, "fill", "f", false, "Use commit info for title and body")
 	fl.BoolVar(&opts.FillFirst, "fill-first", false, "Use first commit info for title and body")
+	fl.BoolVar(&opts.FillChangelog, "fill-changelog", false, "Use a structured changelog grouped by commit type for the body")
 	fl.StringSliceVarP(&opts.Reviewers, "reviewer", "r", nil, "Request reviews from people or teams by their `handle`")
 	fl.StringSliceVarP(&opts.Assignees, "assignee", "a", nil, "Assign people by their `login`. Use \"@me\" to self-assign.")
 	fl.StringSliceVarP(&opts.Labels, "label", "l", nil, "Add labels by `name`")
@@ -315,7 +346,7 @@ func createRun(opts *CreateOptions) (err error) {
 			ghrepo.FullName(ctx.BaseRepo))
 	}
 
-	if opts.FillVerbose || opts.Autofill || opts.FillFirst || (opts.TitleProvided && opts.BodyProvided) {
+	if opts.FillVerbose || opts.Autofill || opts.FillFirst || opts.FillChangelog || (opts.TitleProvided && opts.BodyProvided) {
 		err = handlePush(*opts, *ctx)
 		if err != nil {
 			return
@@ -330,6 +361,8 @@ func createRun(opts *CreateOptions) (err error) {
 		}
 	}
 
+	checkBaseBranch(ctx, opts)
+
 	if !opts.TitleProvided {
 		err = shared.TitleSurvey(opts.Prompter, state)
 		if err != nil {
@@ -428,7 +461,7 @@ func createRun(opts *CreateOptions) (err error) {
 
 var regexPattern = regexp.MustCompile(`(?m)^`)
 
-func initDefaultTitleBody(ctx CreateContext, state *shared.IssueMetadataState, useFirstCommit bool, addBody bool) error {
+func initDefaultTitleBody(ctx CreateContext, state *shared.IssueMetadataState, useFirstCommit bool, addBody bool, useChangelog bool) error {
 	baseRef := ctx.BaseTrackingBranch
 	headRef := ctx.HeadBranch
 	gitClient := ctx.GitClient
@@ -438,7 +471,10 @@ func initDefaultTitleBody(ctx CreateContext, state *shared.IssueMetadataState, u
 		return err
 	}
 
-	if len(commits) == 1 || useFirstCommit {
+	if useChangelog && len(commits) > 1 {
+		state.Title = humanize(headRef)
+		state.Body = changelogBody(commits)
+	} else if len(commits) == 1 || useFirstCommit {
 		state.Title = commits[len(commits)-1].Title
 		state.Body = commits[len(commits)-1].Body
 	} else {
@@ -462,6 +498,88 @@ func initDefaultTitleBody(ctx CreateContext, state *shared.IssueMetadataState, u
 	return nil
 }
 
+// conventionalCommitTypes defines the grouping and display order used by
+// changelogBody. Commit types that don't match any of these fall under "Other Changes".
+var conventionalCommitTypes = []struct {
+	prefix string
+	label  string
+}{
+	{"feat", "Features"},
+	{"fix", "Bug Fixes"},
+	{"perf", "Performance Improvements"},
+	{"refactor", "Refactors"},
+	{"docs", "Documentation"},
+	{"test", "Tests"},
+	{"build", "Build System"},
+	{"ci", "Continuous Integration"},
+	{"chore", "Chores"},
+}
+
+var conventionalCommitPattern = regexp.MustCompile(`(?i)^(\w+)(\(([^)]+)\))?(!)?:\s*(.*)$`)
+var issueReferencePattern = regexp.MustCompile(`#(\d+)`)
+
+// changelogBody builds a PR body from a list of commits, grouped by
+// conventional-commit type, with a dedicated section for breaking changes
+// and a summary of referenced issues.
+func changelogBody(commits []*git.Commit) string {
+	groups := map[string][]string{}
+	var breaking []string
+	issues := map[string]struct{}{}
+
+	for i := len(commits) - 1; i >= 0; i-- {
+		c := commits[i]
+		title := c.Title
+		typ := "other"
+		breakingChange := false
+
+		if m := conventionalCommitPattern.FindStringSubmatch(title); m != nil {
+			typ = strings.ToLower(m[1])
+			title = m[5]
+			if m[4] == "!" {
+				breakingChange = true
+			}
+		}
+		if strings.Contains(c.Body, "BREAKING CHANGE:") {
+			breakingChange = true
+		}
+
+		entry := fmt.Sprintf("- %s (%s)", title, c.Sha[:min(7, len(c.Sha))])
+		groups[typ] = append(groups[typ], entry)
+		if breakingChange {
+			breaking = append(breaking, entry)
+		}
+
+		for _, m := range issueReferencePattern.FindAllStringSubmatch(c.Title+" "+c.Body, -1) {
+			issues[m[0]] = struct{}{}
+		}
+	}
+
+	var out strings.Builder
+	if len(breaking) > 0 {
+		fmt.Fprintf(&out, "## Breaking Changes\n\n%s\n\n", strings.Join(breaking, "\n"))
+	}
+
+	for _, ct := range conventionalCommitTypes {
+		if entries, ok := groups[ct.prefix]; ok {
+			fmt.Fprintf(&out, "## %s\n\n%s\n\n", ct.label, strings.Join(entries, "\n"))
+		}
+	}
+	if entries, ok := groups["other"]; ok {
+		fmt.Fprintf(&out, "## Other Changes\n\n%s\n\n", strings.Join(entries, "\n"))
+	}
+
+	if len(issues) > 0 {
+		refs := make([]string, 0, len(issues))
+		for ref := range issues {
+			refs = append(refs, ref)
+		}
+		slices.Sort(refs)
+		fmt.Fprintf(&out, "## Referenced Issues\n\n%s\n", strings.Join(refs, ", "))
+	}
+
+	return strings.TrimSpace(out.String())
+}
+
 func determineTrackingBranch(gitClient *git.Client, remotes ghContext.Remotes, headBranch string) *git.TrackingRef {
 	refsForLookup := []string{"HEAD"}
 	var trackingRefs []git.TrackingRef
@@ -525,9 +643,9 @@ func NewIssueState(ctx CreateContext, opts CreateOptions) (*shared.IssueMetadata
 		Draft:      opts.IsDraft,
 	}
 
-	if opts.FillVerbose || opts.Autofill || opts.FillFirst || !opts.TitleProvided || !opts.BodyProvided {
-		err := initDefaultTitleBody(ctx, state, opts.FillFirst, opts.FillVerbose)
-		if err != nil && (opts.FillVerbose || opts.Autofill || opts.FillFirst) {
+	if opts.FillVerbose || opts.Autofill || opts.FillFirst || opts.FillChangelog || !opts.TitleProvided || !opts.BodyProvided {
+		err := initDefaultTitleBody(ctx, state, opts.FillFirst, opts.FillVerbose, opts.FillChangelog)
+		if err != nil && (opts.FillVerbose || opts.Autofill || opts.FillFirst || opts.FillChangelog) {
 			return nil, fmt.Errorf("could not compute title or body defaults: %w", err)
 		}
 	}
@@ -859,6 +859,8 @@ func Test_createRun(t *testing.T) {
 			},
 			cmdStubs: func(cs *run.CommandStubber) {
 				cs.Register(`git( .+)? log( .+)? origin/master\.\.\.feature`, 0, "d3476a1\u0000commit 0\u0000\u0000\n7a6ea13\u0000commit 1\u0000\u0000")
+				cs.Register(`git merge-tree --write-tree origin/master feature`, 0, "")
+				cs.Register(`git for-each-ref --format=%\(refname:short\) refs/remotes/origin/`, 0, "")
 			},
 			promptStubs: func(pm *prompter.PrompterMock) {
 				pm.MarkdownEditorFunc = func(p, d string, ba bool) (string, error) {
@@ -1084,6 +1086,8 @@ func Test_createRun(t *testing.T) {
 			cmdStubs: func(cs *run.CommandStubber) {
 				cs.Register(`git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%b%x00 --cherry origin/master...feature`, 0, "")
 				cs.Register(`git rev-parse --show-toplevel`, 0, "")
+				cs.Register(`git merge-tree --write-tree origin/master feature`, 0, "")
+				cs.Register(`git for-each-ref --format=%\(refname:short\) refs/remotes/origin/`, 0, "")
 			},
 			promptStubs: func(pm *prompter.PrompterMock) {
 				pm.MarkdownEditorFunc = func(p, d string, ba bool) (string, error) {
@@ -1142,6 +1146,8 @@ func Test_createRun(t *testing.T) {
 			},
 			cmdStubs: func(cs *run.CommandStubber) {
 				cs.Register(`git( .+)? log( .+)? origin/master\.\.\.feature`, 0, "")
+				cs.Register(`git merge-tree --write-tree origin/master feature`, 0, "")
+				cs.Register(`git for-each-ref --format=%\(refname:short\) refs/remotes/origin/`, 0, "")
 			},
 			promptStubs: func(pm *prompter.PrompterMock) {
 				pm.InputFunc = func(p, d string) (string, error) {
@@ -1240,6 +1246,8 @@ func Test_createRun(t *testing.T) {
 					"3a9b48085046d156c5acce8f3b3a0532cd706a4a\u0000first commit of pr\u0000first commit description\u0000\n",
 				)
 				cs.Register(`git rev-parse --show-toplevel`, 0, "")
+				cs.Register(`git merge-tree --write-tree origin/master feature`, 0, "")
+				cs.Register(`git for-each-ref --format=%\(refname:short\) refs/remotes/origin/`, 0, "")
 			},
 			promptStubs: func(pm *prompter.PrompterMock) {
 				pm.SelectFunc = func(p, _ string, opts []string) (int, error) {
@@ -1693,3 +1701,21 @@ func mockRetrieveProjects(_ *testing.T, reg *httpmock.Registry) {
 }
 
 // TODO interactive metadata tests once: 1) we have test utils for Prompter and 2) metadata questions use Prompter
+
+func Test_changelogBody(t *testing.T) {
+	commits := []*git.Commit{
+		{Sha: "cccccccc", Title: "fix: fix the bug (#123)"},
+		{Sha: "bbbbbbbb", Title: "feat!: add a new feature", Body: "BREAKING CHANGE: changes the API"},
+		{Sha: "aaaaaaaa", Title: "chore: bump deps"},
+	}
+
+	body := changelogBody(commits)
+
+	assert.Contains(t, body, "## Breaking Changes")
+	assert.Contains(t, body, "## Features")
+	assert.Contains(t, body, "## Bug Fixes")
+	assert.Contains(t, body, "## Chores")
+	assert.Contains(t, body, "add a new feature")
+	assert.Contains(t, body, "## Referenced Issues")
+	assert.Contains(t, body, "#123")
+}
@@ -36,6 +36,7 @@ type DiffOptions struct {
 	Patch       bool
 	NameOnly    bool
 	BrowserMode bool
+	Output      *cmdutil.OutputFileFlag
 }
 
 func NewCmdDiff(f *cmdutil.Factory, runF func(*DiffOptions) error) *cobra.Command {
@@ -92,6 +93,7 @@ func NewCmdDiff(f *cmdutil.Factory, runF func(*DiffOptions) error) *cobra.Comman
 	cmd.Flags().BoolVar(&opts.Patch, "patch", false, "Display diff in patch format")
 	cmd.Flags().BoolVar(&opts.NameOnly, "name-only", false, "Display only names of changed files")
 	cmd.Flags().BoolVarP(&opts.BrowserMode, "web", "w", false, "Open the pull request diff in the browser")
+	opts.Output = cmdutil.AddOutputFlag(cmd)
 
 	return cmd
 }
@@ -135,26 +137,36 @@ func diffRun(opts *DiffOptions) error {
 	defer diffReadCloser.Close()
 
 	var diff io.Reader = diffReadCloser
-	if opts.IO.IsStdoutTTY() {
+	if opts.IO.IsStdoutTTY() && !opts.Output.IsSet() {
 		diff = sanitizedReader(diff)
 	}
 
-	if err := opts.IO.StartPager(); err == nil {
-		defer opts.IO.StopPager()
-	} else {
-		fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+	out, err := opts.Output.Open(opts.IO.Out)
+	if err != nil {
+		return err
 	}
 
-	if opts.NameOnly {
-		return changedFilesNames(opts.IO.Out, diff)
+	if !opts.Output.IsSet() {
+		if err := opts.IO.StartPager(); err == nil {
+			defer opts.IO.StopPager()
+		} else {
+			fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+		}
 	}
 
-	if !opts.UseColor {
-		_, err = io.Copy(opts.IO.Out, diff)
+	if opts.NameOnly {
+		err = changedFilesNames(out, diff)
+	} else if !opts.UseColor {
+		_, err = io.Copy(out, diff)
+	} else {
+		err = colorDiffLines(out, diff)
+	}
+	if err != nil {
+		out.Discard()
 		return err
 	}
 
-	return colorDiffLines(opts.IO.Out, diff)
+	return out.Close()
 }
 
 func fetchDiff(httpClient *http.Client, baseRepo ghrepo.Interface, prNumber int, asPatch bool) (io.ReadCloser, error) {
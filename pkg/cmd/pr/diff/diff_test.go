@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"testing/iotest"
@@ -17,6 +19,7 @@ import (
 	"github.com/cli/cli/v2/pkg/httpmock"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/google/shlex"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -254,6 +257,45 @@ func Test_diffRun(t *testing.T) {
 	}
 }
 
+func Test_diffRun_output(t *testing.T) {
+	pr := &api.PullRequest{Number: 123, URL: "https://github.com/OWNER/REPO/pull/123"}
+
+	httpReg := &httpmock.Registry{}
+	defer httpReg.Verify(t)
+	stubDiffRequest(httpReg, "application/vnd.github.v3.diff", fmt.Sprintf(testDiff, "", "", "", ""))
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	finder := shared.NewMockFinder("123", pr, ghrepo.New("OWNER", "REPO"))
+	finder.ExpectFields([]string{"number"})
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "out.diff")
+
+	cmd := &cobra.Command{}
+	output := cmdutil.AddOutputFlag(cmd)
+	require.NoError(t, cmd.Flags().Set("output", path))
+
+	opts := &DiffOptions{
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: httpReg}, nil
+		},
+		IO:          ios,
+		Finder:      finder,
+		SelectorArg: "123",
+		Output:      output,
+	}
+
+	err := diffRun(opts)
+	require.NoError(t, err)
+	assert.Equal(t, "", stdout.String())
+
+	contents, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf(testDiff, "", "", "", ""), string(contents))
+}
+
 const testDiff = `%[2]sdiff --git a/.github/workflows/releases.yml b/.github/workflows/releases.yml%[1]s
 %[2]sindex 73974448..b7fc0154 100644%[1]s
 %[2]s--- a/.github/workflows/releases.yml%[1]s
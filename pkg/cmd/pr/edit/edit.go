@@ -9,8 +9,10 @@ import (
 	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	shared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	searchshared "github.com/cli/cli/v2/pkg/cmd/search/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/search"
 	"github.com/shurcooL/githubv4"
 	"github.com/spf13/cobra"
 	"golang.org/x/sync/errgroup"
@@ -18,6 +20,7 @@ import (
 
 type EditOptions struct {
 	HttpClient func() (*http.Client, error)
+	Searcher   func() (search.Searcher, error)
 	IO         *iostreams.IOStreams
 
 	Finder          shared.PRFinder
@@ -26,8 +29,9 @@ type EditOptions struct {
 	EditorRetriever EditorRetriever
 	Prompter        shared.EditPrompter
 
-	SelectorArg string
-	Interactive bool
+	SelectorArg       string
+	Interactive       bool
+	ReviewerAutoCount int
 
 	shared.Editable
 }
@@ -36,6 +40,7 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 	opts := &EditOptions{
 		IO:              f.IOStreams,
 		HttpClient:      f.HttpClient,
+		Searcher:        func() (search.Searcher, error) { return searchshared.Searcher(f) },
 		Surveyor:        surveyor{P: f.Prompter},
 		Fetcher:         fetcher{},
 		EditorRetriever: editorRetriever{config: f.Config},
@@ -56,11 +61,22 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 
 			Editing a pull request's projects requires authorization with the %[1]sproject%[1]s scope.
 			To authorize, run %[1]sgh auth refresh -s project%[1]s.
+
+			A file passed to %[1]s--body-file%[1]s may begin with a YAML front matter block
+			(%[1]stitle%[1]s, %[1]slabels%[1]s, %[1]sassignees%[1]s, %[1]smilestone%[1]s, %[1]sprojects%[1]s) to add those
+			fields from the file as well; any matching flag takes precedence over the front matter.
+
+			A team passed to %[1]s--add-reviewer%[1]s with an %[1]s:auto%[1]s suffix, e.g.
+			%[1]smyorg/team-name:auto%[1]s, is not requested as a whole. Instead, its least busy
+			members -- by current open review request count -- are requested individually, up to
+			%[1]s--reviewer-auto-count%[1]s of them. This is useful for teams that don't rely on
+			GitHub's CODEOWNERS auto-assignment to spread out review load.
 		`, "`"),
 		Example: heredoc.Doc(`
 			$ gh pr edit 23 --title "I found a bug" --body "Nothing works"
 			$ gh pr edit 23 --add-label "bug,help wanted" --remove-label "core"
 			$ gh pr edit 23 --add-reviewer monalisa,hubot  --remove-reviewer myorg/team-name
+			$ gh pr edit 23 --add-reviewer myorg/team-name:auto --reviewer-auto-count 2
 			$ gh pr edit 23 --add-assignee "@me" --remove-assignee monalisa,hubot
 			$ gh pr edit 23 --add-project "Roadmap" --remove-project v1,v2
 			$ gh pr edit 23 --milestone "Version 1"
@@ -94,6 +110,30 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 						return err
 					}
 					opts.Editable.Body.Value = string(b)
+
+					if fm, rest, ok := shared.ExtractFrontmatter(opts.Editable.Body.Value); ok {
+						opts.Editable.Body.Value = rest
+						if !flags.Changed("title") && fm.Title != "" {
+							opts.Editable.Title.Value = fm.Title
+							opts.Editable.Title.Edited = true
+						}
+						if !flags.Changed("add-assignee") && len(fm.Assignees) > 0 {
+							opts.Editable.Assignees.Add = fm.Assignees
+							opts.Editable.Assignees.Edited = true
+						}
+						if !flags.Changed("add-label") && len(fm.Labels) > 0 {
+							opts.Editable.Labels.Add = fm.Labels
+							opts.Editable.Labels.Edited = true
+						}
+						if !flags.Changed("add-project") && len(fm.Projects) > 0 {
+							opts.Editable.Projects.Add = fm.Projects
+							opts.Editable.Projects.Edited = true
+						}
+						if !flags.Changed("milestone") && !removeMilestone && fm.Milestone != "" {
+							opts.Editable.Milestone.Value = fm.Milestone
+							opts.Editable.Milestone.Edited = true
+						}
+					}
 				}
 			}
 
@@ -157,6 +197,7 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 	cmd.Flags().StringVarP(&opts.Editable.Base.Value, "base", "B", "", "Change the base `branch` for this pull request")
 	cmd.Flags().StringSliceVar(&opts.Editable.Reviewers.Add, "add-reviewer", nil, "Add reviewers by their `login`.")
 	cmd.Flags().StringSliceVar(&opts.Editable.Reviewers.Remove, "remove-reviewer", nil, "Remove reviewers by their `login`.")
+	cmd.Flags().IntVar(&opts.ReviewerAutoCount, "reviewer-auto-count", 1, "Number of least busy team members to request when a reviewer uses the `:auto` suffix")
 	cmd.Flags().StringSliceVar(&opts.Editable.Assignees.Add, "add-assignee", nil, "Add assigned users by their `login`. Use \"@me\" to assign yourself.")
 	cmd.Flags().StringSliceVar(&opts.Editable.Assignees.Remove, "remove-assignee", nil, "Remove assigned users by their `login`. Use \"@me\" to unassign yourself.")
 	cmd.Flags().StringSliceVar(&opts.Editable.Labels.Add, "add-label", nil, "Add labels by `name`")
@@ -230,6 +271,19 @@ func editRun(opts *EditOptions) error {
 	}
 	apiClient := api.NewClientFromHTTP(httpClient)
 
+	if hasAutoTeamReviewer(editable.Reviewers.Add) {
+		searcher, err := opts.Searcher()
+		if err != nil {
+			return err
+		}
+		opts.IO.StartProgressIndicator()
+		err = resolveAutoReviewers(apiClient, searcher, repo, &editable, opts.ReviewerAutoCount)
+		opts.IO.StopProgressIndicator()
+		if err != nil {
+			return err
+		}
+	}
+
 	opts.IO.StartProgressIndicator()
 	err = opts.Fetcher.EditableOptionsFetch(apiClient, repo, &editable)
 	opts.IO.StopProgressIndicator()
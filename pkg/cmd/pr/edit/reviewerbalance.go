@@ -0,0 +1,102 @@
+package edit
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/search"
+)
+
+// autoTeamSuffix marks a team reviewer, e.g. "myorg/team-name:auto", whose whole membership
+// should not be requested. Instead, the least busy members of the team are substituted for it.
+const autoTeamSuffix = ":auto"
+
+// hasAutoTeamReviewer reports whether any of the given reviewers uses the ":auto" suffix.
+func hasAutoTeamReviewer(reviewers []string) bool {
+	for _, r := range reviewers {
+		if strings.HasSuffix(r, autoTeamSuffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveAutoReviewers replaces any "org/team:auto" entries in editable.Reviewers.Add with the
+// `count` least busy members of that team, determined by how many open pull requests currently
+// list them as a requested reviewer. This supports teams that don't rely on GitHub's CODEOWNERS
+// auto-assignment to spread out review load.
+func resolveAutoReviewers(apiClient *api.Client, searcher search.Searcher, repo ghrepo.Interface, editable *shared.Editable, count int) error {
+	resolved := make([]string, 0, len(editable.Reviewers.Add))
+	for _, r := range editable.Reviewers.Add {
+		team, ok := strings.CutSuffix(r, autoTeamSuffix)
+		if !ok {
+			resolved = append(resolved, r)
+			continue
+		}
+
+		org, teamSlug, ok := strings.Cut(team, "/")
+		if !ok {
+			return fmt.Errorf("%q is not a valid team reviewer", team)
+		}
+
+		members, err := api.OrganizationTeamMembers(apiClient, repo.RepoHost(), org, teamSlug)
+		if err != nil {
+			return fmt.Errorf("could not find team %q: %w", team, err)
+		}
+
+		chosen, err := leastBusyReviewers(searcher, repo, members, count)
+		if err != nil {
+			return fmt.Errorf("could not determine review load for %q: %w", team, err)
+		}
+
+		resolved = append(resolved, chosen...)
+	}
+
+	editable.Reviewers.Add = resolved
+	return nil
+}
+
+// leastBusyReviewers returns up to count logins from members, the ones with the fewest open pull
+// requests currently awaiting their review, ascending by that count.
+func leastBusyReviewers(searcher search.Searcher, repo ghrepo.Interface, members []string, count int) ([]string, error) {
+	type reviewerLoad struct {
+		login string
+		open  int
+	}
+
+	loads := make([]reviewerLoad, len(members))
+	for i, login := range members {
+		result, err := searcher.Issues(search.Query{
+			Kind:  search.KindIssues,
+			Limit: 1,
+			Qualifiers: search.Qualifiers{
+				Type:            "pr",
+				State:           "open",
+				Repo:            []string{ghrepo.FullName(repo)},
+				ReviewRequested: login,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		loads[i] = reviewerLoad{login: login, open: result.Total}
+	}
+
+	sort.SliceStable(loads, func(i, j int) bool {
+		return loads[i].open < loads[j].open
+	})
+
+	if count > len(loads) {
+		count = len(loads)
+	}
+
+	chosen := make([]string, count)
+	for i := 0; i < count; i++ {
+		chosen[i] = loads[i].login
+	}
+	return chosen, nil
+}
@@ -0,0 +1,67 @@
+package edit
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_hasAutoTeamReviewer(t *testing.T) {
+	assert.True(t, hasAutoTeamReviewer([]string{"monalisa", "myorg/team-name:auto"}))
+	assert.False(t, hasAutoTeamReviewer([]string{"monalisa", "myorg/team-name"}))
+}
+
+func Test_resolveAutoReviewers(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query OrganizationTeamMembers\b`),
+		httpmock.StringResponse(`
+		{ "data": { "organization": { "team": { "members": {
+			"nodes": [
+				{ "login": "hubot" },
+				{ "login": "monalisa" },
+				{ "login": "octocat" }
+			],
+			"pageInfo": { "hasNextPage": false, "endCursor": "" }
+		} } } } }`),
+	)
+	apiClient := api.NewClientFromHTTP(&http.Client{Transport: reg})
+
+	searcher := &search.SearcherMock{
+		IssuesFunc: func(query search.Query) (search.IssuesResult, error) {
+			counts := map[string]int{"hubot": 3, "monalisa": 0, "octocat": 1}
+			return search.IssuesResult{Total: counts[query.Qualifiers.ReviewRequested]}, nil
+		},
+	}
+
+	editable := &shared.Editable{
+		Reviewers: shared.EditableSlice{
+			Add: []string{"someoneelse", "myorg/team-name:auto"},
+		},
+	}
+
+	err := resolveAutoReviewers(apiClient, searcher, ghrepo.New("OWNER", "REPO"), editable, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"someoneelse", "monalisa", "octocat"}, editable.Reviewers.Add)
+}
+
+func Test_leastBusyReviewers(t *testing.T) {
+	searcher := &search.SearcherMock{
+		IssuesFunc: func(query search.Query) (search.IssuesResult, error) {
+			counts := map[string]int{"hubot": 3, "monalisa": 0, "octocat": 1}
+			return search.IssuesResult{Total: counts[query.Qualifiers.ReviewRequested]}, nil
+		},
+	}
+
+	chosen, err := leastBusyReviewers(searcher, ghrepo.New("OWNER", "REPO"), []string{"hubot", "monalisa", "octocat"}, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"monalisa", "octocat"}, chosen)
+}
@@ -0,0 +1,138 @@
+package export
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	prShared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ExportOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Assignee     string
+	Labels       []string
+	State        string
+	BaseBranch   string
+	HeadBranch   string
+	Author       string
+	Search       string
+	Since        string
+	Format       string
+	LimitResults int
+	Output       *cmdutil.OutputFileFlag
+}
+
+func NewCmdExport(f *cmdutil.Factory, runF func(*ExportOptions) error) *cobra.Command {
+	opts := &ExportOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export pull requests to NDJSON or CSV",
+		Long: heredoc.Doc(`
+			Export pull requests matching a filter to NDJSON or CSV, including their comments
+			and reviews, for data warehousing or migration tooling.
+
+			Use "--since" to only export pull requests updated on or after the given date
+			(YYYY-MM-DD), for incremental exports.
+		`),
+		Example: heredoc.Doc(`
+			$ gh pr export --state all > prs.ndjson
+			$ gh pr export --format csv --since 2024-01-01 > prs.csv
+		`),
+		Args: cmdutil.NoArgsQuoteReminder,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.LimitResults < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.LimitResults)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return exportRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Assignee, "assignee", "a", "", "Filter by assignee")
+	cmd.Flags().StringSliceVarP(&opts.Labels, "label", "l", nil, "Filter by label")
+	cmdutil.StringEnumFlag(cmd, &opts.State, "state", "s", "all", []string{"open", "closed", "merged", "all"}, "Filter by state")
+	cmd.Flags().StringVarP(&opts.BaseBranch, "base", "B", "", "Filter by base branch")
+	cmd.Flags().StringVarP(&opts.HeadBranch, "head", "H", "", "Filter by head branch")
+	cmd.Flags().StringVarP(&opts.Author, "author", "A", "", "Filter by author")
+	cmd.Flags().StringVarP(&opts.Search, "search", "S", "", "Filter pull requests with `query`")
+	cmd.Flags().StringVar(&opts.Since, "since", "", "Only export pull requests updated on or after `YYYY-MM-DD`")
+	cmdutil.StringEnumFlag(cmd, &opts.Format, "format", "", "ndjson", []string{"ndjson", "csv"}, "Output format")
+	cmd.Flags().IntVarP(&opts.LimitResults, "limit", "L", 10000, "Maximum number of pull requests to export")
+	opts.Output = cmdutil.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func exportRun(opts *ExportOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	filters := prShared.FilterOptions{
+		Entity:     "pr",
+		State:      opts.State,
+		Assignee:   opts.Assignee,
+		Labels:     opts.Labels,
+		BaseBranch: opts.BaseBranch,
+		HeadBranch: opts.HeadBranch,
+		Author:     opts.Author,
+		Search:     opts.Search,
+		Fields:     pullRequestExportFields,
+	}
+	if opts.Since != "" {
+		filters.Search = fmt.Sprintf("%s updated:>=%s", filters.Search, opts.Since)
+	}
+
+	prs, err := exportPullRequests(apiClient, baseRepo, filters, opts.LimitResults)
+	if err != nil {
+		return err
+	}
+
+	records := make([]prShared.ExportRecord, len(prs))
+	for i, pr := range prs {
+		records[i] = prShared.NewPullRequestExportRecord(pr)
+	}
+
+	out, err := opts.Output.Open(opts.IO.Out)
+	if err != nil {
+		return err
+	}
+
+	if opts.Format == "csv" {
+		err = prShared.WriteCSVExport(out, records)
+	} else {
+		err = prShared.WriteNDJSONExport(out, records)
+	}
+	if err != nil {
+		out.Discard()
+		return err
+	}
+
+	return out.Close()
+}
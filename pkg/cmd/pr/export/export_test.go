@@ -0,0 +1,83 @@
+package export
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/test"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func runCommand(rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	ios, _, stdout, stderr := iostreams.Test()
+
+	factory := &cmdutil.Factory{
+		IOStreams: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: rt}, nil
+		},
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	cmd := NewCmdExport(factory, nil)
+
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+
+	_, err = cmd.ExecuteC()
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+func TestPRExport_csv(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query PullRequestExport\b`),
+		httpmock.StringResponse(`{"data":{"search":{"nodes":[
+			{"number":1,"title":"a feature","url":"https://github.com/OWNER/REPO/pull/1","state":"MERGED","author":{"login":"monalisa"}}
+		],"pageInfo":{"hasNextPage":false,"endCursor":""}}}}`))
+
+	output, err := runCommand(http, "--format csv")
+	if err != nil {
+		t.Fatalf("error running command `pr export`: %v", err)
+	}
+
+	assert.Equal(t, "", output.Stderr())
+	assert.Contains(t, output.String(), "pull_request,1,a feature,,MERGED,https://github.com/OWNER/REPO/pull/1,monalisa")
+}
+
+func TestPRExport_invalidLimit(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	_, err := runCommand(http, "--limit -1")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	assert.Equal(t, "invalid limit: -1", err.Error())
+}
@@ -0,0 +1,73 @@
+package export
+
+import (
+	"fmt"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	prShared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
+)
+
+// pullRequestExportFields requests everything NewPullRequestExportRecord needs.
+var pullRequestExportFields = []string{
+	"number", "title", "url", "state", "body", "author", "createdAt", "updatedAt", "closedAt",
+	"mergedAt", "labels", "assignees", "milestone", "comments", "reactionGroups", "reviews",
+}
+
+// exportPullRequests fetches every pull request matching filters, up to limit, always through
+// the search API; see exportIssues in pkg/cmd/issue/export for why.
+func exportPullRequests(client *api.Client, repo ghrepo.Interface, filters prShared.FilterOptions, limit int) ([]api.PullRequest, error) {
+	fragment := fmt.Sprintf("fragment pr on PullRequest{%s}", api.PullRequestGraphQL(filters.Fields))
+	query := fragment + `
+		query PullRequestExport($q: String!, $limit: Int!, $endCursor: String) {
+			search(query: $q, type: ISSUE, first: $limit, after: $endCursor) {
+				nodes { ...pr }
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}`
+
+	filters.Repo = ghrepo.FullName(repo)
+	q := prShared.SearchQueryBuild(filters)
+
+	variables := map[string]interface{}{"q": q}
+
+	var prs []api.PullRequest
+	for {
+		variables["limit"] = min(limit-len(prs), 100)
+
+		type response struct {
+			Search struct {
+				Nodes    []api.PullRequest
+				PageInfo struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			}
+		}
+		var resp response
+		if err := client.GraphQL(repo.RepoHost(), query, variables, &resp); err != nil {
+			return nil, err
+		}
+
+		prs = append(prs, resp.Search.Nodes...)
+		if len(prs) >= limit || !resp.Search.PageInfo.HasNextPage {
+			break
+		}
+		variables["endCursor"] = resp.Search.PageInfo.EndCursor
+	}
+
+	if len(prs) > limit {
+		prs = prs[:limit]
+	}
+	return prs, nil
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
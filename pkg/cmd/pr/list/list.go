@@ -11,11 +11,13 @@ import (
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/browser"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/prompter"
 	"github.com/cli/cli/v2/internal/tableprinter"
 	"github.com/cli/cli/v2/internal/text"
 	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/set"
 	"github.com/spf13/cobra"
 )
 
@@ -23,20 +25,26 @@ type ListOptions struct {
 	HttpClient func() (*http.Client, error)
 	IO         *iostreams.IOStreams
 	BaseRepo   func() (ghrepo.Interface, error)
+	BaseRepos  func() ([]ghrepo.Interface, error)
 	Browser    browser.Browser
+	Prompter   prompter.Prompter
 
 	WebMode      bool
+	Interactive  bool
 	LimitResults int
 	Exporter     cmdutil.Exporter
 
-	State      string
-	BaseBranch string
-	HeadBranch string
-	Labels     []string
-	Author     string
-	Assignee   string
-	Search     string
-	Draft      *bool
+	FailFast          bool
+	State             string
+	BaseBranch        string
+	HeadBranch        string
+	Labels            []string
+	Author            string
+	Assignee          string
+	Search            string
+	Draft             *bool
+	Team              string
+	AuthorAffiliation string
 
 	Now func() time.Time
 }
@@ -46,6 +54,7 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 		IO:         f.IOStreams,
 		HttpClient: f.HttpClient,
 		Browser:    f.Browser,
+		Prompter:   f.Prompter,
 		Now:        time.Now,
 	}
 
@@ -59,6 +68,10 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 
 			The search query syntax is documented here:
 			<https://docs.github.com/en/search-github/searching-on-github/searching-issues-and-pull-requests>
+
+			Pass ` + "`-R/--repo`" + ` more than once, or give it a comma-separated list, to list and
+			merge pull requests from several repositories at once. The merged table adds a REPO
+			column, and ` + "`--web`" + `, ` + "`--interactive`" + `, and ` + "`--json`" + ` aren't supported in that mode.
 		`),
 		Example: heredoc.Doc(`
 			List PRs authored by you
@@ -72,12 +85,17 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 
 			Find a PR that introduced a given commit
 			$ gh pr list --search "<SHA>" --state merged
+
+			List open PRs across several repositories
+			$ gh pr list -R cli/cli -R cli/go-gh
 		`),
 		Aliases: []string{"ls"},
 		Args:    cmdutil.NoArgsQuoteReminder,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// support `-R, --repo` override
 			opts.BaseRepo = f.BaseRepo
+			repoOverrides, _ := cmd.Flags().GetStringArray("repo")
+			opts.BaseRepos = cmdutil.BaseReposOverride(f, repoOverrides)
 
 			if opts.LimitResults < 1 {
 				return cmdutil.FlagErrorf("invalid value for --limit: %v", opts.LimitResults)
@@ -91,6 +109,14 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 				opts.Author = fmt.Sprintf("app/%s", appAuthor)
 			}
 
+			if opts.Interactive && opts.WebMode {
+				return cmdutil.FlagErrorf("specify only one of `--interactive` or `--web`")
+			}
+
+			if opts.Interactive && opts.Exporter != nil {
+				return cmdutil.FlagErrorf("specify only one of `--interactive` or `--json`")
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -99,6 +125,7 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	}
 
 	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "List pull requests in the web browser")
+	cmd.Flags().BoolVarP(&opts.Interactive, "interactive", "i", false, "Filter and select a pull request to view in the browser")
 	cmd.Flags().IntVarP(&opts.LimitResults, "limit", "L", 30, "Maximum number of items to fetch")
 	cmdutil.StringEnumFlag(cmd, &opts.State, "state", "s", "open", []string{"open", "closed", "merged", "all"}, "Filter by state")
 	cmd.Flags().StringVarP(&opts.BaseBranch, "base", "B", "", "Filter by base branch")
@@ -109,7 +136,10 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	cmd.Flags().StringVarP(&opts.Assignee, "assignee", "a", "", "Filter by assignee")
 	cmd.Flags().StringVarP(&opts.Search, "search", "S", "", "Search pull requests with `query`")
 	cmdutil.NilBoolFlag(cmd, &opts.Draft, "draft", "d", "Filter by draft state")
+	cmd.Flags().StringVar(&opts.Team, "team", "", "Filter by team membership of the author, using the `org/team` slug")
+	cmdutil.StringEnumFlag(cmd, &opts.AuthorAffiliation, "author-affiliation", "", "", []string{"bot", "first-timer"}, "Filter by author affiliation")
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, api.PullRequestFields)
+	cmdutil.AddFailFastFlag(cmd, &opts.FailFast)
 
 	_ = cmdutil.RegisterBranchCompletionFlags(f.GitClient, cmd, "base", "head")
 
@@ -134,16 +164,46 @@ func listRun(opts *ListOptions) error {
 		return err
 	}
 
-	baseRepo, err := opts.BaseRepo()
+	if opts.BaseRepos == nil {
+		opts.BaseRepos = func() ([]ghrepo.Interface, error) {
+			repo, err := opts.BaseRepo()
+			if err != nil {
+				return nil, err
+			}
+			return []ghrepo.Interface{repo}, nil
+		}
+	}
+
+	baseRepos, err := opts.BaseRepos()
 	if err != nil {
 		return err
 	}
 
+	if len(baseRepos) > 1 {
+		return listRunMultiRepo(opts, httpClient, baseRepos)
+	}
+
+	baseRepo := baseRepos[0]
+
 	prState := strings.ToLower(opts.State)
 	if prState == "open" && shared.QueryHasStateClause(opts.Search) {
 		prState = ""
 	}
 
+	search := opts.Search
+	if opts.Team != "" {
+		apiClient := api.NewClientFromHTTP(httpClient)
+		teamQuery, err := teamAuthorQuery(apiClient, baseRepo, opts.Team)
+		if err != nil {
+			return err
+		}
+		if search != "" {
+			search = fmt.Sprintf("%s %s", teamQuery, search)
+		} else {
+			search = teamQuery
+		}
+	}
+
 	filters := shared.FilterOptions{
 		Entity:     "pr",
 		State:      prState,
@@ -152,13 +212,20 @@ func listRun(opts *ListOptions) error {
 		Labels:     opts.Labels,
 		BaseBranch: opts.BaseBranch,
 		HeadBranch: opts.HeadBranch,
-		Search:     opts.Search,
+		Search:     search,
 		Draft:      opts.Draft,
 		Fields:     defaultFields,
 	}
 	if opts.Exporter != nil {
 		filters.Fields = opts.Exporter.Fields()
 	}
+	if opts.AuthorAffiliation == "first-timer" {
+		fieldSet := set.NewStringSet()
+		fieldSet.AddValues(filters.Fields)
+		if !fieldSet.Contains("authorAssociation") {
+			filters.Fields = append(filters.Fields, "authorAssociation")
+		}
+	}
 	if opts.WebMode {
 		prListURL := ghrepo.GenerateRepoURL(baseRepo, "pulls")
 		openURL, err := shared.ListURLWithQuery(prListURL, filters)
@@ -176,8 +243,16 @@ func listRun(opts *ListOptions) error {
 	if err != nil {
 		return err
 	}
+	hasFilters := !filters.IsDefault() || opts.AuthorAffiliation != ""
+	if opts.AuthorAffiliation != "" {
+		listResult.PullRequests = filterByAuthorAffiliation(listResult.PullRequests, opts.AuthorAffiliation)
+	}
 	if len(listResult.PullRequests) == 0 && opts.Exporter == nil {
-		return shared.ListNoResults(ghrepo.FullName(baseRepo), "pull request", !filters.IsDefault())
+		return shared.ListNoResults(ghrepo.FullName(baseRepo), "pull request", hasFilters, opts.FailFast)
+	}
+
+	if opts.Interactive {
+		return interactiveOpen(opts, listResult.PullRequests)
 	}
 
 	err = opts.IO.StartPager()
@@ -235,6 +310,185 @@ func listRun(opts *ListOptions) error {
 	return nil
 }
 
+// listRunMultiRepo fetches and merges pull requests across more than one repository, given via
+// repeated or comma-separated `-R/--repo` flags, adding a REPO column so the results stay
+// attributable.
+func listRunMultiRepo(opts *ListOptions, httpClient *http.Client, baseRepos []ghrepo.Interface) error {
+	if opts.WebMode {
+		return cmdutil.FlagErrorf("multiple `--repo` values aren't supported with `--web`")
+	}
+	if opts.Interactive {
+		return cmdutil.FlagErrorf("multiple `--repo` values aren't supported with `--interactive`")
+	}
+	if opts.Exporter != nil {
+		return cmdutil.FlagErrorf("multiple `--repo` values aren't supported with `--json`")
+	}
+
+	prState := strings.ToLower(opts.State)
+	if prState == "open" && shared.QueryHasStateClause(opts.Search) {
+		prState = ""
+	}
+
+	type repoPR struct {
+		repo ghrepo.Interface
+		pr   api.PullRequest
+	}
+
+	var merged []repoPR
+	for _, baseRepo := range baseRepos {
+		search := opts.Search
+		if opts.Team != "" {
+			apiClient := api.NewClientFromHTTP(httpClient)
+			teamQuery, err := teamAuthorQuery(apiClient, baseRepo, opts.Team)
+			if err != nil {
+				return err
+			}
+			if search != "" {
+				search = fmt.Sprintf("%s %s", teamQuery, search)
+			} else {
+				search = teamQuery
+			}
+		}
+
+		filters := shared.FilterOptions{
+			Entity:     "pr",
+			State:      prState,
+			Author:     opts.Author,
+			Assignee:   opts.Assignee,
+			Labels:     opts.Labels,
+			BaseBranch: opts.BaseBranch,
+			HeadBranch: opts.HeadBranch,
+			Search:     search,
+			Draft:      opts.Draft,
+			Fields:     defaultFields,
+		}
+
+		listResult, err := listPullRequests(httpClient, baseRepo, filters, opts.LimitResults)
+		if err != nil {
+			return fmt.Errorf("failed to list pull requests for %s: %w", ghrepo.FullName(baseRepo), err)
+		}
+		prs := listResult.PullRequests
+		if opts.AuthorAffiliation != "" {
+			prs = filterByAuthorAffiliation(prs, opts.AuthorAffiliation)
+		}
+		for _, pr := range prs {
+			merged = append(merged, repoPR{repo: baseRepo, pr: pr})
+		}
+	}
+
+	if len(merged) == 0 {
+		hasFilters := opts.State != "open" || opts.Author != "" || opts.Assignee != "" || len(opts.Labels) > 0 ||
+			opts.BaseBranch != "" || opts.HeadBranch != "" || opts.Search != "" || opts.Draft != nil || opts.AuthorAffiliation != ""
+		return shared.ListNoResults(strings.Join(repoNames(baseRepos), ", "), "pull request", hasFilters, opts.FailFast)
+	}
+
+	err := opts.IO.StartPager()
+	if err != nil {
+		fmt.Fprintf(opts.IO.ErrOut, "error starting pager: %v\n", err)
+	}
+	defer opts.IO.StopPager()
+
+	cs := opts.IO.ColorScheme()
+	isTTY := opts.IO.IsStdoutTTY()
+
+	headers := []string{"REPO", "ID", "TITLE", "BRANCH"}
+	if !isTTY {
+		headers = append(headers, "STATE")
+	}
+	headers = append(headers, "CREATED AT")
+
+	table := tableprinter.New(opts.IO, tableprinter.WithHeader(headers...))
+	for _, rp := range merged {
+		prNum := strconv.Itoa(rp.pr.Number)
+		if isTTY {
+			prNum = "#" + prNum
+		}
+
+		table.AddField(ghrepo.FullName(rp.repo), tableprinter.WithColor(cs.Gray))
+		table.AddField(prNum, tableprinter.WithColor(cs.ColorFromString(shared.ColorForPRState(rp.pr))))
+		table.AddField(text.RemoveExcessiveWhitespace(rp.pr.Title))
+		table.AddField(rp.pr.HeadLabel(), tableprinter.WithColor(cs.Cyan))
+		if !isTTY {
+			table.AddField(prStateWithDraft(&rp.pr))
+		}
+		table.AddTimeField(opts.Now(), rp.pr.CreatedAt, cs.Gray)
+		table.EndRow()
+	}
+
+	return table.Render()
+}
+
+func repoNames(repos []ghrepo.Interface) []string {
+	names := make([]string, len(repos))
+	for i, repo := range repos {
+		names[i] = ghrepo.FullName(repo)
+	}
+	return names
+}
+
+// teamAuthorQuery resolves the members of org/team and returns a search query
+// fragment matching pull requests authored by any of them. GitHub's search
+// syntax has no qualifier for "authored by a team", so membership is
+// resolved client-side and OR'd together as individual author: qualifiers.
+func teamAuthorQuery(apiClient *api.Client, baseRepo ghrepo.Interface, team string) (string, error) {
+	idx := strings.IndexRune(team, '/')
+	if idx < 0 {
+		return "", cmdutil.FlagErrorf("team must be in the format `org/team-name`")
+	}
+	org, slug := team[:idx], team[idx+1:]
+
+	members, err := api.OrganizationTeamMembers(apiClient, baseRepo.RepoHost(), org, slug)
+	if err != nil {
+		return "", fmt.Errorf("could not resolve members of team '%s': %w", team, err)
+	}
+	if len(members) == 0 {
+		return "", fmt.Errorf("team '%s' has no members", team)
+	}
+
+	authors := make([]string, len(members))
+	for i, login := range members {
+		authors[i] = fmt.Sprintf("author:%s", login)
+	}
+	return fmt.Sprintf("(%s)", strings.Join(authors, " OR ")), nil
+}
+
+// filterByAuthorAffiliation narrows prs to those matching affiliation. This
+// happens client-side, after the search/list API call, because GitHub search
+// has no qualifier for bot authorship or author association.
+func filterByAuthorAffiliation(prs []api.PullRequest, affiliation string) []api.PullRequest {
+	filtered := make([]api.PullRequest, 0, len(prs))
+	for _, pr := range prs {
+		switch affiliation {
+		case "bot":
+			if strings.HasSuffix(pr.Author.Login, "[bot]") {
+				filtered = append(filtered, pr)
+			}
+		case "first-timer":
+			if pr.AuthorAssociation == "FIRST_TIME_CONTRIBUTOR" || pr.AuthorAssociation == "FIRST_TIMER" {
+				filtered = append(filtered, pr)
+			}
+		}
+	}
+	return filtered
+}
+
+// interactiveOpen lets the user filter and pick a single pull request from
+// labels, then opens it in the web browser, so they don't have to copy a
+// number out of the list first.
+func interactiveOpen(opts *ListOptions, prs []api.PullRequest) error {
+	labels := make([]string, len(prs))
+	for i, pr := range prs {
+		labels[i] = fmt.Sprintf("#%d %s", pr.Number, pr.Title)
+	}
+
+	index, err := cmdutil.SelectFromList(opts.IO, opts.Prompter, "Select a pull request", labels)
+	if err != nil {
+		return err
+	}
+
+	return opts.Browser.Browse(prs[index].URL)
+}
+
 func prStateWithDraft(pr *api.PullRequest) string {
 	if pr.IsDraft && pr.State == "OPEN" {
 		return "DRAFT"
@@ -274,6 +274,35 @@ func TestPRList_filteringAuthor(t *testing.T) {
 	}
 }
 
+func TestPRList_filteringTeam(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query OrganizationTeamMembers\b`),
+		httpmock.StringResponse(`
+		{ "data": { "organization": { "team": { "members": {
+			"nodes": [{"login": "monalisa"}, {"login": "hubot"}],
+			"pageInfo": {"hasNextPage": false, "endCursor": ""}
+		} } } } }`))
+	http.Register(
+		httpmock.GraphQL(`query PullRequestSearch\b`),
+		httpmock.GraphQLQuery(`{}`, func(_ string, params map[string]interface{}) {
+			assert.Equal(t, `(author:monalisa OR author:hubot) repo:OWNER/REPO state:open type:pr`, params["q"].(string))
+		}))
+
+	_, err := runCommand(http, true, `--team "OWNER/core"`)
+	assert.Error(t, err)
+}
+
+func TestPRList_team_invalidFormat(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	_, err := runCommand(http, true, `--team "core"`)
+	assert.EqualError(t, err, "team must be in the format `org/team-name`")
+}
+
 func TestPRList_withInvalidLimitFlag(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
@@ -324,6 +353,86 @@ func TestPRList_web(t *testing.T) {
 	}
 }
 
+// runMultiRepoCommand is like runCommand, but also enables the `-R/--repo` override flag the way
+// the real `pr` parent command does, since `list` on its own never registers it.
+func runMultiRepoCommand(rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	ios, _, stdout, stderr := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	ios.SetStdinTTY(true)
+	ios.SetStderrTTY(true)
+
+	factory := &cmdutil.Factory{
+		IOStreams: ios,
+		Browser:   &browser.Stub{},
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: rt}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	cmd := NewCmdList(factory, func(opts *ListOptions) error {
+		opts.Now = func() time.Time {
+			return time.Date(2022, time.August, 24, 23, 50, 0, 0, time.UTC)
+		}
+		return listRun(opts)
+	})
+	cmdutil.EnableRepoOverride(cmd, factory)
+
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+
+	_, err = cmd.ExecuteC()
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+func TestPRList_multiRepo(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query PullRequestList\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": { "pullRequests": { "totalCount": 1, "nodes": [
+				{ "number": 1, "title": "from owner/one", "headRefName": "feature",
+					"isCrossRepository": false, "createdAt": "2022-08-24T20:50:00Z" } ] } } } }`))
+	http.Register(
+		httpmock.GraphQL(`query PullRequestList\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": { "pullRequests": { "totalCount": 1, "nodes": [
+				{ "number": 9, "title": "from owner/two", "headRefName": "fix",
+					"isCrossRepository": false, "createdAt": "2022-07-20T19:01:12Z" } ] } } } }`))
+
+	output, err := runMultiRepoCommand(http, `-R owner/one -R owner/two`)
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		REPO       ID  TITLE           BRANCH   CREATED AT
+		owner/one  #1  from owner/one  feature  about 3 hours ago
+		owner/two  #9  from owner/two  fix      about 1 month ago
+	`), output.String())
+	assert.Equal(t, "", output.Stderr())
+}
+
+func TestPRList_multiRepo_jsonUnsupported(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	_, err := runMultiRepoCommand(http, `-R owner/one -R owner/two --json number`)
+	assert.EqualError(t, err, "multiple `--repo` values aren't supported with `--json`")
+}
+
 func TestPRList_withProjectItems(t *testing.T) {
 	reg := &httpmock.Registry{}
 	defer reg.Verify(t)
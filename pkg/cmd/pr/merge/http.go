@@ -1,6 +1,7 @@
 package merge
 
 import (
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -156,3 +157,36 @@ func getMergeText(client *http.Client, repo ghrepo.Interface, prID string, merge
 
 	return query.Node.PullRequest.ViewerMergeHeadlineText, query.Node.PullRequest.ViewerMergeBodyText, nil
 }
+
+// refreshChecksStatus refetches just enough of the pull request to recompute
+// its ChecksStatus, for use by a polling loop that doesn't need the rest of
+// the pull request's fields.
+func refreshChecksStatus(client *http.Client, repo ghrepo.Interface, prID string) (api.PullRequestChecksStatus, error) {
+	type response struct {
+		Node *api.PullRequest
+	}
+
+	query := fmt.Sprintf(`
+	query PullRequestAutoMergeChecksStatus($id: ID!) {
+		node(id: $id) {
+			...on PullRequest {
+				%s
+			}
+		}
+	}`, api.PullRequestGraphQL([]string{"statusCheckRollup"}))
+
+	variables := map[string]interface{}{
+		"id": prID,
+	}
+
+	var resp response
+	gql := api.NewClientFromHTTP(client)
+	if err := gql.GraphQL(repo.RepoHost(), query, variables, &resp); err != nil {
+		return api.PullRequestChecksStatus{}, err
+	}
+	if resp.Node == nil {
+		return api.PullRequestChecksStatus{}, nil
+	}
+
+	return resp.Node.ChecksStatus(), nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
@@ -19,6 +20,11 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const defaultDisableAutoInterval time.Duration = 10 * time.Second
+
+// disableAutoWhenChecksFailed is the only condition currently supported by `--disable-auto-when`.
+const disableAutoWhenChecksFailed = "checks-failed"
+
 type editor interface {
 	Edit(string, string) (string, error)
 }
@@ -39,6 +45,9 @@ type MergeOptions struct {
 
 	AutoMergeEnable  bool
 	AutoMergeDisable bool
+	AutoMergeStatus  bool
+	DisableAutoWhen  string
+	Interval         time.Duration
 
 	AuthorEmail string
 
@@ -65,6 +74,7 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 		Branch:     f.Branch,
 		Remotes:    f.Remotes,
 		Prompter:   f.Prompter,
+		Interval:   defaultDisableAutoInterval,
 	}
 
 	var (
@@ -74,6 +84,7 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 	)
 
 	var bodyFile string
+	var interval int
 
 	cmd := &cobra.Command{
 		Use:   "merge [<number> | <url> | <branch>]",
@@ -88,6 +99,14 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 			If required checks have not yet passed, auto-merge will be enabled.
 			If required checks have passed, the pull request will be added to the merge queue.
 			To bypass a merge queue and merge directly, pass the %[1]s--admin%[1]s flag.
+
+			Use %[1]s--auto-status%[1]s to check whether auto-merge is enabled for a pull request,
+			who enabled it, and which merge method it will use.
+
+			Use %[1]s--disable-auto-when checks-failed%[1]s to watch a pull request with auto-merge
+			enabled and automatically disable auto-merge, with an explanatory comment, if any check run
+			fails. This polls on the interval set by %[1]s--interval%[1]s (10 seconds by default) and
+			exits once auto-merge has been disabled or all checks have passed.
 		`, "`"),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -127,14 +146,32 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 			bodyFileProvided := bodyFile != ""
 
 			if err := cmdutil.MutuallyExclusive(
-				"specify only one of `--auto`, `--disable-auto`, or `--admin`",
+				"specify only one of `--auto`, `--disable-auto`, `--auto-status`, `--disable-auto-when`, or `--admin`",
 				opts.AutoMergeEnable,
 				opts.AutoMergeDisable,
+				opts.AutoMergeStatus,
+				opts.DisableAutoWhen != "",
 				opts.UseAdmin,
 			); err != nil {
 				return err
 			}
 
+			if opts.DisableAutoWhen != "" && opts.DisableAutoWhen != disableAutoWhenChecksFailed {
+				return cmdutil.FlagErrorf("unsupported value for `--disable-auto-when`: %q", opts.DisableAutoWhen)
+			}
+
+			intervalChanged := cmd.Flags().Changed("interval")
+			if opts.DisableAutoWhen == "" && intervalChanged {
+				return cmdutil.FlagErrorf("cannot use `--interval` flag without `--disable-auto-when` flag")
+			}
+			if intervalChanged {
+				var err error
+				opts.Interval, err = time.ParseDuration(fmt.Sprintf("%ds", interval))
+				if err != nil {
+					return cmdutil.FlagErrorf("could not parse `--interval` flag: %w", err)
+				}
+			}
+
 			if err := cmdutil.MutuallyExclusive(
 				"specify only one of `--body` or `--body-file`",
 				bodyProvided,
@@ -181,6 +218,9 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 	cmd.Flags().BoolVarP(&flagSquash, "squash", "s", false, "Squash the commits into one commit and merge it into the base branch")
 	cmd.Flags().BoolVar(&opts.AutoMergeEnable, "auto", false, "Automatically merge only after necessary requirements are met")
 	cmd.Flags().BoolVar(&opts.AutoMergeDisable, "disable-auto", false, "Disable auto-merge for this pull request")
+	cmd.Flags().BoolVar(&opts.AutoMergeStatus, "auto-status", false, "Show whether auto-merge is enabled and who enabled it")
+	cmd.Flags().StringVar(&opts.DisableAutoWhen, "disable-auto-when", "", "Watch the pull request and disable auto-merge if `condition` occurs (only \"checks-failed\" is supported)")
+	cmd.Flags().IntVar(&interval, "interval", 10, "Refresh interval in seconds when using `--disable-auto-when` flag")
 	cmd.Flags().StringVar(&opts.MatchHeadCommit, "match-head-commit", "", "Commit `SHA` that the pull request head must match to allow merge")
 	cmd.Flags().StringVarP(&opts.AuthorEmail, "author-email", "A", "", "Email `text` for merge commit author")
 	return cmd
@@ -210,6 +250,63 @@ func (m *mergeContext) disableAutoMerge() error {
 	return m.infof("%s Auto-merge disabled for pull request %s#%d\n", m.cs.SuccessIconWithColor(m.cs.Green), ghrepo.FullName(m.baseRepo), m.pr.Number)
 }
 
+// Print whether auto-merge is enabled for the pull request, and if so, who enabled it and which
+// merge method it will use.
+func (m *mergeContext) printAutoMergeStatus() error {
+	if m.pr.AutoMergeRequest == nil {
+		_, err := fmt.Fprintf(m.opts.IO.Out, "Auto-merge is not enabled for pull request %s#%d\n", ghrepo.FullName(m.baseRepo), m.pr.Number)
+		return err
+	}
+
+	method := "merge"
+	switch m.pr.AutoMergeRequest.MergeMethod {
+	case "REBASE":
+		method = "rebase"
+	case "SQUASH":
+		method = "squash"
+	}
+
+	_, err := fmt.Fprintf(m.opts.IO.Out, "Auto-merge is enabled for pull request %s#%d by @%s via %s, enabled at %s\n",
+		ghrepo.FullName(m.baseRepo), m.pr.Number, m.pr.AutoMergeRequest.EnabledBy.Login, method, m.pr.AutoMergeRequest.EnabledAt.Format(time.RFC3339))
+	return err
+}
+
+// Watch the pull request's checks and disable auto-merge, with an explanatory comment, if the
+// configured condition occurs.
+func (m *mergeContext) watchAutoMergeCondition() error {
+	if m.pr.AutoMergeRequest == nil {
+		return m.infof("%s Auto-merge is not enabled for pull request %s#%d\n", m.cs.WarningIcon(), ghrepo.FullName(m.baseRepo), m.pr.Number)
+	}
+
+	for {
+		checks, err := refreshChecksStatus(m.httpClient, m.baseRepo, m.pr.ID)
+		if err != nil {
+			return err
+		}
+
+		if checks.Failing > 0 {
+			if err := disableAutoMerge(m.httpClient, m.baseRepo, m.pr.ID); err != nil {
+				return err
+			}
+			apiClient := api.NewClientFromHTTP(m.httpClient)
+			_, err := api.CommentCreate(apiClient, m.baseRepo.RepoHost(), api.CommentCreateInput{
+				SubjectId: m.pr.ID,
+				Body:      "Auto-merge was automatically disabled because one or more checks failed.",
+			})
+			if err != nil {
+				return err
+			}
+			return m.infof("%s Auto-merge disabled for pull request %s#%d: a check failed\n", m.cs.SuccessIconWithColor(m.cs.Green), ghrepo.FullName(m.baseRepo), m.pr.Number)
+		}
+
+		if checks.Pending == 0 {
+			return m.infof("%s All checks have passed for pull request %s#%d; leaving auto-merge enabled\n", m.cs.SuccessIconWithColor(m.cs.Green), ghrepo.FullName(m.baseRepo), m.pr.Number)
+		}
+
+		time.Sleep(m.opts.Interval)
+	}
+}
+
 // Check if this pull request is in a merge queue
 func (m *mergeContext) inMergeQueue() error {
 	// if the pull request is in a merge queue no further action is possible
@@ -483,9 +580,14 @@ func (m *mergeContext) infof(format string, args ...interface{}) error {
 
 // Creates a new MergeContext from MergeOptions.
 func NewMergeContext(opts *MergeOptions) (*mergeContext, error) {
+	fields := []string{"id", "number", "state", "title", "lastCommit", "mergeStateStatus", "headRepositoryOwner", "headRefName", "baseRefName", "headRefOid", "isInMergeQueue", "isMergeQueueEnabled"}
+	if opts.AutoMergeStatus || opts.DisableAutoWhen != "" {
+		fields = append(fields, "autoMergeRequest")
+	}
+
 	findOptions := shared.FindOptions{
 		Selector: opts.SelectorArg,
-		Fields:   []string{"id", "number", "state", "title", "lastCommit", "mergeStateStatus", "headRepositoryOwner", "headRefName", "baseRefName", "headRefOid", "isInMergeQueue", "isMergeQueueEnabled"},
+		Fields:   fields,
 	}
 	pr, baseRepo, err := opts.Finder.Find(findOptions)
 	if err != nil {
@@ -520,6 +622,11 @@ func mergeRun(opts *MergeOptions) error {
 		return err
 	}
 
+	// no further action is possible when just checking or watching auto-merge
+	if opts.AutoMergeStatus {
+		return ctx.printAutoMergeStatus()
+	}
+
 	if err := ctx.inMergeQueue(); err != nil {
 		return err
 	}
@@ -529,6 +636,10 @@ func mergeRun(opts *MergeOptions) error {
 		return ctx.disableAutoMerge()
 	}
 
+	if opts.DisableAutoWhen != "" {
+		return ctx.watchAutoMergeCondition()
+	}
+
 	ctx.warnIfDiverged()
 
 	if err := ctx.canMerge(); err != nil {
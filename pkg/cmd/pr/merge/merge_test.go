@@ -180,6 +180,24 @@ func Test_NewCmdMerge(t *testing.T) {
 			isTTY:   false,
 			wantErr: "only one of --merge, --rebase, or --squash can be enabled",
 		},
+		{
+			name:    "auto and auto-status flags",
+			args:    "123 --auto --auto-status",
+			isTTY:   true,
+			wantErr: "specify only one of `--auto`, `--disable-auto`, `--auto-status`, `--disable-auto-when`, or `--admin`",
+		},
+		{
+			name:    "unsupported disable-auto-when condition",
+			args:    "123 --disable-auto-when never",
+			isTTY:   true,
+			wantErr: "unsupported value for `--disable-auto-when`: \"never\"",
+		},
+		{
+			name:    "interval without disable-auto-when",
+			args:    "123 --interval 5",
+			isTTY:   true,
+			wantErr: "cannot use `--interval` flag without `--disable-auto-when` flag",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1652,6 +1670,126 @@ func TestMergeRun_disableAutoMerge(t *testing.T) {
 	assert.Equal(t, "✓ Auto-merge disabled for pull request OWNER/REPO#123\n", stderr.String())
 }
 
+func TestMergeRun_autoMergeStatus_enabled(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	ios.SetStderrTTY(true)
+
+	tr := initFakeHTTP()
+	defer tr.Verify(t)
+
+	_, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	err := mergeRun(&MergeOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: tr}, nil
+		},
+		SelectorArg:     "https://github.com/OWNER/REPO/pull/123",
+		AutoMergeStatus: true,
+		Finder: shared.NewMockFinder(
+			"https://github.com/OWNER/REPO/pull/123",
+			&api.PullRequest{
+				ID:     "THE-ID",
+				Number: 123,
+				AutoMergeRequest: &api.AutoMergeRequest{
+					MergeMethod: "SQUASH",
+					EnabledBy:   api.Author{Login: "monalisa"},
+				},
+			},
+			ghrepo.New("OWNER", "REPO"),
+		),
+	})
+	assert.NoError(t, err)
+
+	assert.Contains(t, stdout.String(), "Auto-merge is enabled for pull request OWNER/REPO#123 by @monalisa via squash")
+}
+
+func TestMergeRun_autoMergeStatus_disabled(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	ios.SetStderrTTY(true)
+
+	tr := initFakeHTTP()
+	defer tr.Verify(t)
+
+	_, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	err := mergeRun(&MergeOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: tr}, nil
+		},
+		SelectorArg:     "https://github.com/OWNER/REPO/pull/123",
+		AutoMergeStatus: true,
+		Finder: shared.NewMockFinder(
+			"https://github.com/OWNER/REPO/pull/123",
+			&api.PullRequest{ID: "THE-ID", Number: 123},
+			ghrepo.New("OWNER", "REPO"),
+		),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Auto-merge is not enabled for pull request OWNER/REPO#123\n", stdout.String())
+}
+
+func TestMergeRun_disableAutoWhen_checksFailed(t *testing.T) {
+	ios, _, _, stderr := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	ios.SetStderrTTY(true)
+
+	tr := initFakeHTTP()
+	defer tr.Verify(t)
+	tr.Register(
+		httpmock.GraphQL(`query PullRequestAutoMergeChecksStatus\b`),
+		httpmock.StringResponse(`{"data":{"node":{"statusCheckRollup":{"nodes":[{"commit":{"statusCheckRollup":{"contexts":{"nodes":[
+			{"__typename":"CheckRun","name":"ci-test","status":"COMPLETED","conclusion":"FAILURE"}
+		]}}}}]}}}}`),
+	)
+	tr.Register(
+		httpmock.GraphQL(`mutation PullRequestAutoMergeDisable\b`),
+		httpmock.GraphQLQuery(`{}`, func(s string, m map[string]interface{}) {
+			assert.Equal(t, map[string]interface{}{"prID": "THE-ID"}, m)
+		}))
+	tr.Register(
+		httpmock.GraphQL(`mutation CommentCreate\b`),
+		httpmock.GraphQLMutation(`{"data":{"addComment":{"commentEdge":{"node":{"url":"https://github.com/OWNER/REPO/pull/123#issuecomment-1"}}}}}`,
+			func(input map[string]interface{}) {
+				assert.Equal(t, "THE-ID", input["subjectId"].(string))
+			}),
+	)
+
+	_, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	err := mergeRun(&MergeOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: tr}, nil
+		},
+		SelectorArg:     "https://github.com/OWNER/REPO/pull/123",
+		DisableAutoWhen: "checks-failed",
+		Interval:        0,
+		Finder: shared.NewMockFinder(
+			"https://github.com/OWNER/REPO/pull/123",
+			&api.PullRequest{
+				ID:     "THE-ID",
+				Number: 123,
+				AutoMergeRequest: &api.AutoMergeRequest{
+					MergeMethod: "MERGE",
+					EnabledBy:   api.Author{Login: "monalisa"},
+				},
+			},
+			ghrepo.New("OWNER", "REPO"),
+		),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "✓ Auto-merge disabled for pull request OWNER/REPO#123: a check failed\n", stderr.String())
+}
+
 func TestPrInMergeQueue(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
@@ -5,15 +5,18 @@ import (
 	cmdLock "github.com/cli/cli/v2/pkg/cmd/issue/lock"
 	cmdCheckout "github.com/cli/cli/v2/pkg/cmd/pr/checkout"
 	cmdChecks "github.com/cli/cli/v2/pkg/cmd/pr/checks"
+	cmdCherryPick "github.com/cli/cli/v2/pkg/cmd/pr/cherrypick"
 	cmdClose "github.com/cli/cli/v2/pkg/cmd/pr/close"
 	cmdComment "github.com/cli/cli/v2/pkg/cmd/pr/comment"
 	cmdCreate "github.com/cli/cli/v2/pkg/cmd/pr/create"
 	cmdDiff "github.com/cli/cli/v2/pkg/cmd/pr/diff"
 	cmdEdit "github.com/cli/cli/v2/pkg/cmd/pr/edit"
+	cmdExport "github.com/cli/cli/v2/pkg/cmd/pr/export"
 	cmdList "github.com/cli/cli/v2/pkg/cmd/pr/list"
 	cmdMerge "github.com/cli/cli/v2/pkg/cmd/pr/merge"
 	cmdReady "github.com/cli/cli/v2/pkg/cmd/pr/ready"
 	cmdReopen "github.com/cli/cli/v2/pkg/cmd/pr/reopen"
+	cmdRevert "github.com/cli/cli/v2/pkg/cmd/pr/revert"
 	cmdReview "github.com/cli/cli/v2/pkg/cmd/pr/review"
 	cmdStatus "github.com/cli/cli/v2/pkg/cmd/pr/status"
 	cmdUpdateBranch "github.com/cli/cli/v2/pkg/cmd/pr/update-branch"
@@ -63,9 +66,12 @@ func NewCmdPR(f *cmdutil.Factory) *cobra.Command {
 		cmdComment.NewCmdComment(f, nil),
 		cmdClose.NewCmdClose(f, nil),
 		cmdReopen.NewCmdReopen(f, nil),
+		cmdRevert.NewCmdRevert(f, nil),
+		cmdCherryPick.NewCmdCherryPick(f, nil),
 		cmdEdit.NewCmdEdit(f, nil),
 		cmdLock.NewCmdLock(f, cmd.Name(), nil),
 		cmdLock.NewCmdUnlock(f, cmd.Name(), nil),
+		cmdExport.NewCmdExport(f, nil),
 	)
 
 	return cmd
@@ -0,0 +1,45 @@
+package revert
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+// revertedPullRequest is the subset of fields we need from the REST "Revert a pull request"
+// response, which is shaped like the REST pull request object rather than our usual GraphQL one.
+type revertedPullRequest struct {
+	Number int    `json:"number"`
+	URL    string `json:"html_url"`
+	Title  string `json:"title"`
+}
+
+// createRevertViaAPI asks GitHub to create the revert branch, revert commit, and pull request
+// on our behalf, the same way the "Revert" button on pull request pages does. It returns an
+// error wrapping api.HTTPError so callers can detect a 404 and fall back to doing it locally,
+// which is necessary on GitHub Enterprise Server versions that predate this endpoint.
+func createRevertViaAPI(client *api.Client, repo ghrepo.Interface, number int, title, body string) (*revertedPullRequest, error) {
+	path := fmt.Sprintf("repos/%s/%s/pulls/%d/revert", repo.RepoOwner(), repo.RepoName(), number)
+
+	payload := map[string]string{}
+	if title != "" {
+		payload["title"] = title
+	}
+	if body != "" {
+		payload["body"] = body
+	}
+	requestBody, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var result revertedPullRequest
+	if err := client.REST(repo.RepoHost(), "POST", path, bytes.NewReader(requestBody), &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,183 @@
+package revert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type RevertOptions struct {
+	HttpClient func() (*http.Client, error)
+	GitClient  *git.Client
+	IO         *iostreams.IOStreams
+
+	Finder shared.PRFinder
+
+	SelectorArg string
+	Title       string
+	Body        string
+}
+
+func NewCmdRevert(f *cmdutil.Factory, runF func(*RevertOptions) error) *cobra.Command {
+	opts := &RevertOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		GitClient:  f.GitClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "revert {<number> | <url> | <branch>}",
+		Short: "Revert a merged pull request",
+		Long: heredoc.Doc(`
+			Open a new pull request that reverts a merged pull request, equivalent to
+			clicking the "Revert" button on the pull request page.
+
+			GitHub creates the revert branch, revert commit, and pull request for you.
+			If the GitHub instance doesn't support that (for example, an older GitHub
+			Enterprise Server release), this falls back to reverting the commit in your
+			local checkout and pushing a new branch and pull request from there.
+		`),
+		Example: heredoc.Doc(`
+			$ gh pr revert 23
+			$ gh pr revert 23 --title "Revert \"Add new feature\""
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot revert pull request: number, url, or branch required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Finder = shared.NewFinder(f)
+			opts.SelectorArg = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return revertRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Title, "title", "t", "", "Title for the revert pull request")
+	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "Body for the revert pull request")
+
+	return cmd
+}
+
+func revertRun(opts *RevertOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	findOptions := shared.FindOptions{
+		Selector: opts.SelectorArg,
+		Fields:   []string{"number", "title", "state", "baseRefName", "headRefName", "mergeCommit", "url"},
+	}
+	pr, baseRepo, err := opts.Finder.Find(findOptions)
+	if err != nil {
+		return err
+	}
+
+	if pr.State != "MERGED" {
+		return fmt.Errorf("can't revert pull request #%d (%s) because it was not merged", pr.Number, pr.Title)
+	}
+	if pr.MergeCommit == nil || pr.MergeCommit.OID == "" {
+		return fmt.Errorf("can't revert pull request #%d (%s): no merge commit found", pr.Number, pr.Title)
+	}
+
+	title := opts.Title
+	if title == "" {
+		title = fmt.Sprintf("Revert %q", pr.Title)
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	opts.IO.StartProgressIndicator()
+	reverted, err := createRevertViaAPI(client, baseRepo, pr.Number, title, opts.Body)
+	opts.IO.StopProgressIndicator()
+
+	if err != nil {
+		var httpErr api.HTTPError
+		if !errors.As(err, &httpErr) || httpErr.StatusCode != 404 {
+			return fmt.Errorf("failed to revert pull request: %w", err)
+		}
+
+		opts.IO.StartProgressIndicator()
+		reverted, err = createRevertViaGit(opts.GitClient, client, baseRepo, pr, title, opts.Body)
+		opts.IO.StopProgressIndicator()
+		if err != nil {
+			return fmt.Errorf("failed to revert pull request: %w", err)
+		}
+	}
+
+	fmt.Fprintf(opts.IO.Out, "%s Created revert pull request %s for %s#%d\n", cs.SuccessIconWithColor(cs.Green), cs.Cyanf("#%d", reverted.Number), ghrepo.FullName(baseRepo), pr.Number)
+	fmt.Fprintln(opts.IO.Out, reverted.URL)
+
+	return nil
+}
+
+// createRevertViaGit reverts the pull request's merge commit in the local checkout, pushes a
+// new branch, and opens a pull request from it. It's the fallback for GitHub instances that
+// don't support the REST revert endpoint.
+func createRevertViaGit(gitClient *git.Client, client *api.Client, baseRepo ghrepo.Interface, pr *api.PullRequest, title, body string) (*revertedPullRequest, error) {
+	ctx := context.Background()
+
+	if err := gitClient.Fetch(ctx, "origin", pr.BaseRefName); err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", pr.BaseRefName, err)
+	}
+
+	revertBranch := fmt.Sprintf("revert-%d-%s", pr.Number, pr.HeadRefName)
+
+	checkoutCmd, err := gitClient.Command(ctx, "checkout", "-b", revertBranch, fmt.Sprintf("origin/%s", pr.BaseRefName))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := checkoutCmd.Output(); err != nil {
+		return nil, fmt.Errorf("failed to create branch %s: %w", revertBranch, err)
+	}
+
+	revertCmd, err := gitClient.Command(ctx, "revert", "--no-edit", "-m", "1", pr.MergeCommit.OID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := revertCmd.Output(); err != nil {
+		// The merge commit might not actually be a merge (e.g. a squash merge), in
+		// which case `-m 1` is invalid and a plain revert is what's needed instead.
+		plainRevertCmd, err := gitClient.Command(ctx, "revert", "--no-edit", pr.MergeCommit.OID)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := plainRevertCmd.Output(); err != nil {
+			return nil, fmt.Errorf("failed to revert commit %s: %w", pr.MergeCommit.OID, err)
+		}
+	}
+
+	if err := gitClient.Push(ctx, "origin", fmt.Sprintf("HEAD:%s", revertBranch)); err != nil {
+		return nil, fmt.Errorf("failed to push branch %s: %w", revertBranch, err)
+	}
+
+	repo, err := api.GitHubRepo(client, baseRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	params := map[string]interface{}{
+		"title":       title,
+		"body":        body,
+		"baseRefName": pr.BaseRefName,
+		"headRefName": revertBranch,
+	}
+	newPR, err := api.CreatePullRequest(client, repo, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &revertedPullRequest{Number: newPR.Number, URL: newPR.URL, Title: newPR.Title}, nil
+}
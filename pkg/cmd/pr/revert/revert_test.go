@@ -0,0 +1,150 @@
+package revert
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/run"
+	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/test"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func runCommand(rt http.RoundTripper, isTTY bool, cli string) (*test.CmdOut, error) {
+	ios, _, stdout, stderr := iostreams.Test()
+	ios.SetStdoutTTY(isTTY)
+	ios.SetStdinTTY(isTTY)
+	ios.SetStderrTTY(isTTY)
+
+	factory := &cmdutil.Factory{
+		IOStreams: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: rt}, nil
+		},
+		GitClient: &git.Client{GitPath: "some/path/git"},
+	}
+
+	cmd := NewCmdRevert(factory, nil)
+
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+
+	_, err = cmd.ExecuteC()
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+func TestNoArgs(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	_, err := runCommand(http, true, "")
+
+	assert.EqualError(t, err, "cannot revert pull request: number, url, or branch required")
+}
+
+func TestRevertRun_notMerged(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	baseRepo, err := ghrepo.FromFullName("OWNER/REPO")
+	assert.NoError(t, err)
+	pr := &api.PullRequest{Number: 96, Title: "The title of the PR", State: "OPEN"}
+	shared.RunCommandFinder("96", pr, baseRepo)
+
+	_, err = runCommand(http, true, "96")
+	assert.EqualError(t, err, "can't revert pull request #96 (The title of the PR) because it was not merged")
+}
+
+func TestRevertRun_viaAPI(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	baseRepo, err := ghrepo.FromFullName("OWNER/REPO")
+	assert.NoError(t, err)
+	pr := &api.PullRequest{
+		Number:      96,
+		Title:       "The title of the PR",
+		State:       "MERGED",
+		BaseRefName: "main",
+		HeadRefName: "feature",
+		MergeCommit: &api.Commit{OID: "abc123"},
+	}
+	shared.RunCommandFinder("96", pr, baseRepo)
+
+	http.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/pulls/96/revert"),
+		httpmock.JSONResponse(map[string]interface{}{
+			"number":   97,
+			"html_url": "https://github.com/OWNER/REPO/pull/97",
+			"title":    `Revert "The title of the PR"`,
+		}),
+	)
+
+	output, err := runCommand(http, true, "96")
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Created revert pull request #97 for OWNER/REPO#96\nhttps://github.com/OWNER/REPO/pull/97\n", output.String())
+}
+
+func TestRevertRun_fallsBackToGitOn404(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	baseRepo, err := ghrepo.FromFullName("OWNER/REPO")
+	assert.NoError(t, err)
+	pr := &api.PullRequest{
+		Number:      96,
+		Title:       "The title of the PR",
+		State:       "MERGED",
+		BaseRefName: "main",
+		HeadRefName: "feature",
+		MergeCommit: &api.Commit{OID: "abc123"},
+	}
+	shared.RunCommandFinder("96", pr, baseRepo)
+
+	http.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/pulls/96/revert"),
+		httpmock.StatusStringResponse(404, `{"message": "Not Found"}`),
+	)
+	http.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`{"data":{"repository":{"id":"THE-REPO-ID","name":"REPO","owner":{"login":"OWNER"},"defaultBranchRef":{"name":"main"}}}}`),
+	)
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestCreate\b`),
+		httpmock.GraphQLMutation(`{"data":{"createPullRequest":{"pullRequest":{"id":"NEW-ID","number":98,"url":"https://github.com/OWNER/REPO/pull/98"}}}}`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "main", inputs["baseRefName"])
+				assert.Equal(t, "revert-96-feature", inputs["headRefName"])
+			}),
+	)
+
+	cs, restoreRun := run.Stub()
+	defer restoreRun(t)
+	cs.Register(`git fetch origin main`, 0, "")
+	cs.Register(`git checkout -b revert-96-feature origin/main`, 0, "")
+	cs.Register(`git revert --no-edit -m 1 abc123`, 0, "")
+	cs.Register(`git push --set-upstream origin HEAD:revert-96-feature`, 0, "")
+
+	output, err := runCommand(http, true, "96")
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Created revert pull request #98 for OWNER/REPO#96\nhttps://github.com/OWNER/REPO/pull/98\n", output.String())
+}
@@ -43,11 +43,16 @@ type CommentableOptions struct {
 	InputType             InputType
 	Body                  string
 	EditLast              bool
+	DeleteLast            bool
 	Quiet                 bool
 	Host                  string
 }
 
 func CommentablePreRun(cmd *cobra.Command, opts *CommentableOptions) error {
+	if opts.DeleteLast {
+		return nil
+	}
+
 	inputFlags := 0
 	if cmd.Flags().Changed("body") {
 		opts.InputType = InputTypeInline
@@ -84,12 +89,40 @@ func CommentableRun(opts *CommentableOptions) error {
 		return err
 	}
 	opts.Host = repo.RepoHost()
+	if opts.DeleteLast {
+		return deleteLastComment(commentable, opts)
+	}
 	if opts.EditLast {
 		return updateComment(commentable, opts)
 	}
 	return createComment(commentable, opts)
 }
 
+func deleteLastComment(commentable Commentable, opts *CommentableOptions) error {
+	comments := commentable.CurrentUserComments()
+	if len(comments) == 0 {
+		return fmt.Errorf("no comments found for current user")
+	}
+
+	lastComment := &comments[len(comments)-1]
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+	if err := api.CommentDelete(apiClient, opts.Host, lastComment.Identifier()); err != nil {
+		return err
+	}
+
+	if !opts.Quiet {
+		fmt.Fprintf(opts.IO.Out, "Deleted comment %s\n", lastComment.Link())
+	}
+
+	return nil
+}
+
 func createComment(commentable Commentable, opts *CommentableOptions) error {
 	switch opts.InputType {
 	case InputTypeWeb:
@@ -55,11 +55,14 @@ func PrintMessage(io *iostreams.IOStreams, s string) {
 	fmt.Fprintln(io.Out, io.ColorScheme().Gray(s))
 }
 
-func ListNoResults(repoName string, itemName string, hasFilters bool) error {
+func ListNoResults(repoName string, itemName string, hasFilters bool, failFast bool) error {
+	var err cmdutil.NoResultsError
 	if hasFilters {
-		return cmdutil.NewNoResultsError(fmt.Sprintf("no %ss match your search in %s", itemName, repoName))
+		err = cmdutil.NewNoResultsError(fmt.Sprintf("no %ss match your search in %s", itemName, repoName))
+	} else {
+		err = cmdutil.NewNoResultsError(fmt.Sprintf("no open %ss in %s", itemName, repoName))
 	}
-	return cmdutil.NewNoResultsError(fmt.Sprintf("no open %ss in %s", itemName, repoName))
+	return cmdutil.WrapNoResultsError(err, failFast)
 }
 
 func ListHeader(repoName string, itemName string, matchCount int, totalMatchCount int, hasFilters bool) string {
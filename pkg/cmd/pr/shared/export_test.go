@@ -0,0 +1,73 @@
+package shared
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIssueExportRecord(t *testing.T) {
+	createdAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	closedAt := time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)
+	issue := api.Issue{
+		Number:    1,
+		Title:     "a bug",
+		State:     "CLOSED",
+		URL:       "https://github.com/OWNER/REPO/issues/1",
+		CreatedAt: createdAt,
+		ClosedAt:  &closedAt,
+	}
+	issue.Author.Login = "monalisa"
+
+	record := NewIssueExportRecord(issue)
+	assert.Equal(t, "issue", record.Type)
+	assert.Equal(t, "monalisa", record.Author)
+	require.Len(t, record.Events, 2)
+	assert.Equal(t, "created", record.Events[0].Type)
+	assert.Equal(t, "closed", record.Events[1].Type)
+}
+
+func TestNewPullRequestExportRecord_merged(t *testing.T) {
+	createdAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	mergedAt := time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)
+	pr := api.PullRequest{
+		Number:    2,
+		Title:     "a feature",
+		State:     "MERGED",
+		CreatedAt: createdAt,
+		MergedAt:  &mergedAt,
+	}
+	pr.Author.Login = "monalisa"
+
+	record := NewPullRequestExportRecord(pr)
+	assert.Equal(t, "pull_request", record.Type)
+	require.Len(t, record.Events, 2)
+	assert.Equal(t, "merged", record.Events[1].Type)
+}
+
+func TestWriteNDJSONExport(t *testing.T) {
+	buf := bytes.Buffer{}
+	records := []ExportRecord{
+		{Type: "issue", Number: 1, Title: "one"},
+		{Type: "issue", Number: 2, Title: "two"},
+	}
+	require.NoError(t, WriteNDJSONExport(&buf, records))
+	assert.Equal(t, 2, bytes.Count(buf.Bytes(), []byte("\n")))
+	assert.Contains(t, buf.String(), `"title":"one"`)
+}
+
+func TestWriteCSVExport(t *testing.T) {
+	buf := bytes.Buffer{}
+	records := []ExportRecord{
+		{Type: "issue", Number: 1, Title: "one", Labels: []string{"bug"}},
+	}
+	require.NoError(t, WriteCSVExport(&buf, records))
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 2)
+	assert.Equal(t, exportCSVHeader[0], "type")
+	assert.Contains(t, string(lines[1]), `issue,1,one,,,,,"[""bug""]"`)
+}
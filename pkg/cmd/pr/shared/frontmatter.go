@@ -0,0 +1,38 @@
+package shared
+
+import (
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FrontmatterOptions is the set of issue/PR metadata fields that can be set
+// via YAML front matter at the top of a body file, letting a single Markdown
+// file (e.g. from a repo of templates) drive both the body text and its
+// surrounding metadata in one shot.
+type FrontmatterOptions struct {
+	Title     string   `yaml:"title"`
+	Labels    []string `yaml:"labels"`
+	Assignees []string `yaml:"assignees"`
+	Milestone string   `yaml:"milestone"`
+	Projects  []string `yaml:"projects"`
+}
+
+var frontmatterPattern = regexp.MustCompile(`(?s)\A---\r?\n(.*?\r?\n)---\r?\n?`)
+
+// ExtractFrontmatter splits a leading YAML front matter block off of body
+// text, if present, and parses it into a FrontmatterOptions. found is false,
+// and rest is body unchanged, when body has no front matter block or the
+// front matter fails to parse as YAML.
+func ExtractFrontmatter(body string) (opts FrontmatterOptions, rest string, found bool) {
+	loc := frontmatterPattern.FindStringSubmatchIndex(body)
+	if loc == nil {
+		return FrontmatterOptions{}, body, false
+	}
+
+	if err := yaml.Unmarshal([]byte(body[loc[2]:loc[3]]), &opts); err != nil {
+		return FrontmatterOptions{}, body, false
+	}
+
+	return opts, body[loc[1]:], true
+}
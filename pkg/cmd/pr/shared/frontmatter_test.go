@@ -0,0 +1,68 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractFrontmatter(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		wantOpts FrontmatterOptions
+		wantBody string
+		wantOk   bool
+	}{
+		{
+			name: "full front matter",
+			body: "---\n" +
+				"title: Bug in widget\n" +
+				"labels: [bug, help wanted]\n" +
+				"assignees: [monalisa]\n" +
+				"milestone: v1.0\n" +
+				"projects: [Roadmap]\n" +
+				"---\n" +
+				"The widget is broken.\n",
+			wantOpts: FrontmatterOptions{
+				Title:     "Bug in widget",
+				Labels:    []string{"bug", "help wanted"},
+				Assignees: []string{"monalisa"},
+				Milestone: "v1.0",
+				Projects:  []string{"Roadmap"},
+			},
+			wantBody: "The widget is broken.\n",
+			wantOk:   true,
+		},
+		{
+			name:     "no front matter",
+			body:     "Just a body.\n",
+			wantBody: "Just a body.\n",
+			wantOk:   false,
+		},
+		{
+			name:     "malformed front matter",
+			body:     "---\ntitle: [unterminated\n---\nbody\n",
+			wantBody: "---\ntitle: [unterminated\n---\nbody\n",
+			wantOk:   false,
+		},
+		{
+			name:     "crlf line endings",
+			body:     "---\r\ntitle: CRLF title\r\n---\r\nbody text\r\n",
+			wantOpts: FrontmatterOptions{Title: "CRLF title"},
+			wantBody: "body text\r\n",
+			wantOk:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, rest, ok := ExtractFrontmatter(tt.body)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.wantBody, rest)
+			if tt.wantOk {
+				assert.Equal(t, tt.wantOpts, opts)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/i18n"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/cli/cli/v2/pkg/surveyext"
@@ -64,7 +65,12 @@ func confirmSubmission(p Prompt, allowPreview, allowMetadata, allowDraft, isDraf
 	}
 	options = append(options, cancelLabel)
 
-	result, err := p.Select("What's next?", "", options)
+	displayOptions := make([]string, len(options))
+	for i, o := range options {
+		displayOptions[i] = i18n.T(o)
+	}
+
+	result, err := p.Select(i18n.T("What's next?"), "", displayOptions)
 	if err != nil {
 		return -1, fmt.Errorf("could not prompt: %w", err)
 	}
@@ -95,7 +101,7 @@ func BodySurvey(p Prompt, state *IssueMetadataState, templateContent string) err
 		state.Body += templateContent
 	}
 
-	result, err := p.MarkdownEditor("Body", state.Body, true)
+	result, err := p.MarkdownEditor(i18n.T("Body"), state.Body, true)
 	if err != nil {
 		return err
 	}
@@ -110,7 +116,7 @@ func BodySurvey(p Prompt, state *IssueMetadataState, templateContent string) err
 }
 
 func TitleSurvey(p Prompt, state *IssueMetadataState) error {
-	result, err := p.Input("Title", state.Title)
+	result, err := p.Input(i18n.T("Title"), state.Title)
 	if err != nil {
 		return err
 	}
@@ -161,7 +167,7 @@ func MetadataSurvey(p Prompt, io *iostreams.IOStreams, baseRepo ghrepo.Interface
 	}
 	extraFieldsOptions = append(extraFieldsOptions, "Assignees", "Labels", "Projects", "Milestone")
 
-	selected, err := p.MultiSelect("What would you like to add?", nil, extraFieldsOptions)
+	selected, err := p.MultiSelect(i18n.T("What would you like to add?"), nil, extraFieldsOptions)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,82 @@
+package status
+
+import (
+	"fmt"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	mergequeueShared "github.com/cli/cli/v2/pkg/cmd/mergequeue/shared"
+)
+
+// enrichPRs augments prs in place with merge-queue position and pending
+// deployment environments. Both require extra requests beyond the status
+// query itself, so callers only invoke this when that cost was asked for
+// (--conflict-status, or the fields were explicitly requested via --json).
+func enrichPRs(apiClient *api.Client, repo ghrepo.Interface, prs []*api.PullRequest) {
+	queues := map[string][]mergequeueShared.Entry{}
+
+	for _, pr := range prs {
+		if pr == nil {
+			continue
+		}
+
+		if pr.IsInMergeQueue {
+			entries, ok := queues[pr.BaseRefName]
+			if !ok {
+				entries, _ = mergequeueShared.GetEntries(apiClient, repo, pr.BaseRefName)
+				queues[pr.BaseRefName] = entries
+			}
+			for _, e := range entries {
+				if e.PRNumber == pr.Number {
+					pr.MergeQueuePosition = e.Position
+					break
+				}
+			}
+		}
+
+		if pr.State == "OPEN" {
+			if envs, err := pendingDeploymentEnvironments(apiClient, repo, pr.HeadRefOid); err == nil {
+				pr.PendingDeploymentEnvironments = envs
+			}
+		}
+	}
+}
+
+type pendingDeployment struct {
+	Environment struct {
+		Name string `json:"name"`
+	} `json:"environment"`
+}
+
+// pendingDeploymentEnvironments returns the names of environments with a
+// deployment awaiting manual approval on a workflow run at headSha. Secrets
+// aside, this is the only part of the bundle that has no GraphQL equivalent.
+func pendingDeploymentEnvironments(apiClient *api.Client, repo ghrepo.Interface, headSha string) ([]string, error) {
+	if headSha == "" {
+		return nil, nil
+	}
+
+	path := fmt.Sprintf("repos/%s/actions/runs?head_sha=%s&status=waiting", ghrepo.FullName(repo), headSha)
+	var runs struct {
+		WorkflowRuns []struct {
+			ID int64 `json:"id"`
+		} `json:"workflow_runs"`
+	}
+	if err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &runs); err != nil {
+		return nil, err
+	}
+
+	var environments []string
+	for _, run := range runs.WorkflowRuns {
+		var pending []pendingDeployment
+		runPath := fmt.Sprintf("repos/%s/actions/runs/%d/pending_deployments", ghrepo.FullName(repo), run.ID)
+		if err := apiClient.REST(repo.RepoHost(), "GET", runPath, nil, &pending); err != nil {
+			return nil, err
+		}
+		for _, p := range pending {
+			environments = append(environments, p.Environment.Name)
+		}
+	}
+
+	return environments, nil
+}
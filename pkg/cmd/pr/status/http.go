@@ -55,6 +55,9 @@ func pullRequestStatus(httpClient *http.Client, repo ghrepo.Interface, options r
 		fields.AddValues(options.Fields)
 		// these are always necessary to find the PR for the current branch
 		fields.AddValues([]string{"isCrossRepository", "headRepositoryOwner", "headRefName"})
+		// mergeQueuePosition and pendingDeploymentEnvironments aren't real GraphQL
+		// fields; pr status fills them in itself, so they must not reach the query builder.
+		fields.RemoveValues([]string{"mergeQueuePosition", "pendingDeploymentEnvironments"})
 		gr := api.PullRequestGraphQL(fields.ToSlice())
 		fragments = fmt.Sprintf("fragment pr on PullRequest{%s}fragment prWithReviews on PullRequest{...pr}", gr)
 	} else {
@@ -192,7 +195,8 @@ func pullRequestFragment(conflictStatus bool, statusCheckRollupWithCountByState
 	fields := []string{
 		"number", "title", "state", "url", "isDraft", "isCrossRepository",
 		"headRefName", "headRepositoryOwner", "mergeStateStatus",
-		"requiresStrictStatusChecks", "autoMergeRequest",
+		"requiresStrictStatusChecks", "autoMergeRequest", "isInMergeQueue",
+		"headRefOid", "baseRefName",
 	}
 
 	if conflictStatus {
@@ -35,10 +35,18 @@ type StatusOptions struct {
 	HasRepoOverride bool
 	Exporter        cmdutil.Exporter
 	ConflictStatus  bool
+	Refresh         bool
 
 	Detector fd.Detector
 }
 
+// statusJSONFields extends the fields every other pr command exports with two
+// that only pr status ever populates: mergeQueuePosition and
+// pendingDeploymentEnvironments have no GraphQL equivalent, so they can't be
+// added to api.PullRequestFields without breaking query construction for
+// commands that don't know how to fill them in.
+var statusJSONFields = append(append([]string{}, api.PullRequestFields...), "mergeQueuePosition", "pendingDeploymentEnvironments")
+
 func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Command {
 	opts := &StatusOptions{
 		IO:         f.IOStreams,
@@ -65,8 +73,9 @@ func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Co
 		},
 	}
 
-	cmd.Flags().BoolVarP(&opts.ConflictStatus, "conflict-status", "c", false, "Display the merge conflict status of each pull request")
-	cmdutil.AddJSONFlags(cmd, &opts.Exporter, api.PullRequestFields)
+	cmd.Flags().BoolVarP(&opts.ConflictStatus, "conflict-status", "c", false, "Display merge conflict, merge queue, and deployment approval status of each pull request")
+	cmd.Flags().BoolVar(&opts.Refresh, "refresh", false, "Bypass the local cache and fetch fresh data")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, statusJSONFields)
 
 	return cmd
 }
@@ -82,6 +91,11 @@ func statusRun(opts *StatusOptions) error {
 		return err
 	}
 
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
 	var currentBranch string
 	var currentPRNumber int
 	var currentPRHeadRef string
@@ -119,11 +133,30 @@ func statusRun(opts *StatusOptions) error {
 	}
 	options.CheckRunAndStatusContextCountsSupported = prFeatures.CheckRunAndStatusContextCounts
 
-	prPayload, err := pullRequestStatus(httpClient, baseRepo, options)
+	statusClient := httpClient
+	if !opts.Refresh {
+		statusClient = api.NewConditionalCacheHTTPClient(httpClient, cfg.CacheDir())
+	}
+	prPayload, err := pullRequestStatus(statusClient, baseRepo, options)
 	if err != nil {
 		return err
 	}
 
+	if wantsEnrichment(opts) {
+		apiClient := api.NewClientFromHTTP(httpClient)
+		var prs []*api.PullRequest
+		if prPayload.CurrentPR != nil {
+			prs = append(prs, prPayload.CurrentPR)
+		}
+		for i := range prPayload.ViewerCreated.PullRequests {
+			prs = append(prs, &prPayload.ViewerCreated.PullRequests[i])
+		}
+		for i := range prPayload.ReviewRequested.PullRequests {
+			prs = append(prs, &prPayload.ReviewRequested.PullRequests[i])
+		}
+		enrichPRs(apiClient, baseRepo, prs)
+	}
+
 	err = opts.IO.StartPager()
 	if err != nil {
 		fmt.Fprintf(opts.IO.ErrOut, "error starting pager: %v\n", err)
@@ -184,6 +217,25 @@ func statusRun(opts *StatusOptions) error {
 	return nil
 }
 
+// wantsEnrichment reports whether merge-queue position and pending
+// deployment status are worth the extra requests: either the human asked for
+// them directly with --conflict-status, or a --json consumer named one of
+// the fields explicitly.
+func wantsEnrichment(opts *StatusOptions) bool {
+	if opts.ConflictStatus {
+		return true
+	}
+	if opts.Exporter == nil {
+		return false
+	}
+	for _, f := range opts.Exporter.Fields() {
+		if f == "mergeQueuePosition" || f == "pendingDeploymentEnvironments" {
+			return true
+		}
+	}
+	return false
+}
+
 func prSelectorForCurrentBranch(gitClient *git.Client, baseRepo ghrepo.Interface, prHeadRef string, rem ghContext.Remotes) (prNumber int, selector string, err error) {
 	selector = prHeadRef
 	branchConfig := gitClient.ReadBranchConfig(context.Background(), prHeadRef)
@@ -302,6 +354,18 @@ func printPrs(io *iostreams.IOStreams, totalCount int, prs ...api.PullRequest) {
 				fmt.Fprintf(w, " %s", cs.Green("✓ Auto-merge enabled"))
 			}
 
+			if pr.IsInMergeQueue {
+				if pr.MergeQueuePosition > 0 {
+					fmt.Fprintf(w, " %s", cs.Cyan(fmt.Sprintf("⏳ Queued to merge (position %d)", pr.MergeQueuePosition)))
+				} else {
+					fmt.Fprintf(w, " %s", cs.Cyan("⏳ Queued to merge"))
+				}
+			}
+
+			if len(pr.PendingDeploymentEnvironments) > 0 {
+				fmt.Fprintf(w, " %s", cs.Yellow(fmt.Sprintf("! Awaiting deployment approval: %s", strings.Join(pr.PendingDeploymentEnvironments, ", "))))
+			}
+
 		} else {
 			fmt.Fprintf(w, " - %s", shared.StateTitleWithColor(cs, pr))
 		}
@@ -375,6 +375,44 @@ Requesting a code review from you
 	}
 }
 
+func TestPRStatus_mergeQueueAndDeployments(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+	http.Register(httpmock.GraphQL(`query PullRequestStatus\b`), httpmock.StringResponse(`
+		{ "data": { "repository": {
+			"pullRequest": {
+				"number": 10, "title": "Blueberries are a good fruit", "state": "OPEN",
+				"url": "https://github.com/cli/cli/pull/10", "headRefName": "blueberries",
+				"baseRefName": "main", "headRefOid": "abc123", "isDraft": false,
+				"headRepositoryOwner": {"login": "OWNER"}, "isCrossRepository": false,
+				"autoMergeRequest": null, "isInMergeQueue": true
+			}
+		} } }`))
+	http.Register(httpmock.GraphQL(`query MergeQueueEntries\b`), httpmock.StringResponse(`
+		{ "data": { "repository": { "ref": { "mergeQueue": { "entries": { "nodes": [
+			{ "id": "MQE_1", "position": 2, "state": "QUEUED", "estimatedTimeToMerge": 300,
+			  "pullRequest": {"number": 10, "title": "Blueberries are a good fruit", "headRefName": "blueberries"} }
+		] } } } } } }`))
+	http.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/runs"),
+		httpmock.StringResponse(`{"workflow_runs": [{"id": 555}]}`),
+	)
+	http.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/555/pending_deployments"),
+		httpmock.StringResponse(`[{"environment": {"name": "production"}}]`),
+	)
+
+	output, err := runCommand(http, "blueberries", true, "--conflict-status")
+	if err != nil {
+		t.Errorf("error running command `pr status`: %v", err)
+	}
+
+	expected := "⏳ Queued to merge (position 2) ! Awaiting deployment approval: production"
+	if !strings.Contains(output.String(), expected) {
+		t.Errorf("output did not contain %q: %q", expected, output.String())
+	}
+}
+
 func Test_prSelectorForCurrentBranch(t *testing.T) {
 	rs, cleanup := run.Stub()
 	defer cleanup(t)
@@ -1,13 +1,16 @@
 package update_branch
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
+	cliContext "github.com/cli/cli/v2/context"
 	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	shared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -20,11 +23,14 @@ type UpdateBranchOptions struct {
 	HttpClient func() (*http.Client, error)
 	IO         *iostreams.IOStreams
 	GitClient  *git.Client
+	Config     func() (gh.Config, error)
+	Remotes    func() (cliContext.Remotes, error)
 
 	Finder shared.PRFinder
 
 	SelectorArg string
 	Rebase      bool
+	Resolve     bool
 }
 
 func NewCmdUpdateBranch(f *cmdutil.Factory, runF func(*UpdateBranchOptions) error) *cobra.Command {
@@ -32,6 +38,8 @@ func NewCmdUpdateBranch(f *cmdutil.Factory, runF func(*UpdateBranchOptions) erro
 		IO:         f.IOStreams,
 		HttpClient: f.HttpClient,
 		GitClient:  f.GitClient,
+		Config:     f.Config,
+		Remotes:    f.Remotes,
 	}
 
 	cmd := &cobra.Command{
@@ -45,10 +53,17 @@ func NewCmdUpdateBranch(f *cmdutil.Factory, runF func(*UpdateBranchOptions) erro
 			The default behavior is to update with a merge commit (i.e., merging the base branch
 			into the the PR's branch). To reconcile the changes with rebasing on top of the base
 			branch, the %[1]s--rebase%[1]s option should be provided.
+
+			If the branch can't be updated through the API due to merge conflicts, the
+			%[1]s--resolve%[1]s flag (used together with %[1]s--rebase%[1]s) performs the rebase
+			locally instead: it fetches the base branch, rebases the checked out PR branch on
+			top of it, opens the configured merge tool to resolve any conflicts, and
+			force-pushes the result back up.
 		`, "`"),
 		Example: heredoc.Doc(`
 			$ gh pr update-branch 23
 			$ gh pr update-branch 23 --rebase
+			$ gh pr update-branch 23 --rebase --resolve
 			$ gh pr update-branch 23 --repo owner/repo
 		`),
 		Args: cobra.MaximumNArgs(1),
@@ -59,6 +74,10 @@ func NewCmdUpdateBranch(f *cmdutil.Factory, runF func(*UpdateBranchOptions) erro
 				return cmdutil.FlagErrorf("argument required when using the --repo flag")
 			}
 
+			if opts.Resolve && !opts.Rebase {
+				return cmdutil.FlagErrorf("cannot use `--resolve` flag without `--rebase` flag")
+			}
+
 			if len(args) > 0 {
 				opts.SelectorArg = args[0]
 			}
@@ -72,6 +91,7 @@ func NewCmdUpdateBranch(f *cmdutil.Factory, runF func(*UpdateBranchOptions) erro
 	}
 
 	cmd.Flags().BoolVar(&opts.Rebase, "rebase", false, "Update PR branch by rebasing on top of latest base branch")
+	cmd.Flags().BoolVar(&opts.Resolve, "resolve", false, "Resolve merge conflicts locally when the branch can't be updated through the API")
 
 	return cmd
 }
@@ -79,7 +99,7 @@ func NewCmdUpdateBranch(f *cmdutil.Factory, runF func(*UpdateBranchOptions) erro
 func updateBranchRun(opts *UpdateBranchOptions) error {
 	findOptions := shared.FindOptions{
 		Selector: opts.SelectorArg,
-		Fields:   []string{"id", "number", "headRefName", "headRefOid", "headRepositoryOwner", "mergeable"},
+		Fields:   []string{"id", "number", "headRefName", "headRefOid", "headRepositoryOwner", "mergeable", "baseRefName"},
 	}
 
 	pr, repo, err := opts.Finder.Find(findOptions)
@@ -89,6 +109,9 @@ func updateBranchRun(opts *UpdateBranchOptions) error {
 
 	cs := opts.IO.ColorScheme()
 	if pr.Mergeable == api.PullRequestMergeableConflicting {
+		if opts.Resolve {
+			return resolveConflictsLocally(opts, pr, repo)
+		}
 		fmt.Fprintf(opts.IO.ErrOut, "%s Cannot update PR branch due to conflicts\n", cs.FailureIcon())
 		return cmdutil.SilentError
 	}
@@ -125,6 +148,9 @@ func updateBranchRun(opts *UpdateBranchOptions) error {
 	if err != nil {
 		// TODO: this is a best effort approach and not a resilient way of handling API errors.
 		if strings.Contains(err.Error(), "GraphQL: merge conflict between base and head (updatePullRequestBranch)") {
+			if opts.Resolve {
+				return resolveConflictsLocally(opts, pr, repo)
+			}
 			fmt.Fprintf(opts.IO.ErrOut, "%s Cannot update PR branch due to conflicts\n", cs.FailureIcon())
 			return cmdutil.SilentError
 		}
@@ -135,6 +161,82 @@ func updateBranchRun(opts *UpdateBranchOptions) error {
 	return nil
 }
 
+// resolveConflictsLocally performs the branch update outside of the API by fetching the base
+// branch, rebasing the checked out PR branch on top of it, and force-pushing the result. Any
+// conflicts encountered during the rebase are resolved by launching the configured merge tool.
+func resolveConflictsLocally(opts *UpdateBranchOptions, pr *api.PullRequest, repo ghrepo.Interface) error {
+	ctx := context.Background()
+	cs := opts.IO.ColorScheme()
+
+	currentBranch, err := opts.GitClient.CurrentBranch(ctx)
+	if err != nil {
+		return err
+	}
+	if currentBranch != pr.HeadRefName {
+		return fmt.Errorf("must have PR #%d (%q) checked out locally to resolve conflicts; run `gh pr checkout %d` first", pr.Number, pr.HeadRefName, pr.Number)
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	protocol := cfg.GitProtocol(repo.RepoHost()).Value
+
+	remotes, err := opts.Remotes()
+	if err != nil {
+		return err
+	}
+	baseURLOrName := ghrepo.FormatRemoteURL(repo, protocol)
+	if remote, err := remotes.FindByRepo(repo.RepoOwner(), repo.RepoName()); err == nil {
+		baseURLOrName = remote.Name
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "%s Fetching the %q branch\n", cs.Gray("-"), pr.BaseRefName)
+	if err := opts.GitClient.Fetch(ctx, baseURLOrName, pr.BaseRefName); err != nil {
+		return fmt.Errorf("failed to fetch %q: %w", pr.BaseRefName, err)
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "%s Rebasing %q onto %q\n", cs.Gray("-"), pr.HeadRefName, pr.BaseRefName)
+	rebaseCmd, err := opts.GitClient.Command(ctx, "rebase", "FETCH_HEAD")
+	if err != nil {
+		return err
+	}
+	if _, err := rebaseCmd.Output(); err != nil {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Rebase hit conflicts; launching merge tool\n", cs.Yellow("!"))
+
+		mergeToolCmd, err := opts.GitClient.Command(ctx, "mergetool")
+		if err != nil {
+			return err
+		}
+		// mergetool's exit status doesn't reliably reflect whether every conflict was
+		// resolved; `rebase --continue` below is the real check for that.
+		_ = mergeToolCmd.Run()
+
+		continueCmd, err := opts.GitClient.Command(ctx, "rebase", "--continue")
+		if err != nil {
+			return err
+		}
+		if err := continueCmd.Run(); err != nil {
+			if abortCmd, abortErr := opts.GitClient.Command(ctx, "rebase", "--abort"); abortErr == nil {
+				_ = abortCmd.Run()
+			}
+			return fmt.Errorf("could not complete the rebase; resolve the remaining conflicts and re-run `gh pr update-branch --rebase --resolve`: %w", err)
+		}
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "%s Pushing rebased %q branch\n", cs.Gray("-"), pr.HeadRefName)
+	pushCmd, err := opts.GitClient.AuthenticatedCommand(ctx, "push", "--force-with-lease", baseURLOrName, fmt.Sprintf("HEAD:%s", pr.HeadRefName))
+	if err != nil {
+		return err
+	}
+	if err := pushCmd.Run(); err != nil {
+		return fmt.Errorf("failed to push rebased branch: %w", err)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "%s PR branch updated\n", cs.SuccessIcon())
+	return nil
+}
+
 // updatePullRequestBranch calls the GraphQL API endpoint to update the given PR
 // branch with latest changes of its base.
 func updatePullRequestBranch(apiClient *api.Client, repo ghrepo.Interface, pullRequestID string, expectedHeadOid string, rebase bool) error {
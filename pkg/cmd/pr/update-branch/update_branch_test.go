@@ -2,12 +2,17 @@ package update_branch
 
 import (
 	"bytes"
+	"errors"
 	"net/http"
 	"testing"
 
 	"github.com/cli/cli/v2/api"
+	cliContext "github.com/cli/cli/v2/context"
 	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/run"
 	shared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/httpmock"
@@ -55,6 +60,20 @@ func TestNewCmdUpdateBranch(t *testing.T) {
 			input:    "--repo owner/repo",
 			wantsErr: "argument required when using the --repo flag",
 		},
+		{
+			name:  "with argument, --rebase --resolve",
+			input: "23 --rebase --resolve",
+			output: UpdateBranchOptions{
+				SelectorArg: "23",
+				Rebase:      true,
+				Resolve:     true,
+			},
+		},
+		{
+			name:     "--resolve without --rebase",
+			input:    "23 --resolve",
+			wantsErr: "cannot use `--resolve` flag without `--rebase` flag",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -92,6 +111,7 @@ func TestNewCmdUpdateBranch(t *testing.T) {
 			assert.NoError(t, err)
 			assert.Equal(t, tt.output.SelectorArg, gotOpts.SelectorArg)
 			assert.Equal(t, tt.output.Rebase, gotOpts.Rebase)
+			assert.Equal(t, tt.output.Resolve, gotOpts.Resolve)
 		})
 	}
 }
@@ -114,6 +134,8 @@ func Test_updateBranchRun(t *testing.T) {
 		name      string
 		input     *UpdateBranchOptions
 		httpStubs func(*testing.T, *httpmock.Registry)
+		runStubs  func(*run.CommandStubber)
+		remotes   map[string]string
 		stdout    string
 		stderr    string
 		wantsErr  string
@@ -208,6 +230,53 @@ func Test_updateBranchRun(t *testing.T) {
 			stderr:   "X Cannot update PR branch due to conflicts\n",
 			wantsErr: cmdutil.SilentError.Error(),
 		},
+		{
+			name: "failure, not mergeable due to conflicts, --resolve but wrong branch checked out",
+			input: &UpdateBranchOptions{
+				SelectorArg: "123",
+				Resolve:     true,
+				Finder: shared.NewMockFinder("123", &api.PullRequest{
+					ID:                  "123",
+					Number:              123,
+					HeadRefOid:          "head-ref-oid",
+					HeadRefName:         "head-ref-name",
+					BaseRefName:         "base-ref-name",
+					HeadRepositoryOwner: api.Owner{Login: "OWNER"},
+					Mergeable:           api.PullRequestMergeableConflicting,
+				}, ghrepo.New("OWNER", "REPO")),
+			},
+			runStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git symbolic-ref --quiet HEAD`, 0, "refs/heads/some-other-branch\n")
+			},
+			wantsErr: `must have PR #123 ("head-ref-name") checked out locally to resolve conflicts; run ` + "`gh pr checkout 123`" + ` first`,
+		},
+		{
+			name: "success, not mergeable due to conflicts, --resolve rebases and pushes locally",
+			input: &UpdateBranchOptions{
+				SelectorArg: "123",
+				Resolve:     true,
+				Finder: shared.NewMockFinder("123", &api.PullRequest{
+					ID:                  "123",
+					Number:              123,
+					HeadRefOid:          "head-ref-oid",
+					HeadRefName:         "head-ref-name",
+					BaseRefName:         "base-ref-name",
+					HeadRepositoryOwner: api.Owner{Login: "OWNER"},
+					Mergeable:           api.PullRequestMergeableConflicting,
+				}, ghrepo.New("OWNER", "REPO")),
+			},
+			remotes: map[string]string{"origin": "OWNER/REPO"},
+			runStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git symbolic-ref --quiet HEAD`, 0, "refs/heads/head-ref-name\n")
+				cs.Register(`git fetch origin base-ref-name`, 0, "")
+				cs.Register(`git rebase FETCH_HEAD`, 0, "")
+				cs.Register(`git push --force-with-lease origin HEAD:head-ref-name`, 0, "")
+			},
+			stderr: "- Fetching the \"base-ref-name\" branch\n" +
+				"- Rebasing \"head-ref-name\" onto \"base-ref-name\"\n" +
+				"- Pushing rebased \"head-ref-name\" branch\n",
+			stdout: "✓ PR branch updated\n",
+		},
 		{
 			name: "success, merge",
 			input: &UpdateBranchOptions{
@@ -421,10 +490,35 @@ func Test_updateBranchRun(t *testing.T) {
 				GitPath: "some/path/git",
 			}
 
+			cmdStubs, cmdTeardown := run.Stub()
+			defer cmdTeardown(t)
+			if tt.runStubs != nil {
+				tt.runStubs(cmdStubs)
+			}
+
 			if tt.input.Finder == nil {
 				tt.input.Finder = defaultInput().Finder
 			}
 
+			tt.input.Config = func() (gh.Config, error) { return config.NewBlankConfig(), nil }
+			tt.input.Remotes = func() (cliContext.Remotes, error) {
+				if len(tt.remotes) == 0 {
+					return nil, errors.New("no remotes")
+				}
+				var remotes cliContext.Remotes
+				for name, repo := range tt.remotes {
+					r, err := ghrepo.FromFullName(repo)
+					if err != nil {
+						return remotes, err
+					}
+					remotes = append(remotes, &cliContext.Remote{
+						Remote: &git.Remote{Name: name},
+						Repo:   r,
+					})
+				}
+				return remotes, nil
+			}
+
 			httpClient := func() (*http.Client, error) { return &http.Client{Transport: reg}, nil }
 
 			tt.input.IO = ios
@@ -11,6 +11,7 @@ import (
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/browser"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
 	"github.com/cli/cli/v2/internal/text"
 	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -29,6 +30,7 @@ type ViewOptions struct {
 	SelectorArg string
 	BrowserMode bool
 	Comments    bool
+	Files       bool
 
 	Now func() time.Time
 }
@@ -72,6 +74,7 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 
 	cmd.Flags().BoolVarP(&opts.BrowserMode, "web", "w", false, "Open a pull request in the browser")
 	cmd.Flags().BoolVarP(&opts.Comments, "comments", "c", false, "View pull request comments")
+	cmd.Flags().BoolVar(&opts.Files, "files", false, "Display the list of changed files")
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, api.PullRequestFields)
 
 	return cmd
@@ -94,6 +97,8 @@ func viewRun(opts *ViewOptions) error {
 		findOptions.Fields = []string{"url"}
 	} else if opts.Exporter != nil {
 		findOptions.Fields = opts.Exporter.Fields()
+	} else if opts.Files {
+		findOptions.Fields = append(append([]string{}, defaultFields...), "files")
 	}
 	pr, baseRepo, err := opts.Finder.Find(findOptions)
 	if err != nil {
@@ -130,10 +135,10 @@ func viewRun(opts *ViewOptions) error {
 		return nil
 	}
 
-	return printRawPrPreview(opts.IO, pr)
+	return printRawPrPreview(opts.IO, pr, opts.Files)
 }
 
-func printRawPrPreview(io *iostreams.IOStreams, pr *api.PullRequest) error {
+func printRawPrPreview(io *iostreams.IOStreams, pr *api.PullRequest, showFiles bool) error {
 	out := io.Out
 	cs := io.ColorScheme()
 
@@ -171,6 +176,13 @@ func printRawPrPreview(io *iostreams.IOStreams, pr *api.PullRequest) error {
 	fmt.Fprintln(out, "--")
 	fmt.Fprintln(out, pr.Body)
 
+	if showFiles {
+		fmt.Fprintln(out, "--")
+		for _, f := range pr.Files.Nodes {
+			fmt.Fprintf(out, "%s\t%s\t+%d\t-%d\n", f.Path, f.Status, f.Additions, f.Deletions)
+		}
+	}
+
 	return nil
 }
 
@@ -233,6 +245,14 @@ func printHumanPrPreview(opts *ViewOptions, baseRepo ghrepo.Interface, pr *api.P
 		fmt.Fprintln(out, pr.Milestone.Title)
 	}
 
+	// Changed files
+	if opts.Files {
+		fmt.Fprintln(out, cs.Bold("Files:"))
+		if err := printChangedFilesTable(opts.IO, pr.Files.Nodes); err != nil {
+			return err
+		}
+	}
+
 	// Auto-Merge status
 	autoMerge := pr.AutoMergeRequest
 	if autoMerge != nil {
@@ -287,6 +307,21 @@ func printHumanPrPreview(opts *ViewOptions, baseRepo ghrepo.Interface, pr *api.P
 	return nil
 }
 
+func printChangedFilesTable(io *iostreams.IOStreams, files []api.PullRequestFile) error {
+	cs := io.ColorScheme()
+	tp := tableprinter.New(io, tableprinter.WithHeader("FILE", "STATUS", "ADDITIONS", "DELETIONS"))
+
+	for _, f := range files {
+		tp.AddField(f.Path)
+		tp.AddField(strings.ToLower(f.Status))
+		tp.AddField(strconv.Itoa(f.Additions), tableprinter.WithColor(cs.Green))
+		tp.AddField(strconv.Itoa(f.Deletions), tableprinter.WithColor(cs.Red))
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
+
 const (
 	requestedReviewState        = "REQUESTED" // This is our own state for review request
 	approvedReviewState         = "APPROVED"
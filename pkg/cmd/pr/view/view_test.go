@@ -30,6 +30,7 @@ func TestJSONFields(t *testing.T) {
 		"additions",
 		"assignees",
 		"author",
+		"authorAssociation",
 		"autoMergeRequest",
 		"baseRefName",
 		"body",
@@ -49,6 +50,7 @@ func TestJSONFields(t *testing.T) {
 		"id",
 		"isCrossRepository",
 		"isDraft",
+		"isInMergeQueue",
 		"labels",
 		"latestReviews",
 		"maintainerCanModify",
@@ -625,6 +627,57 @@ func TestPRView_Preview(t *testing.T) {
 	}
 }
 
+func TestPRView_Files(t *testing.T) {
+	pr := &api.PullRequest{
+		Number: 12,
+		Title:  "Blueberries are from a fork",
+		State:  "OPEN",
+		URL:    "https://github.com/OWNER/REPO/pull/12",
+		Author: api.Author{Login: "nobody"},
+		Files: struct {
+			Nodes []api.PullRequestFile
+		}{
+			Nodes: []api.PullRequestFile{
+				{Path: "jam.go", Additions: 20, Deletions: 5, Status: "MODIFIED"},
+				{Path: "preserves.go", Additions: 30, Deletions: 0, Status: "ADDED"},
+			},
+		},
+	}
+
+	t.Run("tty", func(t *testing.T) {
+		http := &httpmock.Registry{}
+		defer http.Verify(t)
+
+		shared.RunCommandFinder("12", pr, ghrepo.New("OWNER", "REPO"))
+
+		output, err := runCommand(http, "master", true, "12 --files")
+		require.NoError(t, err)
+
+		//nolint:staticcheck // prefer exact matchers over ExpectLines
+		test.ExpectLines(t, output.String(),
+			"Files:",
+			"jam.go.*modified.*20.*5",
+			"preserves.go.*added.*30.*0",
+		)
+	})
+
+	t.Run("non-tty", func(t *testing.T) {
+		http := &httpmock.Registry{}
+		defer http.Verify(t)
+
+		shared.RunCommandFinder("12", pr, ghrepo.New("OWNER", "REPO"))
+
+		output, err := runCommand(http, "master", false, "12 --files")
+		require.NoError(t, err)
+
+		//nolint:staticcheck // prefer exact matchers over ExpectLines
+		test.ExpectLines(t, output.String(),
+			`jam.go\tMODIFIED\t\+20\t-5`,
+			`preserves.go\tADDED\t\+30\t-0`,
+		)
+	})
+}
+
 func TestPRView_web_currentBranch(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -2,6 +2,7 @@ package itemedit
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -28,6 +29,12 @@ type editItemOpts struct {
 	singleSelectOptionID string
 	iterationID          string
 	clear                bool
+	// bulk edit via query
+	query         string
+	owner         string
+	projectNumber int32
+	limit         int
+	dryRun        bool
 	// format
 	exporter cmdutil.Exporter
 }
@@ -59,14 +66,21 @@ type ClearProjectV2FieldValue struct {
 func NewCmdEditItem(f *cmdutil.Factory, runF func(config editItemConfig) error) *cobra.Command {
 	opts := editItemOpts{}
 	editItemCmd := &cobra.Command{
-		Use:   "item-edit",
+		Use:   "item-edit [<number>]",
 		Short: "Edit an item in a project",
 		Long: heredoc.Docf(`
 			Edit either a draft issue or a project item. Both usages require the ID of the item to edit.
-			
+
 			For non-draft issues, the ID of the project is also required, and only a single field value can be updated per invocation.
 
 			Remove project item field value using %[1]s--clear%[1]s flag.
+
+			Instead of %[1]s--id%[1]s, a project %[1]s<number>%[1]s together with %[1]s--owner%[1]s and
+			%[1]s--query%[1]s can be used to update every item in the project matching the query. The
+			query is a space-separated list of %[1]sfield:value%[1]s pairs, all of which must match; the
+			special value %[1]s@me%[1]s resolves to the currently authenticated user when matching a
+			user field such as "Assignees". %[1]s--dry-run%[1]s reports how many items would be edited
+			without making any changes.
 		`, "`"),
 		Example: heredoc.Doc(`
 			# edit an item's text field value
@@ -74,7 +88,12 @@ func NewCmdEditItem(f *cmdutil.Factory, runF func(config editItemConfig) error)
 
 			# clear an item's field value
 			gh project item-edit --id <item-ID> --field-id <field-ID> --project-id <project-ID> --clear
+
+			# set the "Status" field to "Done" on every item assigned to the viewer in project 1
+			gh project item-edit 1 --owner "@me" --query "status:Todo assignee:@me" \
+				--field-id <field-ID> --project-id <project-ID> --single-select-option-id <option-ID>
 		`),
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := cmdutil.MutuallyExclusive(
 				"only one of `--text`, `--number`, `--date`, `--single-select-option-id` or `--iteration-id` may be used",
@@ -95,6 +114,29 @@ func NewCmdEditItem(f *cmdutil.Factory, runF func(config editItemConfig) error)
 				return err
 			}
 
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--id` or `--query`",
+				opts.itemID != "",
+				opts.query != "",
+			); err != nil {
+				return err
+			}
+
+			if opts.query != "" {
+				if len(args) != 1 {
+					return cmdutil.FlagErrorf("a project number is required when using `--query`")
+				}
+				num, err := strconv.ParseInt(args[0], 10, 32)
+				if err != nil {
+					return cmdutil.FlagErrorf("invalid number: %v", args[0])
+				}
+				opts.projectNumber = int32(num)
+			} else if opts.itemID == "" {
+				return cmdutil.FlagErrorf("`--id` or `--query` is required")
+			} else if opts.dryRun {
+				return cmdutil.FlagErrorf("`--dry-run` can only be used with `--query`")
+			}
+
 			client, err := client.New(f)
 			if err != nil {
 				return err
@@ -129,12 +171,20 @@ func NewCmdEditItem(f *cmdutil.Factory, runF func(config editItemConfig) error)
 	editItemCmd.Flags().StringVar(&opts.iterationID, "iteration-id", "", "ID of the iteration value to set on the field")
 	editItemCmd.Flags().BoolVar(&opts.clear, "clear", false, "Remove field value")
 
-	_ = editItemCmd.MarkFlagRequired("id")
+	editItemCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner of the project used with `--query`. Use \"@me\" for the current user.")
+	editItemCmd.Flags().StringVar(&opts.query, "query", "", "Edit every item matching a space-separated list of `field:value` pairs instead of a single `--id`")
+	editItemCmd.Flags().IntVarP(&opts.limit, "limit", "L", queries.LimitDefault, "Maximum number of items to consider when using `--query`")
+	editItemCmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Report how many items match `--query` without editing them")
 
 	return editItemCmd
 }
 
 func runEditItem(config editItemConfig) error {
+	// apply a field update to every item matched by --query
+	if config.opts.query != "" {
+		return bulkEditItems(config)
+	}
+
 	// when clear flag is used, remove value set to the corresponding field ID
 	if config.opts.clear {
 		return clearItemFieldValue(config)
@@ -302,3 +352,170 @@ func fieldIdAndProjectIdPresence(config editItemConfig) error {
 	}
 	return nil
 }
+
+// queryToken is a single `field:value` pair parsed out of `--query`.
+type queryToken struct {
+	field string
+	value string
+}
+
+// parseQuery splits a `--query` string into the `field:value` pairs that must all match for
+// an item to be selected. Matching is case-insensitive and the special value "@me" resolves
+// to the currently authenticated user when compared against a user field, such as "Assignees".
+func parseQuery(query string) ([]queryToken, error) {
+	fields := strings.Fields(query)
+	if len(fields) == 0 {
+		return nil, cmdutil.FlagErrorf("invalid `--query`: expected a space-separated list of `field:value` pairs")
+	}
+
+	tokens := make([]queryToken, 0, len(fields))
+	for _, f := range fields {
+		field, value, ok := strings.Cut(f, ":")
+		if !ok || field == "" || value == "" {
+			return nil, cmdutil.FlagErrorf("invalid `--query` term %q: expected `field:value`", f)
+		}
+		tokens = append(tokens, queryToken{field: field, value: value})
+	}
+	return tokens, nil
+}
+
+// bulkEditItems applies the configured field update to every item in a project that matches
+// `--query`, reporting how many items matched before editing them. With `--dry-run`, it reports
+// the match count without making any changes.
+func bulkEditItems(config editItemConfig) error {
+	if err := fieldIdAndProjectIdPresence(config); err != nil {
+		return err
+	}
+
+	if !config.opts.clear && config.opts.text == "" && config.opts.number == 0 && config.opts.date == "" && config.opts.singleSelectOptionID == "" && config.opts.iterationID == "" {
+		if _, err := fmt.Fprintln(config.io.ErrOut, "error: no changes to make"); err != nil {
+			return err
+		}
+		return cmdutil.SilentError
+	}
+
+	tokens, err := parseQuery(config.opts.query)
+	if err != nil {
+		return err
+	}
+
+	canPrompt := config.io.CanPrompt()
+	owner, err := config.client.NewOwner(canPrompt, config.opts.owner)
+	if err != nil {
+		return err
+	}
+
+	project, err := config.client.ProjectItems(owner, config.opts.projectNumber, config.opts.limit)
+	if err != nil {
+		return err
+	}
+
+	var matches []queries.ProjectItem
+	for _, item := range project.Items.Nodes {
+		ok, err := itemMatchesQuery(config, item, tokens)
+		if err != nil {
+			return err
+		}
+		if ok {
+			matches = append(matches, item)
+		}
+	}
+
+	if len(matches) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("no items in project %d matched %q", config.opts.projectNumber, config.opts.query))
+	}
+
+	if config.io.IsStdoutTTY() {
+		verb := "Editing"
+		if config.opts.dryRun {
+			verb = "Would edit"
+		}
+		if _, err := fmt.Fprintf(config.io.Out, "%s %d item(s) matching %q\n", verb, len(matches), config.opts.query); err != nil {
+			return err
+		}
+	}
+
+	if config.opts.dryRun {
+		return nil
+	}
+
+	for _, item := range matches {
+		itemConfig := config
+		itemConfig.opts.itemID = item.ID()
+		if config.opts.clear {
+			if err := clearItemFieldValue(itemConfig); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := updateItemValues(itemConfig); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// itemMatchesQuery reports whether item satisfies every `field:value` pair in tokens.
+func itemMatchesQuery(config editItemConfig, item queries.ProjectItem, tokens []queryToken) (bool, error) {
+	for _, t := range tokens {
+		ok, err := itemMatchesToken(config, item, t)
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func itemMatchesToken(config editItemConfig, item queries.ProjectItem, t queryToken) (bool, error) {
+	want := t.value
+	if want == "@me" {
+		login, err := config.client.ViewerLoginName()
+		if err != nil {
+			return false, err
+		}
+		want = login
+	}
+
+	for _, fv := range item.FieldValues.Nodes {
+		if !fieldNameMatches(fv.Name(), t.field) {
+			continue
+		}
+		if fieldValueMatches(fv.Value(), want) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// fieldNameMatches compares a project field's name against a query term, treating "assignee"
+// as an alias for the "Assignees" field that GitHub adds to every project by default.
+func fieldNameMatches(fieldName, term string) bool {
+	if strings.EqualFold(fieldName, term) {
+		return true
+	}
+	return strings.EqualFold(term, "assignee") && strings.EqualFold(fieldName, "assignees")
+}
+
+// fieldValueMatches reports whether a field's value, as returned by FieldValueNodes.Value,
+// matches want. Values with more than one entry, such as labels or assignees, match if any
+// entry matches.
+func fieldValueMatches(value interface{}, want string) bool {
+	switch v := value.(type) {
+	case string:
+		return strings.EqualFold(v, want)
+	case []string:
+		for _, s := range v {
+			if strings.EqualFold(s, want) {
+				return true
+			}
+		}
+		return false
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32) == want
+	}
+	return false
+}
@@ -24,7 +24,37 @@ func TestNewCmdeditItem(t *testing.T) {
 			name:        "missing-id",
 			cli:         "",
 			wantsErr:    true,
-			wantsErrMsg: "required flag(s) \"id\" not set",
+			wantsErrMsg: "`--id` or `--query` is required",
+		},
+		{
+			name:        "id-and-query",
+			cli:         "--id 123 --query status:Todo",
+			wantsErr:    true,
+			wantsErrMsg: "specify only one of `--id` or `--query`",
+		},
+		{
+			name:        "query-without-number",
+			cli:         "--query status:Todo",
+			wantsErr:    true,
+			wantsErrMsg: "a project number is required when using `--query`",
+		},
+		{
+			name:        "dry-run-without-query",
+			cli:         "--id 123 --dry-run",
+			wantsErr:    true,
+			wantsErrMsg: "`--dry-run` can only be used with `--query`",
+		},
+		{
+			name: "query",
+			cli:  "1 --owner @me --query status:Todo --field-id FIELD_ID --project-id PROJECT_ID --text t",
+			wants: editItemOpts{
+				query:         "status:Todo",
+				owner:         "@me",
+				projectNumber: 1,
+				fieldID:       "FIELD_ID",
+				projectID:     "PROJECT_ID",
+				text:          "t",
+			},
 		},
 		{
 			name:        "invalid-flags",
@@ -154,6 +184,9 @@ func TestNewCmdeditItem(t *testing.T) {
 			assert.Equal(t, tt.wants.singleSelectOptionID, gotOpts.singleSelectOptionID)
 			assert.Equal(t, tt.wants.iterationID, gotOpts.iterationID)
 			assert.Equal(t, tt.wants.clear, gotOpts.clear)
+			assert.Equal(t, tt.wants.query, gotOpts.query)
+			assert.Equal(t, tt.wants.owner, gotOpts.owner)
+			assert.Equal(t, tt.wants.projectNumber, gotOpts.projectNumber)
 		})
 	}
 }
@@ -575,3 +608,213 @@ func TestRunItemEdit_JSON(t *testing.T) {
 		`{"id":"DI_item_id","title":"a title","body":"a new body","type":"DraftIssue"}`,
 		stdout.String())
 }
+
+func TestRunItemEdit_BulkQuery(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	// resolve the owner
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// list project items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  30,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"id": "matching item ID",
+									"fieldValues": map[string]interface{}{
+										"nodes": []map[string]interface{}{
+											{
+												"__typename": "ProjectV2ItemFieldSingleSelectValue",
+												"name":       "Todo",
+												"field":      map[string]interface{}{"__typename": "ProjectV2SingleSelectField", "name": "Status"},
+											},
+										},
+									},
+								},
+								{
+									"id": "non-matching item ID",
+									"fieldValues": map[string]interface{}{
+										"nodes": []map[string]interface{}{
+											{
+												"__typename": "ProjectV2ItemFieldSingleSelectValue",
+												"name":       "Done",
+												"field":      map[string]interface{}{"__typename": "ProjectV2SingleSelectField", "name": "Status"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	// edit the matching item
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation UpdateItemValues.*","variables":{"input":{"projectId":"project_id","itemId":"matching item ID","fieldId":"field_id","value":{"text":"done"}}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2ItemFieldValue": map[string]interface{}{
+					"projectV2Item": map[string]interface{}{
+						"ID": "matching item ID",
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	config := editItemConfig{
+		io: ios,
+		opts: editItemOpts{
+			query:         "status:Todo",
+			owner:         "monalisa",
+			projectNumber: 1,
+			limit:         queries.LimitDefault,
+			projectID:     "project_id",
+			fieldID:       "field_id",
+			text:          "done",
+		},
+		client: client,
+	}
+
+	err := runEditItem(config)
+	assert.NoError(t, err)
+	assert.Equal(t, "Editing 1 item(s) matching \"status:Todo\"\nEdited item \"\"\n", stdout.String())
+}
+
+func TestRunItemEdit_BulkQuery_DryRun(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  30,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"id": "matching item ID",
+									"fieldValues": map[string]interface{}{
+										"nodes": []map[string]interface{}{
+											{
+												"__typename": "ProjectV2ItemFieldSingleSelectValue",
+												"name":       "Todo",
+												"field":      map[string]interface{}{"__typename": "ProjectV2SingleSelectField", "name": "Status"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	config := editItemConfig{
+		io: ios,
+		opts: editItemOpts{
+			query:         "status:Todo",
+			owner:         "monalisa",
+			projectNumber: 1,
+			limit:         queries.LimitDefault,
+			projectID:     "project_id",
+			fieldID:       "field_id",
+			text:          "done",
+			dryRun:        true,
+		},
+		client: client,
+	}
+
+	err := runEditItem(config)
+	assert.NoError(t, err)
+	assert.Equal(t, "Would edit 1 item(s) matching \"status:Todo\"\n", stdout.String())
+}
@@ -21,6 +21,7 @@ import (
 	cmdTemplate "github.com/cli/cli/v2/pkg/cmd/project/mark-template"
 	cmdUnlink "github.com/cli/cli/v2/pkg/cmd/project/unlink"
 	cmdView "github.com/cli/cli/v2/pkg/cmd/project/view"
+	cmdWorkflow "github.com/cli/cli/v2/pkg/cmd/project/workflow"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/spf13/cobra"
 )
@@ -63,5 +64,8 @@ func NewCmdProject(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(cmdFieldCreate.NewCmdCreateField(f, nil))
 	cmd.AddCommand(cmdFieldDelete.NewCmdDeleteField(f, nil))
 
+	// workflows
+	cmd.AddCommand(cmdWorkflow.NewCmdWorkflow(f))
+
 	return cmd
 }
@@ -335,6 +335,43 @@ func (v FieldValueNodes) ID() string {
 	return ""
 }
 
+// Name is the name of the project field that this value belongs to.
+func (v FieldValueNodes) Name() string {
+	switch v.Type {
+	case "ProjectV2ItemFieldDateValue":
+		return v.ProjectV2ItemFieldDateValue.Field.Name()
+	case "ProjectV2ItemFieldIterationValue":
+		return v.ProjectV2ItemFieldIterationValue.Field.Name()
+	case "ProjectV2ItemFieldNumberValue":
+		return v.ProjectV2ItemFieldNumberValue.Field.Name()
+	case "ProjectV2ItemFieldSingleSelectValue":
+		return v.ProjectV2ItemFieldSingleSelectValue.Field.Name()
+	case "ProjectV2ItemFieldTextValue":
+		return v.ProjectV2ItemFieldTextValue.Field.Name()
+	case "ProjectV2ItemFieldMilestoneValue":
+		return v.ProjectV2ItemFieldMilestoneValue.Field.Name()
+	case "ProjectV2ItemFieldLabelValue":
+		return v.ProjectV2ItemFieldLabelValue.Field.Name()
+	case "ProjectV2ItemFieldPullRequestValue":
+		return v.ProjectV2ItemFieldPullRequestValue.Field.Name()
+	case "ProjectV2ItemFieldRepositoryValue":
+		return v.ProjectV2ItemFieldRepositoryValue.Field.Name()
+	case "ProjectV2ItemFieldUserValue":
+		return v.ProjectV2ItemFieldUserValue.Field.Name()
+	case "ProjectV2ItemFieldReviewerValue":
+		return v.ProjectV2ItemFieldReviewerValue.Field.Name()
+	}
+
+	return ""
+}
+
+// Value is the underlying value of the field, such as the text of a text field or the
+// selected option of a single select field. For fields that hold more than one value,
+// such as a label or user field, a slice of values is returned.
+func (v FieldValueNodes) Value() interface{} {
+	return projectFieldValueData(v)
+}
+
 type DraftIssue struct {
 	ID    string
 	Body  string
@@ -1445,6 +1482,106 @@ func (c *Client) UnlinkProjectFromTeam(projectID string, teamID string) error {
 	return c.Mutate("UnlinkProjectV2FromTeam", &mutation, variables)
 }
 
+// ProjectV2Workflow is a built-in automation on a project, such as setting a field when an item
+// is added to the project or archiving an item when it is closed.
+type ProjectV2Workflow struct {
+	ID      string
+	Name    string
+	Number  int32
+	Enabled bool
+}
+
+func (w ProjectV2Workflow) ExportData(_ []string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":      w.ID,
+		"name":    w.Name,
+		"number":  w.Number,
+		"enabled": w.Enabled,
+	}
+}
+
+type projectWorkflows struct {
+	TotalCount int
+	Nodes      []ProjectV2Workflow
+}
+
+type userOwnerWorkflows struct {
+	Owner struct {
+		Project struct {
+			Workflows projectWorkflows `graphql:"workflows(first: $first)"`
+		} `graphql:"projectV2(number: $number)"`
+	} `graphql:"user(login: $login)"`
+}
+
+type orgOwnerWorkflows struct {
+	Owner struct {
+		Project struct {
+			Workflows projectWorkflows `graphql:"workflows(first: $first)"`
+		} `graphql:"projectV2(number: $number)"`
+	} `graphql:"organization(login: $login)"`
+}
+
+type viewerOwnerWorkflows struct {
+	Owner struct {
+		Project struct {
+			Workflows projectWorkflows `graphql:"workflows(first: $first)"`
+		} `graphql:"projectV2(number: $number)"`
+	} `graphql:"viewer"`
+}
+
+// ProjectWorkflows returns the built-in workflows configured on a project. If the OwnerType is
+// VIEWER, no login is required.
+func (c *Client) ProjectWorkflows(o *Owner, number int32) ([]ProjectV2Workflow, error) {
+	variables := map[string]interface{}{
+		"number": githubv4.Int(number),
+		"first":  githubv4.Int(LimitMax),
+	}
+
+	switch o.Type {
+	case UserOwner:
+		variables["login"] = githubv4.String(o.Login)
+		var query userOwnerWorkflows
+		if err := c.doQuery("UserProjectWorkflows", &query, variables); err != nil {
+			return nil, err
+		}
+		return query.Owner.Project.Workflows.Nodes, nil
+	case OrgOwner:
+		variables["login"] = githubv4.String(o.Login)
+		var query orgOwnerWorkflows
+		if err := c.doQuery("OrgProjectWorkflows", &query, variables); err != nil {
+			return nil, err
+		}
+		return query.Owner.Project.Workflows.Nodes, nil
+	case ViewerOwner:
+		var query viewerOwnerWorkflows
+		if err := c.doQuery("ViewerProjectWorkflows", &query, variables); err != nil {
+			return nil, err
+		}
+		return query.Owner.Project.Workflows.Nodes, nil
+	}
+	return nil, errors.New("unknown owner type")
+}
+
+type deleteProjectWorkflowMutation struct {
+	DeleteProjectV2Workflow struct {
+		ClientMutationId string `graphql:"clientMutationId"`
+	} `graphql:"deleteProjectV2Workflow(input:$input)"`
+}
+
+// DisableProjectWorkflow disables a built-in project workflow. The public API only exposes
+// deletion of a workflow, not toggling it, so disabling removes the workflow from the project;
+// it can be re-enabled from the project's web UI under Workflows.
+func (c *Client) DisableProjectWorkflow(workflowID string) error {
+	var mutation deleteProjectWorkflowMutation
+	variables := map[string]interface{}{
+		"input": githubv4.DeleteProjectV2WorkflowInput{
+			WorkflowID: githubv4.ID(workflowID),
+		},
+	}
+
+	return c.Mutate("DeleteProjectV2Workflow", &mutation, variables)
+}
+
 func handleError(err error) error {
 	var gerr api.GraphQLError
 	if errors.As(err, &gerr) {
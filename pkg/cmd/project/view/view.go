@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/text"
 	"github.com/cli/cli/v2/pkg/cmd/project/shared/client"
 	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -16,6 +17,7 @@ import (
 
 type viewOpts struct {
 	web      bool
+	board    bool
 	owner    string
 	number   int32
 	exporter cmdutil.Exporter
@@ -39,9 +41,20 @@ func NewCmdView(f *cmdutil.Factory, runF func(config viewConfig) error) *cobra.C
 
 			# open user monalisa's project "1" in the browser
 			gh project view 1 --owner monalisa --web
+
+			# render project "1" as a board of items grouped by their "Status" field
+			gh project view 1 --board
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--web` or `--board`",
+				opts.web,
+				opts.board,
+			); err != nil {
+				return err
+			}
+
 			client, err := client.New(f)
 			if err != nil {
 				return err
@@ -76,6 +89,7 @@ func NewCmdView(f *cmdutil.Factory, runF func(config viewConfig) error) *cobra.C
 
 	viewCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user.")
 	viewCmd.Flags().BoolVarP(&opts.web, "web", "w", false, "Open a project in the browser")
+	viewCmd.Flags().BoolVar(&opts.board, "board", false, "Render items as a board grouped by their \"Status\" field")
 	cmdutil.AddFormatFlags(viewCmd, &opts.exporter)
 
 	return viewCmd
@@ -97,6 +111,10 @@ func runView(config viewConfig) error {
 		return config.URLOpener(project.URL)
 	}
 
+	if config.opts.board {
+		return printBoard(config, owner, project)
+	}
+
 	if config.opts.exporter != nil {
 		return config.opts.exporter.Write(config.io, *project)
 	}
@@ -157,3 +175,112 @@ func printResults(config viewConfig, project *queries.Project) error {
 	_, err = fmt.Fprint(config.io.Out, out)
 	return err
 }
+
+// noStatus is the column items without a "Status" value are grouped under.
+const noStatus = "No Status"
+
+// printBoard renders the items of a project as a Kanban-style board, with one column per
+// option of the project's "Status" single select field. Columns that don't fit in the
+// terminal width wrap onto additional rows of columns below.
+func printBoard(config viewConfig, owner *queries.Owner, project *queries.Project) error {
+	var statusField queries.ProjectField
+	for _, f := range project.Fields.Nodes {
+		if strings.EqualFold(f.Name(), "status") {
+			statusField = f
+			break
+		}
+	}
+	if statusField.Name() == "" {
+		return cmdutil.FlagErrorf("project %d has no \"Status\" field to render as a board", project.Number)
+	}
+
+	items, err := config.client.ProjectItems(owner, project.Number, queries.LimitMax)
+	if err != nil {
+		return err
+	}
+
+	columns := make([]string, 0, len(statusField.Options())+1)
+	titlesByColumn := make(map[string][]string, len(statusField.Options())+1)
+	for _, opt := range statusField.Options() {
+		columns = append(columns, opt.Name)
+		titlesByColumn[opt.Name] = nil
+	}
+	columns = append(columns, noStatus)
+
+	for _, item := range items.Items.Nodes {
+		column := noStatus
+		for _, fv := range item.FieldValues.Nodes {
+			if !strings.EqualFold(fv.Name(), "status") {
+				continue
+			}
+			if s, ok := fv.Value().(string); ok && s != "" {
+				column = s
+			}
+			break
+		}
+
+		title := item.Title()
+		if title == "" {
+			title = item.ID()
+		}
+		titlesByColumn[column] = append(titlesByColumn[column], title)
+	}
+
+	return renderBoard(config.io, columns, titlesByColumn)
+}
+
+// boardColumnWidth and boardColumnGap bound how many columns fit side by side in the
+// terminal; columns that don't fit wrap onto additional rows below.
+const (
+	boardColumnWidth = 24
+	boardColumnGap   = 2
+)
+
+func renderBoard(io *iostreams.IOStreams, columns []string, titlesByColumn map[string][]string) error {
+	cs := io.ColorScheme()
+	palette := []func(string) string{cs.Blue, cs.Green, cs.Magenta, cs.Yellow, cs.Cyan, cs.Red}
+
+	columnsPerRow := io.TerminalWidth() / (boardColumnWidth + boardColumnGap)
+	if columnsPerRow < 1 {
+		columnsPerRow = 1
+	}
+
+	var sb strings.Builder
+	for start := 0; start < len(columns); start += columnsPerRow {
+		end := start + columnsPerRow
+		if end > len(columns) {
+			end = len(columns)
+		}
+		row := columns[start:end]
+
+		rows := 0
+		for _, column := range row {
+			if n := len(titlesByColumn[column]); n > rows {
+				rows = n
+			}
+		}
+
+		for i, column := range row {
+			header := fmt.Sprintf("%s (%d)", column, len(titlesByColumn[column]))
+			sb.WriteString(palette[(start+i)%len(palette)](text.PadRight(boardColumnWidth, text.Truncate(boardColumnWidth, header))))
+			sb.WriteString(strings.Repeat(" ", boardColumnGap))
+		}
+		sb.WriteString("\n")
+
+		for r := 0; r < rows; r++ {
+			for _, column := range row {
+				var cell string
+				if titles := titlesByColumn[column]; r < len(titles) {
+					cell = titles[r]
+				}
+				sb.WriteString(text.PadRight(boardColumnWidth, text.Truncate(boardColumnWidth, cell)))
+				sb.WriteString(strings.Repeat(" ", boardColumnGap))
+			}
+			sb.WriteString("\n")
+		}
+		sb.WriteString("\n")
+	}
+
+	_, err := fmt.Fprint(io.Out, sb.String())
+	return err
+}
@@ -49,6 +49,19 @@ func TestNewCmdview(t *testing.T) {
 				web: true,
 			},
 		},
+		{
+			name: "board",
+			cli:  "--board",
+			wants: viewOpts{
+				board: true,
+			},
+		},
+		{
+			name:        "web-and-board",
+			cli:         "--web --board",
+			wantsErr:    true,
+			wantsErrMsg: "specify only one of `--web` or `--board`",
+		},
 		{
 			name:          "json",
 			cli:           "--format json",
@@ -87,6 +100,7 @@ func TestNewCmdview(t *testing.T) {
 			assert.Equal(t, tt.wants.owner, gotOpts.owner)
 			assert.Equal(t, tt.wantsExporter, gotOpts.exporter != nil)
 			assert.Equal(t, tt.wants.web, gotOpts.web)
+			assert.Equal(t, tt.wants.board, gotOpts.board)
 		})
 	}
 }
@@ -163,6 +177,135 @@ func TestRunView_User(t *testing.T) {
 
 }
 
+func TestRunView_Board(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// get the project and its fields
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		Reply(200).
+		JSON(`
+			{"data":
+				{"user":
+					{
+						"projectV2": {
+							"number": 1,
+							"items": {
+								"totalCount": 2
+							},
+							"fields": {
+								"nodes": [
+									{
+										"__typename": "ProjectV2SingleSelectField",
+										"name": "Status",
+										"options": [
+											{"id": "1", "name": "Todo"},
+											{"id": "2", "name": "Done"}
+										]
+									}
+								]
+							}
+						}
+					}
+				}
+			}
+		`)
+
+	// get the project's items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		Reply(200).
+		JSON(`
+			{"data":
+				{"user":
+					{
+						"projectV2": {
+							"items": {
+								"nodes": [
+									{
+										"id": "item 1",
+										"content": {
+											"__typename": "Issue",
+											"title": "an issue"
+										},
+										"fieldValues": {
+											"nodes": [
+												{
+													"__typename": "ProjectV2ItemFieldSingleSelectValue",
+													"name": "Todo",
+													"field": {"__typename": "ProjectV2SingleSelectField", "name": "Status"}
+												}
+											]
+										}
+									},
+									{
+										"id": "item 2",
+										"content": {
+											"__typename": "Issue",
+											"title": "another issue"
+										},
+										"fieldValues": {
+											"nodes": []
+										}
+									}
+								]
+							}
+						}
+					}
+				}
+			}
+		`)
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	config := viewConfig{
+		opts: viewOpts{
+			owner:  "monalisa",
+			number: 1,
+			board:  true,
+		},
+		io:     ios,
+		client: client,
+	}
+
+	err := runView(config)
+	assert.NoError(t, err)
+
+	out := stdout.String()
+	assert.Contains(t, out, "Todo (1)")
+	assert.Contains(t, out, "Done (0)")
+	assert.Contains(t, out, "No Status (1)")
+	assert.Contains(t, out, "an issue")
+	assert.Contains(t, out, "another issue")
+}
+
 func TestRunView_Viewer(t *testing.T) {
 	defer gock.Off()
 
@@ -0,0 +1,114 @@
+package disable
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/client"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type disableOpts struct {
+	owner          string
+	projectNumber  int32
+	workflowNumber int32
+}
+
+type disableConfig struct {
+	io     *iostreams.IOStreams
+	client *queries.Client
+	opts   disableOpts
+}
+
+func NewCmdDisable(f *cmdutil.Factory, runF func(config disableConfig) error) *cobra.Command {
+	opts := disableOpts{}
+	disableCmd := &cobra.Command{
+		Short: "Disable a built-in workflow in a project",
+		Use:   "disable <project-number> <workflow-number>",
+		Long: heredoc.Doc(`
+			Disable a built-in workflow in a project, such as an "Item added" or "Item closed" automation.
+
+			The GitHub API only supports removing a workflow, not toggling it off, so this
+			permanently deletes the workflow from the project. It can be re-created from the
+			project's web UI under Workflows.
+		`),
+		Example: heredoc.Doc(`
+			# disable workflow "3" in the current user's project "1"
+			gh project workflow disable 1 3 --owner "@me"
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := client.New(f)
+			if err != nil {
+				return err
+			}
+
+			projectNumber, err := strconv.ParseInt(args[0], 10, 32)
+			if err != nil {
+				return cmdutil.FlagErrorf("invalid project number: %v", args[0])
+			}
+			opts.projectNumber = int32(projectNumber)
+
+			workflowNumber, err := strconv.ParseInt(args[1], 10, 32)
+			if err != nil {
+				return cmdutil.FlagErrorf("invalid workflow number: %v", args[1])
+			}
+			opts.workflowNumber = int32(workflowNumber)
+
+			config := disableConfig{
+				io:     f.IOStreams,
+				client: client,
+				opts:   opts,
+			}
+
+			// allow testing of the command without actually running it
+			if runF != nil {
+				return runF(config)
+			}
+			return runDisable(config)
+		},
+	}
+
+	disableCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user.")
+
+	return disableCmd
+}
+
+func runDisable(config disableConfig) error {
+	canPrompt := config.io.CanPrompt()
+	owner, err := config.client.NewOwner(canPrompt, config.opts.owner)
+	if err != nil {
+		return err
+	}
+
+	workflows, err := config.client.ProjectWorkflows(owner, config.opts.projectNumber)
+	if err != nil {
+		return err
+	}
+
+	var workflow *queries.ProjectV2Workflow
+	for i := range workflows {
+		if workflows[i].Number == config.opts.workflowNumber {
+			workflow = &workflows[i]
+			break
+		}
+	}
+	if workflow == nil {
+		return cmdutil.FlagErrorf("workflow %d not found in project %d", config.opts.workflowNumber, config.opts.projectNumber)
+	}
+
+	if err := config.client.DisableProjectWorkflow(workflow.ID); err != nil {
+		return err
+	}
+
+	if !config.io.IsStdoutTTY() {
+		return nil
+	}
+
+	_, err = fmt.Fprintf(config.io.Out, "Disabled workflow %q\n", workflow.Name)
+	return err
+}
@@ -0,0 +1,196 @@
+package disable
+
+import (
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestNewCmdDisable(t *testing.T) {
+	tests := []struct {
+		name        string
+		cli         string
+		wants       disableOpts
+		wantsErr    bool
+		wantsErrMsg string
+	}{
+		{
+			name:        "missing-args",
+			cli:         "1",
+			wantsErr:    true,
+			wantsErrMsg: "accepts 2 arg(s), received 1",
+		},
+		{
+			name:        "not-a-number",
+			cli:         "1 x",
+			wantsErr:    true,
+			wantsErrMsg: "invalid workflow number: x",
+		},
+		{
+			name: "numbers",
+			cli:  "1 3 --owner monalisa",
+			wants: disableOpts{
+				owner:          "monalisa",
+				projectNumber:  1,
+				workflowNumber: 3,
+			},
+		},
+	}
+
+	t.Setenv("GH_TOKEN", "auth-token")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts disableOpts
+			cmd := NewCmdDisable(f, func(config disableConfig) error {
+				gotOpts = config.opts
+				return nil
+			})
+
+			cmd.SetArgs(argv)
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Equal(t, tt.wantsErrMsg, err.Error())
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.owner, gotOpts.owner)
+			assert.Equal(t, tt.wants.projectNumber, gotOpts.projectNumber)
+			assert.Equal(t, tt.wants.workflowNumber, gotOpts.workflowNumber)
+		})
+	}
+}
+
+func TestRunDisable(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		Reply(200).
+		JSON(`
+			{"data":
+				{"user":
+					{"projectV2":
+						{"workflows":
+							{"totalCount": 1,
+							 "nodes": [
+								{"id": "WF_1", "name": "Item added", "number": 3, "enabled": true}
+							 ]}
+						}
+					}
+				}
+			}
+		`)
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation DeleteProjectV2Workflow.*","variables":{"input":{"workflowId":"WF_1"}}}`).
+		Reply(200).
+		JSON(`{"data": {"deleteProjectV2Workflow": {"clientMutationId": ""}}}`)
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := disableConfig{
+		io:     ios,
+		client: client,
+		opts: disableOpts{
+			owner:          "monalisa",
+			projectNumber:  1,
+			workflowNumber: 3,
+		},
+	}
+
+	err := runDisable(config)
+	assert.NoError(t, err)
+	assert.Equal(t, "Disabled workflow \"Item added\"\n", stdout.String())
+}
+
+func TestRunDisable_NotFound(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		Reply(200).
+		JSON(`{"data": {"user": {"projectV2": {"workflows": {"totalCount": 0, "nodes": []}}}}}`)
+
+	client := queries.NewTestClient()
+
+	ios, _, _, _ := iostreams.Test()
+	config := disableConfig{
+		io:     ios,
+		client: client,
+		opts: disableOpts{
+			owner:          "monalisa",
+			projectNumber:  1,
+			workflowNumber: 3,
+		},
+	}
+
+	err := runDisable(config)
+	assert.EqualError(t, err, "workflow 3 not found in project 1")
+}
@@ -0,0 +1,30 @@
+package enable
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdEnable(f *cmdutil.Factory, runF func() error) *cobra.Command {
+	enableCmd := &cobra.Command{
+		Short: "Enable a built-in workflow in a project",
+		Use:   "enable <project-number> <workflow-number>",
+		Long: heredoc.Doc(`
+			Enable a built-in workflow in a project.
+
+			This is not currently possible: the GitHub API exposes deleting a project's
+			built-in workflows but has no mutation to create or re-enable one. Use the
+			project's web UI under Workflows instead.
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF()
+			}
+			return cmdutil.FlagErrorf("gh project workflow enable is not supported by the GitHub API; enable the workflow from the project's web UI under Workflows")
+		},
+	}
+
+	return enableCmd
+}
@@ -0,0 +1,26 @@
+package enable
+
+import (
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdEnable(t *testing.T) {
+	ios, _, _, _ := iostreams.Test()
+	f := &cmdutil.Factory{
+		IOStreams: ios,
+	}
+
+	argv, err := shlex.Split("1 3")
+	assert.NoError(t, err)
+
+	cmd := NewCmdEnable(f, nil)
+	cmd.SetArgs(argv)
+
+	_, err = cmd.ExecuteC()
+	assert.EqualError(t, err, "gh project workflow enable is not supported by the GitHub API; enable the workflow from the project's web UI under Workflows")
+}
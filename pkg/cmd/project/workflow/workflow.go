@@ -0,0 +1,27 @@
+package workflow
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdDisable "github.com/cli/cli/v2/pkg/cmd/project/workflow/disable"
+	cmdEnable "github.com/cli/cli/v2/pkg/cmd/project/workflow/enable"
+	cmdList "github.com/cli/cli/v2/pkg/cmd/project/workflow/list"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdWorkflow(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "workflow <command>",
+		Short: "Work with a project's built-in workflows",
+		Long: heredoc.Doc(`
+			Work with a project's built-in workflows, the automations that set a field
+			when an item is added or archive an item when it is closed.
+		`),
+	}
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdEnable.NewCmdEnable(f, nil))
+	cmd.AddCommand(cmdDisable.NewCmdDisable(f, nil))
+
+	return cmd
+}
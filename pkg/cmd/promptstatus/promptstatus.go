@@ -0,0 +1,143 @@
+package promptstatus
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+// cacheTTL bounds how long a prompt-status lookup can be served from the
+// local HTTP cache. Shell prompts call this command on every render, so the
+// bar for a fresh lookup is "fast enough to not be noticed", not "always
+// up-to-date".
+const cacheTTL = 15 * time.Second
+
+type StatusOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Finder     shared.PRFinder
+
+	Refresh bool
+}
+
+func NewCmdPromptStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Command {
+	opts := &StatusOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "prompt-status",
+		Short: "Print a one-line status summary for use in a shell prompt",
+		Long: heredoc.Doc(`
+			Print a single-line, machine-readable summary of the current repository's
+			open pull request for this branch: its number, CI state, and review
+			decision, followed by your unread notification count.
+
+			Output is tab-separated and intended for consumption by shell prompt
+			frameworks (powerline, starship, etc.), not for humans. Lookups are
+			cached locally for a few seconds; pass --refresh to bypass the cache.
+
+			If there is no open pull request for the current branch, or the command
+			is run outside of a git repository, it prints nothing and exits 0.
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.Finder = shared.NewFinder(f)
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return statusRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Refresh, "refresh", false, "Bypass the local cache and fetch fresh data")
+
+	return cmd
+}
+
+func statusRun(opts *StatusOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	if !opts.Refresh {
+		httpClient = api.NewCachedHTTPClient(httpClient, cacheTTL)
+	}
+
+	pr, _, err := opts.Finder.Find(shared.FindOptions{
+		Fields: []string{"number", "reviewDecision", "statusCheckRollup"},
+	})
+	if err != nil {
+		// No pull request for the current branch (or no repo, or not on a
+		// branch) isn't an error worth surfacing to a shell prompt.
+		return nil
+	}
+
+	checks := pr.ChecksStatus()
+
+	var ciState string
+	switch {
+	case checks.Total == 0:
+		ciState = "none"
+	case checks.Failing > 0:
+		ciState = "failing"
+	case checks.Pending > 0:
+		ciState = "pending"
+	default:
+		ciState = "success"
+	}
+
+	reviewState := pr.ReviewDecision
+	if reviewState == "" {
+		reviewState = "none"
+	}
+
+	unread, err := unreadNotificationCount(httpClient, opts)
+	if err != nil {
+		unread = -1
+	}
+
+	fmt.Fprintf(opts.IO.Out, "#%d\t%s\t%s\t%d\n", pr.Number, ciState, reviewState, unread)
+
+	return nil
+}
+
+// unreadNotificationCount returns the number of unread notifications visible
+// on the first page of results. It is a cheap approximation, not an exact
+// total, to keep this command fast.
+func unreadNotificationCount(httpClient *http.Client, opts *StatusOptions) (int, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return 0, err
+	}
+
+	var notifications []struct {
+		Unread bool `json:"unread"`
+	}
+	if err := apiClient.REST(baseRepo.RepoHost(), "GET", "notifications?per_page=50", nil, &notifications); err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, n := range notifications {
+		if n.Unread {
+			count++
+		}
+	}
+	return count, nil
+}
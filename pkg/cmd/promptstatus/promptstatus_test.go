@@ -0,0 +1,68 @@
+package promptstatus
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "notifications"),
+		httpmock.JSONResponse([]map[string]interface{}{
+			{"unread": true},
+			{"unread": false},
+		}),
+	)
+
+	ios, _, stdout, _ := iostreams.Test()
+
+	opts := &StatusOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Finder: shared.NewMockFinder("", &api.PullRequest{
+			Number:         12,
+			ReviewDecision: "APPROVED",
+		}, ghrepo.New("OWNER", "REPO")),
+		Refresh: true,
+	}
+
+	err := statusRun(opts)
+	require.NoError(t, err)
+	assert.Equal(t, "#12\tnone\tAPPROVED\t1\n", stdout.String())
+}
+
+func TestStatusRun_NoPR(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+
+	opts := &StatusOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Finder:  shared.NewMockFinder("", nil, nil),
+		Refresh: true,
+	}
+
+	err := statusRun(opts)
+	require.NoError(t, err)
+	assert.Equal(t, "", stdout.String())
+}
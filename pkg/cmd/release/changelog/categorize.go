@@ -0,0 +1,65 @@
+package changelog
+
+import (
+	"regexp"
+
+	"github.com/cli/cli/v2/git"
+)
+
+// category is a named grouping of commits, rendered as its own section of the changelog.
+type category struct {
+	Title   string
+	Commits []*git.Commit
+}
+
+var conventionalCommitRE = regexp.MustCompile(`(?i)^(\w+)(?:\([^)]*\))?!?:\s*(.+)`)
+
+// conventionalCommitTitles maps a conventional-commit type prefix to the section it belongs in.
+// Commits that don't match the conventional commit format, or whose type isn't recognized, are
+// grouped under "Other Changes".
+var conventionalCommitTitles = map[string]string{
+	"feat":     "Features",
+	"fix":      "Bug Fixes",
+	"perf":     "Performance",
+	"docs":     "Documentation",
+	"refactor": "Refactoring",
+	"test":     "Tests",
+	"chore":    "Chores",
+}
+
+// categoryOrder fixes the display order of categories regardless of the order commits appear in.
+var categoryOrder = []string{
+	"Features",
+	"Bug Fixes",
+	"Performance",
+	"Documentation",
+	"Refactoring",
+	"Tests",
+	"Chores",
+	"Other Changes",
+}
+
+const otherChangesTitle = "Other Changes"
+
+// categorizeCommits groups commits by their conventional commit type, falling back to
+// "Other Changes" for commits that don't follow that convention.
+func categorizeCommits(commits []*git.Commit) []category {
+	byTitle := map[string][]*git.Commit{}
+	for _, c := range commits {
+		title := otherChangesTitle
+		if m := conventionalCommitRE.FindStringSubmatch(c.Title); m != nil {
+			if t, ok := conventionalCommitTitles[m[1]]; ok {
+				title = t
+			}
+		}
+		byTitle[title] = append(byTitle[title], c)
+	}
+
+	var categories []category
+	for _, title := range categoryOrder {
+		if commits, ok := byTitle[title]; ok {
+			categories = append(categories, category{Title: title, Commits: commits})
+		}
+	}
+	return categories
+}
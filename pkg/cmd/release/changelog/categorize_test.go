@@ -0,0 +1,56 @@
+package changelog
+
+import (
+	"testing"
+
+	"github.com/cli/cli/v2/git"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCategorizeCommits(t *testing.T) {
+	commits := []*git.Commit{
+		{Sha: "1111111", Title: "feat: add new widget"},
+		{Sha: "2222222", Title: "fix(api): handle nil pointer"},
+		{Sha: "3333333", Title: "docs: update README"},
+		{Sha: "4444444", Title: "chore!: drop support for old config"},
+		{Sha: "5555555", Title: "bump dependency versions"},
+		{Sha: "6666666", Title: "perf: speed up rendering"},
+	}
+
+	categories := categorizeCommits(commits)
+
+	var got []string
+	for _, c := range categories {
+		var titles []string
+		for _, commit := range c.Commits {
+			titles = append(titles, commit.Title)
+		}
+		got = append(got, c.Title+": "+fmtTitles(titles))
+	}
+
+	want := []string{
+		"Features: feat: add new widget",
+		"Bug Fixes: fix(api): handle nil pointer",
+		"Performance: perf: speed up rendering",
+		"Documentation: docs: update README",
+		"Chores: chore!: drop support for old config",
+		"Other Changes: bump dependency versions",
+	}
+
+	assert.Equal(t, want, got)
+}
+
+func TestCategorizeCommitsEmpty(t *testing.T) {
+	assert.Nil(t, categorizeCommits(nil))
+}
+
+func fmtTitles(titles []string) string {
+	out := ""
+	for i, title := range titles {
+		if i > 0 {
+			out += ", "
+		}
+		out += title
+	}
+	return out
+}
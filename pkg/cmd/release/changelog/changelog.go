@@ -0,0 +1,211 @@
+package changelog
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"text/template"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/release/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ChangelogOptions struct {
+	IO         *iostreams.IOStreams
+	HttpClient func() (*http.Client, error)
+	GitClient  *git.Client
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	TagName     string
+	PreviousTag string
+	Target      string
+
+	TemplateFile string
+	Draft        bool
+}
+
+func NewCmdChangelog(f *cmdutil.Factory, runF func(*ChangelogOptions) error) *cobra.Command {
+	opts := &ChangelogOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		GitClient:  f.GitClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "changelog <tag> [<previous-tag>]",
+		Short: "Generate a changelog between two tags",
+		Long: heredoc.Docf(`
+			Generate a changelog for %[1]s<tag>%[1]s, combining GitHub's auto-generated release
+			notes with a local breakdown of the commits between %[1]s<previous-tag>%[1]s and
+			%[1]s<tag>%[1]s grouped by conventional commit type (%[1]sfeat%[1]s, %[1]sfix%[1]s, etc).
+
+			If %[1]s<previous-tag>%[1]s is omitted, the commit breakdown is skipped and the
+			changelog only contains GitHub's auto-generated notes, which pick their own starting
+			point (usually the previous release).
+
+			Use %[1]s--template%[1]s to render the changelog with a custom Go template file instead
+			of the default format. The template is executed with a struct exposing %[1]s.Tag%[1]s,
+			%[1]s.PreviousTag%[1]s, %[1]s.Notes%[1]s (the GitHub-generated notes), and
+			%[1]s.Categories%[1]s (a slice of %[1]s{Title string; Commits []*git.Commit}%[1]s).
+
+			Use %[1]s--draft%[1]s to write the changelog directly into a draft release for
+			%[1]s<tag>%[1]s instead of printing it to standard output.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Generate a changelog for v1.3.0 since v1.2.0
+			$ gh release changelog v1.3.0 v1.2.0
+
+			# Save the changelog directly into a draft release
+			$ gh release changelog v1.3.0 v1.2.0 --draft
+
+			# Render the changelog using a custom template
+			$ gh release changelog v1.3.0 v1.2.0 --template .github/changelog.tmpl
+		`),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			opts.TagName = args[0]
+			if len(args) > 1 {
+				opts.PreviousTag = args[1]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return changelogRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Target, "target", "", "Target `branch` or full commit SHA to generate notes for")
+	cmd.Flags().StringVar(&opts.TemplateFile, "template", "", "Render the changelog using the Go template in `file`")
+	cmd.Flags().BoolVarP(&opts.Draft, "draft", "d", false, "Write the changelog into a draft release instead of printing it")
+
+	return cmd
+}
+
+type changelogData struct {
+	Tag         string
+	PreviousTag string
+	Notes       string
+	Categories  []category
+}
+
+func changelogRun(opts *ChangelogOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	notes, err := generateReleaseNotes(httpClient, baseRepo, opts.TagName, opts.Target, opts.PreviousTag)
+	if err != nil && !errors.Is(err, errNotesNotImplemented) {
+		return err
+	}
+	if notes == nil {
+		notes = &releaseNotes{}
+	}
+
+	var categories []category
+	if opts.PreviousTag != "" {
+		commits, err := opts.GitClient.Commits(context.Background(), opts.PreviousTag, opts.TagName)
+		if err != nil {
+			return fmt.Errorf("could not find commits between %s and %s: %w", opts.PreviousTag, opts.TagName, err)
+		}
+		categories = categorizeCommits(commits)
+	}
+
+	body, err := renderChangelog(opts, changelogData{
+		Tag:         opts.TagName,
+		PreviousTag: opts.PreviousTag,
+		Notes:       notes.Body,
+		Categories:  categories,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !opts.Draft {
+		fmt.Fprint(opts.IO.Out, body)
+		return nil
+	}
+
+	name := notes.Name
+	if name == "" {
+		name = opts.TagName
+	}
+
+	release, err := shared.FetchRelease(context.Background(), httpClient, baseRepo, opts.TagName)
+	if err != nil && !errors.Is(err, shared.ErrReleaseNotFound) {
+		return err
+	}
+
+	if release != nil {
+		if !release.IsDraft {
+			return fmt.Errorf("a published release already exists for tag %s", opts.TagName)
+		}
+		release, err = updateDraftReleaseBody(httpClient, baseRepo, release.DatabaseID, name, body)
+	} else {
+		release, err = createDraftRelease(httpClient, baseRepo, opts.TagName, opts.Target, name, body)
+	}
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Wrote changelog to draft release %s\n", cs.SuccessIcon(), release.URL)
+	}
+
+	return nil
+}
+
+func renderChangelog(opts *ChangelogOptions, data changelogData) (string, error) {
+	if opts.TemplateFile != "" {
+		tmplBytes, err := os.ReadFile(opts.TemplateFile)
+		if err != nil {
+			return "", fmt.Errorf("could not read template file: %w", err)
+		}
+		tmpl, err := template.New("changelog").Parse(string(tmplBytes))
+		if err != nil {
+			return "", fmt.Errorf("could not parse template file: %w", err)
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return "", fmt.Errorf("could not render template file: %w", err)
+		}
+		return buf.String(), nil
+	}
+
+	var buf bytes.Buffer
+	if data.Notes != "" {
+		fmt.Fprintf(&buf, "%s\n", data.Notes)
+	}
+	for _, c := range data.Categories {
+		fmt.Fprintf(&buf, "\n## %s\n\n", c.Title)
+		for _, commit := range c.Commits {
+			fmt.Fprintf(&buf, "- %s (%s)\n", commit.Title, shortSha(commit.Sha))
+		}
+	}
+	return buf.String(), nil
+}
+
+func shortSha(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
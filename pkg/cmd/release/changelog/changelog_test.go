@@ -0,0 +1,225 @@
+package changelog
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/run"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdChangelog(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		want    ChangelogOptions
+		wantErr string
+	}{
+		{
+			name:    "no arguments",
+			args:    "",
+			wantErr: "accepts between 1 and 2 arg(s), received 0",
+		},
+		{
+			name: "tag only",
+			args: "v1.2.3",
+			want: ChangelogOptions{
+				TagName: "v1.2.3",
+			},
+		},
+		{
+			name: "tag and previous tag",
+			args: "v1.2.3 v1.2.2",
+			want: ChangelogOptions{
+				TagName:     "v1.2.3",
+				PreviousTag: "v1.2.2",
+			},
+		},
+		{
+			name: "target and template and draft",
+			args: "v1.2.3 v1.2.2 --target main --template changelog.tmpl --draft",
+			want: ChangelogOptions{
+				TagName:      "v1.2.3",
+				PreviousTag:  "v1.2.2",
+				Target:       "main",
+				TemplateFile: "changelog.tmpl",
+				Draft:        true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			var opts *ChangelogOptions
+			cmd := NewCmdChangelog(f, func(o *ChangelogOptions) error {
+				opts = o
+				return nil
+			})
+			cmd.PersistentFlags().StringP("repo", "R", "", "")
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want.TagName, opts.TagName)
+			assert.Equal(t, tt.want.PreviousTag, opts.PreviousTag)
+			assert.Equal(t, tt.want.Target, opts.Target)
+			assert.Equal(t, tt.want.TemplateFile, opts.TemplateFile)
+			assert.Equal(t, tt.want.Draft, opts.Draft)
+		})
+	}
+}
+
+func Test_changelogRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       ChangelogOptions
+		httpStubs  func(*httpmock.Registry)
+		runStubs   func(*run.CommandStubber)
+		wantStdout string
+		wantErr    string
+	}{
+		{
+			name: "notes only, no previous tag",
+			opts: ChangelogOptions{
+				TagName: "v1.2.3",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/releases/generate-notes"),
+					httpmock.StringResponse(`{"name": "v1.2.3", "body": "## What's Changed\n* some notes"}`),
+				)
+			},
+			wantStdout: "## What's Changed\n* some notes\n",
+		},
+		{
+			name: "notes not implemented",
+			opts: ChangelogOptions{
+				TagName: "v1.2.3",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/releases/generate-notes"),
+					httpmock.StatusStringResponse(404, "not found"),
+				)
+			},
+			wantStdout: "",
+		},
+		{
+			name: "notes and categorized commits",
+			opts: ChangelogOptions{
+				TagName:     "v1.2.3",
+				PreviousTag: "v1.2.2",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/releases/generate-notes"),
+					httpmock.StringResponse(`{"name": "v1.2.3", "body": "## What's Changed"}`),
+				)
+			},
+			runStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%b%x00 --cherry v1.2.2...v1.2.3`, 0,
+					"6a6872b918c601a0e730710ad8473938a7516d30\x00feat: add widget\x00\x00\n7a6872b918c601a0e730710ad8473938a7516d31\x00fix bug\x00\x00\n")
+			},
+			wantStdout: "## What's Changed\n\n## Features\n\n- feat: add widget (6a6872b)\n\n## Other Changes\n\n- fix bug (7a6872b)\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, stdout, _ := iostreams.Test()
+
+			reg := &httpmock.Registry{}
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+			defer reg.Verify(t)
+
+			tt.opts.IO = ios
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			}
+			tt.opts.GitClient = &git.Client{GitPath: "some/path/git"}
+
+			cs, teardown := run.Stub()
+			defer teardown(t)
+			if tt.runStubs != nil {
+				tt.runStubs(cs)
+			}
+
+			err := changelogRun(&tt.opts)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
+
+func Test_changelogRun_template(t *testing.T) {
+	tempDir := t.TempDir()
+	tmplFile, err := os.CreateTemp(tempDir, "changelog")
+	require.NoError(t, err)
+	_, err = tmplFile.WriteString("Changelog for {{.Tag}}: {{.Notes}}")
+	require.NoError(t, err)
+	tmplFile.Close()
+
+	ios, _, stdout, _ := iostreams.Test()
+
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/releases/generate-notes"),
+		httpmock.StringResponse(`{"name": "v1.2.3", "body": "some notes"}`),
+	)
+	defer reg.Verify(t)
+
+	opts := &ChangelogOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+		GitClient:    &git.Client{GitPath: "some/path/git"},
+		TagName:      "v1.2.3",
+		TemplateFile: tmplFile.Name(),
+	}
+
+	cs, teardown := run.Stub()
+	defer teardown(t)
+	_ = cs
+
+	err = changelogRun(opts)
+	require.NoError(t, err)
+	assert.Equal(t, "Changelog for v1.2.3: some notes", stdout.String())
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/internal/tableprinter"
 	"github.com/cli/cli/v2/internal/text"
@@ -17,6 +18,7 @@ type ListOptions struct {
 	HttpClient func() (*http.Client, error)
 	IO         *iostreams.IOStreams
 	BaseRepo   func() (ghrepo.Interface, error)
+	BaseRepos  func() ([]ghrepo.Interface, error)
 
 	Exporter cmdutil.Exporter
 
@@ -33,13 +35,22 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	}
 
 	cmd := &cobra.Command{
-		Use:     "list",
-		Short:   "List releases in a repository",
+		Use:   "list",
+		Short: "List releases in a repository",
+		Long: heredoc.Doc(`
+			List releases in a GitHub repository.
+
+			Pass ` + "`-R/--repo`" + ` more than once, or give it a comma-separated list, to list and
+			merge releases from several repositories at once. The merged table adds a Repo column,
+			and ` + "`--json`" + ` isn't supported in that mode.
+		`),
 		Aliases: []string{"ls"},
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// support `-R, --repo` override
 			opts.BaseRepo = f.BaseRepo
+			repoOverrides, _ := cmd.Flags().GetStringArray("repo")
+			opts.BaseRepos = cmdutil.BaseReposOverride(f, repoOverrides)
 
 			if runF != nil {
 				return runF(opts)
@@ -63,11 +74,27 @@ func listRun(opts *ListOptions) error {
 		return err
 	}
 
-	baseRepo, err := opts.BaseRepo()
+	if opts.BaseRepos == nil {
+		opts.BaseRepos = func() ([]ghrepo.Interface, error) {
+			repo, err := opts.BaseRepo()
+			if err != nil {
+				return nil, err
+			}
+			return []ghrepo.Interface{repo}, nil
+		}
+	}
+
+	baseRepos, err := opts.BaseRepos()
 	if err != nil {
 		return err
 	}
 
+	if len(baseRepos) > 1 {
+		return listRunMultiRepo(opts, httpClient, baseRepos)
+	}
+
+	baseRepo := baseRepos[0]
+
 	releases, err := fetchReleases(httpClient, baseRepo, opts.LimitResults, opts.ExcludeDrafts, opts.ExcludePreReleases, opts.Order)
 	if err != nil {
 		return err
@@ -126,3 +153,75 @@ func listRun(opts *ListOptions) error {
 
 	return nil
 }
+
+// listRunMultiRepo fetches and merges releases across more than one repository, given via
+// repeated or comma-separated `-R/--repo` flags, adding a REPO column so the results stay
+// attributable.
+func listRunMultiRepo(opts *ListOptions, httpClient *http.Client, baseRepos []ghrepo.Interface) error {
+	if opts.Exporter != nil {
+		return cmdutil.FlagErrorf("multiple `--repo` values aren't supported with `--json`")
+	}
+
+	type repoRelease struct {
+		repo    ghrepo.Interface
+		release Release
+	}
+
+	var merged []repoRelease
+	for _, baseRepo := range baseRepos {
+		releases, err := fetchReleases(httpClient, baseRepo, opts.LimitResults, opts.ExcludeDrafts, opts.ExcludePreReleases, opts.Order)
+		if err != nil {
+			return fmt.Errorf("failed to list releases for %s: %w", ghrepo.FullName(baseRepo), err)
+		}
+		for _, release := range releases {
+			merged = append(merged, repoRelease{repo: baseRepo, release: release})
+		}
+	}
+
+	if len(merged) == 0 {
+		return cmdutil.NewNoResultsError("no releases found")
+	}
+
+	if err := opts.IO.StartPager(); err == nil {
+		defer opts.IO.StopPager()
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+	}
+
+	table := tableprinter.New(opts.IO, tableprinter.WithHeader("Repo", "Title", "Type", "Tag name", "Published"))
+	iofmt := opts.IO.ColorScheme()
+	for _, rr := range merged {
+		table.AddField(ghrepo.FullName(rr.repo), tableprinter.WithColor(iofmt.Gray))
+
+		title := text.RemoveExcessiveWhitespace(rr.release.Name)
+		if title == "" {
+			title = rr.release.TagName
+		}
+		table.AddField(title)
+
+		badge := ""
+		var badgeColor func(string) string
+		if rr.release.IsLatest {
+			badge = "Latest"
+			badgeColor = iofmt.Green
+		} else if rr.release.IsDraft {
+			badge = "Draft"
+			badgeColor = iofmt.Red
+		} else if rr.release.IsPrerelease {
+			badge = "Pre-release"
+			badgeColor = iofmt.Yellow
+		}
+		table.AddField(badge, tableprinter.WithColor(badgeColor))
+
+		table.AddField(rr.release.TagName, tableprinter.WithTruncate(nil))
+
+		pubDate := rr.release.PublishedAt
+		if rr.release.PublishedAt.IsZero() {
+			pubDate = rr.release.CreatedAt
+		}
+		table.AddTimeField(time.Now(), pubDate, iofmt.Gray)
+		table.EndRow()
+	}
+
+	return table.Render()
+}
@@ -229,6 +229,70 @@ func Test_listRun(t *testing.T) {
 	}
 }
 
+func Test_listRun_multiRepo(t *testing.T) {
+	createdAt := time.Now().Add(time.Duration(-24) * time.Hour).Format(time.RFC3339)
+
+	fakeHTTP := &httpmock.Registry{}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.Register(httpmock.GraphQL(`\bRepositoryReleaseList\(`), httpmock.StringResponse(fmt.Sprintf(`
+		{ "data": { "repository": { "releases": { "nodes": [
+			{ "name": "from one", "tagName": "v1.0.0", "createdAt": "%[1]s", "publishedAt": "%[1]s" }
+		] } } } }`, createdAt)))
+	fakeHTTP.Register(httpmock.GraphQL(`\bRepositoryReleaseList\(`), httpmock.StringResponse(fmt.Sprintf(`
+		{ "data": { "repository": { "releases": { "nodes": [
+			{ "name": "from two", "tagName": "v2.0.0", "isLatest": true, "createdAt": "%[1]s", "publishedAt": "%[1]s" }
+		] } } } }`, createdAt)))
+
+	ios, _, stdout, stderr := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	ios.SetStdinTTY(true)
+	ios.SetStderrTTY(true)
+
+	opts := &ListOptions{
+		IO:           ios,
+		LimitResults: 30,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: fakeHTTP}, nil
+		},
+		BaseRepos: func() ([]ghrepo.Interface, error) {
+			return []ghrepo.Interface{
+				ghrepo.New("owner", "one"),
+				ghrepo.New("owner", "two"),
+			}, nil
+		},
+	}
+
+	err := listRun(opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		REPO       TITLE     TYPE    TAG NAME  PUBLISHED
+		owner/one  from one          v1.0.0    about 1 day ago
+		owner/two  from two  Latest  v2.0.0    about 1 day ago
+	`), stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
+
+func Test_listRun_multiRepo_jsonUnsupported(t *testing.T) {
+	exporter := cmdutil.NewJSONExporter()
+	exporter.SetFields([]string{"tagName"})
+
+	opts := &ListOptions{
+		IO:       iostreams.System(),
+		Exporter: exporter,
+		BaseRepos: func() ([]ghrepo.Interface, error) {
+			return []ghrepo.Interface{ghrepo.New("owner", "one"), ghrepo.New("owner", "two")}, nil
+		},
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{}, nil
+		},
+	}
+
+	err := listRun(opts)
+	assert.EqualError(t, err, "multiple `--repo` values aren't supported with `--json`")
+}
+
 func TestExportReleases(t *testing.T) {
 	ios, _, stdout, _ := iostreams.Test()
 	createdAt, _ := time.Parse(time.RFC3339, "2024-01-01T00:00:00Z")
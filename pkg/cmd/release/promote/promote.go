@@ -0,0 +1,129 @@
+package promote
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/release/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type PromoteOptions struct {
+	IO         *iostreams.IOStreams
+	HttpClient func() (*http.Client, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	TagName            string
+	NewTagName         string
+	Body               *string
+	DiscussionCategory string
+	NotLatest          bool
+}
+
+func NewCmdPromote(f *cmdutil.Factory, runF func(*PromoteOptions) error) *cobra.Command {
+	opts := &PromoteOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	var notesFile string
+
+	cmd := &cobra.Command{
+		Use:   "promote <tag>",
+		Short: "Promote a prerelease to a full release",
+		Long: heredoc.Docf(`
+			Promote a prerelease to a full release.
+
+			This marks the release as no longer a prerelease, and by default also
+			marks it as the %[1]slatest%[1]s release. Combine with %[1]s--tag%[1]s to
+			retarget the release onto a new tag, and with %[1]s--notes-file%[1]s to
+			replace the release notes with the contents of a prepared template.
+
+			Providing %[1]s--discussion-category%[1]s additionally starts a
+			discussion in the given category to announce the promotion.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# promote a prerelease and mark it as the latest release
+			$ gh release promote v1.2.3-rc.1
+
+			# promote a prerelease, retargeting it onto a new tag
+			$ gh release promote v1.2.3-rc.1 --tag v1.2.3
+
+			# promote a prerelease, replacing its notes and announcing in a discussion
+			$ gh release promote v1.2.3-rc.1 --notes-file CHANGELOG.md --discussion-category announcements
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.TagName = args[0]
+
+			if notesFile != "" {
+				b, err := cmdutil.ReadFile(notesFile, opts.IO.In)
+				if err != nil {
+					return err
+				}
+				body := string(b)
+				opts.Body = &body
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return promoteRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.NewTagName, "tag", "", "Retarget the release onto a new tag")
+	cmd.Flags().StringVarP(&notesFile, "notes-file", "F", "", "Read release notes from `file` (use \"-\" to read from standard input)")
+	cmd.Flags().StringVar(&opts.DiscussionCategory, "discussion-category", "", "Start a discussion in the specified category to announce the promotion")
+	cmd.Flags().BoolVar(&opts.NotLatest, "not-latest", false, "Do not mark the promoted release as \"Latest\"")
+
+	return cmd
+}
+
+func promoteRun(opts *PromoteOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	release, err := shared.FetchRelease(context.Background(), httpClient, baseRepo, opts.TagName)
+	if err != nil {
+		return err
+	}
+
+	params := map[string]interface{}{
+		"tag_name":    release.TagName,
+		"prerelease":  false,
+		"make_latest": fmt.Sprintf("%v", !opts.NotLatest),
+	}
+
+	if opts.NewTagName != "" {
+		params["tag_name"] = opts.NewTagName
+	}
+	if opts.Body != nil {
+		params["body"] = *opts.Body
+	}
+	if opts.DiscussionCategory != "" {
+		params["discussion_category_name"] = opts.DiscussionCategory
+	}
+
+	promoted, err := promoteRelease(httpClient, baseRepo, release.DatabaseID, params)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(opts.IO.Out, "%s\n", promoted.URL)
+
+	return nil
+}
@@ -0,0 +1,229 @@
+package promote
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/release/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdPromote(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		isTTY   bool
+		want    PromoteOptions
+		wantErr string
+	}{
+		{
+			name:    "no arguments",
+			args:    "",
+			isTTY:   true,
+			wantErr: "accepts 1 arg(s), received 0",
+		},
+		{
+			name:  "tag only",
+			args:  "v1.2.3",
+			isTTY: true,
+			want: PromoteOptions{
+				TagName: "v1.2.3",
+			},
+		},
+		{
+			name:  "retarget and skip latest",
+			args:  "v1.2.3-rc.1 --tag v1.2.3 --not-latest",
+			isTTY: true,
+			want: PromoteOptions{
+				TagName:    "v1.2.3-rc.1",
+				NewTagName: "v1.2.3",
+				NotLatest:  true,
+			},
+		},
+		{
+			name:  "discussion category",
+			args:  "v1.2.3 --discussion-category announcements",
+			isTTY: true,
+			want: PromoteOptions{
+				TagName:            "v1.2.3",
+				DiscussionCategory: "announcements",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			ios.SetStdoutTTY(tt.isTTY)
+			ios.SetStdinTTY(tt.isTTY)
+			ios.SetStderrTTY(tt.isTTY)
+
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			var opts *PromoteOptions
+			cmd := NewCmdPromote(f, func(o *PromoteOptions) error {
+				opts = o
+				return nil
+			})
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want.TagName, opts.TagName)
+			assert.Equal(t, tt.want.NewTagName, opts.NewTagName)
+			assert.Equal(t, tt.want.NotLatest, opts.NotLatest)
+			assert.Equal(t, tt.want.DiscussionCategory, opts.DiscussionCategory)
+		})
+	}
+}
+
+func Test_promoteRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       PromoteOptions
+		httpStubs  func(t *testing.T, reg *httpmock.Registry)
+		wantErr    string
+		wantStdout string
+	}{
+		{
+			name: "promote to latest",
+			opts: PromoteOptions{
+				TagName: "v1.2.3",
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				mockSuccessfulPromoteResponse(reg, func(params map[string]interface{}) {
+					assert.Equal(t, map[string]interface{}{
+						"tag_name":    "v1.2.3",
+						"prerelease":  false,
+						"make_latest": "true",
+					}, params)
+				})
+			},
+			wantStdout: "https://github.com/OWNER/REPO/releases/tag/v1.2.3\n",
+		},
+		{
+			name: "promote without marking latest",
+			opts: PromoteOptions{
+				TagName:   "v1.2.3",
+				NotLatest: true,
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				mockSuccessfulPromoteResponse(reg, func(params map[string]interface{}) {
+					assert.Equal(t, map[string]interface{}{
+						"tag_name":    "v1.2.3",
+						"prerelease":  false,
+						"make_latest": "false",
+					}, params)
+				})
+			},
+			wantStdout: "https://github.com/OWNER/REPO/releases/tag/v1.2.3\n",
+		},
+		{
+			name: "promote and retarget onto a new tag",
+			opts: PromoteOptions{
+				TagName:    "v1.2.3",
+				NewTagName: "v1.2.4",
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				mockSuccessfulPromoteResponse(reg, func(params map[string]interface{}) {
+					assert.Equal(t, map[string]interface{}{
+						"tag_name":    "v1.2.4",
+						"prerelease":  false,
+						"make_latest": "true",
+					}, params)
+				})
+			},
+			wantStdout: "https://github.com/OWNER/REPO/releases/tag/v1.2.3\n",
+		},
+		{
+			name: "promote with new notes and a discussion category",
+			opts: PromoteOptions{
+				TagName:            "v1.2.3",
+				Body:               stringPtr("Some Notes"),
+				DiscussionCategory: "announcements",
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				mockSuccessfulPromoteResponse(reg, func(params map[string]interface{}) {
+					assert.Equal(t, map[string]interface{}{
+						"tag_name":                 "v1.2.3",
+						"prerelease":               false,
+						"make_latest":              "true",
+						"body":                     "Some Notes",
+						"discussion_category_name": "announcements",
+					}, params)
+				})
+			},
+			wantStdout: "https://github.com/OWNER/REPO/releases/tag/v1.2.3\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, stdout, stderr := iostreams.Test()
+			ios.SetStdoutTTY(true)
+			ios.SetStdinTTY(true)
+			ios.SetStderrTTY(true)
+
+			fakeHTTP := &httpmock.Registry{}
+			defer fakeHTTP.Verify(t)
+			shared.StubFetchRelease(t, fakeHTTP, "OWNER", "REPO", tt.opts.TagName, `{
+				"id": 12345,
+				"tag_name": "v1.2.3"
+			}`)
+			if tt.httpStubs != nil {
+				tt.httpStubs(t, fakeHTTP)
+			}
+
+			tt.opts.IO = ios
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: fakeHTTP}, nil
+			}
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			}
+
+			err := promoteRun(&tt.opts)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantStdout, stdout.String())
+			assert.Equal(t, "", stderr.String())
+		})
+	}
+}
+
+func mockSuccessfulPromoteResponse(reg *httpmock.Registry, cb func(params map[string]interface{})) {
+	matcher := httpmock.REST("PATCH", "repos/OWNER/REPO/releases/12345")
+	responder := httpmock.RESTPayload(201, `{
+		"html_url": "https://github.com/OWNER/REPO/releases/tag/v1.2.3"
+	}`, cb)
+	reg.Register(matcher, responder)
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
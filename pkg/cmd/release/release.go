@@ -1,13 +1,16 @@
 package release
 
 import (
+	cmdChangelog "github.com/cli/cli/v2/pkg/cmd/release/changelog"
 	cmdCreate "github.com/cli/cli/v2/pkg/cmd/release/create"
 	cmdDelete "github.com/cli/cli/v2/pkg/cmd/release/delete"
 	cmdDeleteAsset "github.com/cli/cli/v2/pkg/cmd/release/delete-asset"
 	cmdDownload "github.com/cli/cli/v2/pkg/cmd/release/download"
 	cmdUpdate "github.com/cli/cli/v2/pkg/cmd/release/edit"
 	cmdList "github.com/cli/cli/v2/pkg/cmd/release/list"
+	cmdPromote "github.com/cli/cli/v2/pkg/cmd/release/promote"
 	cmdUpload "github.com/cli/cli/v2/pkg/cmd/release/upload"
+	cmdVerifyAsset "github.com/cli/cli/v2/pkg/cmd/release/verify-asset"
 	cmdView "github.com/cli/cli/v2/pkg/cmd/release/view"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -30,8 +33,11 @@ func NewCmdRelease(f *cmdutil.Factory) *cobra.Command {
 	cmdutil.AddGroup(cmd, "Targeted commands",
 		cmdView.NewCmdView(f, nil),
 		cmdUpdate.NewCmdEdit(f, nil),
+		cmdPromote.NewCmdPromote(f, nil),
 		cmdUpload.NewCmdUpload(f, nil),
 		cmdDownload.NewCmdDownload(f, nil),
+		cmdVerifyAsset.NewCmdVerifyAsset(f, nil),
+		cmdChangelog.NewCmdChangelog(f, nil),
 		cmdDelete.NewCmdDelete(f, nil),
 		cmdDeleteAsset.NewCmdDeleteAsset(f, nil),
 	)
@@ -0,0 +1,148 @@
+package upload
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/release/shared"
+)
+
+// createDraftRelease idempotently creates a draft release for tagName. If another concurrent CI
+// job has already created it, the resulting 422 from GitHub is treated as success and the
+// now-existing release is fetched instead.
+func createDraftRelease(httpClient *http.Client, repo ghrepo.Interface, tagName string) (*shared.Release, error) {
+	params := map[string]interface{}{
+		"tag_name": tagName,
+		"draft":    true,
+	}
+	bodyBytes, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("repos/%s/%s/releases", repo.RepoOwner(), repo.RepoName())
+	url := ghinstance.RESTPrefix(repo.RepoHost()) + path
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(bodyBytes))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnprocessableEntity {
+		return shared.FetchRelease(context.Background(), httpClient, repo, tagName)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var release shared.Release
+	err = json.Unmarshal(b, &release)
+	return &release, err
+}
+
+// acquireUploadLock takes an advisory lock on the release by uploading a marker asset, retrying
+// until it succeeds or timeout elapses. The returned func deletes the marker asset to release the
+// lock for the next waiting job.
+func acquireUploadLock(httpClient *http.Client, repo ghrepo.Interface, tagName, uploadURL string, timeout time.Duration) (func() error, error) {
+	lockAsset := &shared.AssetForUpload{
+		Name:     lockAssetName,
+		MIMEType: "text/plain",
+		Open: func() (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader("")), nil
+		},
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		err := shared.ConcurrentUpload(httpClient, uploadURL, 1, []*shared.AssetForUpload{lockAsset})
+		if err == nil {
+			break
+		}
+
+		var httpErr api.HTTPError
+		if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusUnprocessableEntity {
+			return nil, err
+		}
+		if time.Now().After(deadline) {
+			return nil, errors.New("timed out waiting for another job to release the lock")
+		}
+		time.Sleep(lockPollInterval)
+	}
+
+	release := func() error {
+		rel, err := shared.FetchRelease(context.Background(), httpClient, repo, tagName)
+		if err != nil {
+			return err
+		}
+		for _, a := range rel.Assets {
+			if a.Name == lockAssetName {
+				return deleteReleaseAsset(httpClient, a.APIURL)
+			}
+		}
+		return nil
+	}
+	return release, nil
+}
+
+func deleteReleaseAsset(httpClient *http.Client, assetURL string) error {
+	req, err := http.NewRequest("DELETE", assetURL, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return api.HandleHTTPError(resp)
+	}
+	return nil
+}
+
+func fetchAssetContent(httpClient *http.Client, assetURL string) (string, error) {
+	req, err := http.NewRequest("GET", assetURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/octet-stream")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return "", api.HandleHTTPError(resp)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
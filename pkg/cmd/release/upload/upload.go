@@ -1,11 +1,15 @@
 package upload
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/internal/ghrepo"
@@ -27,8 +31,19 @@ type UploadOptions struct {
 	// maximum number of simultaneous uploads
 	Concurrency       int
 	OverwriteExisting bool
+	ClobberChecksum   bool
+	CreateIfMissing   bool
+	Lock              bool
+	LockTimeout       time.Duration
 }
 
+// lockAssetName is the name of the advisory-lock marker asset used to serialize uploads from
+// parallel CI jobs. It is deleted again once the uploading job releases the lock.
+const lockAssetName = ".gh-release-upload.lock"
+
+// lockPollInterval bounds how often a job waiting on --lock retries acquiring it.
+var lockPollInterval = time.Second
+
 func NewCmdUpload(f *cmdutil.Factory, runF func(*UploadOptions) error) *cobra.Command {
 	opts := &UploadOptions{
 		IO:         f.IOStreams,
@@ -67,6 +82,10 @@ func NewCmdUpload(f *cmdutil.Factory, runF func(*UploadOptions) error) *cobra.Co
 	}
 
 	cmd.Flags().BoolVar(&opts.OverwriteExisting, "clobber", false, "Overwrite existing assets of the same name")
+	cmd.Flags().BoolVar(&opts.ClobberChecksum, "clobber-checksum", false, "When an asset looks like a checksums file, merge new checksum lines into the existing file instead of overwriting it")
+	cmd.Flags().BoolVar(&opts.CreateIfMissing, "create-if-missing", false, "Create the release as a draft if it doesn't already exist")
+	cmd.Flags().BoolVar(&opts.Lock, "lock", false, "Take an advisory lock on the release before uploading, to avoid races with concurrent jobs")
+	cmd.Flags().DurationVar(&opts.LockTimeout, "lock-timeout", 2*time.Minute, "How long to wait to acquire the `--lock`")
 
 	return cmd
 }
@@ -84,7 +103,13 @@ func uploadRun(opts *UploadOptions) error {
 
 	release, err := shared.FetchRelease(context.Background(), httpClient, baseRepo, opts.TagName)
 	if err != nil {
-		return err
+		if !opts.CreateIfMissing || !errors.Is(err, shared.ErrReleaseNotFound) {
+			return err
+		}
+		release, err = createDraftRelease(httpClient, baseRepo, opts.TagName)
+		if err != nil {
+			return err
+		}
 	}
 
 	uploadURL := release.UploadURL
@@ -92,6 +117,18 @@ func uploadRun(opts *UploadOptions) error {
 		uploadURL = uploadURL[:idx]
 	}
 
+	if opts.Lock {
+		unlock, err := acquireUploadLock(httpClient, baseRepo, opts.TagName, uploadURL, opts.LockTimeout)
+		if err != nil {
+			return fmt.Errorf("failed to acquire upload lock: %w", err)
+		}
+		defer func() {
+			if err := unlock(); err != nil {
+				fmt.Fprintf(opts.IO.ErrOut, "warning: failed to release upload lock: %v\n", err)
+			}
+		}()
+	}
+
 	var existingNames []string
 	for _, a := range opts.Assets {
 		sanitizedFileName := sanitizeFileName(a.Name)
@@ -104,8 +141,29 @@ func uploadRun(opts *UploadOptions) error {
 		}
 	}
 
-	if len(existingNames) > 0 && !opts.OverwriteExisting {
-		return fmt.Errorf("asset under the same name already exists: %v", existingNames)
+	var unhandledExisting []string
+	for _, name := range existingNames {
+		if opts.OverwriteExisting {
+			continue
+		}
+		if opts.ClobberChecksum && looksLikeChecksumsFile(name) {
+			continue
+		}
+		unhandledExisting = append(unhandledExisting, name)
+	}
+	if len(unhandledExisting) > 0 {
+		return fmt.Errorf("asset under the same name already exists: %v", unhandledExisting)
+	}
+
+	if opts.ClobberChecksum {
+		for _, a := range opts.Assets {
+			if a.ExistingURL == "" || !looksLikeChecksumsFile(a.Name) {
+				continue
+			}
+			if err := mergeChecksumAsset(httpClient, a); err != nil {
+				return fmt.Errorf("failed to merge checksums for %s: %w", a.Name, err)
+			}
+		}
 	}
 
 	opts.IO.StartProgressIndicator()
@@ -151,3 +209,68 @@ func sanitizeFileName(name string) string {
 
 	return value
 }
+
+// looksLikeChecksumsFile reports whether name appears to be a checksums manifest, based on the
+// naming conventions used by common checksum tools and release workflows.
+func looksLikeChecksumsFile(name string) bool {
+	lower := strings.ToLower(name)
+	return strings.Contains(lower, "checksum") || strings.Contains(lower, "sha256sum")
+}
+
+// mergeChecksumAsset rewrites a's contents to be the union of the checksums already attached to
+// the release and the checksums a is about to upload, so that multiple matrix jobs can each
+// upload their own partial checksums file without clobbering one another's entries.
+func mergeChecksumAsset(httpClient *http.Client, a *shared.AssetForUpload) error {
+	existing, err := fetchAssetContent(httpClient, a.ExistingURL)
+	if err != nil {
+		return err
+	}
+
+	rc, err := a.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	incoming, err := io.ReadAll(rc)
+	if err != nil {
+		return err
+	}
+
+	merged := []byte(mergeChecksumLines(existing, string(incoming)))
+	a.Open = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(merged)), nil
+	}
+	a.Size = int64(len(merged))
+	return nil
+}
+
+// mergeChecksumLines combines two checksums files in the conventional `sha256sum` output format
+// (one "<hex digest>  <file name>" entry per line), keeping the original ordering of existing
+// and appending any new file names. An incoming entry replaces an existing one for the same file.
+func mergeChecksumLines(existing, incoming string) string {
+	order := make([]string, 0)
+	lines := make(map[string]string)
+
+	addLines := func(content string) {
+		for _, line := range strings.Split(content, "\n") {
+			line = strings.TrimRight(line, "\r")
+			fields := strings.Fields(line)
+			if len(fields) < 2 {
+				continue
+			}
+			name := strings.TrimPrefix(fields[len(fields)-1], "*")
+			if _, ok := lines[name]; !ok {
+				order = append(order, name)
+			}
+			lines[name] = line
+		}
+	}
+	addLines(existing)
+	addLines(incoming)
+
+	merged := make([]string, 0, len(order))
+	for _, name := range order {
+		merged = append(merged, lines[name])
+	}
+	return strings.Join(merged, "\n") + "\n"
+}
@@ -1,9 +1,17 @@
 package upload
 
 import (
+	"bytes"
+	"io"
+	"net/http"
 	"testing"
 
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/release/shared"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_SanitizeFileName(t *testing.T) {
@@ -50,3 +58,151 @@ func Test_SanitizeFileName(t *testing.T) {
 		})
 	}
 }
+
+func Test_mergeChecksumLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing string
+		incoming string
+		expected string
+	}{
+		{
+			name:     "new entries are appended",
+			existing: "aaa  linux.tgz\n",
+			incoming: "bbb  windows.zip\n",
+			expected: "aaa  linux.tgz\nbbb  windows.zip\n",
+		},
+		{
+			name:     "incoming entry replaces an existing one for the same file",
+			existing: "aaa  linux.tgz\nbbb  windows.zip\n",
+			incoming: "ccc  linux.tgz\n",
+			expected: "ccc  linux.tgz\nbbb  windows.zip\n",
+		},
+		{
+			name:     "empty existing file",
+			existing: "",
+			incoming: "aaa  linux.tgz\n",
+			expected: "aaa  linux.tgz\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, mergeChecksumLines(tt.existing, tt.incoming))
+		})
+	}
+}
+
+func Test_uploadRun_createIfMissing(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	ios.SetStdinTTY(true)
+	ios.SetStderrTTY(true)
+
+	fakeHTTP := &httpmock.Registry{}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.Register(httpmock.REST("GET", "repos/OWNER/REPO/releases/tags/v1.2.3"), httpmock.StatusStringResponse(404, `{}`))
+	fakeHTTP.Register(httpmock.GraphQL(`query RepositoryReleaseByTag\b`),
+		httpmock.StringResponse(`{ "data": { "repository": { "release": null }}}`))
+	fakeHTTP.Register(httpmock.REST("POST", "repos/OWNER/REPO/releases"), httpmock.RESTPayload(201, `{
+		"tag_name": "v1.2.3",
+		"upload_url": "https://api.github.com/assets/upload",
+		"html_url": "https://github.com/OWNER/REPO/releases/tag/v1.2.3"
+	}`, func(params map[string]interface{}) {
+		assert.Equal(t, map[string]interface{}{
+			"tag_name": "v1.2.3",
+			"draft":    true,
+		}, params)
+	}))
+	fakeHTTP.Register(httpmock.REST("POST", "assets/upload"), func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "ball.tgz", req.URL.Query().Get("name"))
+		return &http.Response{
+			StatusCode: 201,
+			Request:    req,
+			Body:       io.NopCloser(bytes.NewBufferString(`{}`)),
+			Header:     map[string][]string{"Content-Type": {"application/json"}},
+		}, nil
+	})
+
+	opts := &UploadOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: fakeHTTP}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+		TagName:         "v1.2.3",
+		CreateIfMissing: true,
+		Concurrency:     1,
+		Assets: []*shared.AssetForUpload{
+			{
+				Name: "ball.tgz",
+				Open: func() (io.ReadCloser, error) {
+					return io.NopCloser(bytes.NewBufferString(`TARBALL`)), nil
+				},
+			},
+		},
+	}
+
+	err := uploadRun(opts)
+	require.NoError(t, err)
+	assert.Equal(t, "Successfully uploaded 1 asset to v1.2.3\n", stdout.String())
+}
+
+func Test_uploadRun_clobberChecksum(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	ios.SetStdinTTY(true)
+	ios.SetStderrTTY(true)
+
+	fakeHTTP := &httpmock.Registry{}
+	defer fakeHTTP.Verify(t)
+
+	shared.StubFetchRelease(t, fakeHTTP, "OWNER", "REPO", "v1.2.3", `{
+		"tag_name": "v1.2.3",
+		"upload_url": "https://api.github.com/assets/upload",
+		"assets": [
+			{ "name": "checksums.txt", "url": "https://api.github.com/assets/9999" }
+		]
+	}`)
+	fakeHTTP.Register(httpmock.REST("GET", "assets/9999"), httpmock.StringResponse("aaa  linux.tgz\n"))
+	fakeHTTP.Register(httpmock.REST("DELETE", "assets/9999"), httpmock.StatusStringResponse(204, ""))
+	fakeHTTP.Register(httpmock.REST("POST", "assets/upload"), func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "checksums.txt", req.URL.Query().Get("name"))
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "aaa  linux.tgz\nbbb  windows.zip\n", string(body))
+		return &http.Response{
+			StatusCode: 201,
+			Request:    req,
+			Body:       io.NopCloser(bytes.NewBufferString(`{}`)),
+			Header:     map[string][]string{"Content-Type": {"application/json"}},
+		}, nil
+	})
+
+	opts := &UploadOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: fakeHTTP}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+		TagName:         "v1.2.3",
+		ClobberChecksum: true,
+		Concurrency:     1,
+		Assets: []*shared.AssetForUpload{
+			{
+				Name: "checksums.txt",
+				Open: func() (io.ReadCloser, error) {
+					return io.NopCloser(bytes.NewBufferString("bbb  windows.zip\n")), nil
+				},
+			},
+		},
+	}
+
+	err := uploadRun(opts)
+	require.NoError(t, err)
+	assert.Equal(t, "Successfully uploaded 1 asset to v1.2.3\n", stdout.String())
+}
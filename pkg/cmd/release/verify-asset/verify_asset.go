@@ -0,0 +1,341 @@
+package verifyasset
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	attestationVerify "github.com/cli/cli/v2/pkg/cmd/attestation/verify"
+	"github.com/cli/cli/v2/pkg/cmd/release/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type VerifyAssetOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	TagName            string
+	FilePatterns       []string
+	ChecksumsFile      string
+	VerifyAttestations bool
+}
+
+func NewCmdVerifyAsset(f *cmdutil.Factory, runF func(*VerifyAssetOptions) error) *cobra.Command {
+	opts := &VerifyAssetOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "verify-asset [<tag>]",
+		Short: "Verify release assets against the release's checksums",
+		Long: heredoc.Docf(`
+			Download a release's assets and verify each one against a checksums
+			file attached to the same release, printing a verification table.
+
+			Without an explicit tag name argument, assets are verified against the
+			latest release in the project.
+
+			The checksums file is located by name among the release's assets. Use
+			%[1]s--checksums-file%[1]s if it isn't named anything containing
+			"checksum" or "sha256sum".
+
+			Pass %[1]s--attestations%[1]s to additionally verify each asset's
+			build provenance attestations, equivalent to running
+			%[1]sgh attestation verify%[1]s against it.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# verify all assets from the latest release
+			$ gh release verify-asset
+
+			# verify only assets from a specific release
+			$ gh release verify-asset v1.2.3
+
+			# verify assets and their build provenance attestations
+			$ gh release verify-asset v1.2.3 --attestations
+
+			# verify only Debian packages, using an explicitly named checksums file
+			$ gh release verify-asset v1.2.3 -p '*.deb' --checksums-file SHA256SUMS
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if len(args) > 0 {
+				opts.TagName = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return verifyAssetRun(f, opts)
+		},
+	}
+
+	cmd.Flags().StringArrayVarP(&opts.FilePatterns, "pattern", "p", nil, "Verify only assets that match a glob pattern")
+	cmd.Flags().StringVarP(&opts.ChecksumsFile, "checksums-file", "c", "", "Name of the release asset containing checksums")
+	cmd.Flags().BoolVar(&opts.VerifyAttestations, "attestations", false, "Also verify each asset's build provenance attestations")
+
+	return cmd
+}
+
+func verifyAssetRun(f *cmdutil.Factory, opts *VerifyAssetOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicatorWithLabel("Fetching release")
+	ctx := context.Background()
+	var release *shared.Release
+	if opts.TagName == "" {
+		release, err = shared.FetchLatestRelease(ctx, httpClient, baseRepo)
+	} else {
+		release, err = shared.FetchRelease(ctx, httpClient, baseRepo, opts.TagName)
+	}
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	checksumsAsset, err := findChecksumsAsset(release.Assets, opts.ChecksumsFile)
+	if err != nil {
+		return err
+	}
+
+	var toVerify []shared.ReleaseAsset
+	for _, a := range release.Assets {
+		if a.Name == checksumsAsset.Name {
+			continue
+		}
+		if len(opts.FilePatterns) > 0 && !matchAny(opts.FilePatterns, a.Name) {
+			continue
+		}
+		toVerify = append(toVerify, a)
+	}
+	if len(toVerify) == 0 {
+		return errors.New("no assets match the file pattern")
+	}
+
+	opts.IO.StartProgressIndicatorWithLabel(fmt.Sprintf("Fetching %s", checksumsAsset.Name))
+	checksumsBody, err := fetchAsset(httpClient, checksumsAsset.APIURL)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to fetch %s: %w", checksumsAsset.Name, err)
+	}
+	checksums := parseChecksums(checksumsBody)
+
+	results := make([]assetResult, 0, len(toVerify))
+	anyFailed := false
+	for _, a := range toVerify {
+		opts.IO.StartProgressIndicatorWithLabel(fmt.Sprintf("Verifying %s", a.Name))
+		result, err := verifyAsset(f, httpClient, baseRepo, a, checksums, opts.VerifyAttestations)
+		opts.IO.StopProgressIndicator()
+		if err != nil {
+			return fmt.Errorf("failed to verify %s: %w", a.Name, err)
+		}
+		if !result.checksumOK || (opts.VerifyAttestations && !result.attestationOK) {
+			anyFailed = true
+		}
+		results = append(results, result)
+	}
+
+	printResults(opts.IO, results, opts.VerifyAttestations)
+
+	if anyFailed {
+		return cmdutil.SilentError
+	}
+	return nil
+}
+
+type assetResult struct {
+	name            string
+	checksumOK      bool
+	checksumDetail  string
+	checkAttested   bool
+	attestationOK   bool
+	attestationNote string
+}
+
+func verifyAsset(f *cmdutil.Factory, httpClient *http.Client, baseRepo ghrepo.Interface, asset shared.ReleaseAsset, checksums map[string]string, verifyAttestations bool) (assetResult, error) {
+	result := assetResult{name: asset.Name}
+
+	tmp, err := os.CreateTemp("", "gh-release-verify-*")
+	if err != nil {
+		return result, err
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath)
+
+	req, err := http.NewRequest("GET", asset.APIURL, nil)
+	if err != nil {
+		tmp.Close()
+		return result, err
+	}
+	req.Header.Set("Accept", "application/octet-stream")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		tmp.Close()
+		return result, err
+	}
+
+	hasher := sha256.New()
+	_, err = io.Copy(io.MultiWriter(tmp, hasher), resp.Body)
+	resp.Body.Close()
+	closeErr := tmp.Close()
+	if err != nil {
+		return result, err
+	}
+	if closeErr != nil {
+		return result, closeErr
+	}
+	if resp.StatusCode > 299 {
+		return result, api.HandleHTTPError(resp)
+	}
+
+	sum := hex.EncodeToString(hasher.Sum(nil))
+	expected, ok := checksums[asset.Name]
+	switch {
+	case !ok:
+		result.checksumDetail = "no entry in checksums file"
+	case sum == expected:
+		result.checksumOK = true
+		result.checksumDetail = "checksum matches"
+	default:
+		result.checksumDetail = fmt.Sprintf("checksum mismatch (got %s, want %s)", sum, expected)
+	}
+
+	if verifyAttestations {
+		result.checkAttested = true
+		verifyCmd := attestationVerify.NewVerifyCmd(f, nil)
+		verifyCmd.SilenceUsage = true
+		verifyCmd.SilenceErrors = true
+		verifyCmd.SetArgs([]string{tmpPath, "--repo", ghrepo.FullName(baseRepo)})
+		verifyCmd.SetOut(io.Discard)
+		verifyCmd.SetErr(io.Discard)
+		if err := verifyCmd.Execute(); err != nil {
+			result.attestationNote = "no verified attestations found"
+		} else {
+			result.attestationOK = true
+			result.attestationNote = "verified"
+		}
+	}
+
+	return result, nil
+}
+
+func printResults(io *iostreams.IOStreams, results []assetResult, showAttestations bool) {
+	cs := io.ColorScheme()
+	headers := []string{"ASSET", "CHECKSUM"}
+	if showAttestations {
+		headers = append(headers, "ATTESTATION")
+	}
+	table := tableprinter.New(io, tableprinter.WithHeader(headers...))
+	for _, r := range results {
+		table.AddField(r.name)
+		if r.checksumOK {
+			table.AddField(cs.SuccessIcon() + " " + r.checksumDetail)
+		} else {
+			table.AddField(cs.FailureIcon() + " " + r.checksumDetail)
+		}
+		if showAttestations {
+			if r.attestationOK {
+				table.AddField(cs.SuccessIcon() + " " + r.attestationNote)
+			} else {
+				table.AddField(cs.FailureIcon() + " " + r.attestationNote)
+			}
+		}
+		table.EndRow()
+	}
+	_ = table.Render()
+}
+
+// findChecksumsAsset locates the release asset that holds checksums for the other assets.
+// If name is empty, it looks for an asset whose name suggests it contains checksums.
+func findChecksumsAsset(assets []shared.ReleaseAsset, name string) (shared.ReleaseAsset, error) {
+	if name != "" {
+		for _, a := range assets {
+			if a.Name == name {
+				return a, nil
+			}
+		}
+		return shared.ReleaseAsset{}, fmt.Errorf("checksums file %q not found among release assets", name)
+	}
+
+	for _, a := range assets {
+		lower := strings.ToLower(a.Name)
+		if strings.Contains(lower, "checksum") || strings.Contains(lower, "sha256sum") {
+			return a, nil
+		}
+	}
+	return shared.ReleaseAsset{}, errors.New("no checksums file found among release assets; specify one with `--checksums-file`")
+}
+
+// parseChecksums parses the contents of a checksums file in the conventional
+// `sha256sum` output format, e.g. "<hex digest>  <file name>" per line.
+func parseChecksums(content string) map[string]string {
+	checksums := make(map[string]string)
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		name := strings.TrimPrefix(fields[len(fields)-1], "*")
+		checksums[filepath.Base(name)] = strings.ToLower(fields[0])
+	}
+	return checksums
+}
+
+func fetchAsset(httpClient *http.Client, assetURL string) (string, error) {
+	req, err := http.NewRequest("GET", assetURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/octet-stream")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return "", api.HandleHTTPError(resp)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+func matchAny(patterns []string, name string) bool {
+	for _, p := range patterns {
+		if isMatch, err := filepath.Match(p, name); err == nil && isMatch {
+			return true
+		}
+	}
+	return false
+}
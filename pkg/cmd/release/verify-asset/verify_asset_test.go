@@ -0,0 +1,180 @@
+package verifyasset
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/release/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdVerifyAsset(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		isTTY   bool
+		want    VerifyAssetOptions
+		wantErr string
+	}{
+		{
+			name:  "version argument",
+			args:  "v1.2.3",
+			isTTY: true,
+			want: VerifyAssetOptions{
+				TagName: "v1.2.3",
+			},
+		},
+		{
+			name:  "version and file pattern",
+			args:  "v1.2.3 -p *.tgz",
+			isTTY: true,
+			want: VerifyAssetOptions{
+				TagName:      "v1.2.3",
+				FilePatterns: []string{"*.tgz"},
+			},
+		},
+		{
+			name:  "checksums file and attestations",
+			args:  "v1.2.3 --checksums-file SHA256SUMS --attestations",
+			isTTY: true,
+			want: VerifyAssetOptions{
+				TagName:            "v1.2.3",
+				ChecksumsFile:      "SHA256SUMS",
+				VerifyAttestations: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			ios.SetStdoutTTY(tt.isTTY)
+			ios.SetStdinTTY(tt.isTTY)
+			ios.SetStderrTTY(tt.isTTY)
+
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			var opts *VerifyAssetOptions
+			cmd := NewCmdVerifyAsset(f, func(o *VerifyAssetOptions) error {
+				opts = o
+				return nil
+			})
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want.TagName, opts.TagName)
+			assert.Equal(t, tt.want.FilePatterns, opts.FilePatterns)
+			assert.Equal(t, tt.want.ChecksumsFile, opts.ChecksumsFile)
+			assert.Equal(t, tt.want.VerifyAttestations, opts.VerifyAttestations)
+		})
+	}
+}
+
+func Test_verifyAssetRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       VerifyAssetOptions
+		wantErr    string
+		wantStdout string
+	}{
+		{
+			name: "all checksums match",
+			opts: VerifyAssetOptions{
+				TagName: "v1.2.3",
+			},
+			wantStdout: "linux.tgz\t✓ checksum matches\n",
+		},
+		{
+			name: "checksum matches a single selected asset",
+			opts: VerifyAssetOptions{
+				TagName:      "v1.2.3",
+				FilePatterns: []string{"linux.tgz"},
+			},
+			wantStdout: "linux.tgz\t✓ checksum matches\n",
+		},
+		{
+			name: "no assets match the pattern",
+			opts: VerifyAssetOptions{
+				TagName:      "v1.2.3",
+				FilePatterns: []string{"*.zip"},
+			},
+			wantErr: "no assets match the file pattern",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, stdout, _ := iostreams.Test()
+			ios.SetStdoutTTY(false)
+			ios.SetStdinTTY(false)
+			ios.SetStderrTTY(false)
+
+			fakeHTTP := &httpmock.Registry{}
+			defer fakeHTTP.Verify(t)
+			shared.StubFetchRelease(t, fakeHTTP, "OWNER", "REPO", tt.opts.TagName, `{
+				"assets": [
+					{ "name": "linux.tgz", "size": 6,
+					  "url": "https://api.github.com/assets/1234" },
+					{ "name": "checksums.txt", "size": 64,
+					  "url": "https://api.github.com/assets/5678" }
+				]
+			}`)
+			if tt.wantErr == "" {
+				fakeHTTP.Register(httpmock.REST("GET", "assets/1234"), httpmock.StringResponse(`linux`))
+				fakeHTTP.Register(httpmock.REST("GET", "assets/5678"), httpmock.StringResponse(
+					"caf90169eefa5f807d577486b9f795ab86ae2983c5c20806cff959117e90af18  linux.tgz\n",
+				))
+			}
+
+			tt.opts.IO = ios
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: fakeHTTP}, nil
+			}
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			}
+
+			f := &cmdutil.Factory{IOStreams: ios}
+
+			err := verifyAssetRun(f, &tt.opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
+
+func Test_parseChecksums(t *testing.T) {
+	content := "caf90169eefa5f807d577486b9f795ab86ae2983c5c20806cff959117e90af18  linux.tgz\n" +
+		"abc123 *windows.zip\n" +
+		"\n"
+	got := parseChecksums(content)
+	assert.Equal(t, map[string]string{
+		"linux.tgz":   "caf90169eefa5f807d577486b9f795ab86ae2983c5c20806cff959117e90af18",
+		"windows.zip": "abc123",
+	}, got)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc"
@@ -24,9 +25,10 @@ type CloneOptions struct {
 	Config     func() (gh.Config, error)
 	IO         *iostreams.IOStreams
 
-	GitArgs      []string
-	Repository   string
-	UpstreamName string
+	GitArgs           []string
+	Repository        string
+	UpstreamName      string
+	RecurseSubmodules bool
 }
 
 func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Command {
@@ -60,6 +62,11 @@ func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Comm
 			the remote after the owner of the parent repository.
 
 			If the repository is a fork, its parent repository will be set as the default remote repository.
+
+			Use %[1]s--recurse-gh%[1]s to also initialize submodules that point at GitHub repositories you have
+			access to, rewriting their URLs to match your configured %[1]sgit_protocol%[1]s first. This avoids the
+			common failure where a submodule is recorded with a protocol you aren't set up for. Submodules
+			pointing elsewhere, or at repositories you can't access, are left untouched.
 		`, "`"),
 		Example: heredoc.Doc(`
 			# Clone a repository from a specific org
@@ -91,6 +98,7 @@ func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Comm
 	}
 
 	cmd.Flags().StringVarP(&opts.UpstreamName, "upstream-remote-name", "u", "upstream", "Upstream remote name when cloning a fork")
+	cmd.Flags().BoolVar(&opts.RecurseSubmodules, "recurse-gh", false, "Initialize submodules, rewriting GitHub submodule URLs to your preferred protocol first")
 	cmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
 		if err == pflag.ErrHelp {
 			return err
@@ -185,6 +193,12 @@ func cloneRun(opts *CloneOptions) error {
 		return err
 	}
 
+	if opts.RecurseSubmodules {
+		if err := recurseGitHubSubmodules(ctx, apiClient, cfg, gitClient, cloneDir); err != nil {
+			return err
+		}
+	}
+
 	// If the repo is a fork, add the parent as an upstream remote and set the parent as the default repo.
 	if canonicalRepo.Parent != nil {
 		protocol := cfg.GitProtocol(canonicalRepo.Parent.RepoHost()).Value
@@ -223,6 +237,49 @@ func cloneRun(opts *CloneOptions) error {
 	return nil
 }
 
+// recurseGitHubSubmodules rewrites the URL of any submodule that points at a GitHub repository the
+// user can access to match their configured git_protocol, then initializes all submodules.
+// Submodules that don't resolve to an accessible GitHub repository are left untouched, so git falls
+// back to whatever protocol they were recorded with.
+func recurseGitHubSubmodules(ctx context.Context, apiClient *api.Client, cfg gh.Config, gitClient *git.Client, cloneDir string) error {
+	gc := gitClient.Copy()
+	gc.RepoDir = cloneDir
+
+	submodules, err := gc.Submodules(ctx)
+	if err != nil {
+		return err
+	}
+
+	authenticatedHosts := cfg.Authentication().Hosts()
+	for _, submodule := range submodules {
+		if !git.IsURL(submodule.URL) {
+			continue
+		}
+		submoduleURL, err := git.ParseURL(submodule.URL)
+		if err != nil {
+			continue
+		}
+		submoduleRepo, err := ghrepo.FromURL(submoduleURL)
+		if err != nil || !slices.Contains(authenticatedHosts, submoduleRepo.RepoHost()) {
+			continue
+		}
+		if _, err := api.GitHubRepo(apiClient, submoduleRepo); err != nil {
+			continue
+		}
+
+		protocol := cfg.GitProtocol(submoduleRepo.RepoHost()).Value
+		newURL := ghrepo.FormatRemoteURL(submoduleRepo, protocol)
+		if newURL == submodule.URL {
+			continue
+		}
+		if err := gc.UpdateSubmoduleURL(ctx, submodule.Name, newURL); err != nil {
+			return err
+		}
+	}
+
+	return gc.SubmoduleUpdate(ctx)
+}
+
 // simplifyURL strips given URL of extra parts like extra path segments (i.e.,
 // anything beyond `/owner/repo`), query strings, or fragments. This function
 // never returns an error.
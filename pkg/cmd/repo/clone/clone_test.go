@@ -309,6 +309,68 @@ func Test_RepoClone_hasParent_upstreamRemoteName(t *testing.T) {
 	}
 }
 
+func Test_RepoClone_recurseGh(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+				{ "data": { "repository": {
+					"name": "REPO",
+					"owner": {
+						"login": "OWNER"
+					}
+				} } }
+				`))
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+				{ "data": { "repository": {
+					"name": "sub",
+					"owner": {
+						"login": "other"
+					}
+				} } }
+				`))
+
+	httpClient := &http.Client{Transport: reg}
+
+	cs, restore := run.Stub()
+	defer restore(t)
+
+	cs.Register(`git clone https://github.com/OWNER/REPO.git`, 0, "")
+	cs.Register(`git -C REPO config --file \.gitmodules --get-regexp`, 0, "submodule.sub.path vendor/sub\nsubmodule.sub.url git@github.com:other/sub.git\n")
+	cs.Register(`git -C REPO config submodule.sub.url https://github.com/other/sub.git`, 0, "")
+	cs.Register(`git -C REPO submodule update --init --recursive`, 0, "")
+
+	ios, stdin, stdout, stderr := iostreams.Test()
+	fac := &cmdutil.Factory{
+		IOStreams: ios,
+		HttpClient: func() (*http.Client, error) {
+			return httpClient, nil
+		},
+		Config: func() (gh.Config, error) {
+			cfg := config.NewBlankConfig()
+			cfg.Set("github.com", "oauth_token", "token123")
+			return cfg, nil
+		},
+		GitClient: &git.Client{
+			GhPath:  "some/path/gh",
+			GitPath: "some/path/git",
+		},
+	}
+
+	cmd := NewCmdClone(fac, nil)
+	cmd.SetArgs([]string{"OWNER/REPO", "--recurse-gh"})
+	cmd.SetIn(stdin)
+	cmd.SetOut(stderr)
+	cmd.SetErr(stderr)
+
+	_, err := cmd.ExecuteC()
+	assert.NoError(t, err)
+	assert.Equal(t, "", stdout.String())
+}
+
 func Test_RepoClone_withoutUsername(t *testing.T) {
 	reg := &httpmock.Registry{}
 	defer reg.Verify(t)
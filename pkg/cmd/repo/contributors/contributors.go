@@ -0,0 +1,127 @@
+package contributors
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ContributorsOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Exporter cmdutil.Exporter
+
+	Limit int
+}
+
+var contributorsFields = []string{"login", "commits", "additions", "deletions"}
+
+func NewCmdContributors(f *cmdutil.Factory, runF func(*ContributorsOptions) error) *cobra.Command {
+	opts := &ContributorsOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "contributors",
+		Short: "List commit activity by contributor",
+		Long: heredoc.Doc(`
+			List a repository's contributors ranked by commit count, along with the lines
+			they've added and removed.
+
+			GitHub computes these statistics asynchronously; if they haven't been generated
+			for this repository yet, this command waits briefly and retries before giving up.
+		`),
+		Example: heredoc.Doc(`
+			$ gh repo contributors
+			$ gh repo contributors --limit 10 --repo cli/cli
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.Limit < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return contributorsRun(opts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 25, "Maximum number of contributors to list")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, contributorsFields)
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	return cmd
+}
+
+func contributorsRun(opts *ContributorsOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	contributors, err := ContributorStats(context.Background(), httpClient, repo)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if len(contributors) > opts.Limit {
+		contributors = contributors[:opts.Limit]
+	}
+
+	if len(contributors) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("no contributor statistics found for %s", ghrepo.FullName(repo)))
+	}
+
+	if err := opts.IO.StartPager(); err == nil {
+		defer opts.IO.StopPager()
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, contributors)
+	}
+
+	isTTY := opts.IO.IsStdoutTTY()
+	headers := []string{"CONTRIBUTOR", "COMMITS", "ADDITIONS", "DELETIONS"}
+	if isTTY {
+		headers = append(headers, "ACTIVITY")
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader(headers...))
+	for _, c := range contributors {
+		tp.AddField(c.Author.Login, tableprinter.WithColor(cs.Bold))
+		tp.AddField(fmt.Sprintf("%d", c.Total))
+		tp.AddField(fmt.Sprintf("%d", c.Additions()), tableprinter.WithColor(cs.Green))
+		tp.AddField(fmt.Sprintf("%d", c.Deletions()), tableprinter.WithColor(cs.Red))
+		if isTTY {
+			tp.AddField(text.Sparkline(c.WeeklyCommits(), 20), tableprinter.WithColor(cs.Cyan))
+		}
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
@@ -0,0 +1,60 @@
+package contributors
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_contributorsRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/stats/contributors"),
+		httpmock.StringResponse(`[
+			{"author": {"login": "monalisa"}, "total": 42, "weeks": [{"w": 1, "a": 100, "d": 10, "c": 20}, {"w": 2, "a": 50, "d": 5, "c": 22}]},
+			{"author": {"login": "hubot"}, "total": 7, "weeks": [{"w": 1, "a": 3, "d": 1, "c": 7}]}
+		]`))
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	opts := &ContributorsOptions{
+		IO:         ios,
+		HttpClient: func() (*http.Client, error) { return &http.Client{Transport: reg}, nil },
+		BaseRepo:   func() (ghrepo.Interface, error) { return ghrepo.New("OWNER", "REPO"), nil },
+		Limit:      25,
+	}
+
+	require.NoError(t, contributorsRun(opts))
+
+	out := stdout.String()
+	assert.Contains(t, out, "monalisa")
+	assert.Contains(t, out, "42")
+	assert.Contains(t, out, "hubot")
+}
+
+func Test_contributorsRun_noResults(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/stats/contributors"),
+		httpmock.StringResponse(`[]`))
+
+	ios, _, _, _ := iostreams.Test()
+
+	opts := &ContributorsOptions{
+		IO:         ios,
+		HttpClient: func() (*http.Client, error) { return &http.Client{Transport: reg}, nil },
+		BaseRepo:   func() (ghrepo.Interface, error) { return ghrepo.New("OWNER", "REPO"), nil },
+		Limit:      25,
+	}
+
+	err := contributorsRun(opts)
+	assert.Error(t, err)
+}
@@ -0,0 +1,127 @@
+package contributors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+// Week is one week of a contributor's commit activity, as returned by the stats API.
+type Week struct {
+	// Start is the Unix timestamp of the first day of the week.
+	Start     int64 `json:"w"`
+	Additions int   `json:"a"`
+	Deletions int   `json:"d"`
+	Commits   int   `json:"c"`
+}
+
+type Contributor struct {
+	Author struct {
+		Login string `json:"login"`
+	} `json:"author"`
+	Total int    `json:"total"`
+	Weeks []Week `json:"weeks"`
+}
+
+func (c Contributor) Additions() int {
+	total := 0
+	for _, w := range c.Weeks {
+		total += w.Additions
+	}
+	return total
+}
+
+func (c Contributor) Deletions() int {
+	total := 0
+	for _, w := range c.Weeks {
+		total += w.Deletions
+	}
+	return total
+}
+
+// WeeklyCommits returns the commit count for each week, oldest first, for use in a sparkline.
+func (c Contributor) WeeklyCommits() []int {
+	counts := make([]int, len(c.Weeks))
+	for i, w := range c.Weeks {
+		counts[i] = w.Commits
+	}
+	return counts
+}
+
+func (c Contributor) ExportData(fields []string) map[string]interface{} {
+	v := map[string]interface{}{
+		"login":     c.Author.Login,
+		"commits":   c.Total,
+		"additions": c.Additions(),
+		"deletions": c.Deletions(),
+	}
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		data[f] = v[f]
+	}
+	return data
+}
+
+// statsComputingError indicates that GitHub is still computing the repository's contributor
+// statistics and the caller should retry shortly.
+var statsComputingError = fmt.Errorf("GitHub is still computing contributor statistics for this repository; try again in a few moments")
+
+// ContributorStats fetches per-contributor commit activity, sorted by total commits descending.
+// The underlying API computes these statistics asynchronously and responds 202 while it does so,
+// so this polls for a short while before giving up.
+func ContributorStats(ctx context.Context, httpClient *http.Client, repo ghrepo.Interface) ([]Contributor, error) {
+	path := fmt.Sprintf("%srepos/%s/%s/stats/contributors", ghinstance.RESTPrefix(repo.RepoHost()), repo.RepoOwner(), repo.RepoName())
+
+	const maxAttempts = 6
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, "GET", path, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if res.StatusCode == http.StatusAccepted {
+			res.Body.Close()
+			if attempt == maxAttempts-1 {
+				return nil, statsComputingError
+			}
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(time.Second):
+			}
+			continue
+		}
+
+		if res.StatusCode != http.StatusOK {
+			defer res.Body.Close()
+			return nil, api.HandleHTTPError(res)
+		}
+
+		var contributors []Contributor
+		err = json.NewDecoder(res.Body).Decode(&contributors)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		sort.Slice(contributors, func(i, j int) bool {
+			return contributors[i].Total > contributors[j].Total
+		})
+
+		return contributors, nil
+	}
+
+	return nil, statsComputingError
+}
@@ -0,0 +1,164 @@
+package create
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/text"
+	"golang.org/x/crypto/nacl/box"
+)
+
+// bootstrapRepo applies the optional `--protect-default-branch` and
+// `--secret` bootstrap steps to a newly created repository.
+func bootstrapRepo(opts *CreateOptions, httpClient *http.Client, repo *api.Repository) error {
+	if !opts.ProtectDefaultBranch && len(opts.Secrets) == 0 {
+		return nil
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+	cs := opts.IO.ColorScheme()
+
+	if opts.ProtectDefaultBranch {
+		branch := repo.DefaultBranchRef.Name
+		if branch == "" {
+			branch = "main"
+		}
+		if err := applyBranchProtection(apiClient, repo, branch); err != nil {
+			return fmt.Errorf("failed to protect default branch: %w", err)
+		}
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "%s Protected the default branch\n", cs.SuccessIcon())
+		}
+	}
+
+	secrets, err := parseSecrets(opts.Secrets)
+	if err != nil {
+		return err
+	}
+	if err := applyBootstrapSecrets(apiClient, repo, secrets); err != nil {
+		return err
+	}
+	if len(secrets) > 0 && opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s Added %d Actions %s\n", cs.SuccessIcon(), len(secrets), text.Pluralize(len(secrets), "secret"))
+	}
+
+	return nil
+}
+
+// parseSecrets splits a list of `KEY=VALUE` strings into a name->value map,
+// erroring out on anything that doesn't look like an assignment.
+func parseSecrets(pairs []string) (map[string]string, error) {
+	secrets := map[string]string{}
+	for _, pair := range pairs {
+		name, value, ok := strings.Cut(pair, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid secret %q: expected format `KEY=VALUE`", pair)
+		}
+		secrets[name] = value
+	}
+	return secrets, nil
+}
+
+// applyBootstrapSecrets seeds one or more Actions secrets on the newly
+// created repository.
+func applyBootstrapSecrets(apiClient *api.Client, repo ghrepo.Interface, secrets map[string]string) error {
+	if len(secrets) == 0 {
+		return nil
+	}
+
+	pk, err := getRepoPublicKey(apiClient, repo)
+	if err != nil {
+		return fmt.Errorf("failed to fetch repository public key: %w", err)
+	}
+
+	for name, value := range secrets {
+		encrypted, err := encryptSecret(pk, value)
+		if err != nil {
+			return fmt.Errorf("failed to encrypt secret %q: %w", name, err)
+		}
+
+		payload := struct {
+			EncryptedValue string `json:"encrypted_value"`
+			KeyID          string `json:"key_id"`
+		}{
+			EncryptedValue: encrypted,
+			KeyID:          pk.ID,
+		}
+		payloadBytes, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+
+		path := fmt.Sprintf("repos/%s/actions/secrets/%s", ghrepo.FullName(repo), name)
+		if err := apiClient.REST(repo.RepoHost(), "PUT", path, bytes.NewReader(payloadBytes), nil); err != nil {
+			return fmt.Errorf("failed to set secret %q: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+type repoPublicKey struct {
+	ID  string `json:"key_id"`
+	Key string `json:"key"`
+}
+
+func getRepoPublicKey(apiClient *api.Client, repo ghrepo.Interface) (*repoPublicKey, error) {
+	pk := repoPublicKey{}
+	path := fmt.Sprintf("repos/%s/actions/secrets/public-key", ghrepo.FullName(repo))
+	if err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &pk); err != nil {
+		return nil, err
+	}
+	return &pk, nil
+}
+
+func encryptSecret(pk *repoPublicKey, value string) (string, error) {
+	decodedPubKey, err := base64.StdEncoding.DecodeString(pk.Key)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode public key: %w", err)
+	}
+	var peersPubKey [32]byte
+	copy(peersPubKey[:], decodedPubKey[0:32])
+
+	eBody, err := box.SealAnonymous(nil, []byte(value), &peersPubKey, rand.Reader)
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt body: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(eBody), nil
+}
+
+// applyBranchProtection applies a minimal required-reviews branch protection
+// rule to the repository's default branch.
+func applyBranchProtection(apiClient *api.Client, repo ghrepo.Interface, branch string) error {
+	payload := struct {
+		RequiredPullRequestReviews struct {
+			RequiredApprovingReviewCount int `json:"required_approving_review_count"`
+		} `json:"required_pull_request_reviews"`
+		RequiredStatusChecks  interface{} `json:"required_status_checks"`
+		EnforceAdmins         bool        `json:"enforce_admins"`
+		RequiredLinearHistory bool        `json:"required_linear_history"`
+		AllowForcePushes      bool        `json:"allow_force_pushes"`
+		AllowDeletions        bool        `json:"allow_deletions"`
+		Restrictions          interface{} `json:"restrictions"`
+	}{
+		EnforceAdmins:         true,
+		RequiredLinearHistory: true,
+	}
+	payload.RequiredPullRequestReviews.RequiredApprovingReviewCount = 1
+
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("repos/%s/branches/%s/protection", ghrepo.FullName(repo), branch)
+	return apiClient.REST(repo.RepoHost(), "PUT", path, bytes.NewReader(payloadBytes), nil)
+}
@@ -0,0 +1,32 @@
+package create
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSecrets(t *testing.T) {
+	t.Run("valid pairs", func(t *testing.T) {
+		secrets, err := parseSecrets([]string{"FOO=bar", "BAZ=qux=quux"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"FOO": "bar", "BAZ": "qux=quux"}, secrets)
+	})
+
+	t.Run("missing equals sign", func(t *testing.T) {
+		_, err := parseSecrets([]string{"FOO"})
+		assert.EqualError(t, err, `invalid secret "FOO": expected format `+"`KEY=VALUE`")
+	})
+
+	t.Run("empty key", func(t *testing.T) {
+		_, err := parseSecrets([]string{"=bar"})
+		assert.Error(t, err)
+	})
+
+	t.Run("no pairs", func(t *testing.T) {
+		secrets, err := parseSecrets(nil)
+		require.NoError(t, err)
+		assert.Empty(t, secrets)
+	})
+}
@@ -43,26 +43,28 @@ type CreateOptions struct {
 	Prompter   iprompter
 	BackOff    backoff.BackOff
 
-	Name               string
-	Description        string
-	Homepage           string
-	Team               string
-	Template           string
-	Public             bool
-	Private            bool
-	Internal           bool
-	Visibility         string
-	Push               bool
-	Clone              bool
-	Source             string
-	Remote             string
-	GitIgnoreTemplate  string
-	LicenseTemplate    string
-	DisableIssues      bool
-	DisableWiki        bool
-	Interactive        bool
-	IncludeAllBranches bool
-	AddReadme          bool
+	Name                 string
+	Description          string
+	Homepage             string
+	Team                 string
+	Template             string
+	Public               bool
+	Private              bool
+	Internal             bool
+	Visibility           string
+	Push                 bool
+	Clone                bool
+	Source               string
+	Remote               string
+	GitIgnoreTemplate    string
+	LicenseTemplate      string
+	DisableIssues        bool
+	DisableWiki          bool
+	Interactive          bool
+	IncludeAllBranches   bool
+	AddReadme            bool
+	ProtectDefaultBranch bool
+	Secrets              []string
 }
 
 func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
@@ -154,6 +156,12 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 
 			} else if opts.Clone || opts.GitIgnoreTemplate != "" || opts.LicenseTemplate != "" || opts.Template != "" {
 				return cmdutil.FlagErrorf("the `--source` option is not supported with `--clone`, `--template`, `--license`, or `--gitignore`")
+			} else if opts.ProtectDefaultBranch || len(opts.Secrets) > 0 {
+				return cmdutil.FlagErrorf("the `--source` option is not supported with `--protect-default-branch` or `--secret`")
+			}
+
+			if _, err := parseSecrets(opts.Secrets); err != nil {
+				return err
 			}
 
 			if opts.Template != "" && (opts.GitIgnoreTemplate != "" || opts.LicenseTemplate != "") {
@@ -202,6 +210,8 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 	cmd.Flags().BoolVar(&opts.DisableWiki, "disable-wiki", false, "Disable wiki in the new repository")
 	cmd.Flags().BoolVar(&opts.IncludeAllBranches, "include-all-branches", false, "Include all branches from template repository")
 	cmd.Flags().BoolVar(&opts.AddReadme, "add-readme", false, "Add a README file to the new repository")
+	cmd.Flags().BoolVar(&opts.ProtectDefaultBranch, "protect-default-branch", false, "Apply a basic branch protection rule to the default branch")
+	cmd.Flags().StringArrayVar(&opts.Secrets, "secret", nil, "Add an Actions secret in `KEY=VALUE` format (can be used multiple times)")
 
 	// deprecated flags
 	cmd.Flags().BoolP("confirm", "y", false, "Skip the confirmation prompt")
@@ -393,6 +403,10 @@ func createFromScratch(opts *CreateOptions) error {
 		fmt.Fprintln(opts.IO.Out, repo.URL)
 	}
 
+	if err := bootstrapRepo(opts, httpClient, repo); err != nil {
+		return err
+	}
+
 	if opts.Interactive {
 		var err error
 		opts.Clone, err = opts.Prompter.Confirm("Clone the new repository locally?", true)
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/pkg/cmd/repo/shared"
 	"github.com/shurcooL/githubv4"
 )
 
@@ -336,24 +337,12 @@ func listTemplateRepositories(client *http.Client, hostname, owner string) ([]ap
 
 // listGitIgnoreTemplates uses API v3 here because gitignore template isn't supported by GraphQL yet.
 func listGitIgnoreTemplates(httpClient *http.Client, hostname string) ([]string, error) {
-	var gitIgnoreTemplates []string
-	client := api.NewClientFromHTTP(httpClient)
-	err := client.REST(hostname, "GET", "gitignore/templates", nil, &gitIgnoreTemplates)
-	if err != nil {
-		return []string{}, err
-	}
-	return gitIgnoreTemplates, nil
+	return shared.ListGitIgnoreTemplates(httpClient, hostname)
 }
 
 // listLicenseTemplates uses API v3 here because license template isn't supported by GraphQL yet.
 func listLicenseTemplates(httpClient *http.Client, hostname string) ([]api.License, error) {
-	var licenseTemplates []api.License
-	client := api.NewClientFromHTTP(httpClient)
-	err := client.REST(hostname, "GET", "licenses", nil, &licenseTemplates)
-	if err != nil {
-		return nil, err
-	}
-	return licenseTemplates, nil
+	return shared.ListLicenseTemplates(httpClient, hostname)
 }
 
 // Returns the current username and any orgs that user is a member of.
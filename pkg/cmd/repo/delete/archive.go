@@ -0,0 +1,118 @@
+package delete
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+// checkRecentActivity refuses deletion of a repository that was pushed to within
+// recentActivityWindow, so that "--force" is required to delete something that's still active.
+func checkRecentActivity(apiClient *api.Client, repo ghrepo.Interface) error {
+	r, err := api.FetchRepository(apiClient, repo, []string{"pushedAt"})
+	if err != nil {
+		return err
+	}
+	if r.PushedAt != nil && time.Since(*r.PushedAt) < recentActivityWindow {
+		return fmt.Errorf("%s was pushed to on %s; use --force to delete it anyway", ghrepo.FullName(repo), r.PushedAt.Format("2006-01-02"))
+	}
+	return nil
+}
+
+// archivedIssue is a lightweight snapshot of a single issue or pull request, kept deliberately
+// small since archiveRepo exists to give a last-chance safety net before deletion, not to be a
+// full export of a repository's history.
+type archivedIssue struct {
+	Number    int       `json:"number"`
+	Title     string    `json:"title"`
+	State     string    `json:"state"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type archiveManifest struct {
+	Repository   string          `json:"repository"`
+	ArchivedAt   time.Time       `json:"archived_at"`
+	Issues       []archivedIssue `json:"issues,omitempty"`
+	PullRequests []archivedIssue `json:"pull_requests,omitempty"`
+}
+
+// archiveRepo saves a git bundle and a JSON snapshot of a repository's issues and pull requests
+// to opts.ArchiveTo before the repository is deleted. It is a best-effort safety net, not a
+// substitute for a complete backup.
+func archiveRepo(opts *DeleteOptions, apiClient *api.Client, repo ghrepo.Interface) error {
+	if err := os.MkdirAll(opts.ArchiveTo, 0755); err != nil {
+		return err
+	}
+
+	base := strings.ReplaceAll(ghrepo.FullName(repo), "/", "-")
+	ctx := context.Background()
+
+	mirrorDir := filepath.Join(opts.ArchiveTo, base+".git")
+	protocol := "https"
+	cloneURL := ghrepo.FormatRemoteURL(repo, protocol)
+	if _, err := opts.GitClient.Clone(ctx, cloneURL, []string{mirrorDir, "--mirror"}); err != nil {
+		return err
+	}
+	defer os.RemoveAll(mirrorDir)
+
+	gc := opts.GitClient.Copy()
+	gc.RepoDir = mirrorDir
+	bundlePath := filepath.Join(opts.ArchiveTo, base+".bundle")
+	cmd, err := gc.Command(ctx, "bundle", "create", bundlePath, "--all")
+	if err != nil {
+		return err
+	}
+	if err := cmd.Run(); err != nil {
+		return err
+	}
+
+	manifest, err := fetchArchiveManifest(apiClient, repo)
+	if err != nil {
+		return err
+	}
+	manifestBytes, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(opts.ArchiveTo, base+".json"), manifestBytes, 0644)
+}
+
+// fetchArchiveManifest takes a single-page, best-effort snapshot of a repository's issues and
+// pull requests. Repositories with more than 100 issues and pull requests combined will only
+// have the most recently updated ones captured.
+func fetchArchiveManifest(apiClient *api.Client, repo ghrepo.Interface) (*archiveManifest, error) {
+	var rawIssues []struct {
+		Number      int       `json:"number"`
+		Title       string    `json:"title"`
+		State       string    `json:"state"`
+		UpdatedAt   time.Time `json:"updated_at"`
+		PullRequest *struct{} `json:"pull_request,omitempty"`
+	}
+
+	path := fmt.Sprintf("repos/%s/issues?state=all&per_page=100&sort=updated&direction=desc", ghrepo.FullName(repo))
+	if err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &rawIssues); err != nil {
+		return nil, err
+	}
+
+	manifest := &archiveManifest{
+		Repository: ghrepo.FullName(repo),
+		ArchivedAt: time.Now(),
+	}
+	for _, ri := range rawIssues {
+		item := archivedIssue{Number: ri.Number, Title: ri.Title, State: ri.State, UpdatedAt: ri.UpdatedAt}
+		if ri.PullRequest != nil {
+			manifest.PullRequests = append(manifest.PullRequests, item)
+		} else {
+			manifest.Issues = append(manifest.Issues, item)
+		}
+	}
+
+	return manifest, nil
+}
@@ -0,0 +1,111 @@
+package delete
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/run"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_archiveRepo(t *testing.T) {
+	dir := t.TempDir()
+	opts := &DeleteOptions{
+		ArchiveTo: dir,
+		GitClient: &git.Client{
+			GhPath:  "some/path/gh",
+			GitPath: "some/path/git",
+		},
+	}
+	repo := ghrepo.New("OWNER", "REPO")
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/issues"),
+		httpmock.JSONResponse([]map[string]interface{}{
+			{"number": 2, "title": "a bug", "state": "open", "updated_at": "2023-02-01T00:00:00Z"},
+			{"number": 1, "title": "a pull request", "state": "closed", "updated_at": "2023-01-01T00:00:00Z", "pull_request": map[string]interface{}{}},
+		}))
+	apiClient := api.NewClientFromHTTP(&http.Client{Transport: reg})
+
+	cs, restoreRun := run.Stub()
+	defer restoreRun(t)
+	cs.Register(`git clone --mirror https://github\.com/OWNER/REPO\.git .+OWNER-REPO\.git`, 0, "")
+	cs.Register(`git -C .+OWNER-REPO\.git bundle create .+OWNER-REPO\.bundle --all`, 0, "")
+
+	err := archiveRepo(opts, apiClient, repo)
+	require.NoError(t, err)
+
+	manifestBytes, err := os.ReadFile(filepath.Join(dir, "OWNER-REPO.json"))
+	require.NoError(t, err)
+	assert.Contains(t, string(manifestBytes), `"number": 2`)
+	assert.Contains(t, string(manifestBytes), `"number": 1`)
+
+	// the mirror clone is a scratch directory and should not survive archival
+	_, err = os.Stat(filepath.Join(dir, "OWNER-REPO.git"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func Test_archiveRepo_bundleCreateFailure(t *testing.T) {
+	dir := t.TempDir()
+	opts := &DeleteOptions{
+		ArchiveTo: dir,
+		GitClient: &git.Client{
+			GhPath:  "some/path/gh",
+			GitPath: "some/path/git",
+		},
+	}
+	repo := ghrepo.New("OWNER", "REPO")
+
+	reg := &httpmock.Registry{}
+	apiClient := api.NewClientFromHTTP(&http.Client{Transport: reg})
+
+	cs, restoreRun := run.Stub()
+	defer restoreRun(t)
+	cs.Register(`git clone --mirror https://github\.com/OWNER/REPO\.git .+OWNER-REPO\.git`, 0, "")
+	cs.Register(`git -C .+OWNER-REPO\.git bundle create .+OWNER-REPO\.bundle --all`, 1, "")
+
+	err := archiveRepo(opts, apiClient, repo)
+	assert.Error(t, err)
+
+	_, err = os.Stat(filepath.Join(dir, "OWNER-REPO.json"))
+	assert.True(t, os.IsNotExist(err), "manifest should not be written when the bundle fails")
+}
+
+func Test_fetchArchiveManifest_truncatesToOnePage(t *testing.T) {
+	repo := ghrepo.New("OWNER", "REPO")
+
+	var rawIssues []map[string]interface{}
+	for i := 1; i <= 100; i++ {
+		rawIssues = append(rawIssues, map[string]interface{}{
+			"number":     i,
+			"title":      "issue",
+			"state":      "open",
+			"updated_at": "2023-01-01T00:00:00Z",
+		})
+	}
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/issues"),
+		httpmock.JSONResponse(rawIssues))
+	apiClient := api.NewClientFromHTTP(&http.Client{Transport: reg})
+
+	manifest, err := fetchArchiveManifest(apiClient, repo)
+	require.NoError(t, err)
+
+	// fetchArchiveManifest only ever requests a single page, so a repository
+	// with more than 100 issues and pull requests combined only has the 100
+	// most recently updated captured; reg.Verify above confirms no second
+	// request for a later page was attempted.
+	assert.Len(t, manifest.Issues, 100)
+}
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -14,6 +16,10 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// recentActivityWindow is how recently a repository must have been pushed to for gh to refuse
+// to delete it without --force, as a guard against deleting a repository that's still in use.
+const recentActivityWindow = 30 * 24 * time.Hour
+
 type iprompter interface {
 	ConfirmDeletion(string) error
 }
@@ -21,10 +27,13 @@ type iprompter interface {
 type DeleteOptions struct {
 	HttpClient func() (*http.Client, error)
 	BaseRepo   func() (ghrepo.Interface, error)
+	GitClient  *git.Client
 	Prompter   iprompter
 	IO         *iostreams.IOStreams
 	RepoArg    string
 	Confirmed  bool
+	Force      bool
+	ArchiveTo  string
 }
 
 func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Command {
@@ -32,6 +41,7 @@ func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Co
 		IO:         f.IOStreams,
 		HttpClient: f.HttpClient,
 		BaseRepo:   f.BaseRepo,
+		GitClient:  f.GitClient,
 		Prompter:   f.Prompter,
 	}
 
@@ -42,8 +52,14 @@ func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Co
 
 With no argument, deletes the current repository. Otherwise, deletes the specified repository.
 
-Deletion requires authorization with the "delete_repo" scope. 
-To authorize, run "gh auth refresh -s delete_repo"`,
+Deletion requires authorization with the "delete_repo" scope.
+To authorize, run "gh auth refresh -s delete_repo"
+
+If the repository was pushed to within the last 30 days, deletion is refused unless "--force"
+is given, to guard against accidentally deleting a repository that's still in use.
+
+The "--archive-to" flag saves a git bundle of the repository along with a snapshot of its
+issue and pull request metadata to the given directory before the repository is deleted.`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) > 0 {
@@ -65,6 +81,8 @@ To authorize, run "gh auth refresh -s delete_repo"`,
 	cmd.Flags().BoolVar(&opts.Confirmed, "confirm", false, "confirm deletion without prompting")
 	_ = cmd.Flags().MarkDeprecated("confirm", "use `--yes` instead")
 	cmd.Flags().BoolVar(&opts.Confirmed, "yes", false, "confirm deletion without prompting")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "delete the repository even if it was pushed to recently")
+	cmd.Flags().StringVar(&opts.ArchiveTo, "archive-to", "", "save a git bundle and issue/pull request metadata snapshot to `<directory>` before deleting")
 	return cmd
 }
 
@@ -99,12 +117,24 @@ func deleteRun(opts *DeleteOptions) error {
 	}
 	fullName := ghrepo.FullName(toDelete)
 
+	if !opts.Force {
+		if err := checkRecentActivity(apiClient, toDelete); err != nil {
+			return err
+		}
+	}
+
 	if !opts.Confirmed {
 		if err := opts.Prompter.ConfirmDeletion(fullName); err != nil {
 			return err
 		}
 	}
 
+	if opts.ArchiveTo != "" {
+		if err := archiveRepo(opts, apiClient, toDelete); err != nil {
+			return fmt.Errorf("could not archive repository before deletion: %w", err)
+		}
+	}
+
 	err = deleteRepo(httpClient, toDelete)
 	if err != nil {
 		var httpErr api.HTTPError
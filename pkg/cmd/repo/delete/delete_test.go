@@ -104,6 +104,9 @@ func Test_deleteRun(t *testing.T) {
 				}
 			},
 			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(`{"data":{"repository":{"pushedAt":"2020-01-01T00:00:00Z"}}}`))
 				reg.Register(
 					httpmock.REST("DELETE", "repos/OWNER/REPO"),
 					httpmock.StatusStringResponse(204, "{}"))
@@ -120,6 +123,9 @@ func Test_deleteRun(t *testing.T) {
 				}
 			},
 			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(`{"data":{"repository":{"pushedAt":"2020-01-01T00:00:00Z"}}}`))
 				reg.Register(
 					httpmock.REST("DELETE", "repos/OWNER/REPO"),
 					httpmock.StatusStringResponse(204, "{}"))
@@ -132,6 +138,9 @@ func Test_deleteRun(t *testing.T) {
 				Confirmed: true,
 			},
 			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(`{"data":{"repository":{"pushedAt":"2020-01-01T00:00:00Z"}}}`))
 				reg.Register(
 					httpmock.REST("DELETE", "repos/OWNER/REPO"),
 					httpmock.StatusStringResponse(204, "{}"))
@@ -151,6 +160,9 @@ func Test_deleteRun(t *testing.T) {
 				reg.Register(
 					httpmock.GraphQL(`query UserCurrent\b`),
 					httpmock.StringResponse(`{"data":{"viewer":{"login":"OWNER"}}}`))
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(`{"data":{"repository":{"pushedAt":"2020-01-01T00:00:00Z"}}}`))
 				reg.Register(
 					httpmock.REST("DELETE", "repos/OWNER/REPO"),
 					httpmock.StatusStringResponse(204, "{}"))
@@ -163,11 +175,38 @@ func Test_deleteRun(t *testing.T) {
 			errMsg:     "SilentError",
 			wantStderr: "X Failed to delete repository: OWNER/REPO has changed name or transferred ownership\n",
 			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(`{"data":{"repository":{"pushedAt":"2020-01-01T00:00:00Z"}}}`))
 				reg.Register(
 					httpmock.REST("DELETE", "repos/OWNER/REPO"),
 					httpmock.StatusStringResponse(307, "{}"))
 			},
 		},
+		{
+			name:    "recent activity without force",
+			opts:    &DeleteOptions{RepoArg: "OWNER/REPO", Confirmed: true},
+			wantErr: true,
+			errMsg:  "OWNER/REPO was pushed to on 2038-01-01; use --force to delete it anyway",
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(`{"data":{"repository":{"pushedAt":"2038-01-01T00:00:00Z"}}}`))
+			},
+		},
+		{
+			name: "recent activity with force",
+			opts: &DeleteOptions{
+				RepoArg:   "OWNER/REPO",
+				Confirmed: true,
+				Force:     true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("DELETE", "repos/OWNER/REPO"),
+					httpmock.StatusStringResponse(204, "{}"))
+			},
+		},
 	}
 	for _, tt := range tests {
 		pm := &prompter.PrompterMock{}
@@ -1,6 +1,7 @@
 package add
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -10,6 +11,7 @@ import (
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/ssh"
 	"github.com/spf13/cobra"
 )
 
@@ -17,10 +19,12 @@ type AddOptions struct {
 	IO         *iostreams.IOStreams
 	HTTPClient func() (*http.Client, error)
 	BaseRepo   func() (ghrepo.Interface, error)
+	SSHContext ssh.Context
 
-	KeyFile    string
-	Title      string
-	AllowWrite bool
+	KeyFile     string
+	Title       string
+	AllowWrite  bool
+	GenerateKey bool
 }
 
 func NewCmdAdd(f *cmdutil.Factory, runF func(*AddOptions) error) *cobra.Command {
@@ -30,24 +34,46 @@ func NewCmdAdd(f *cmdutil.Factory, runF func(*AddOptions) error) *cobra.Command
 	}
 
 	cmd := &cobra.Command{
-		Use:   "add <key-file>",
+		Use:   "add [<key-file>]",
 		Short: "Add a deploy key to a GitHub repository",
-		Long: heredoc.Doc(`
+		Long: heredoc.Docf(`
 			Add a deploy key to a GitHub repository.
-			
+
 			Note that any key added by gh will be associated with the current authentication token.
 			If you de-authorize the GitHub CLI app or authentication token from your account, any
 			deploy keys added by GitHub CLI will be removed as well.
-		`),
+
+			Pass the %[1]s--generate-key%[1]s flag to have gh generate a new passwordless ed25519
+			key pair instead of supplying an existing public key file.
+		`, "`"),
 		Example: heredoc.Doc(`
 			# generate a passwordless SSH key and add it as a deploy key to a repository
 			$ ssh-keygen -t ed25519 -C "my description" -N "" -f ~/.ssh/gh-test
 			$ gh repo deploy-key add ~/.ssh/gh-test.pub
+
+			# have gh generate the key pair instead
+			$ gh repo deploy-key add --generate-key --title "deploy key"
 		`),
-		Args: cobra.ExactArgs(1),
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.BaseRepo = f.BaseRepo
-			opts.KeyFile = args[0]
+
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `<key-file>` or `--generate-key`",
+				len(args) > 0,
+				opts.GenerateKey,
+			); err != nil {
+				return err
+			}
+
+			if len(args) > 0 {
+				opts.KeyFile = args[0]
+			} else if !opts.GenerateKey {
+				if opts.IO.IsStdoutTTY() && opts.IO.IsStdinTTY() {
+					return cmdutil.FlagErrorf("public key file or `--generate-key` required")
+				}
+				opts.KeyFile = "-"
+			}
 
 			if runF != nil {
 				return runF(opts)
@@ -58,6 +84,7 @@ func NewCmdAdd(f *cmdutil.Factory, runF func(*AddOptions) error) *cobra.Command
 
 	cmd.Flags().StringVarP(&opts.Title, "title", "t", "", "Title of the new key")
 	cmd.Flags().BoolVarP(&opts.AllowWrite, "allow-write", "w", false, "Allow write access for the key")
+	cmd.Flags().BoolVar(&opts.GenerateKey, "generate-key", false, "Generate a new ed25519 key pair to use as the deploy key")
 	return cmd
 }
 
@@ -67,8 +94,33 @@ func addRun(opts *AddOptions) error {
 		return err
 	}
 
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+
 	var keyReader io.Reader
-	if opts.KeyFile == "-" {
+	if opts.GenerateKey {
+		if !opts.SSHContext.HasKeygen() {
+			return errors.New("ssh-keygen is required to generate a new deploy key but was not found on your system")
+		}
+
+		keyPair, err := opts.SSHContext.GenerateSSHKey(fmt.Sprintf("%s_deploy_key", repo.RepoName()), "")
+		if err != nil {
+			return fmt.Errorf("failed to generate a new deploy key: %w", err)
+		}
+
+		f, err := os.Open(keyPair.PublicKeyPath)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		keyReader = f
+
+		fmt.Fprintf(opts.IO.ErrOut, "%s Generated a new private key at %s; copy it to wherever this repository is deployed\n", cs.SuccessIcon(), keyPair.PrivateKeyPath)
+	} else if opts.KeyFile == "-" {
 		keyReader = opts.IO.In
 		defer opts.IO.In.Close()
 	} else {
@@ -80,11 +132,6 @@ func addRun(opts *AddOptions) error {
 		keyReader = f
 	}
 
-	repo, err := opts.BaseRepo()
-	if err != nil {
-		return err
-	}
-
 	if err := uploadDeployKey(httpClient, repo, keyReader, opts.Title, opts.AllowWrite); err != nil {
 		return err
 	}
@@ -93,7 +140,6 @@ func addRun(opts *AddOptions) error {
 		return nil
 	}
 
-	cs := opts.IO.ColorScheme()
 	_, err = fmt.Fprintf(opts.IO.Out, "%s Deploy key added to %s\n", cs.SuccessIcon(), cs.Bold(ghrepo.FullName(repo)))
 	return err
 }
@@ -2,11 +2,15 @@ package add
 
 import (
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/run"
 	"github.com/cli/cli/v2/pkg/httpmock"
 	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/ssh"
 )
 
 func Test_addRun(t *testing.T) {
@@ -48,6 +52,32 @@ func Test_addRun(t *testing.T) {
 			wantStderr: "",
 			wantErr:    false,
 		},
+		{
+			name:  "generate a new key",
+			isTTY: true,
+			opts: AddOptions{
+				GenerateKey: true,
+				Title:       "my generated key",
+				AllowWrite:  true,
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/keys"),
+					httpmock.RESTPayload(200, `{}`, func(payload map[string]interface{}) {
+						if title := payload["title"].(string); title != "my generated key" {
+							t.Errorf("POST title %q, want %q", title, "my generated key")
+						}
+						if key := payload["key"].(string); key != "ssh-ed25519 AAAA" {
+							t.Errorf("POST key %q, want %q", key, "ssh-ed25519 AAAA")
+						}
+						if isReadOnly := payload["read_only"].(bool); isReadOnly {
+							t.Errorf("POST read_only %v, want %v", isReadOnly, false)
+						}
+					}))
+			},
+			wantStdout: "✓ Deploy key added to OWNER/REPO\n",
+			wantErr:    false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -68,6 +98,18 @@ func Test_addRun(t *testing.T) {
 			opts.BaseRepo = func() (ghrepo.Interface, error) { return ghrepo.New("OWNER", "REPO"), nil }
 			opts.HTTPClient = func() (*http.Client, error) { return &http.Client{Transport: reg}, nil }
 
+			var keyFile string
+			if opts.GenerateKey {
+				dir := t.TempDir()
+				keyFile = filepath.Join(dir, "REPO_deploy_key")
+				opts.SSHContext = ssh.Context{ConfigDir: dir, KeygenExe: "ssh-keygen"}
+				cs, cmdTeardown := run.Stub()
+				defer cmdTeardown(t)
+				cs.Register(`ssh-keygen`, 0, "", func(args []string) {
+					_ = os.WriteFile(keyFile+".pub", []byte("ssh-ed25519 AAAA"), 0600)
+				})
+			}
+
 			err := addRun(&opts)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("addRun() return error: %v", err)
@@ -77,8 +119,13 @@ func Test_addRun(t *testing.T) {
 			if stdout.String() != tt.wantStdout {
 				t.Errorf("wants stdout %q, got %q", tt.wantStdout, stdout.String())
 			}
-			if stderr.String() != tt.wantStderr {
-				t.Errorf("wants stderr %q, got %q", tt.wantStderr, stderr.String())
+
+			wantStderr := tt.wantStderr
+			if opts.GenerateKey {
+				wantStderr = "✓ Generated a new private key at " + keyFile + "; copy it to wherever this repository is deployed\n" + wantStderr
+			}
+			if stderr.String() != wantStderr {
+				t.Errorf("wants stderr %q, got %q", wantStderr, stderr.String())
 			}
 		})
 	}
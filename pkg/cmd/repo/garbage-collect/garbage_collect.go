@@ -0,0 +1,286 @@
+package garbagecollect
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	ghConfig "github.com/cli/go-gh/v2/pkg/config"
+	"github.com/spf13/cobra"
+)
+
+// item is a single file or directory on disk that gc has identified as safe to remove.
+type item struct {
+	category string
+	path     string
+	size     int64
+}
+
+type GarbageCollectOptions struct {
+	IO *iostreams.IOStreams
+
+	CacheDir      string
+	ExtensionsDir string
+	SSHDir        string
+
+	MaxCacheAge time.Duration
+	DryRun      bool
+}
+
+func NewCmdGarbageCollect(f *cmdutil.Factory, runF func(*GarbageCollectOptions) error) *cobra.Command {
+	opts := &GarbageCollectOptions{
+		IO: f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "garbage-collect",
+		Short: "Clean up gh's local on-disk state",
+		Long: heredoc.Doc(`
+			Clean up files that gh accumulates on disk over time: cached run logs
+			older than the retention period, extension directories left behind by
+			an interrupted install, and deprecated codespace SSH keys that have
+			already been replaced.
+
+			This command does not touch your configuration, credentials, or any
+			files outside of gh's own cache, data, and ssh directories.
+		`),
+		Example: heredoc.Doc(`
+			# See what would be removed without removing anything
+			$ gh repo garbage-collect --dry-run
+
+			# Remove stale local state
+			$ gh repo garbage-collect
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.CacheDir == "" {
+				opts.CacheDir = ghConfig.CacheDir()
+			}
+			if opts.ExtensionsDir == "" {
+				opts.ExtensionsDir = filepath.Join(config.DataDir(), "extensions")
+			}
+			if opts.SSHDir == "" {
+				sshDir, err := config.HomeDirPath(".ssh")
+				if err != nil {
+					return err
+				}
+				opts.SSHDir = sshDir
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return garbageCollectRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "List what would be removed without removing it")
+	cmd.Flags().DurationVar(&opts.MaxCacheAge, "max-cache-age", 30*24*time.Hour, "Maximum age of cached files before they are considered stale")
+
+	return cmd
+}
+
+func garbageCollectRun(opts *GarbageCollectOptions) error {
+	items, err := collect(opts)
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+
+	if len(items) == 0 {
+		fmt.Fprintf(opts.IO.Out, "%s Nothing to clean up\n", cs.SuccessIcon())
+		return nil
+	}
+
+	var total int64
+	for _, it := range items {
+		total += it.size
+		verb := "Removed"
+		if opts.DryRun {
+			verb = "Would remove"
+		}
+		fmt.Fprintf(opts.IO.Out, "%s %s (%s): %s\n", verb, it.category, formatSize(it.size), it.path)
+	}
+
+	if opts.DryRun {
+		fmt.Fprintf(opts.IO.Out, "\nWould free up %s across %s\n", formatSize(total), text.Pluralize(len(items), "item"))
+		return nil
+	}
+
+	for _, it := range items {
+		if err := os.RemoveAll(it.path); err != nil {
+			return fmt.Errorf("failed to remove %s: %w", it.path, err)
+		}
+	}
+
+	fmt.Fprintf(opts.IO.Out, "\n%s Freed up %s across %s\n", cs.SuccessIcon(), formatSize(total), text.Pluralize(len(items), "item"))
+	return nil
+}
+
+func collect(opts *GarbageCollectOptions) ([]item, error) {
+	var items []item
+
+	staleCache, err := staleCacheFiles(opts.CacheDir, opts.MaxCacheAge)
+	if err != nil {
+		return nil, err
+	}
+	items = append(items, staleCache...)
+
+	orphanedExtensions, err := orphanedExtensionDirs(opts.ExtensionsDir)
+	if err != nil {
+		return nil, err
+	}
+	items = append(items, orphanedExtensions...)
+
+	deprecatedKeys, err := deprecatedCodespaceSSHKeys(opts.SSHDir)
+	if err != nil {
+		return nil, err
+	}
+	items = append(items, deprecatedKeys...)
+
+	return items, nil
+}
+
+// staleCacheFiles finds files directly under cacheDir, such as cached `gh run view --log` zips,
+// that haven't been modified within maxAge.
+func staleCacheFiles(cacheDir string, maxAge time.Duration) ([]item, error) {
+	entries, err := os.ReadDir(cacheDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cutoff := time.Now().Add(-maxAge)
+	var items []item
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		if info.IsDir() || info.ModTime().After(cutoff) {
+			continue
+		}
+		items = append(items, item{
+			category: "stale cache file",
+			path:     filepath.Join(cacheDir, entry.Name()),
+			size:     info.Size(),
+		})
+	}
+	return items, nil
+}
+
+// orphanedExtensionDirs finds directories under extensionsDir that don't contain the executable
+// a successful install would have left behind, which happens when an install is interrupted
+// partway through.
+func orphanedExtensionDirs(extensionsDir string) ([]item, error) {
+	entries, err := os.ReadDir(extensionsDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var items []item
+	for _, entry := range entries {
+		if !entry.IsDir() || !strings.HasPrefix(entry.Name(), "gh-") {
+			continue
+		}
+		dir := filepath.Join(extensionsDir, entry.Name())
+		if hasExtensionEntrypoint(dir, entry.Name()) {
+			continue
+		}
+		size, err := dirSize(dir)
+		if err != nil {
+			continue
+		}
+		items = append(items, item{
+			category: "orphaned extension directory",
+			path:     dir,
+			size:     size,
+		})
+	}
+	return items, nil
+}
+
+func hasExtensionEntrypoint(dir, name string) bool {
+	candidates := []string{name, name + ".exe"}
+	for _, candidate := range candidates {
+		if _, err := os.Stat(filepath.Join(dir, candidate)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// deprecatedCodespaceSSHKeys finds the "codespaces" keypair left behind after gh migrated to the
+// "codespaces.auto" name, which only happens if the new keypair was generated independently
+// instead of by renaming the old one.
+func deprecatedCodespaceSSHKeys(sshDir string) ([]item, error) {
+	const oldName = "codespaces"
+	const newName = "codespaces.auto"
+
+	if _, err := os.Stat(filepath.Join(sshDir, newName)); os.IsNotExist(err) {
+		// The new keypair doesn't exist, so the old one (if any) hasn't actually been
+		// superseded yet and is still in use.
+		return nil, nil
+	}
+
+	var items []item
+	for _, name := range []string{oldName, oldName + ".pub"} {
+		path := filepath.Join(sshDir, name)
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		items = append(items, item{
+			category: "deprecated codespace SSH key",
+			path:     path,
+			size:     info.Size(),
+		})
+	}
+	return items, nil
+}
+
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, err
+}
+
+func formatSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%dB", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,104 @@
+package garbagecollect
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGarbageCollectRun(t *testing.T) {
+	cacheDir := t.TempDir()
+	extensionsDir := t.TempDir()
+	sshDir := t.TempDir()
+
+	staleFile := filepath.Join(cacheDir, "run-log-123.zip")
+	require.NoError(t, os.WriteFile(staleFile, []byte("stale"), 0600))
+	oldTime := time.Now().Add(-60 * 24 * time.Hour)
+	require.NoError(t, os.Chtimes(staleFile, oldTime, oldTime))
+
+	freshFile := filepath.Join(cacheDir, "run-log-456.zip")
+	require.NoError(t, os.WriteFile(freshFile, []byte("fresh"), 0600))
+
+	orphanedExtDir := filepath.Join(extensionsDir, "gh-orphaned")
+	require.NoError(t, os.Mkdir(orphanedExtDir, 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(orphanedExtDir, "README.md"), []byte("partial clone"), 0600))
+
+	validExtDir := filepath.Join(extensionsDir, "gh-valid")
+	require.NoError(t, os.Mkdir(validExtDir, 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(validExtDir, "gh-valid"), []byte("#!/bin/sh"), 0700))
+
+	require.NoError(t, os.WriteFile(filepath.Join(sshDir, "codespaces.auto"), []byte("new"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(sshDir, "codespaces"), []byte("old"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(sshDir, "codespaces.pub"), []byte("old pub"), 0600))
+
+	ios, _, stdout, _ := iostreams.Test()
+	opts := &GarbageCollectOptions{
+		IO:            ios,
+		CacheDir:      cacheDir,
+		ExtensionsDir: extensionsDir,
+		SSHDir:        sshDir,
+		MaxCacheAge:   30 * 24 * time.Hour,
+	}
+
+	require.NoError(t, garbageCollectRun(opts))
+
+	assert.NoFileExists(t, staleFile)
+	assert.FileExists(t, freshFile)
+	assert.NoDirExists(t, orphanedExtDir)
+	assert.DirExists(t, validExtDir)
+	assert.NoFileExists(t, filepath.Join(sshDir, "codespaces"))
+	assert.NoFileExists(t, filepath.Join(sshDir, "codespaces.pub"))
+	assert.FileExists(t, filepath.Join(sshDir, "codespaces.auto"))
+
+	out := stdout.String()
+	assert.Contains(t, out, "run-log-123.zip")
+	assert.Contains(t, out, "gh-orphaned")
+	assert.Contains(t, out, "codespaces")
+	assert.Contains(t, out, "Freed up")
+}
+
+func TestGarbageCollectRun_dryRun(t *testing.T) {
+	cacheDir := t.TempDir()
+	extensionsDir := t.TempDir()
+	sshDir := t.TempDir()
+
+	staleFile := filepath.Join(cacheDir, "run-log-123.zip")
+	require.NoError(t, os.WriteFile(staleFile, []byte("stale"), 0600))
+	oldTime := time.Now().Add(-60 * 24 * time.Hour)
+	require.NoError(t, os.Chtimes(staleFile, oldTime, oldTime))
+
+	ios, _, stdout, _ := iostreams.Test()
+	opts := &GarbageCollectOptions{
+		IO:            ios,
+		CacheDir:      cacheDir,
+		ExtensionsDir: extensionsDir,
+		SSHDir:        sshDir,
+		MaxCacheAge:   30 * 24 * time.Hour,
+		DryRun:        true,
+	}
+
+	require.NoError(t, garbageCollectRun(opts))
+
+	assert.FileExists(t, staleFile)
+	assert.Contains(t, stdout.String(), "Would remove")
+	assert.Contains(t, stdout.String(), "Would free up")
+}
+
+func TestGarbageCollectRun_nothingToClean(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+	opts := &GarbageCollectOptions{
+		IO:            ios,
+		CacheDir:      t.TempDir(),
+		ExtensionsDir: t.TempDir(),
+		SSHDir:        t.TempDir(),
+		MaxCacheAge:   30 * 24 * time.Hour,
+	}
+
+	require.NoError(t, garbageCollectRun(opts))
+	assert.Contains(t, stdout.String(), "Nothing to clean up")
+}
@@ -0,0 +1,65 @@
+package list
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_listRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		httpStubs  func(*httpmock.Registry)
+		wantStdout string
+		wantErr    bool
+	}{
+		{
+			name: "list templates",
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "gitignore/templates"),
+					httpmock.StringResponse(`["Go", "Node", "Python"]`),
+				)
+			},
+			wantStdout: "Go\nNode\nPython\n",
+		},
+		{
+			name: "no templates",
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "gitignore/templates"),
+					httpmock.StringResponse(`[]`),
+				)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			tt.httpStubs(reg)
+
+			ios, _, stdout, _ := iostreams.Test()
+
+			opts := &ListOptions{
+				IO:         ios,
+				Config:     func() (gh.Config, error) { return config.NewBlankConfig(), nil },
+				HTTPClient: func() (*http.Client, error) { return &http.Client{Transport: reg}, nil },
+			}
+
+			err := listRun(opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package view
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_viewRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "gitignore/templates/Go"),
+		httpmock.StringResponse(`{"name": "Go", "source": "*.exe\n*.o\n"}`),
+	)
+
+	ios, _, stdout, _ := iostreams.Test()
+
+	opts := &ViewOptions{
+		IO:         ios,
+		Name:       "Go",
+		Config:     func() (gh.Config, error) { return config.NewBlankConfig(), nil },
+		HTTPClient: func() (*http.Client, error) { return &http.Client{Transport: reg}, nil },
+	}
+
+	err := viewRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "*.exe\n*.o\n", stdout.String())
+}
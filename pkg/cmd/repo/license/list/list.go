@@ -0,0 +1,82 @@
+package list
+
+import (
+	"net/http"
+
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmd/repo/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
+	HTTPClient func() (*http.Client, error)
+	Exporter   cmdutil.Exporter
+}
+
+var licenseFields = []string{
+	"key",
+	"name",
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HTTPClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List available repository licenses",
+		Aliases: []string{"ls"},
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, licenseFields)
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, _ := cfg.Authentication().DefaultHost()
+
+	licenses, err := shared.ListLicenseTemplates(httpClient, host)
+	if err != nil {
+		return err
+	}
+
+	if len(licenses) == 0 {
+		return cmdutil.NewNoResultsError("no licenses found")
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, licenses)
+	}
+
+	t := tableprinter.New(opts.IO, tableprinter.WithHeader("KEY", "NAME"))
+	for _, license := range licenses {
+		t.AddField(license.Key)
+		t.AddField(license.Name)
+		t.EndRow()
+	}
+
+	return t.Render()
+}
@@ -0,0 +1,88 @@
+package list
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_listRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		isTTY      bool
+		httpStubs  func(*httpmock.Registry)
+		wantStdout string
+		wantErr    bool
+	}{
+		{
+			name:  "list tty",
+			isTTY: true,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "licenses"),
+					httpmock.StringResponse(`[
+						{"key": "mit", "name": "MIT License"},
+						{"key": "apache-2.0", "name": "Apache License 2.0"}
+					]`),
+				)
+			},
+			wantStdout: heredoc.Doc(`
+				KEY         NAME
+				mit         MIT License
+				apache-2.0  Apache License 2.0
+			`),
+		},
+		{
+			name: "list non-tty",
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "licenses"),
+					httpmock.StringResponse(`[
+						{"key": "mit", "name": "MIT License"}
+					]`),
+				)
+			},
+			wantStdout: "mit\tMIT License\n",
+		},
+		{
+			name: "no licenses",
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "licenses"),
+					httpmock.StringResponse(`[]`),
+				)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			tt.httpStubs(reg)
+
+			ios, _, stdout, _ := iostreams.Test()
+			ios.SetStdoutTTY(tt.isTTY)
+
+			opts := &ListOptions{
+				IO:         ios,
+				Config:     func() (gh.Config, error) { return config.NewBlankConfig(), nil },
+				HTTPClient: func() (*http.Client, error) { return &http.Client{Transport: reg}, nil },
+			}
+
+			err := listRun(opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
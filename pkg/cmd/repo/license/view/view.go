@@ -0,0 +1,85 @@
+package view
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/cmd/repo/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ViewOptions struct {
+	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
+	HTTPClient func() (*http.Client, error)
+
+	Key  string
+	Save string
+}
+
+func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
+	opts := &ViewOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HTTPClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view <license-key>",
+		Short: "View an available repository license",
+		Long: heredoc.Docf(`
+			View the contents of a repository license template, identified by its key.
+
+			Run %[1]sgh repo license list%[1]s to see the list of available license keys.
+		`, "`"),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Key = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return viewRun(opts)
+		},
+	}
+	cmd.Flags().StringVar(&opts.Save, "save", "", "Save the license text to `file`")
+
+	return cmd
+}
+
+func viewRun(opts *ViewOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, _ := cfg.Authentication().DefaultHost()
+
+	license, err := shared.LicenseTemplate(httpClient, host, opts.Key)
+	if err != nil {
+		return err
+	}
+
+	if opts.Save != "" {
+		if err := os.WriteFile(opts.Save, []byte(license.Body), 0644); err != nil {
+			return fmt.Errorf("failed to save license to %s: %w", opts.Save, err)
+		}
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "%s Saved %s to %s\n", opts.IO.ColorScheme().SuccessIcon(), license.Name, opts.Save)
+		}
+		return nil
+	}
+
+	fmt.Fprint(opts.IO.Out, license.Body)
+
+	return nil
+}
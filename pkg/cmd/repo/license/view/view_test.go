@@ -0,0 +1,67 @@
+package view
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_viewRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "licenses/mit"),
+		httpmock.StringResponse(`{"key": "mit", "name": "MIT License", "body": "The MIT License\n"}`),
+	)
+
+	ios, _, stdout, _ := iostreams.Test()
+
+	opts := &ViewOptions{
+		IO:         ios,
+		Key:        "mit",
+		Config:     func() (gh.Config, error) { return config.NewBlankConfig(), nil },
+		HTTPClient: func() (*http.Client, error) { return &http.Client{Transport: reg}, nil },
+	}
+
+	err := viewRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "The MIT License\n", stdout.String())
+}
+
+func Test_viewRun_save(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "licenses/mit"),
+		httpmock.StringResponse(`{"key": "mit", "name": "MIT License", "body": "The MIT License\n"}`),
+	)
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	dest := filepath.Join(t.TempDir(), "LICENSE")
+
+	opts := &ViewOptions{
+		IO:         ios,
+		Key:        "mit",
+		Save:       dest,
+		Config:     func() (gh.Config, error) { return config.NewBlankConfig(), nil },
+		HTTPClient: func() (*http.Client, error) { return &http.Client{Transport: reg}, nil },
+	}
+
+	err := viewRun(opts)
+	require.NoError(t, err)
+	assert.Equal(t, "✓ Saved MIT License to "+dest+"\n", stdout.String())
+
+	contents, err := os.ReadFile(dest)
+	require.NoError(t, err)
+	assert.Equal(t, "The MIT License\n", string(contents))
+}
@@ -0,0 +1,206 @@
+package mirror
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type MirrorOptions struct {
+	HttpClient func() (*http.Client, error)
+	GitClient  *git.Client
+	Config     func() (gh.Config, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Repository string
+	To         string
+	LFS        bool
+	Schedule   string
+}
+
+func NewCmdMirror(f *cmdutil.Factory, runF func(*MirrorOptions) error) *cobra.Command {
+	opts := &MirrorOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		GitClient:  f.GitClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "mirror [<repository>] --to <url>",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Push mirror a repository to another remote",
+		Long: heredoc.Docf(`
+			Set up push mirroring of a GitHub repository to another remote, which may be another
+			GitHub host or an external Git server. This is useful for migrations and disaster
+			recovery, where a full copy of the repository (every branch and tag, and optionally
+			Git LFS objects) needs to be kept in sync somewhere outside GitHub.
+
+			Without an argument, the local repository is mirrored. A full %[1]sOWNER/REPO%[1]s or
+			URL can be passed to mirror a repository without a local checkout.
+
+			%[1]s--to%[1]s accepts any URL that %[1]sgit push%[1]s understands, including SSH and
+			HTTPS URLs for other GitHub instances or third-party Git servers. Credentials for the
+			destination must already be usable by git, e.g. via an embedded token in the URL, an
+			SSH key, or a configured credential helper.
+
+			Pass %[1]s--lfs%[1]s to also mirror Git LFS objects, which %[1]sgit push --mirror%[1]s
+			does not transfer on its own.
+
+			Rather than mirroring immediately, %[1]s--schedule%[1]s prints a ready-to-use GitHub
+			Actions workflow that performs the same mirror push on a cron schedule. Redirect its
+			output to a file under %[1]s.github/workflows%[1]s and set the %[1]sMIRROR_REMOTE_URL%[1]s
+			repository secret to the destination URL before committing it.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Mirror the local repository's GitHub remote to another server
+			$ gh repo mirror --to git@git.example.com:team/repo.git
+
+			# Mirror a repository, including Git LFS objects, without cloning it locally
+			$ gh repo mirror cli/cli --to https://git.example.com/cli/cli.git --lfs
+
+			# Print a workflow that mirrors on a schedule instead of mirroring now
+			$ gh repo mirror cli/cli --to https://git.example.com/cli/cli.git --schedule "0 */6 * * *" > .github/workflows/mirror.yml
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if len(args) > 0 {
+				opts.Repository = args[0]
+			}
+
+			if opts.To == "" {
+				return cmdutil.FlagErrorf("`--to` is required")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return mirrorRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.To, "to", "t", "", "Destination remote `URL` to mirror push to (required)")
+	cmd.Flags().BoolVar(&opts.LFS, "lfs", false, "Also mirror Git LFS objects")
+	cmd.Flags().StringVar(&opts.Schedule, "schedule", "", "Print a GitHub Actions workflow (instead of mirroring now) that mirrors on this cron `schedule`")
+
+	return cmd
+}
+
+func mirrorRun(opts *MirrorOptions) error {
+	if opts.Schedule != "" {
+		return printScheduleWorkflow(opts)
+	}
+	return mirrorNow(opts)
+}
+
+func resolveSourceRepo(opts *MirrorOptions) (ghrepo.Interface, error) {
+	if opts.Repository != "" {
+		return ghrepo.FromFullName(opts.Repository)
+	}
+	return opts.BaseRepo()
+}
+
+func mirrorNow(opts *MirrorOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	repo, err := resolveSourceRepo(opts)
+	if err != nil {
+		return err
+	}
+
+	canonicalRepo, err := api.GitHubRepo(apiClient, repo)
+	if err != nil {
+		return err
+	}
+
+	protocol := cfg.GitProtocol(canonicalRepo.RepoHost()).Value
+	srcURL := ghrepo.FormatRemoteURL(canonicalRepo, protocol)
+
+	tmpDir, err := os.MkdirTemp("", "gh-repo-mirror-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	ctx := context.Background()
+	cloneDir, err := opts.GitClient.Clone(ctx, srcURL, []string{tmpDir, "--mirror"})
+	if err != nil {
+		return fmt.Errorf("failed to create a mirror clone of %s: %w", ghrepo.FullName(canonicalRepo), err)
+	}
+
+	mirrorClient := opts.GitClient.Copy()
+	mirrorClient.RepoDir = cloneDir
+
+	opts.IO.StartProgressIndicator()
+	err = pushMirror(ctx, mirrorClient, opts.LFS, opts.To)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Mirrored %s to %s\n", cs.SuccessIcon(), ghrepo.FullName(canonicalRepo), opts.To)
+	}
+
+	return nil
+}
+
+// pushMirror fetches LFS objects from the mirror clone's origin remote (if lfs is set), then
+// pushes every ref and, again if lfs is set, every LFS object to destURL. Every operation goes
+// through AuthenticatedCommand, the same as the rest of the git.Client API, so that gh's
+// credential helper is offered a chance to authenticate the destination; if destURL isn't a
+// GitHub host, gh declines and git falls back to whatever credential helper or prompt the user
+// already has configured for it.
+func pushMirror(ctx context.Context, gitClient *git.Client, lfs bool, destURL string) error {
+	if lfs {
+		cmd, err := gitClient.AuthenticatedCommand(ctx, "lfs", "fetch", "--all", "origin")
+		if err != nil {
+			return err
+		}
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("failed to fetch LFS objects: %w", err)
+		}
+	}
+
+	cmd, err := gitClient.AuthenticatedCommand(ctx, "push", "--mirror", destURL)
+	if err != nil {
+		return err
+	}
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to push mirror to %s: %w", destURL, err)
+	}
+
+	if lfs {
+		cmd, err := gitClient.AuthenticatedCommand(ctx, "lfs", "push", "--all", destURL)
+		if err != nil {
+			return err
+		}
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("failed to push LFS objects to %s: %w", destURL, err)
+		}
+	}
+
+	return nil
+}
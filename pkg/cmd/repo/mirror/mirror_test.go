@@ -0,0 +1,209 @@
+package mirror
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/run"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// gitCredentialRE builds a pattern for an AuthenticatedCommand invocation whose git subcommand
+// (e.g. "lfs fetch") isn't one of the verbs that run.CommandStubber's own credential-helper
+// insertion recognizes, so the credential helper flags are spelled out here instead.
+func gitCredentialRE(rest string) string {
+	return `(?:git) -C .+ -c credential\.helper= -c credential\.helper=!"[^"]+" auth git-credential ` + rest
+}
+
+func TestNewCmdMirror(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     string
+		wantOpts MirrorOptions
+		wantErr  string
+	}{
+		{
+			name:    "missing --to",
+			args:    "OWNER/REPO",
+			wantErr: "`--to` is required",
+		},
+		{
+			name: "repository and destination",
+			args: "OWNER/REPO --to git@git.example.com:team/repo.git",
+			wantOpts: MirrorOptions{
+				Repository: "OWNER/REPO",
+				To:         "git@git.example.com:team/repo.git",
+			},
+		},
+		{
+			name: "lfs flag",
+			args: "OWNER/REPO --to git@git.example.com:team/repo.git --lfs",
+			wantOpts: MirrorOptions{
+				Repository: "OWNER/REPO",
+				To:         "git@git.example.com:team/repo.git",
+				LFS:        true,
+			},
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			fac := &cmdutil.Factory{IOStreams: ios}
+
+			var opts *MirrorOptions
+			cmd := NewCmdMirror(fac, func(mo *MirrorOptions) error {
+				opts = mo
+				return nil
+			})
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+			cmd.SetOut(ios.ErrOut)
+			cmd.SetErr(ios.ErrOut)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantOpts.Repository, opts.Repository)
+			assert.Equal(t, tt.wantOpts.To, opts.To)
+			assert.Equal(t, tt.wantOpts.LFS, opts.LFS)
+		})
+	}
+}
+
+func Test_mirrorRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       *MirrorOptions
+		httpStubs  func(*httpmock.Registry)
+		execStubs  func(*run.CommandStubber)
+		tty        bool
+		wantStdout string
+	}{
+		{
+			name: "mirror now",
+			opts: &MirrorOptions{
+				Repository: "OWNER/REPO",
+				To:         "https://git.example.com/OWNER/REPO.git",
+			},
+			tty: true,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(`{"data":{"repository":{"id":"THEID","name":"REPO","owner":{"login":"OWNER"}}}}`))
+			},
+			execStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git clone --mirror https://github\.com/OWNER/REPO\.git .+`, 0, "")
+				cs.Register(`git -C .+ push --mirror https://git\.example\.com/OWNER/REPO\.git`, 0, "")
+			},
+			wantStdout: "✓ Mirrored OWNER/REPO to https://git.example.com/OWNER/REPO.git\n",
+		},
+		{
+			name: "mirror now with lfs",
+			opts: &MirrorOptions{
+				Repository: "OWNER/REPO",
+				To:         "https://git.example.com/OWNER/REPO.git",
+				LFS:        true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(`{"data":{"repository":{"id":"THEID","name":"REPO","owner":{"login":"OWNER"}}}}`))
+			},
+			execStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git clone --mirror https://github\.com/OWNER/REPO\.git .+`, 0, "")
+				cs.Register(gitCredentialRE(`lfs fetch --all origin`), 0, "")
+				cs.Register(`git -C .+ push --mirror https://git\.example\.com/OWNER/REPO\.git`, 0, "")
+				cs.Register(gitCredentialRE(`lfs push --all https://git\.example\.com/OWNER/REPO\.git`), 0, "")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, stdout, stderr := iostreams.Test()
+			ios.SetStdoutTTY(tt.tty)
+			tt.opts.IO = ios
+
+			reg := &httpmock.Registry{}
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+			defer reg.Verify(t)
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+
+			cfg := config.NewBlankConfig()
+			tt.opts.Config = func() (gh.Config, error) {
+				return cfg, nil
+			}
+
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.New("OWNER", "REPO"), nil
+			}
+
+			tt.opts.GitClient = &git.Client{
+				GhPath:  "some/path/gh",
+				GitPath: "some/path/git",
+			}
+
+			cs, restoreRun := run.Stub()
+			defer restoreRun(t)
+			if tt.execStubs != nil {
+				tt.execStubs(cs)
+			}
+
+			err := mirrorRun(tt.opts)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantStdout, stdout.String())
+			assert.Equal(t, "", stderr.String())
+		})
+	}
+}
+
+func Test_printScheduleWorkflow(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+	opts := &MirrorOptions{
+		IO:       ios,
+		To:       "https://git.example.com/OWNER/REPO.git",
+		Schedule: "0 */6 * * *",
+	}
+
+	err := mirrorRun(opts)
+	require.NoError(t, err)
+
+	assert.Contains(t, stdout.String(), `- cron: "0 */6 * * *"`)
+	assert.Contains(t, stdout.String(), `gh secret set MIRROR_REMOTE_URL --body "https://git.example.com/OWNER/REPO.git"`)
+	assert.NotContains(t, stdout.String(), "lfs")
+}
+
+func Test_printScheduleWorkflow_lfs(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+	opts := &MirrorOptions{
+		IO:       ios,
+		To:       "https://git.example.com/OWNER/REPO.git",
+		Schedule: "0 */6 * * *",
+		LFS:      true,
+	}
+
+	err := mirrorRun(opts)
+	require.NoError(t, err)
+
+	assert.Contains(t, stdout.String(), "git -C mirror.git lfs fetch --all origin")
+	assert.Contains(t, stdout.String(), `git -C mirror.git lfs push --all "$MIRROR_REMOTE_URL"`)
+}
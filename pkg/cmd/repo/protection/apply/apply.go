@@ -0,0 +1,104 @@
+package apply
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/repo/protection/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ApplyOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Branch   string
+	Filename string
+}
+
+func NewCmdApply(f *cmdutil.Factory, runF func(*ApplyOptions) error) *cobra.Command {
+	opts := &ApplyOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "apply [<branch>]",
+		Short: "Apply a branch protection rule from a JSON file",
+		Long: heredoc.Docf(`
+			Replace a branch's protection rule wholesale with the contents of a JSON
+			file, matching the shape documented for the REST API's "Update branch
+			protection" endpoint.
+
+			If no branch is specified, the repository's default branch is used.
+			Pass %[1]s--input -%[1]s to read the JSON document from standard input.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ gh repo protection apply --input protection.json
+			$ cat protection.json | gh repo protection apply release-1.0 --input -
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if len(args) > 0 {
+				opts.Branch = args[0]
+			}
+
+			if opts.Filename == "" {
+				return cmdutil.FlagErrorf("`--input` is required")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return applyRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Filename, "input", "F", "", "Path to a JSON file describing the protection rule, or `-` to read from standard input")
+
+	return cmd
+}
+
+func applyRun(opts *ApplyOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	branch := opts.Branch
+	if branch == "" {
+		branch, err = api.RepoDefaultBranch(api.NewClientFromHTTP(httpClient), baseRepo)
+		if err != nil {
+			return err
+		}
+	}
+
+	raw, err := cmdutil.ReadFile(opts.Filename, opts.IO.In)
+	if err != nil {
+		return err
+	}
+
+	if err := shared.ApplyRaw(httpClient, baseRepo, branch, raw); err != nil {
+		return fmt.Errorf("failed to apply branch protection for %s: %w", branch, err)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Applied branch protection for %s\n", cs.SuccessIcon(), branch)
+	}
+
+	return nil
+}
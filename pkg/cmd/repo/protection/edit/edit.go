@@ -0,0 +1,115 @@
+package edit
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/repo/protection/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type EditOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Branch               string
+	ReviewsRequired      int
+	EnforceAdmins        bool
+	RequiredChecks       []string
+	RequireLinearHistory bool
+}
+
+func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Command {
+	opts := &EditOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "edit [<branch>]",
+		Short: "Edit branch protection settings",
+		Long: heredoc.Doc(`
+			Edit the branch protection rule for a branch, creating one if it doesn't
+			already exist.
+
+			If no branch is specified, the repository's default branch is used.
+		`),
+		Example: heredoc.Doc(`
+			$ gh repo protection edit --reviews 2 --enforce-admins --required-checks lint,test
+			$ gh repo protection edit release-1.0 --linear-history
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if len(args) > 0 {
+				opts.Branch = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return editRun(opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.ReviewsRequired, "reviews", 0, "Number of required approving reviews")
+	cmd.Flags().BoolVar(&opts.EnforceAdmins, "enforce-admins", false, "Enforce protection rules for repository administrators")
+	cmd.Flags().StringSliceVar(&opts.RequiredChecks, "required-checks", nil, "Comma-separated list of required status check contexts")
+	cmd.Flags().BoolVar(&opts.RequireLinearHistory, "linear-history", false, "Require a linear commit history")
+
+	return cmd
+}
+
+func editRun(opts *EditOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	branch := opts.Branch
+	if branch == "" {
+		branch, err = api.RepoDefaultBranch(api.NewClientFromHTTP(httpClient), baseRepo)
+		if err != nil {
+			return err
+		}
+	}
+
+	input := shared.UpdateInput{
+		EnforceAdmins:         opts.EnforceAdmins,
+		RequiredLinearHistory: opts.RequireLinearHistory,
+	}
+	if opts.ReviewsRequired > 0 {
+		input.RequiredPullRequestReviews = &struct {
+			RequiredApprovingReviewCount int `json:"required_approving_review_count"`
+		}{RequiredApprovingReviewCount: opts.ReviewsRequired}
+	}
+	if len(opts.RequiredChecks) > 0 {
+		input.RequiredStatusChecks = &struct {
+			Strict   bool     `json:"strict"`
+			Contexts []string `json:"contexts"`
+		}{Strict: true, Contexts: opts.RequiredChecks}
+	}
+
+	if err := shared.UpdateProtection(httpClient, baseRepo, branch, input); err != nil {
+		return fmt.Errorf("failed to update branch protection for %s: %w", branch, err)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Updated branch protection for %s\n", cs.SuccessIcon(), branch)
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package protection
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdApply "github.com/cli/cli/v2/pkg/cmd/repo/protection/apply"
+	cmdEdit "github.com/cli/cli/v2/pkg/cmd/repo/protection/edit"
+	cmdView "github.com/cli/cli/v2/pkg/cmd/repo/protection/view"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdProtection(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "protection <command>",
+		Short: "Manage branch protection rules",
+		Long: heredoc.Doc(`
+			Work with branch protection rules for a repository, replacing the
+			common "gh api repos/.../branches/.../protection" incantation.
+		`),
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdView.NewCmdView(f, nil))
+	cmd.AddCommand(cmdEdit.NewCmdEdit(f, nil))
+	cmd.AddCommand(cmdApply.NewCmdApply(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,127 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+// Protection mirrors the subset of GitHub's branch protection rule that this
+// command group reads and writes.
+// https://docs.github.com/en/rest/branches/branch-protection
+type Protection struct {
+	RequiredStatusChecks *struct {
+		Strict   bool     `json:"strict"`
+		Contexts []string `json:"contexts"`
+	} `json:"required_status_checks" `
+	RequiredPullRequestReviews *struct {
+		RequiredApprovingReviewCount int `json:"required_approving_review_count"`
+	} `json:"required_pull_request_reviews"`
+	EnforceAdmins struct {
+		Enabled bool `json:"enabled"`
+	} `json:"enforce_admins"`
+	RequiredLinearHistory struct {
+		Enabled bool `json:"enabled"`
+	} `json:"required_linear_history"`
+	AllowForcePushes struct {
+		Enabled bool `json:"enabled"`
+	} `json:"allow_force_pushes"`
+	AllowDeletions struct {
+		Enabled bool `json:"enabled"`
+	} `json:"allow_deletions"`
+}
+
+// UpdateInput is the request body shape expected by the branch protection
+// update endpoint, which (unlike the read shape) takes flat boolean fields
+// instead of nested `{"enabled": ...}` objects.
+type UpdateInput struct {
+	RequiredStatusChecks *struct {
+		Strict   bool     `json:"strict"`
+		Contexts []string `json:"contexts"`
+	} `json:"required_status_checks"`
+	RequiredPullRequestReviews *struct {
+		RequiredApprovingReviewCount int `json:"required_approving_review_count"`
+	} `json:"required_pull_request_reviews"`
+	EnforceAdmins         bool        `json:"enforce_admins"`
+	RequiredLinearHistory bool        `json:"required_linear_history"`
+	Restrictions          interface{} `json:"restrictions"`
+}
+
+func Fields() []string {
+	return []string{
+		"enforceAdmins",
+		"requiredLinearHistory",
+		"allowForcePushes",
+		"allowDeletions",
+		"requiredApprovingReviewCount",
+		"requiredStatusChecks",
+	}
+}
+
+// ExportData implements cmdutil.Exporter's per-field access so Protection can
+// be rendered with --json even though its wire shape doesn't map 1:1 onto the
+// flattened fields users expect to query.
+func (p *Protection) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "enforceAdmins":
+			data[f] = p.EnforceAdmins.Enabled
+		case "requiredLinearHistory":
+			data[f] = p.RequiredLinearHistory.Enabled
+		case "allowForcePushes":
+			data[f] = p.AllowForcePushes.Enabled
+		case "allowDeletions":
+			data[f] = p.AllowDeletions.Enabled
+		case "requiredApprovingReviewCount":
+			if p.RequiredPullRequestReviews != nil {
+				data[f] = p.RequiredPullRequestReviews.RequiredApprovingReviewCount
+			} else {
+				data[f] = 0
+			}
+		case "requiredStatusChecks":
+			if p.RequiredStatusChecks != nil {
+				data[f] = p.RequiredStatusChecks.Contexts
+			} else {
+				data[f] = []string{}
+			}
+		}
+	}
+	return data
+}
+
+func protectionPath(repo ghrepo.Interface, branch string) string {
+	return fmt.Sprintf("repos/%s/branches/%s/protection", ghrepo.FullName(repo), branch)
+}
+
+func GetProtection(httpClient *http.Client, repo ghrepo.Interface, branch string) (*Protection, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	var protection Protection
+	err := apiClient.REST(repo.RepoHost(), "GET", protectionPath(repo, branch), nil, &protection)
+	if err != nil {
+		return nil, err
+	}
+	return &protection, nil
+}
+
+func UpdateProtection(httpClient *http.Client, repo ghrepo.Interface, branch string, input UpdateInput) error {
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	body, err := json.Marshal(input)
+	if err != nil {
+		return err
+	}
+
+	return apiClient.REST(repo.RepoHost(), "PUT", protectionPath(repo, branch), bytes.NewReader(body), nil)
+}
+
+func ApplyRaw(httpClient *http.Client, repo ghrepo.Interface, branch string, rawJSON []byte) error {
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	return apiClient.REST(repo.RepoHost(), "PUT", protectionPath(repo, branch), bytes.NewReader(rawJSON), nil)
+}
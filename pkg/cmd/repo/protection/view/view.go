@@ -0,0 +1,130 @@
+package view
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/repo/protection/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ViewOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Branch   string
+	Exporter cmdutil.Exporter
+}
+
+func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
+	opts := &ViewOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view [<branch>]",
+		Short: "View branch protection settings",
+		Long: heredoc.Doc(`
+			View the branch protection rule configured for a branch.
+
+			If no branch is specified, the repository's default branch is used.
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if len(args) > 0 {
+				opts.Branch = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return viewRun(opts)
+		},
+	}
+
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.Fields())
+
+	return cmd
+}
+
+func viewRun(opts *ViewOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	branch, err := resolveBranch(httpClient, baseRepo, opts.Branch)
+	if err != nil {
+		return err
+	}
+
+	protection, err := shared.GetProtection(httpClient, baseRepo, branch)
+	if err != nil {
+		return fmt.Errorf("failed to fetch branch protection for %s: %w", branch, err)
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, protection)
+	}
+
+	cs := opts.IO.ColorScheme()
+	out := opts.IO.Out
+
+	fmt.Fprintf(out, "%s %s\n", cs.Bold("Branch:"), branch)
+	fmt.Fprintf(out, "%s %d\n", cs.Bold("Required approving reviews:"), reviewCount(protection))
+	fmt.Fprintf(out, "%s %s\n", cs.Bold("Enforce for admins:"), yesNo(cs, protection.EnforceAdmins.Enabled))
+	fmt.Fprintf(out, "%s %s\n", cs.Bold("Require linear history:"), yesNo(cs, protection.RequiredLinearHistory.Enabled))
+	fmt.Fprintf(out, "%s %s\n", cs.Bold("Required status checks:"), statusChecks(protection))
+
+	return nil
+}
+
+func resolveBranch(httpClient *http.Client, repo ghrepo.Interface, branch string) (string, error) {
+	if branch != "" {
+		return branch, nil
+	}
+
+	return api.RepoDefaultBranch(api.NewClientFromHTTP(httpClient), repo)
+}
+
+func reviewCount(p *shared.Protection) int {
+	if p.RequiredPullRequestReviews == nil {
+		return 0
+	}
+	return p.RequiredPullRequestReviews.RequiredApprovingReviewCount
+}
+
+func statusChecks(p *shared.Protection) string {
+	if p.RequiredStatusChecks == nil || len(p.RequiredStatusChecks.Contexts) == 0 {
+		return "none"
+	}
+	out := ""
+	for i, c := range p.RequiredStatusChecks.Contexts {
+		if i > 0 {
+			out += ", "
+		}
+		out += c
+	}
+	return out
+}
+
+func yesNo(cs *iostreams.ColorScheme, b bool) string {
+	if b {
+		return cs.SuccessIcon() + " yes"
+	}
+	return cs.Gray("no")
+}
@@ -0,0 +1,50 @@
+package view
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestViewRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/branches/main/protection"),
+		httpmock.StringResponse(`{
+			"required_status_checks": {"strict": true, "contexts": ["build", "test"]},
+			"required_pull_request_reviews": {"required_approving_review_count": 2},
+			"enforce_admins": {"enabled": true},
+			"required_linear_history": {"enabled": false}
+		}`),
+	)
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	ios.SetColorEnabled(false)
+
+	opts := &ViewOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Branch: "main",
+	}
+
+	err := viewRun(opts)
+	require.NoError(t, err)
+
+	out := stdout.String()
+	assert.Contains(t, out, "Branch: main")
+	assert.Contains(t, out, "Required approving reviews: 2")
+	assert.Contains(t, out, "Required status checks: build, test")
+}
@@ -32,6 +32,7 @@ type RenameOptions struct {
 	DoConfirm       bool
 	HasRepoOverride bool
 	newRepoSelector string
+	UpdateClones    []string
 }
 
 func NewCmdRename(f *cmdutil.Factory, runf func(*RenameOptions) error) *cobra.Command {
@@ -51,7 +52,11 @@ func NewCmdRename(f *cmdutil.Factory, runf func(*RenameOptions) error) *cobra.Co
 		Short: "Rename a repository",
 		Long: heredoc.Doc(`Rename a GitHub repository.
 
-		By default, this renames the current repository; otherwise renames the specified repository.`),
+		By default, this renames the current repository; otherwise renames the specified repository.
+
+		Only the remote in the current directory is updated by default. Pass --update-clones one or
+		more times with the path to another local clone to rewrite its remote too, so a later push
+		from that clone doesn't fail.`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.BaseRepo = f.BaseRepo
@@ -82,6 +87,7 @@ func NewCmdRename(f *cmdutil.Factory, runf func(*RenameOptions) error) *cobra.Co
 	cmd.Flags().BoolVar(&confirm, "confirm", false, "Skip confirmation prompt")
 	_ = cmd.Flags().MarkDeprecated("confirm", "use `--yes` instead")
 	cmd.Flags().BoolVarP(&confirm, "yes", "y", false, "Skip the confirmation prompt")
+	cmd.Flags().StringArrayVar(&opts.UpdateClones, "update-clones", nil, "Update the remote in other local `path`s that clone this repository")
 
 	return cmd
 }
@@ -129,19 +135,28 @@ func renameRun(opts *RenameOptions) error {
 		fmt.Fprintf(opts.IO.Out, "%s Renamed repository %s\n", cs.SuccessIcon(), ghrepo.FullName(newRepo))
 	}
 
-	if opts.HasRepoOverride {
-		return nil
+	if !opts.HasRepoOverride {
+		remote, err := updateRemote(currRepo, newRepo, opts)
+		if err != nil {
+			if remote != nil {
+				fmt.Fprintf(opts.IO.ErrOut, "%s Warning: unable to update remote %q: %v\n", cs.WarningIcon(), remote.Name, err)
+			} else {
+				fmt.Fprintf(opts.IO.ErrOut, "%s Warning: unable to update remote: %v\n", cs.WarningIcon(), err)
+			}
+		} else if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "%s Updated the %q remote\n", cs.SuccessIcon(), remote.Name)
+		}
 	}
 
-	remote, err := updateRemote(currRepo, newRepo, opts)
-	if err != nil {
-		if remote != nil {
-			fmt.Fprintf(opts.IO.ErrOut, "%s Warning: unable to update remote %q: %v\n", cs.WarningIcon(), remote.Name, err)
-		} else {
-			fmt.Fprintf(opts.IO.ErrOut, "%s Warning: unable to update remote: %v\n", cs.WarningIcon(), err)
+	for _, clonePath := range opts.UpdateClones {
+		updated, err := updateClone(clonePath, currRepo, newRepo, opts)
+		if err != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Warning: unable to update remote in %q: %v\n", cs.WarningIcon(), clonePath, err)
+		} else if !updated {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Warning: %q has no remote pointing at %s\n", cs.WarningIcon(), clonePath, ghrepo.FullName(currRepo))
+		} else if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "%s Updated the remote in %q\n", cs.SuccessIcon(), clonePath)
 		}
-	} else if opts.IO.IsStdoutTTY() {
-		fmt.Fprintf(opts.IO.Out, "%s Updated the %q remote\n", cs.SuccessIcon(), remote.Name)
 	}
 
 	return nil
@@ -170,3 +185,49 @@ func updateRemote(repo ghrepo.Interface, renamed ghrepo.Interface, opts *RenameO
 
 	return remote, err
 }
+
+// updateClone rewrites any remote in the local clone at clonePath that points at repo to point
+// at renamed instead, preserving the remote's existing protocol (ssh vs https). It reports
+// whether a matching remote was found, since a path may be an unrelated or stale clone.
+func updateClone(clonePath string, repo ghrepo.Interface, renamed ghrepo.Interface, opts *RenameOptions) (bool, error) {
+	gc := opts.GitClient.Copy()
+	gc.RepoDir = clonePath
+
+	remotes, err := gc.Remotes(context.Background())
+	if err != nil {
+		return false, err
+	}
+
+	updated := false
+	for _, remote := range remotes {
+		remoteRepo, err := remoteRepoFor(remote)
+		if err != nil || !ghrepo.IsSame(remoteRepo, repo) {
+			continue
+		}
+
+		protocol := "https"
+		if remote.FetchURL != nil && remote.FetchURL.Scheme == "ssh" {
+			protocol = "ssh"
+		}
+
+		remoteURL := ghrepo.FormatRemoteURL(renamed, protocol)
+		if err := gc.UpdateRemoteURL(context.Background(), remote.Name, remoteURL); err != nil {
+			return updated, err
+		}
+		updated = true
+	}
+
+	return updated, nil
+}
+
+func remoteRepoFor(remote *git.Remote) (ghrepo.Interface, error) {
+	if remote.FetchURL != nil {
+		if r, err := ghrepo.FromURL(remote.FetchURL); err == nil {
+			return r, nil
+		}
+	}
+	if remote.PushURL != nil {
+		return ghrepo.FromURL(remote.PushURL)
+	}
+	return nil, fmt.Errorf("remote %q has no URL", remote.Name)
+}
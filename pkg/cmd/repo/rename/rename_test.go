@@ -203,6 +203,26 @@ func TestRenameRun(t *testing.T) {
 			},
 		},
 
+		{
+			name: "update other clones",
+			opts: RenameOptions{
+				newRepoSelector: "NEW_REPO",
+				UpdateClones:    []string{"../other-clone"},
+			},
+			wantOut: "✓ Renamed repository OWNER/NEW_REPO\n✓ Updated the \"origin\" remote\n✓ Updated the remote in \"../other-clone\"\n",
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("PATCH", "repos/OWNER/REPO"),
+					httpmock.StatusStringResponse(200, `{"name":"NEW_REPO","owner":{"login":"OWNER"}}`))
+			},
+			execStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git remote set-url origin https://github.com/OWNER/NEW_REPO.git`, 0, "")
+				cs.Register(`git -C \.\./other-clone remote -v`, 0, "origin\thttps://github.com/OWNER/REPO.git (fetch)\norigin\thttps://github.com/OWNER/REPO.git (push)\n")
+				cs.Register(`git -C \.\./other-clone config --get-regexp \^remote\\\..*\\\.gh-resolved\$`, 0, "")
+				cs.Register(`git -C \.\./other-clone remote set-url origin https://github.com/OWNER/NEW_REPO.git`, 0, "")
+			},
+			tty: true,
+		},
 		{
 			name: "confirmation with no",
 			tty:  true,
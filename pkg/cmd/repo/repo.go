@@ -4,18 +4,28 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	repoArchiveCmd "github.com/cli/cli/v2/pkg/cmd/repo/archive"
 	repoCloneCmd "github.com/cli/cli/v2/pkg/cmd/repo/clone"
+	contributorsCmd "github.com/cli/cli/v2/pkg/cmd/repo/contributors"
 	repoCreateCmd "github.com/cli/cli/v2/pkg/cmd/repo/create"
 	creditsCmd "github.com/cli/cli/v2/pkg/cmd/repo/credits"
 	repoDeleteCmd "github.com/cli/cli/v2/pkg/cmd/repo/delete"
 	deployKeyCmd "github.com/cli/cli/v2/pkg/cmd/repo/deploy-key"
 	repoEditCmd "github.com/cli/cli/v2/pkg/cmd/repo/edit"
 	repoForkCmd "github.com/cli/cli/v2/pkg/cmd/repo/fork"
+	garbageCollectCmd "github.com/cli/cli/v2/pkg/cmd/repo/garbage-collect"
 	gardenCmd "github.com/cli/cli/v2/pkg/cmd/repo/garden"
+	gitignoreCmd "github.com/cli/cli/v2/pkg/cmd/repo/gitignore"
+	licenseCmd "github.com/cli/cli/v2/pkg/cmd/repo/license"
 	repoListCmd "github.com/cli/cli/v2/pkg/cmd/repo/list"
+	repoMirrorCmd "github.com/cli/cli/v2/pkg/cmd/repo/mirror"
+	protectionCmd "github.com/cli/cli/v2/pkg/cmd/repo/protection"
 	repoRenameCmd "github.com/cli/cli/v2/pkg/cmd/repo/rename"
 	repoDefaultCmd "github.com/cli/cli/v2/pkg/cmd/repo/setdefault"
+	repoStarCmd "github.com/cli/cli/v2/pkg/cmd/repo/star"
 	repoSyncCmd "github.com/cli/cli/v2/pkg/cmd/repo/sync"
+	topicsCmd "github.com/cli/cli/v2/pkg/cmd/repo/topics"
+	trafficCmd "github.com/cli/cli/v2/pkg/cmd/repo/traffic"
 	repoUnarchiveCmd "github.com/cli/cli/v2/pkg/cmd/repo/unarchive"
+	repoUnstarCmd "github.com/cli/cli/v2/pkg/cmd/repo/unstar"
 	repoViewCmd "github.com/cli/cli/v2/pkg/cmd/repo/view"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -44,6 +54,8 @@ func NewCmdRepo(f *cmdutil.Factory) *cobra.Command {
 	cmdutil.AddGroup(cmd, "General commands",
 		repoListCmd.NewCmdList(f, nil),
 		repoCreateCmd.NewCmdCreate(f, nil),
+		licenseCmd.NewCmdLicense(f),
+		gitignoreCmd.NewCmdGitIgnore(f),
 	)
 
 	cmdutil.AddGroup(cmd, "Targeted commands",
@@ -52,14 +64,22 @@ func NewCmdRepo(f *cmdutil.Factory) *cobra.Command {
 		repoForkCmd.NewCmdFork(f, nil),
 		repoDefaultCmd.NewCmdSetDefault(f, nil),
 		repoSyncCmd.NewCmdSync(f, nil),
+		repoMirrorCmd.NewCmdMirror(f, nil),
 		repoEditCmd.NewCmdEdit(f, nil),
 		deployKeyCmd.NewCmdDeployKey(f),
+		protectionCmd.NewCmdProtection(f),
 		repoRenameCmd.NewCmdRename(f, nil),
+		topicsCmd.NewCmdTopics(f),
 		repoArchiveCmd.NewCmdArchive(f, nil),
 		repoUnarchiveCmd.NewCmdUnarchive(f, nil),
+		repoStarCmd.NewCmdStar(f, nil),
+		repoUnstarCmd.NewCmdUnstar(f, nil),
 		repoDeleteCmd.NewCmdDelete(f, nil),
 		creditsCmd.NewCmdRepoCredits(f, nil),
 		gardenCmd.NewCmdGarden(f, nil),
+		garbageCollectCmd.NewCmdGarbageCollect(f, nil),
+		contributorsCmd.NewCmdContributors(f, nil),
+		trafficCmd.NewCmdTraffic(f),
 	)
 
 	return cmd
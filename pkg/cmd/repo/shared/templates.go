@@ -0,0 +1,63 @@
+package shared
+
+import (
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+)
+
+// License holds the full contents of a license template, including the text of the license
+// itself. The `/licenses` endpoint returns License values without a Body; the Body is only
+// populated by fetching a single license from `/licenses/{key}`.
+type License struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+	Body string `json:"body"`
+}
+
+// ListGitIgnoreTemplates uses API v3 here because gitignore template isn't supported by GraphQL yet.
+func ListGitIgnoreTemplates(httpClient *http.Client, hostname string) ([]string, error) {
+	var gitIgnoreTemplates []string
+	client := api.NewClientFromHTTP(httpClient)
+	err := client.REST(hostname, "GET", "gitignore/templates", nil, &gitIgnoreTemplates)
+	if err != nil {
+		return []string{}, err
+	}
+	return gitIgnoreTemplates, nil
+}
+
+// GitIgnoreTemplate fetches the contents of a single named .gitignore template.
+func GitIgnoreTemplate(httpClient *http.Client, hostname, name string) (string, error) {
+	var template struct {
+		Name   string `json:"name"`
+		Source string `json:"source"`
+	}
+	client := api.NewClientFromHTTP(httpClient)
+	err := client.REST(hostname, "GET", "gitignore/templates/"+name, nil, &template)
+	if err != nil {
+		return "", err
+	}
+	return template.Source, nil
+}
+
+// ListLicenseTemplates uses API v3 here because license template isn't supported by GraphQL yet.
+func ListLicenseTemplates(httpClient *http.Client, hostname string) ([]api.License, error) {
+	var licenseTemplates []api.License
+	client := api.NewClientFromHTTP(httpClient)
+	err := client.REST(hostname, "GET", "licenses", nil, &licenseTemplates)
+	if err != nil {
+		return nil, err
+	}
+	return licenseTemplates, nil
+}
+
+// LicenseTemplate fetches the full contents, including the license body, of a single license by key.
+func LicenseTemplate(httpClient *http.Client, hostname, key string) (*License, error) {
+	var license License
+	client := api.NewClientFromHTTP(httpClient)
+	err := client.REST(hostname, "GET", "licenses/"+key, nil, &license)
+	if err != nil {
+		return nil, err
+	}
+	return &license, nil
+}
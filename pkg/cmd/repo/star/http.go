@@ -0,0 +1,27 @@
+package star
+
+import (
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/shurcooL/githubv4"
+)
+
+func starRepo(client *http.Client, repo *api.Repository) error {
+	var mutation struct {
+		AddStar struct {
+			Starrable struct {
+				ID string
+			}
+		} `graphql:"addStar(input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"input": githubv4.AddStarInput{
+			StarrableID: repo.ID,
+		},
+	}
+
+	gql := api.NewClientFromHTTP(client)
+	return gql.Mutate(repo.RepoHost(), "AddStar", &mutation, variables)
+}
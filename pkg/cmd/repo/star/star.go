@@ -0,0 +1,132 @@
+package star
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/cobra"
+)
+
+type StarOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (gh.Config, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+	IO         *iostreams.IOStreams
+
+	RepoArgs []string
+}
+
+func NewCmdStar(f *cmdutil.Factory, runF func(*StarOptions) error) *cobra.Command {
+	opts := &StarOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "star [<repository>...]",
+		Short: "Star a repository",
+		Long: heredoc.Doc(`
+			Star one or more GitHub repositories.
+
+			With no argument, stars the current repository.
+		`),
+		Example: heredoc.Doc(`
+			$ gh repo star cli/cli
+			$ gh repo star cli/cli cli/go-gh monalisa/octocat
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.RepoArgs = args
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return starRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func starRun(opts *StarOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+	cs := opts.IO.ColorScheme()
+
+	repos, err := resolveRepos(apiClient, opts.Config, opts.BaseRepo, opts.RepoArgs)
+	if err != nil {
+		return err
+	}
+
+	var errs error
+	for _, toStar := range repos {
+		fullName := ghrepo.FullName(toStar)
+
+		repo, err := api.FetchRepository(apiClient, toStar, []string{"id"})
+		if err != nil {
+			fmt.Fprintf(opts.IO.Out, "%s %s: %s\n", cs.FailureIcon(), fullName, err)
+			errs = multierror.Append(errs, fmt.Errorf("%s: %w", fullName, err))
+			continue
+		}
+
+		if err := starRepo(httpClient, repo); err != nil {
+			fmt.Fprintf(opts.IO.Out, "%s %s: %s\n", cs.FailureIcon(), fullName, err)
+			errs = multierror.Append(errs, fmt.Errorf("%s: %w", fullName, err))
+			continue
+		}
+
+		fmt.Fprintf(opts.IO.Out, "%s Starred %s\n", cs.SuccessIcon(), fullName)
+	}
+
+	return errs
+}
+
+// resolveRepos turns the command's repository arguments into a list of
+// repos to act on, falling back to the base repo when none were given.
+func resolveRepos(apiClient *api.Client, cfg func() (gh.Config, error), baseRepo func() (ghrepo.Interface, error), args []string) ([]ghrepo.Interface, error) {
+	if len(args) == 0 {
+		repo, err := baseRepo()
+		if err != nil {
+			return nil, err
+		}
+		return []ghrepo.Interface{repo}, nil
+	}
+
+	repos := make([]ghrepo.Interface, len(args))
+	for i, arg := range args {
+		selector := arg
+		if !strings.Contains(selector, "/") {
+			c, err := cfg()
+			if err != nil {
+				return nil, err
+			}
+			hostname, _ := c.Authentication().DefaultHost()
+			currentUser, err := api.CurrentLoginName(apiClient, hostname)
+			if err != nil {
+				return nil, err
+			}
+			selector = currentUser + "/" + selector
+		}
+
+		repo, err := ghrepo.FromFullName(selector)
+		if err != nil {
+			return nil, fmt.Errorf("argument error: %w", err)
+		}
+		repos[i] = repo
+	}
+
+	return repos, nil
+}
@@ -0,0 +1,157 @@
+package add
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/repo/topics/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/set"
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/cobra"
+)
+
+type AddOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Config     func() (gh.Config, error)
+
+	Topics []string
+
+	Org         string
+	Match       string
+	Concurrency int
+}
+
+func NewCmdAdd(f *cmdutil.Factory, runF func(*AddOptions) error) *cobra.Command {
+	opts := &AddOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "add <topic>...",
+		Short: "Add topics to a repository",
+		Long: heredoc.Doc(`
+			Add one or more topics to a repository, leaving any existing topics in place.
+
+			Each topic is validated locally before any request is made.
+
+			With --org, the topics are added across every repository in the
+			organization, optionally narrowed with --match.
+		`),
+		Example: heredoc.Doc(`
+			$ gh repo topics add cli golang
+			$ gh repo topics add internal --org my-org --match "service-*"
+		`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.Topics = args
+
+			for _, topic := range opts.Topics {
+				if err := shared.ValidateTopic(topic); err != nil {
+					return err
+				}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			if opts.Org != "" {
+				return runBulkAdd(opts)
+			}
+			return runAdd(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Org, "org", "", "Add the topics across every repository in an organization")
+	cmd.Flags().StringVar(&opts.Match, "match", "", "Glob `pattern` limiting which repositories in --org are affected")
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", 5, "Number of repositories to process at once when using --org")
+
+	return cmd
+}
+
+func runAdd(opts *AddOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	if err := addTopics(context.Background(), httpClient, repo, opts.Topics); err != nil {
+		return err
+	}
+
+	if opts.IO.CanPrompt() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Added topics to %s\n", cs.SuccessIcon(), ghrepo.FullName(repo))
+	}
+
+	return nil
+}
+
+func runBulkAdd(opts *AddOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	hostname, _ := cfg.Authentication().DefaultHost()
+
+	repos, err := shared.ListOrgRepos(client, hostname, opts.Org, opts.Match)
+	if err != nil {
+		return fmt.Errorf("failed to list repositories for %s: %w", opts.Org, err)
+	}
+	if len(repos) == 0 {
+		return fmt.Errorf("no repositories in %s matched", opts.Org)
+	}
+
+	ctx := context.Background()
+	results := shared.BulkApply(repos, opts.Concurrency, func(repo ghrepo.Interface) error {
+		return addTopics(ctx, httpClient, repo, opts.Topics)
+	})
+
+	cs := opts.IO.ColorScheme()
+	var errs error
+	for _, result := range results {
+		if result.Err != nil {
+			fmt.Fprintf(opts.IO.Out, "%s %s: %s\n", cs.FailureIcon(), ghrepo.FullName(result.Repo), result.Err)
+			errs = multierror.Append(errs, fmt.Errorf("%s: %w", ghrepo.FullName(result.Repo), result.Err))
+			continue
+		}
+		fmt.Fprintf(opts.IO.Out, "%s %s\n", cs.SuccessIcon(), ghrepo.FullName(result.Repo))
+	}
+
+	return errs
+}
+
+func addTopics(ctx context.Context, httpClient *http.Client, repo ghrepo.Interface, topics []string) error {
+	existing, err := shared.GetTopics(ctx, httpClient, repo)
+	if err != nil {
+		return err
+	}
+
+	newTopics := set.NewStringSet()
+	newTopics.AddValues(existing)
+	newTopics.AddValues(topics)
+
+	return shared.SetTopics(ctx, httpClient, repo, newTopics.ToSlice())
+}
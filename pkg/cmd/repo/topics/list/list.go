@@ -0,0 +1,98 @@
+package list
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/repo/topics/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Exporter cmdutil.Exporter
+}
+
+type topic struct {
+	Name string `json:"name"`
+}
+
+func (t topic) ExportData(_ []string) map[string]interface{} {
+	return map[string]interface{}{"name": t.Name}
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List a repository's topics",
+		Long: heredoc.Doc(`
+			List the topics currently applied to a repository.
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, []string{"name"})
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	names, err := shared.GetTopics(context.Background(), httpClient, repo)
+	if err != nil {
+		return err
+	}
+	sort.Strings(names)
+
+	if opts.Exporter != nil {
+		topics := make([]topic, len(names))
+		for i, name := range names {
+			topics[i] = topic{Name: name}
+		}
+		return opts.Exporter.Write(opts.IO, topics)
+	}
+
+	if len(names) == 0 {
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "%s has no topics\n", ghrepo.FullName(repo))
+		}
+		return nil
+	}
+
+	for _, name := range names {
+		fmt.Fprintln(opts.IO.Out, name)
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package remove
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/repo/topics/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/set"
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/cobra"
+)
+
+type RemoveOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Config     func() (gh.Config, error)
+
+	Topics []string
+
+	Org         string
+	Match       string
+	Concurrency int
+}
+
+func NewCmdRemove(f *cmdutil.Factory, runF func(*RemoveOptions) error) *cobra.Command {
+	opts := &RemoveOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "remove <topic>...",
+		Short: "Remove topics from a repository",
+		Long: heredoc.Doc(`
+			Remove one or more topics from a repository, leaving any other topics in place.
+
+			With --org, the topics are removed across every repository in the
+			organization, optionally narrowed with --match.
+		`),
+		Example: heredoc.Doc(`
+			$ gh repo topics remove deprecated
+			$ gh repo topics remove deprecated --org my-org --match "service-*"
+		`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.Topics = args
+
+			for _, topic := range opts.Topics {
+				if err := shared.ValidateTopic(topic); err != nil {
+					return err
+				}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			if opts.Org != "" {
+				return runBulkRemove(opts)
+			}
+			return runRemove(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Org, "org", "", "Remove the topics across every repository in an organization")
+	cmd.Flags().StringVar(&opts.Match, "match", "", "Glob `pattern` limiting which repositories in --org are affected")
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", 5, "Number of repositories to process at once when using --org")
+
+	return cmd
+}
+
+func runRemove(opts *RemoveOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	if err := removeTopics(context.Background(), httpClient, repo, opts.Topics); err != nil {
+		return err
+	}
+
+	if opts.IO.CanPrompt() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Removed topics from %s\n", cs.SuccessIcon(), ghrepo.FullName(repo))
+	}
+
+	return nil
+}
+
+func runBulkRemove(opts *RemoveOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	hostname, _ := cfg.Authentication().DefaultHost()
+
+	repos, err := shared.ListOrgRepos(client, hostname, opts.Org, opts.Match)
+	if err != nil {
+		return fmt.Errorf("failed to list repositories for %s: %w", opts.Org, err)
+	}
+	if len(repos) == 0 {
+		return fmt.Errorf("no repositories in %s matched", opts.Org)
+	}
+
+	ctx := context.Background()
+	results := shared.BulkApply(repos, opts.Concurrency, func(repo ghrepo.Interface) error {
+		return removeTopics(ctx, httpClient, repo, opts.Topics)
+	})
+
+	cs := opts.IO.ColorScheme()
+	var errs error
+	for _, result := range results {
+		if result.Err != nil {
+			fmt.Fprintf(opts.IO.Out, "%s %s: %s\n", cs.FailureIcon(), ghrepo.FullName(result.Repo), result.Err)
+			errs = multierror.Append(errs, fmt.Errorf("%s: %w", ghrepo.FullName(result.Repo), result.Err))
+			continue
+		}
+		fmt.Fprintf(opts.IO.Out, "%s %s\n", cs.SuccessIcon(), ghrepo.FullName(result.Repo))
+	}
+
+	return errs
+}
+
+func removeTopics(ctx context.Context, httpClient *http.Client, repo ghrepo.Interface, topics []string) error {
+	existing, err := shared.GetTopics(ctx, httpClient, repo)
+	if err != nil {
+		return err
+	}
+
+	remaining := set.NewStringSet()
+	remaining.AddValues(existing)
+	remaining.RemoveValues(topics)
+
+	return shared.SetTopics(ctx, httpClient, repo, remaining.ToSlice())
+}
@@ -0,0 +1,78 @@
+package shared
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"golang.org/x/sync/errgroup"
+)
+
+type orgRepo struct {
+	Name  string `json:"name"`
+	Owner struct {
+		Login string `json:"login"`
+	} `json:"owner"`
+}
+
+// ListOrgRepos fetches the non-fork repositories belonging to org, optionally
+// filtered to those whose name matches the glob pattern in match.
+func ListOrgRepos(client *api.Client, host, org, match string) ([]ghrepo.Interface, error) {
+	var repos []ghrepo.Interface
+
+	path := fmt.Sprintf("orgs/%s/repos?per_page=100", org)
+	for path != "" {
+		var page []orgRepo
+		var err error
+		path, err = client.RESTWithNext(host, "GET", path, nil, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range page {
+			if match != "" {
+				ok, err := filepath.Match(match, r.Name)
+				if err != nil {
+					return nil, fmt.Errorf("invalid match pattern %q: %w", match, err)
+				}
+				if !ok {
+					continue
+				}
+			}
+			repos = append(repos, ghrepo.NewWithHost(r.Owner.Login, r.Name, host))
+		}
+	}
+
+	return repos, nil
+}
+
+// BulkResult is the outcome of applying a bulk operation to a single repo.
+type BulkResult struct {
+	Repo ghrepo.Interface
+	Err  error
+}
+
+// BulkApply runs fn for each repo, bounded by concurrency at a time, and
+// returns one result per repo in the same order regardless of individual
+// failures.
+func BulkApply(repos []ghrepo.Interface, concurrency int, fn func(ghrepo.Interface) error) []BulkResult {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make([]BulkResult, len(repos))
+
+	g := new(errgroup.Group)
+	g.SetLimit(concurrency)
+	for i, repo := range repos {
+		i, repo := i, repo
+		g.Go(func() error {
+			results[i] = BulkResult{Repo: repo, Err: fn(repo)}
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	return results
+}
@@ -0,0 +1,90 @@
+package shared
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+// topicRE mirrors the format GitHub enforces for repository topics: lowercase
+// letters, digits, and hyphens, starting and ending with a letter or digit,
+// 50 characters or fewer.
+var topicRE = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,48}[a-z0-9])?$`)
+
+// ValidateTopic returns an error if topic doesn't match the format GitHub
+// accepts, so a typo is caught locally instead of surfacing as a server
+// error partway through a bulk --org run.
+func ValidateTopic(topic string) error {
+	if !topicRE.MatchString(topic) {
+		return fmt.Errorf("%q is not a valid topic name: topics may only contain lowercase letters, numbers, and hyphens, and must be 50 characters or fewer", topic)
+	}
+	return nil
+}
+
+func topicsPath(repo ghrepo.Interface) string {
+	return fmt.Sprintf("repos/%s/%s/topics", repo.RepoOwner(), repo.RepoName())
+}
+
+// GetTopics fetches the topics currently applied to repo.
+func GetTopics(ctx context.Context, httpClient *http.Client, repo ghrepo.Interface) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", ghinstance.RESTPrefix(repo.RepoHost())+topicsPath(repo), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// "mercy-preview" is still needed for some GitHub Enterprise versions
+	req.Header.Set("Accept", "application/vnd.github.mercy-preview+json")
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, api.HandleHTTPError(res)
+	}
+
+	var responseData struct {
+		Names []string `json:"names"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&responseData)
+	return responseData.Names, err
+}
+
+// SetTopics replaces the full set of topics applied to repo with topics.
+func SetTopics(ctx context.Context, httpClient *http.Client, repo ghrepo.Interface, topics []string) error {
+	payload := struct {
+		Names []string `json:"names"`
+	}{
+		Names: topics,
+	}
+	body := &bytes.Buffer{}
+	if err := json.NewEncoder(body).Encode(&payload); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", ghinstance.RESTPrefix(repo.RepoHost())+topicsPath(repo), body)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-type", "application/json")
+	// "mercy-preview" is still needed for some GitHub Enterprise versions
+	req.Header.Set("Accept", "application/vnd.github.mercy-preview+json")
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return api.HandleHTTPError(res)
+	}
+
+	return nil
+}
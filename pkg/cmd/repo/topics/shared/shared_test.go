@@ -0,0 +1,34 @@
+package shared
+
+import "testing"
+
+func TestValidateTopic(t *testing.T) {
+	tests := []struct {
+		topic   string
+		wantErr bool
+	}{
+		{topic: "cli", wantErr: false},
+		{topic: "go", wantErr: false},
+		{topic: "topic-with-hyphens", wantErr: false},
+		{topic: "a", wantErr: false},
+		{topic: "", wantErr: true},
+		{topic: "-leading-hyphen", wantErr: true},
+		{topic: "trailing-hyphen-", wantErr: true},
+		{topic: "Has-Capitals", wantErr: true},
+		{topic: "has_underscore", wantErr: true},
+		{topic: "has space", wantErr: true},
+		{topic: "012345678901234567890123456789012345678901234567890", wantErr: true}, // 51 chars
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.topic, func(t *testing.T) {
+			err := ValidateTopic(tt.topic)
+			if tt.wantErr && err == nil {
+				t.Errorf("expected error for topic %q, got none", tt.topic)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expected no error for topic %q, got %v", tt.topic, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package topics
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdAdd "github.com/cli/cli/v2/pkg/cmd/repo/topics/add"
+	cmdList "github.com/cli/cli/v2/pkg/cmd/repo/topics/list"
+	cmdRemove "github.com/cli/cli/v2/pkg/cmd/repo/topics/remove"
+	cmdSet "github.com/cli/cli/v2/pkg/cmd/repo/topics/set"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdTopics(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "topics <command>",
+		Short: "Manage repository topics",
+		Long: heredoc.Doc(`
+			Work with the topics applied to a repository.
+
+			Topic names are validated locally before any request is made, and
+			"add"/"remove"/"set" can be applied across every repository in an
+			organization with --org.
+		`),
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdAdd.NewCmdAdd(f, nil))
+	cmd.AddCommand(cmdRemove.NewCmdRemove(f, nil))
+	cmd.AddCommand(cmdSet.NewCmdSet(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,125 @@
+package clones
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmd/repo/traffic/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ClonesOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Exporter cmdutil.Exporter
+
+	Per       string
+	DateRange shared.DateRangeFlags
+}
+
+var clonesFields = []string{"count", "uniques", "breakdown"}
+
+func NewCmdClones(f *cmdutil.Factory, runF func(*ClonesOptions) error) *cobra.Command {
+	opts := &ClonesOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "clones",
+		Short: "Show repository clone counts",
+		Long: heredoc.Doc(`
+			Show the number of times a repository has been cloned over the last 14 days.
+
+			GitHub only retains this data for 14 days, so --since and --until can narrow the
+			window but can't look further back than that.
+		`),
+		Example: heredoc.Doc(`
+			$ gh repo traffic clones
+			$ gh repo traffic clones --per week
+			$ gh repo traffic clones --since 2024-01-08
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return clonesRun(opts)
+		},
+	}
+
+	cmdutil.StringEnumFlag(cmd, &opts.Per, "per", "", "day", []string{"day", "week"}, "Group results by day or week")
+	cmd.Flags().StringVar(&opts.DateRange.Since, "since", "", "Only show results on or after `YYYY-MM-DD`")
+	cmd.Flags().StringVar(&opts.DateRange.Until, "until", "", "Only show results on or before `YYYY-MM-DD`")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, clonesFields)
+
+	return cmd
+}
+
+func clonesRun(opts *ClonesOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	since, until, err := opts.DateRange.Parse()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	summary, err := shared.Clones(httpClient, repo, opts.Per)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	summary.Breakdown = shared.FilterRange(summary.Breakdown, since, until)
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, summary)
+	}
+
+	cs := opts.IO.ColorScheme()
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s: %d clones, %d unique cloners\n\n", ghrepo.FullName(repo), summary.Count, summary.Uniques)
+
+		counts := make([]int, len(summary.Breakdown))
+		for i, d := range summary.Breakdown {
+			counts[i] = d.Count
+		}
+		fmt.Fprintf(opts.IO.Out, "%s\n\n", cs.Cyan(text.Sparkline(counts, minInt(opts.IO.TerminalWidth(), 52))))
+	}
+
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("DATE", "CLONES", "UNIQUE CLONERS"))
+	for _, d := range summary.Breakdown {
+		tp.AddField(d.Timestamp.Format("2006-01-02"))
+		tp.AddField(fmt.Sprintf("%d", d.Count))
+		tp.AddField(fmt.Sprintf("%d", d.Uniques))
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,58 @@
+package clones
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/repo/traffic/shared"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_clonesRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/traffic/clones"),
+		httpmock.StringResponse(`{
+			"count": 15,
+			"uniques": 6,
+			"clones": [
+				{"timestamp": "2024-01-01T00:00:00Z", "count": 5, "uniques": 2},
+				{"timestamp": "2024-01-02T00:00:00Z", "count": 10, "uniques": 4}
+			]
+		}`))
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	opts := &ClonesOptions{
+		IO:         ios,
+		HttpClient: func() (*http.Client, error) { return &http.Client{Transport: reg}, nil },
+		BaseRepo:   func() (ghrepo.Interface, error) { return ghrepo.New("OWNER", "REPO"), nil },
+		Per:        "day",
+	}
+
+	require.NoError(t, clonesRun(opts))
+
+	out := stdout.String()
+	assert.Contains(t, out, "OWNER/REPO: 15 clones, 6 unique cloners")
+	assert.Contains(t, out, "2024-01-01")
+	assert.Contains(t, out, "2024-01-02")
+}
+
+func Test_clonesRun_invalidUntil(t *testing.T) {
+	ios, _, _, _ := iostreams.Test()
+
+	opts := &ClonesOptions{
+		IO:         ios,
+		HttpClient: func() (*http.Client, error) { return &http.Client{}, nil },
+		BaseRepo:   func() (ghrepo.Interface, error) { return ghrepo.New("OWNER", "REPO"), nil },
+		DateRange:  shared.DateRangeFlags{Until: "not-a-date"},
+	}
+
+	assert.Error(t, clonesRun(opts))
+}
@@ -0,0 +1,93 @@
+package paths
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmd/repo/traffic/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type PathsOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Exporter cmdutil.Exporter
+}
+
+var pathsFields = []string{"path", "title", "count", "uniques"}
+
+func NewCmdPaths(f *cmdutil.Factory, runF func(*PathsOptions) error) *cobra.Command {
+	opts := &PathsOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "paths",
+		Short: "Show the most popular content in a repository",
+		Long: heredoc.Doc(`
+			Show the top 10 most visited paths in a repository over the last 14 days.
+		`),
+		Example: heredoc.Doc(`
+			$ gh repo traffic paths
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return pathsRun(opts)
+		},
+	}
+
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, pathsFields)
+
+	return cmd
+}
+
+func pathsRun(opts *PathsOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	paths, err := shared.PopularPaths(httpClient, repo)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, paths)
+	}
+
+	if len(paths) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("no path traffic found for %s", ghrepo.FullName(repo)))
+	}
+
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("PATH", "TITLE", "VIEWS", "UNIQUE VISITORS"))
+	for _, p := range paths {
+		tp.AddField(p.Path)
+		tp.AddField(p.Title)
+		tp.AddField(fmt.Sprintf("%d", p.Count))
+		tp.AddField(fmt.Sprintf("%d", p.Uniques))
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
@@ -0,0 +1,55 @@
+package paths
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_pathsRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/traffic/popular/paths"),
+		httpmock.StringResponse(`[
+			{"path": "/", "title": "OWNER/REPO", "count": 100, "uniques": 50},
+			{"path": "/README.md", "title": "README.md", "count": 40, "uniques": 20}
+		]`))
+
+	ios, _, stdout, _ := iostreams.Test()
+
+	opts := &PathsOptions{
+		IO:         ios,
+		HttpClient: func() (*http.Client, error) { return &http.Client{Transport: reg}, nil },
+		BaseRepo:   func() (ghrepo.Interface, error) { return ghrepo.New("OWNER", "REPO"), nil },
+	}
+
+	require.NoError(t, pathsRun(opts))
+
+	out := stdout.String()
+	assert.Contains(t, out, "/README.md")
+	assert.Contains(t, out, "OWNER/REPO")
+}
+
+func Test_pathsRun_noResults(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/traffic/popular/paths"),
+		httpmock.StringResponse(`[]`))
+
+	ios, _, _, _ := iostreams.Test()
+
+	opts := &PathsOptions{
+		IO:         ios,
+		HttpClient: func() (*http.Client, error) { return &http.Client{Transport: reg}, nil },
+		BaseRepo:   func() (ghrepo.Interface, error) { return ghrepo.New("OWNER", "REPO"), nil },
+	}
+
+	assert.Error(t, pathsRun(opts))
+}
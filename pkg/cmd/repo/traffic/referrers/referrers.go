@@ -0,0 +1,93 @@
+package referrers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmd/repo/traffic/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ReferrersOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Exporter cmdutil.Exporter
+}
+
+var referrersFields = []string{"referrer", "count", "uniques"}
+
+func NewCmdReferrers(f *cmdutil.Factory, runF func(*ReferrersOptions) error) *cobra.Command {
+	opts := &ReferrersOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "referrers",
+		Short: "Show the top referrers sending traffic to a repository",
+		Long: heredoc.Doc(`
+			Show the top 10 external sites that sent traffic to a repository over the last 14
+			days.
+		`),
+		Example: heredoc.Doc(`
+			$ gh repo traffic referrers
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return referrersRun(opts)
+		},
+	}
+
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, referrersFields)
+
+	return cmd
+}
+
+func referrersRun(opts *ReferrersOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	referrers, err := shared.Referrers(httpClient, repo)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, referrers)
+	}
+
+	if len(referrers) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("no referrer traffic found for %s", ghrepo.FullName(repo)))
+	}
+
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("REFERRER", "VIEWS", "UNIQUE VISITORS"))
+	for _, r := range referrers {
+		tp.AddField(r.Referrer)
+		tp.AddField(fmt.Sprintf("%d", r.Count))
+		tp.AddField(fmt.Sprintf("%d", r.Uniques))
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
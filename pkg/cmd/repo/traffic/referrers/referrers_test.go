@@ -0,0 +1,55 @@
+package referrers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_referrersRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/traffic/popular/referrers"),
+		httpmock.StringResponse(`[
+			{"referrer": "google.com", "count": 10, "uniques": 8},
+			{"referrer": "github.com", "count": 5, "uniques": 4}
+		]`))
+
+	ios, _, stdout, _ := iostreams.Test()
+
+	opts := &ReferrersOptions{
+		IO:         ios,
+		HttpClient: func() (*http.Client, error) { return &http.Client{Transport: reg}, nil },
+		BaseRepo:   func() (ghrepo.Interface, error) { return ghrepo.New("OWNER", "REPO"), nil },
+	}
+
+	require.NoError(t, referrersRun(opts))
+
+	out := stdout.String()
+	assert.Contains(t, out, "google.com")
+	assert.Contains(t, out, "github.com")
+}
+
+func Test_referrersRun_noResults(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/traffic/popular/referrers"),
+		httpmock.StringResponse(`[]`))
+
+	ios, _, _, _ := iostreams.Test()
+
+	opts := &ReferrersOptions{
+		IO:         ios,
+		HttpClient: func() (*http.Client, error) { return &http.Client{Transport: reg}, nil },
+		BaseRepo:   func() (ghrepo.Interface, error) { return ghrepo.New("OWNER", "REPO"), nil },
+	}
+
+	assert.Error(t, referrersRun(opts))
+}
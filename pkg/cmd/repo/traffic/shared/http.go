@@ -0,0 +1,173 @@
+package shared
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+)
+
+// DailyCount is one day (or week, depending on --per) of traffic.
+type DailyCount struct {
+	Timestamp time.Time `json:"timestamp"`
+	Count     int       `json:"count"`
+	Uniques   int       `json:"uniques"`
+}
+
+// Summary is the totals and day-by-day (or week-by-week) breakdown returned by the views and
+// clones traffic endpoints.
+type Summary struct {
+	Count     int          `json:"count"`
+	Uniques   int          `json:"uniques"`
+	Breakdown []DailyCount `json:"breakdown"`
+}
+
+func (s Summary) ExportData(fields []string) map[string]interface{} {
+	v := map[string]interface{}{
+		"count":     s.Count,
+		"uniques":   s.Uniques,
+		"breakdown": s.Breakdown,
+	}
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		data[f] = v[f]
+	}
+	return data
+}
+
+type viewsResponse struct {
+	Count   int          `json:"count"`
+	Uniques int          `json:"uniques"`
+	Views   []DailyCount `json:"views"`
+}
+
+type clonesResponse struct {
+	Count   int          `json:"count"`
+	Uniques int          `json:"uniques"`
+	Clones  []DailyCount `json:"clones"`
+}
+
+// Views fetches the count of visits to the repository over the last 14 days, grouped by day or
+// by week depending on per ("day" or "week").
+func Views(httpClient *http.Client, repo ghrepo.Interface, per string) (*Summary, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("repos/%s/%s/traffic/views?per=%s", repo.RepoOwner(), repo.RepoName(), per)
+
+	var resp viewsResponse
+	if err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &Summary{Count: resp.Count, Uniques: resp.Uniques, Breakdown: resp.Views}, nil
+}
+
+// Clones fetches the count of clones of the repository over the last 14 days, grouped by day or
+// by week depending on per ("day" or "week").
+func Clones(httpClient *http.Client, repo ghrepo.Interface, per string) (*Summary, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("repos/%s/%s/traffic/clones?per=%s", repo.RepoOwner(), repo.RepoName(), per)
+
+	var resp clonesResponse
+	if err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &Summary{Count: resp.Count, Uniques: resp.Uniques, Breakdown: resp.Clones}, nil
+}
+
+// Referrer is one of the top 10 external sites that sent traffic to the repository over the
+// last 14 days.
+type Referrer struct {
+	Referrer string `json:"referrer"`
+	Count    int    `json:"count"`
+	Uniques  int    `json:"uniques"`
+}
+
+func (r Referrer) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(r, fields)
+}
+
+// Referrers fetches the top referrers for the repository over the last 14 days.
+func Referrers(httpClient *http.Client, repo ghrepo.Interface) ([]Referrer, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("repos/%s/%s/traffic/popular/referrers", repo.RepoOwner(), repo.RepoName())
+
+	var referrers []Referrer
+	if err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &referrers); err != nil {
+		return nil, err
+	}
+
+	return referrers, nil
+}
+
+// PopularPath is one of the top 10 most visited paths in the repository over the last 14 days.
+type PopularPath struct {
+	Path    string `json:"path"`
+	Title   string `json:"title"`
+	Count   int    `json:"count"`
+	Uniques int    `json:"uniques"`
+}
+
+func (p PopularPath) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(p, fields)
+}
+
+// PopularPaths fetches the most visited paths in the repository over the last 14 days.
+func PopularPaths(httpClient *http.Client, repo ghrepo.Interface) ([]PopularPath, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("repos/%s/%s/traffic/popular/paths", repo.RepoOwner(), repo.RepoName())
+
+	var paths []PopularPath
+	if err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &paths); err != nil {
+		return nil, err
+	}
+
+	return paths, nil
+}
+
+// FilterRange drops any entries from breakdown whose timestamp falls before since or after
+// until. A zero since or until leaves that end of the range unbounded.
+func FilterRange(breakdown []DailyCount, since, until time.Time) []DailyCount {
+	if since.IsZero() && until.IsZero() {
+		return breakdown
+	}
+
+	filtered := make([]DailyCount, 0, len(breakdown))
+	for _, d := range breakdown {
+		if !since.IsZero() && d.Timestamp.Before(since) {
+			continue
+		}
+		if !until.IsZero() && d.Timestamp.After(until) {
+			continue
+		}
+		filtered = append(filtered, d)
+	}
+	return filtered
+}
+
+// DateRangeFlags are the --since/--until flags shared by the views and clones subcommands.
+type DateRangeFlags struct {
+	Since string
+	Until string
+}
+
+// Parse converts the YYYY-MM-DD strings in f into times suitable for FilterRange, or returns an
+// error naming the offending flag.
+func (f DateRangeFlags) Parse() (since, until time.Time, err error) {
+	if f.Since != "" {
+		since, err = time.Parse("2006-01-02", f.Since)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid --since date: %w", err)
+		}
+	}
+	if f.Until != "" {
+		until, err = time.Parse("2006-01-02", f.Until)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid --until date: %w", err)
+		}
+	}
+	return since, until, nil
+}
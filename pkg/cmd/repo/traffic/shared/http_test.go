@@ -0,0 +1,51 @@
+package shared
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterRange(t *testing.T) {
+	day := func(s string) time.Time {
+		t, _ := time.Parse("2006-01-02", s)
+		return t
+	}
+
+	breakdown := []DailyCount{
+		{Timestamp: day("2024-01-01"), Count: 1},
+		{Timestamp: day("2024-01-05"), Count: 2},
+		{Timestamp: day("2024-01-10"), Count: 3},
+	}
+
+	tests := []struct {
+		name  string
+		since time.Time
+		until time.Time
+		want  int
+	}{
+		{name: "unbounded", want: 3},
+		{name: "since only", since: day("2024-01-05"), want: 2},
+		{name: "until only", until: day("2024-01-05"), want: 2},
+		{name: "since and until", since: day("2024-01-02"), until: day("2024-01-09"), want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FilterRange(breakdown, tt.since, tt.until)
+			assert.Len(t, got, tt.want)
+		})
+	}
+}
+
+func TestDateRangeFlagsParse(t *testing.T) {
+	f := DateRangeFlags{Since: "2024-01-01", Until: "2024-01-31"}
+	since, until, err := f.Parse()
+	assert.NoError(t, err)
+	assert.Equal(t, 2024, since.Year())
+	assert.Equal(t, 2024, until.Year())
+
+	_, _, err = DateRangeFlags{Since: "not-a-date"}.Parse()
+	assert.Error(t, err)
+}
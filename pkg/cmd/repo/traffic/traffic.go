@@ -0,0 +1,34 @@
+package traffic
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdClones "github.com/cli/cli/v2/pkg/cmd/repo/traffic/clones"
+	cmdPaths "github.com/cli/cli/v2/pkg/cmd/repo/traffic/paths"
+	cmdReferrers "github.com/cli/cli/v2/pkg/cmd/repo/traffic/referrers"
+	cmdViews "github.com/cli/cli/v2/pkg/cmd/repo/traffic/views"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdTraffic(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "traffic <command>",
+		Short: "View repository traffic analytics",
+		Long: heredoc.Doc(`
+			View the visitor, clone, referrer, and popular content analytics GitHub shows on a
+			repository's Insights > Traffic page.
+
+			This data only covers the last 14 days; GitHub doesn't retain any more history than
+			that.
+		`),
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdViews.NewCmdViews(f, nil))
+	cmd.AddCommand(cmdClones.NewCmdClones(f, nil))
+	cmd.AddCommand(cmdReferrers.NewCmdReferrers(f, nil))
+	cmd.AddCommand(cmdPaths.NewCmdPaths(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,89 @@
+package views
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/repo/traffic/shared"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_viewsRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/traffic/views"),
+		httpmock.StringResponse(`{
+			"count": 30,
+			"uniques": 12,
+			"views": [
+				{"timestamp": "2024-01-01T00:00:00Z", "count": 10, "uniques": 5},
+				{"timestamp": "2024-01-02T00:00:00Z", "count": 20, "uniques": 7}
+			]
+		}`))
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	opts := &ViewsOptions{
+		IO:         ios,
+		HttpClient: func() (*http.Client, error) { return &http.Client{Transport: reg}, nil },
+		BaseRepo:   func() (ghrepo.Interface, error) { return ghrepo.New("OWNER", "REPO"), nil },
+		Per:        "day",
+	}
+
+	require.NoError(t, viewsRun(opts))
+
+	out := stdout.String()
+	assert.Contains(t, out, "OWNER/REPO: 30 views, 12 unique visitors")
+	assert.Contains(t, out, "2024-01-01")
+	assert.Contains(t, out, "2024-01-02")
+}
+
+func Test_viewsRun_dateRange(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/traffic/views"),
+		httpmock.StringResponse(`{
+			"count": 30,
+			"uniques": 12,
+			"views": [
+				{"timestamp": "2024-01-01T00:00:00Z", "count": 10, "uniques": 5},
+				{"timestamp": "2024-01-02T00:00:00Z", "count": 20, "uniques": 7}
+			]
+		}`))
+
+	ios, _, stdout, _ := iostreams.Test()
+
+	opts := &ViewsOptions{
+		IO:         ios,
+		HttpClient: func() (*http.Client, error) { return &http.Client{Transport: reg}, nil },
+		BaseRepo:   func() (ghrepo.Interface, error) { return ghrepo.New("OWNER", "REPO"), nil },
+		Per:        "day",
+		DateRange:  shared.DateRangeFlags{Since: "2024-01-02"},
+	}
+
+	require.NoError(t, viewsRun(opts))
+
+	out := stdout.String()
+	assert.NotContains(t, out, "2024-01-01")
+	assert.Contains(t, out, "2024-01-02")
+}
+
+func Test_viewsRun_invalidSince(t *testing.T) {
+	ios, _, _, _ := iostreams.Test()
+
+	opts := &ViewsOptions{
+		IO:         ios,
+		HttpClient: func() (*http.Client, error) { return &http.Client{}, nil },
+		BaseRepo:   func() (ghrepo.Interface, error) { return ghrepo.New("OWNER", "REPO"), nil },
+		DateRange:  shared.DateRangeFlags{Since: "not-a-date"},
+	}
+
+	assert.Error(t, viewsRun(opts))
+}
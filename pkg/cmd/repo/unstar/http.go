@@ -0,0 +1,27 @@
+package unstar
+
+import (
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/shurcooL/githubv4"
+)
+
+func unstarRepo(client *http.Client, repo *api.Repository) error {
+	var mutation struct {
+		RemoveStar struct {
+			Starrable struct {
+				ID string
+			}
+		} `graphql:"removeStar(input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"input": githubv4.RemoveStarInput{
+			StarrableID: repo.ID,
+		},
+	}
+
+	gql := api.NewClientFromHTTP(client)
+	return gql.Mutate(repo.RepoHost(), "RemoveStar", &mutation, variables)
+}
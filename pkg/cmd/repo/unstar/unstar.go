@@ -0,0 +1,132 @@
+package unstar
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/cobra"
+)
+
+type UnstarOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (gh.Config, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+	IO         *iostreams.IOStreams
+
+	RepoArgs []string
+}
+
+func NewCmdUnstar(f *cmdutil.Factory, runF func(*UnstarOptions) error) *cobra.Command {
+	opts := &UnstarOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "unstar [<repository>...]",
+		Short: "Unstar a repository",
+		Long: heredoc.Doc(`
+			Unstar one or more GitHub repositories.
+
+			With no argument, unstars the current repository.
+		`),
+		Example: heredoc.Doc(`
+			$ gh repo unstar cli/cli
+			$ gh repo unstar cli/cli cli/go-gh monalisa/octocat
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.RepoArgs = args
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return unstarRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func unstarRun(opts *UnstarOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+	cs := opts.IO.ColorScheme()
+
+	repos, err := resolveRepos(apiClient, opts.Config, opts.BaseRepo, opts.RepoArgs)
+	if err != nil {
+		return err
+	}
+
+	var errs error
+	for _, toUnstar := range repos {
+		fullName := ghrepo.FullName(toUnstar)
+
+		repo, err := api.FetchRepository(apiClient, toUnstar, []string{"id"})
+		if err != nil {
+			fmt.Fprintf(opts.IO.Out, "%s %s: %s\n", cs.FailureIcon(), fullName, err)
+			errs = multierror.Append(errs, fmt.Errorf("%s: %w", fullName, err))
+			continue
+		}
+
+		if err := unstarRepo(httpClient, repo); err != nil {
+			fmt.Fprintf(opts.IO.Out, "%s %s: %s\n", cs.FailureIcon(), fullName, err)
+			errs = multierror.Append(errs, fmt.Errorf("%s: %w", fullName, err))
+			continue
+		}
+
+		fmt.Fprintf(opts.IO.Out, "%s Unstarred %s\n", cs.SuccessIcon(), fullName)
+	}
+
+	return errs
+}
+
+// resolveRepos turns the command's repository arguments into a list of
+// repos to act on, falling back to the base repo when none were given.
+func resolveRepos(apiClient *api.Client, cfg func() (gh.Config, error), baseRepo func() (ghrepo.Interface, error), args []string) ([]ghrepo.Interface, error) {
+	if len(args) == 0 {
+		repo, err := baseRepo()
+		if err != nil {
+			return nil, err
+		}
+		return []ghrepo.Interface{repo}, nil
+	}
+
+	repos := make([]ghrepo.Interface, len(args))
+	for i, arg := range args {
+		selector := arg
+		if !strings.Contains(selector, "/") {
+			c, err := cfg()
+			if err != nil {
+				return nil, err
+			}
+			hostname, _ := c.Authentication().DefaultHost()
+			currentUser, err := api.CurrentLoginName(apiClient, hostname)
+			if err != nil {
+				return nil, err
+			}
+			selector = currentUser + "/" + selector
+		}
+
+		repo, err := ghrepo.FromFullName(selector)
+		if err != nil {
+			return nil, fmt.Errorf("argument error: %w", err)
+		}
+		repos[i] = repo
+	}
+
+	return repos, nil
+}
@@ -0,0 +1,133 @@
+package unstar
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdUnstar(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{
+			name:  "no arguments",
+			input: "",
+			want:  []string{},
+		},
+		{
+			name:  "one repository",
+			input: "cli/cli",
+			want:  []string{"cli/cli"},
+		},
+		{
+			name:  "multiple repositories",
+			input: "cli/cli cli/go-gh",
+			want:  []string{"cli/cli", "cli/go-gh"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{IOStreams: ios}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+
+			var gotOpts *UnstarOptions
+			cmd := NewCmdUnstar(f, func(opts *UnstarOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, gotOpts.RepoArgs)
+		})
+	}
+}
+
+func TestUnstarRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       UnstarOptions
+		httpStubs  func(*httpmock.Registry)
+		wantErr    bool
+		wantStdout string
+	}{
+		{
+			name: "unstars the current repository",
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(`{ "data": { "repository": { "id": "THE-ID" } } }`))
+				reg.Register(
+					httpmock.GraphQL(`mutation RemoveStar\b`),
+					httpmock.StringResponse(`{}`))
+			},
+			wantStdout: "✓ Unstarred OWNER/REPO\n",
+		},
+		{
+			name: "reports a failure and continues",
+			opts: UnstarOptions{RepoArgs: []string{"cli/cli", "cli/go-gh"}},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StatusStringResponse(404, `{"message": "Not Found"}`))
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(`{ "data": { "repository": { "id": "GO-GH-ID" } } }`))
+				reg.Register(
+					httpmock.GraphQL(`mutation RemoveStar\b`),
+					httpmock.StringResponse(`{}`))
+			},
+			wantErr:    true,
+			wantStdout: "X cli/cli: HTTP 404 (https://api.github.com/graphql)\n✓ Unstarred cli/go-gh\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+			defer reg.Verify(t)
+
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			}
+			tt.opts.Config = func() (gh.Config, error) {
+				return config.NewBlankConfig(), nil
+			}
+
+			ios, _, stdout, _ := iostreams.Test()
+			tt.opts.IO = ios
+
+			err := unstarRun(&tt.opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
@@ -62,7 +62,11 @@ var HelpTopics = []helpTopic{
 			%[1]sGH_BROWSER%[1]s, %[1]sBROWSER%[1]s (in order of precedence): the web browser to use for opening links.
 
 			%[1]sGH_DEBUG%[1]s: set to a truthy value to enable verbose output on standard error. Set to %[1]sapi%[1]s
-			to additionally log details of HTTP traffic.
+			to additionally log details of HTTP traffic. Set to %[1]strace%[1]s to record structured JSON events
+			covering command lifecycle, HTTP request timings, git subprocess invocations, and prompts, useful for
+			diagnosing why a command is slow.
+
+			%[1]sGH_DEBUG_FILE%[1]s: the file to write %[1]sGH_DEBUG=trace%[1]s events to. Defaults to standard error.
 
 			%[1]sDEBUG%[1]s (deprecated): set to %[1]s1%[1]s, %[1]strue%[1]s, or %[1]syes%[1]s to enable verbose output on standard
 			error.
@@ -267,6 +271,12 @@ var HelpTopics = []helpTopic{
 
 			- If a command requires authentication, the exit code will be 4
 
+			- If a list command was run with --fail-fast and found no results, the exit code will be 16
+
+			- If a command failed because the GitHub API rate limit was exceeded, the exit code will be 32
+
+			- If a command failed because the network could not be reached, the exit code will be 64
+
 			NOTE: It is possible that a particular command may have more exit codes, so it is a good
 			practice to check documentation for the command if you are relying on exit codes to
 			control some behavior.
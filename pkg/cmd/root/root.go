@@ -14,27 +14,42 @@ import (
 	authCmd "github.com/cli/cli/v2/pkg/cmd/auth"
 	browseCmd "github.com/cli/cli/v2/pkg/cmd/browse"
 	cacheCmd "github.com/cli/cli/v2/pkg/cmd/cache"
+	codeScanningCmd "github.com/cli/cli/v2/pkg/cmd/codescanning"
 	codespaceCmd "github.com/cli/cli/v2/pkg/cmd/codespace"
 	completionCmd "github.com/cli/cli/v2/pkg/cmd/completion"
 	configCmd "github.com/cli/cli/v2/pkg/cmd/config"
+	dashCmd "github.com/cli/cli/v2/pkg/cmd/dash"
+	dependabotCmd "github.com/cli/cli/v2/pkg/cmd/dependabot"
+	deploymentCmd "github.com/cli/cli/v2/pkg/cmd/deployment"
+	doctorCmd "github.com/cli/cli/v2/pkg/cmd/doctor"
+	environmentCmd "github.com/cli/cli/v2/pkg/cmd/environment"
 	extensionCmd "github.com/cli/cli/v2/pkg/cmd/extension"
 	"github.com/cli/cli/v2/pkg/cmd/factory"
 	gistCmd "github.com/cli/cli/v2/pkg/cmd/gist"
 	gpgKeyCmd "github.com/cli/cli/v2/pkg/cmd/gpg-key"
+	interestCmd "github.com/cli/cli/v2/pkg/cmd/interest"
 	issueCmd "github.com/cli/cli/v2/pkg/cmd/issue"
 	labelCmd "github.com/cli/cli/v2/pkg/cmd/label"
+	lfsCmd "github.com/cli/cli/v2/pkg/cmd/lfs"
+	mergequeueCmd "github.com/cli/cli/v2/pkg/cmd/mergequeue"
 	orgCmd "github.com/cli/cli/v2/pkg/cmd/org"
 	prCmd "github.com/cli/cli/v2/pkg/cmd/pr"
 	projectCmd "github.com/cli/cli/v2/pkg/cmd/project"
+	promptStatusCmd "github.com/cli/cli/v2/pkg/cmd/promptstatus"
 	releaseCmd "github.com/cli/cli/v2/pkg/cmd/release"
 	repoCmd "github.com/cli/cli/v2/pkg/cmd/repo"
 	creditsCmd "github.com/cli/cli/v2/pkg/cmd/repo/credits"
 	rulesetCmd "github.com/cli/cli/v2/pkg/cmd/ruleset"
 	runCmd "github.com/cli/cli/v2/pkg/cmd/run"
+	runnerCmd "github.com/cli/cli/v2/pkg/cmd/runner"
 	searchCmd "github.com/cli/cli/v2/pkg/cmd/search"
 	secretCmd "github.com/cli/cli/v2/pkg/cmd/secret"
+	secretScanningCmd "github.com/cli/cli/v2/pkg/cmd/secretscanning"
 	sshKeyCmd "github.com/cli/cli/v2/pkg/cmd/ssh-key"
+	starCmd "github.com/cli/cli/v2/pkg/cmd/star"
 	statusCmd "github.com/cli/cli/v2/pkg/cmd/status"
+	tagCmd "github.com/cli/cli/v2/pkg/cmd/tag"
+	telemetryCmd "github.com/cli/cli/v2/pkg/cmd/telemetry"
 	variableCmd "github.com/cli/cli/v2/pkg/cmd/variable"
 	versionCmd "github.com/cli/cli/v2/pkg/cmd/version"
 	workflowCmd "github.com/cli/cli/v2/pkg/cmd/workflow"
@@ -130,15 +145,24 @@ func NewCmdRoot(f *cmdutil.Factory, version, buildDate string) (*cobra.Command,
 	cmd.AddCommand(creditsCmd.NewCmdCredits(f, nil))
 	cmd.AddCommand(gistCmd.NewCmdGist(f))
 	cmd.AddCommand(gpgKeyCmd.NewCmdGPGKey(f))
+	cmd.AddCommand(interestCmd.NewCmdInterest(f))
 	cmd.AddCommand(completionCmd.NewCmdCompletion(f.IOStreams))
 	cmd.AddCommand(extensionCmd.NewCmdExtension(f))
 	cmd.AddCommand(searchCmd.NewCmdSearch(f))
 	cmd.AddCommand(secretCmd.NewCmdSecret(f))
 	cmd.AddCommand(variableCmd.NewCmdVariable(f))
 	cmd.AddCommand(sshKeyCmd.NewCmdSSHKey(f))
+	cmd.AddCommand(starCmd.NewCmdStar(f))
 	cmd.AddCommand(statusCmd.NewCmdStatus(f, nil))
 	cmd.AddCommand(codespaceCmd.NewCmdCodespace(f))
 	cmd.AddCommand(projectCmd.NewCmdProject(f))
+	cmd.AddCommand(dependabotCmd.NewCmdDependabot(f))
+	cmd.AddCommand(doctorCmd.NewCmdDoctor(f, nil))
+	cmd.AddCommand(codeScanningCmd.NewCmdCodeScanning(f))
+	cmd.AddCommand(secretScanningCmd.NewCmdSecretScanning(f))
+	cmd.AddCommand(promptStatusCmd.NewCmdPromptStatus(f, nil))
+	cmd.AddCommand(dashCmd.NewCmdDash(f, nil))
+	cmd.AddCommand(telemetryCmd.NewCmdTelemetry(f))
 
 	// below here at the commands that require the "intelligent" BaseRepo resolver
 	repoResolvingCmdFactory := *f
@@ -149,12 +173,18 @@ func NewCmdRoot(f *cmdutil.Factory, version, buildDate string) (*cobra.Command,
 	cmd.AddCommand(orgCmd.NewCmdOrg(&repoResolvingCmdFactory))
 	cmd.AddCommand(issueCmd.NewCmdIssue(&repoResolvingCmdFactory))
 	cmd.AddCommand(releaseCmd.NewCmdRelease(&repoResolvingCmdFactory))
+	cmd.AddCommand(tagCmd.NewCmdTag(&repoResolvingCmdFactory))
 	cmd.AddCommand(repoCmd.NewCmdRepo(&repoResolvingCmdFactory))
 	cmd.AddCommand(rulesetCmd.NewCmdRuleset(&repoResolvingCmdFactory))
+	cmd.AddCommand(environmentCmd.NewCmdEnvironment(&repoResolvingCmdFactory))
+	cmd.AddCommand(deploymentCmd.NewCmdDeployment(&repoResolvingCmdFactory))
 	cmd.AddCommand(runCmd.NewCmdRun(&repoResolvingCmdFactory))
+	cmd.AddCommand(runnerCmd.NewCmdRunner(&repoResolvingCmdFactory))
 	cmd.AddCommand(workflowCmd.NewCmdWorkflow(&repoResolvingCmdFactory))
 	cmd.AddCommand(labelCmd.NewCmdLabel(&repoResolvingCmdFactory))
 	cmd.AddCommand(cacheCmd.NewCmdCache(&repoResolvingCmdFactory))
+	cmd.AddCommand(lfsCmd.NewCmdLFS(&repoResolvingCmdFactory))
+	cmd.AddCommand(mergequeueCmd.NewCmdMergeQueue(&repoResolvingCmdFactory))
 	cmd.AddCommand(apiCmd.NewCmdApi(&repoResolvingCmdFactory, nil))
 
 	// Help topics
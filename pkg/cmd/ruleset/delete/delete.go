@@ -0,0 +1,137 @@
+package delete
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/ruleset/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type DeleteOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Config     func() (gh.Config, error)
+	Prompter   cmdutil.DeletionPrompter
+
+	ID           string
+	Organization string
+
+	Confirmed    bool
+	ConfirmToken string
+}
+
+func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Command {
+	opts := &DeleteOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		Prompter:   f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete <ruleset-id>",
+		Short: "Delete a ruleset",
+		Long: heredoc.Doc(`
+			Delete a ruleset from a repository or organization.
+
+			Use the --org flag if you want to delete an organization-level ruleset.
+		`),
+		Example: heredoc.Doc(`
+			# Delete a ruleset configured in the current repository
+			$ gh ruleset delete 43
+
+			# Delete a ruleset configured in a different repository
+			$ gh ruleset delete 23 --repo owner/repo
+
+			# Delete an organization-level ruleset
+			$ gh ruleset delete 23 --org my-org
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repoOverride, _ := cmd.Flags().GetString("repo"); repoOverride != "" && opts.Organization != "" {
+				return cmdutil.FlagErrorf("only one of --repo and --org may be specified")
+			}
+
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			// a string is actually needed later on, so verify that it's numeric
+			// but use the string anyway
+			if _, err := strconv.Atoi(args[0]); err != nil {
+				return cmdutil.FlagErrorf("invalid value for ruleset ID: %v is not an integer", args[0])
+			}
+			opts.ID = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return deleteRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Organization, "org", "o", "", "Organization name if the provided ID is an organization-level ruleset")
+	cmdutil.AddConfirmDeletionFlags(cmd, &opts.Confirmed, &opts.ConfirmToken)
+
+	return cmd
+}
+
+func deleteRun(opts *DeleteOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	var repo ghrepo.Interface
+	var hostname string
+	if opts.Organization == "" {
+		repo, err = opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+		hostname = repo.RepoHost()
+	} else {
+		cfg, err := opts.Config()
+		if err != nil {
+			return err
+		}
+		hostname, _ = cfg.Authentication().DefaultHost()
+	}
+
+	var rs *shared.RulesetREST
+	if opts.Organization != "" {
+		rs, err = getOrgRuleset(httpClient, opts.Organization, opts.ID, hostname)
+	} else {
+		rs, err = getRepoRuleset(httpClient, repo, opts.ID)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := cmdutil.ConfirmDeletion(opts.IO, opts.Prompter, opts.Config, hostname, rs.Name, opts.Confirmed, opts.ConfirmToken); err != nil {
+		return err
+	}
+
+	if opts.Organization != "" {
+		err = deleteOrgRuleset(httpClient, opts.Organization, opts.ID, hostname)
+	} else {
+		err = deleteRepoRuleset(httpClient, repo, opts.ID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete ruleset %s: %w", opts.ID, err)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Deleted ruleset %s\n", cs.SuccessIconWithColor(cs.Red), cs.Bold(rs.Name))
+	}
+
+	return nil
+}
@@ -0,0 +1,244 @@
+package delete
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/prompter"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdDelete(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     string
+		wants    DeleteOptions
+		wantsErr string
+	}{
+		{
+			name:     "no arguments",
+			args:     "",
+			wantsErr: "accepts 1 arg(s), received 0",
+		},
+		{
+			name:     "invalid ID",
+			args:     "not-a-number",
+			wantsErr: "invalid value for ruleset ID: not-a-number is not an integer",
+		},
+		{
+			name: "ID",
+			args: "23",
+			wants: DeleteOptions{
+				ID: "23",
+			},
+		},
+		{
+			name: "org",
+			args: "23 --org my-org",
+			wants: DeleteOptions{
+				ID:           "23",
+				Organization: "my-org",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+			argv, err := shlex.Split(tt.args)
+			assert.NoError(t, err)
+
+			var gotOpts *DeleteOptions
+			cmd := NewCmdDelete(f, func(opts *DeleteOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				assert.EqualError(t, err, tt.wantsErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wants.ID, gotOpts.ID)
+			assert.Equal(t, tt.wants.Organization, gotOpts.Organization)
+		})
+	}
+}
+
+func Test_deleteRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       DeleteOptions
+		isTTY      bool
+		httpStubs  func(*httpmock.Registry)
+		wantStdout string
+	}{
+		{
+			name:  "delete repo ruleset",
+			isTTY: true,
+			opts: DeleteOptions{
+				ID: "42",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/my-owner/repo-name/rulesets/42"),
+					httpmock.FileResponse("../view/fixtures/rulesetViewRepo.json"),
+				)
+				reg.Register(
+					httpmock.REST("DELETE", "repos/my-owner/repo-name/rulesets/42"),
+					httpmock.StatusStringResponse(204, ""),
+				)
+			},
+			wantStdout: "✓ Deleted ruleset Test Ruleset\n",
+		},
+		{
+			name:  "delete org ruleset",
+			isTTY: true,
+			opts: DeleteOptions{
+				ID:           "74",
+				Organization: "my-owner",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "orgs/my-owner/rulesets/74"),
+					httpmock.FileResponse("../view/fixtures/rulesetViewOrg.json"),
+				)
+				reg.Register(
+					httpmock.REST("DELETE", "orgs/my-owner/rulesets/74"),
+					httpmock.StatusStringResponse(204, ""),
+				)
+			},
+			wantStdout: "✓ Deleted ruleset My Org Ruleset\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, stdout, _ := iostreams.Test()
+			ios.SetStdoutTTY(tt.isTTY)
+			ios.SetStdinTTY(tt.isTTY)
+			ios.SetStderrTTY(tt.isTTY)
+
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+
+			tt.opts.IO = ios
+			tt.opts.Confirmed = true
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			tt.opts.Config = func() (gh.Config, error) { return config.NewBlankConfig(), nil }
+			if tt.opts.Organization == "" {
+				tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+					return ghrepo.FromFullName("my-owner/repo-name")
+				}
+			}
+
+			err := deleteRun(&tt.opts)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
+
+func Test_deleteRun_confirmation(t *testing.T) {
+	t.Run("non-interactive without --yes or --confirm-token errors", func(t *testing.T) {
+		reg := &httpmock.Registry{}
+		reg.Register(
+			httpmock.REST("GET", "repos/my-owner/repo-name/rulesets/42"),
+			httpmock.FileResponse("../view/fixtures/rulesetViewRepo.json"),
+		)
+		defer reg.Verify(t)
+
+		ios, _, _, _ := iostreams.Test()
+		err := deleteRun(&DeleteOptions{
+			IO: ios,
+			ID: "42",
+			HttpClient: func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			},
+			BaseRepo: func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("my-owner/repo-name")
+			},
+		})
+		assert.EqualError(t, err, "--yes or --confirm-token required when not running interactively")
+	})
+
+	t.Run("interactive prompts to type the ruleset name", func(t *testing.T) {
+		reg := &httpmock.Registry{}
+		reg.Register(
+			httpmock.REST("GET", "repos/my-owner/repo-name/rulesets/42"),
+			httpmock.FileResponse("../view/fixtures/rulesetViewRepo.json"),
+		)
+		reg.Register(
+			httpmock.REST("DELETE", "repos/my-owner/repo-name/rulesets/42"),
+			httpmock.StatusStringResponse(204, ""),
+		)
+		defer reg.Verify(t)
+
+		ios, _, _, _ := iostreams.Test()
+		ios.SetStdinTTY(true)
+		ios.SetStdoutTTY(true)
+
+		pm := prompter.NewMockPrompter(t)
+		pm.RegisterConfirmDeletion("Test Ruleset", func(_ string) error { return nil })
+
+		err := deleteRun(&DeleteOptions{
+			IO:       ios,
+			Prompter: pm,
+			ID:       "42",
+			HttpClient: func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			},
+			BaseRepo: func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("my-owner/repo-name")
+			},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("confirm-token matching the ruleset name skips the prompt", func(t *testing.T) {
+		reg := &httpmock.Registry{}
+		reg.Register(
+			httpmock.REST("GET", "repos/my-owner/repo-name/rulesets/42"),
+			httpmock.FileResponse("../view/fixtures/rulesetViewRepo.json"),
+		)
+		reg.Register(
+			httpmock.REST("DELETE", "repos/my-owner/repo-name/rulesets/42"),
+			httpmock.StatusStringResponse(204, ""),
+		)
+		defer reg.Verify(t)
+
+		ios, _, _, _ := iostreams.Test()
+
+		err := deleteRun(&DeleteOptions{
+			IO:           ios,
+			ID:           "42",
+			ConfirmToken: "Test Ruleset",
+			HttpClient: func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			},
+			BaseRepo: func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("my-owner/repo-name")
+			},
+		})
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,47 @@
+package delete
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/ruleset/shared"
+)
+
+func getRepoRuleset(httpClient *http.Client, repo ghrepo.Interface, databaseId string) (*shared.RulesetREST, error) {
+	path := fmt.Sprintf("repos/%s/%s/rulesets/%s", repo.RepoOwner(), repo.RepoName(), databaseId)
+	return getRuleset(httpClient, repo.RepoHost(), path)
+}
+
+func getOrgRuleset(httpClient *http.Client, orgLogin string, databaseId string, host string) (*shared.RulesetREST, error) {
+	path := fmt.Sprintf("orgs/%s/rulesets/%s", orgLogin, databaseId)
+	return getRuleset(httpClient, host, path)
+}
+
+func getRuleset(httpClient *http.Client, hostname string, path string) (*shared.RulesetREST, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	result := shared.RulesetREST{}
+
+	err := apiClient.REST(hostname, "GET", path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func deleteRepoRuleset(httpClient *http.Client, repo ghrepo.Interface, databaseId string) error {
+	path := fmt.Sprintf("repos/%s/%s/rulesets/%s", repo.RepoOwner(), repo.RepoName(), databaseId)
+	return deleteRuleset(httpClient, repo.RepoHost(), path)
+}
+
+func deleteOrgRuleset(httpClient *http.Client, orgLogin string, databaseId string, host string) error {
+	path := fmt.Sprintf("orgs/%s/rulesets/%s", orgLogin, databaseId)
+	return deleteRuleset(httpClient, host, path)
+}
+
+func deleteRuleset(httpClient *http.Client, hostname string, path string) error {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	return apiClient.REST(hostname, "DELETE", path, nil, nil)
+}
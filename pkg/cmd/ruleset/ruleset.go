@@ -3,6 +3,7 @@ package ruleset
 import (
 	"github.com/MakeNowJust/heredoc"
 	cmdCheck "github.com/cli/cli/v2/pkg/cmd/ruleset/check"
+	cmdDelete "github.com/cli/cli/v2/pkg/cmd/ruleset/delete"
 	cmdList "github.com/cli/cli/v2/pkg/cmd/ruleset/list"
 	cmdView "github.com/cli/cli/v2/pkg/cmd/ruleset/view"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -12,16 +13,17 @@ import (
 func NewCmdRuleset(f *cmdutil.Factory) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "ruleset <command>",
-		Short: "View info about repo rulesets",
+		Short: "View and delete repo rulesets",
 		Long: heredoc.Doc(`
 			Repository rulesets are a way to define a set of rules that apply to a repository.
-			These commands allow you to view information about them.
+			These commands allow you to view and delete them.
 		`),
 		Aliases: []string{"rs"},
 		Example: heredoc.Doc(`
 			$ gh ruleset list
 			$ gh ruleset view --repo OWNER/REPO --web
 			$ gh ruleset check branch-name
+			$ gh ruleset delete 23
 		`),
 	}
 
@@ -29,6 +31,7 @@ func NewCmdRuleset(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(cmdList.NewCmdList(f, nil))
 	cmd.AddCommand(cmdView.NewCmdView(f, nil))
 	cmd.AddCommand(cmdCheck.NewCmdCheck(f, nil))
+	cmd.AddCommand(cmdDelete.NewCmdDelete(f, nil))
 
 	return cmd
 }
@@ -5,24 +5,41 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
+	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/text"
 	"github.com/cli/cli/v2/pkg/cmd/run/shared"
+	workflowShared "github.com/cli/cli/v2/pkg/cmd/workflow/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/hashicorp/go-multierror"
 	"github.com/spf13/cobra"
 )
 
+type iprompter interface {
+	shared.Prompter
+	Confirm(string, bool) (bool, error)
+}
+
 type CancelOptions struct {
 	HttpClient func() (*http.Client, error)
 	IO         *iostreams.IOStreams
 	BaseRepo   func() (ghrepo.Interface, error)
-	Prompter   shared.Prompter
+	Prompter   iprompter
 
 	Prompt bool
 
 	RunID string
+
+	WorkflowSelector string
+	Branch           string
+	Event            string
+	Status           string
+	OlderThan        time.Duration
+	SkipConfirm      bool
 }
 
 func NewCmdCancel(f *cmdutil.Factory, runF func(*CancelOptions) error) *cobra.Command {
@@ -35,15 +52,39 @@ func NewCmdCancel(f *cmdutil.Factory, runF func(*CancelOptions) error) *cobra.Co
 	cmd := &cobra.Command{
 		Use:   "cancel [<run-id>]",
 		Short: "Cancel a workflow run",
-		Args:  cobra.MaximumNArgs(1),
+		Long: heredoc.Doc(`
+			Cancel a workflow run, either by ID or, with at least one filter flag,
+			in bulk across all matching queued or in-progress runs.
+
+			Bulk cancellation asks for confirmation before cancelling anything
+			unless --yes is given.
+		`),
+		Example: heredoc.Doc(`
+			# cancel a single run
+			$ gh run cancel 12345
+
+			# cancel every queued or in-progress run of a workflow
+			$ gh run cancel --workflow ci.yml --status queued
+
+			# cancel stuck runs older than a day on a branch
+			$ gh run cancel --branch feature-1 --older-than 24h
+		`),
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// support `-R, --repo` override
 			opts.BaseRepo = f.BaseRepo
 
+			bulk := opts.WorkflowSelector != "" || opts.Branch != "" || opts.Event != "" || opts.Status != "" || opts.OlderThan > 0
+
 			if len(args) > 0 {
+				if bulk {
+					return cmdutil.FlagErrorf("cannot specify both a run ID and filter flags")
+				}
 				opts.RunID = args[0]
+			} else if bulk {
+				// filters alone are enough to proceed; no run ID or prompt needed
 			} else if !opts.IO.CanPrompt() {
-				return cmdutil.FlagErrorf("run ID required when not running interactively")
+				return cmdutil.FlagErrorf("run ID or a filter flag required when not running interactively")
 			} else {
 				opts.Prompt = true
 			}
@@ -52,10 +93,20 @@ func NewCmdCancel(f *cmdutil.Factory, runF func(*CancelOptions) error) *cobra.Co
 				return runF(opts)
 			}
 
+			if bulk {
+				return runBulkCancel(opts)
+			}
 			return runCancel(opts)
 		},
 	}
 
+	cmd.Flags().StringVarP(&opts.WorkflowSelector, "workflow", "w", "", "Cancel runs for a specific workflow")
+	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "Cancel runs for a specific branch")
+	cmd.Flags().StringVarP(&opts.Event, "event", "e", "", "Cancel runs for a specific `event` type")
+	cmdutil.StringEnumFlag(cmd, &opts.Status, "status", "s", "", []string{string(shared.Queued), string(shared.InProgress)}, "Cancel runs with this status")
+	cmd.Flags().DurationVar(&opts.OlderThan, "older-than", 0, "Cancel only runs started more than this long ago, e.g. \"24h\"")
+	cmd.Flags().BoolVarP(&opts.SkipConfirm, "yes", "y", false, "Skip the confirmation prompt")
+
 	return cmd
 }
 
@@ -132,6 +183,89 @@ func runCancel(opts *CancelOptions) error {
 	return nil
 }
 
+func runBulkCancel(opts *CancelOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("failed to create http client: %w", err)
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return fmt.Errorf("failed to determine base repo: %w", err)
+	}
+
+	filters := &shared.FilterOptions{
+		Branch: opts.Branch,
+		Event:  opts.Event,
+	}
+	if opts.WorkflowSelector != "" {
+		workflow, err := workflowShared.ResolveWorkflow(opts.Prompter, opts.IO, client, repo, false, opts.WorkflowSelector, []workflowShared.WorkflowState{workflowShared.Active})
+		if err != nil {
+			return err
+		}
+		filters.WorkflowID = workflow.ID
+		filters.WorkflowName = workflow.Name
+	}
+
+	cutoff := time.Now().Add(-opts.OlderThan)
+	runs, err := shared.GetRunsWithFilter(client, repo, filters, 1000, func(run shared.Run) bool {
+		if run.Status == shared.Completed {
+			return false
+		}
+		if opts.Status != "" && string(run.Status) != opts.Status {
+			return false
+		}
+		if opts.OlderThan > 0 && run.StartedTime().After(cutoff) {
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get runs: %w", err)
+	}
+
+	cs := opts.IO.ColorScheme()
+
+	if len(runs) == 0 {
+		fmt.Fprintln(opts.IO.Out, "no matching runs to cancel")
+		return nil
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.ErrOut, "This will cancel %s:\n", text.Pluralize(len(runs), "run"))
+		for _, run := range runs {
+			fmt.Fprintf(opts.IO.ErrOut, "  #%d %s, %s (%s)\n", run.ID, run.Title(), run.WorkflowName(), run.HeadBranch)
+		}
+	}
+
+	if !opts.SkipConfirm && opts.IO.CanPrompt() {
+		confirmed, err := opts.Prompter.Confirm(fmt.Sprintf("Cancel %s?", text.Pluralize(len(runs), "run")), false)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return cmdutil.CancelError
+		}
+	}
+
+	var cancelErrs error
+	for _, run := range runs {
+		runID := fmt.Sprintf("%d", run.ID)
+		if err := cancelWorkflowRun(client, repo, runID); err != nil {
+			var httpErr api.HTTPError
+			if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusConflict {
+				err = fmt.Errorf("run %s is already completed", runID)
+			}
+			cancelErrs = multierror.Append(cancelErrs, fmt.Errorf("failed to cancel run %s: %w", runID, err))
+			continue
+		}
+		fmt.Fprintf(opts.IO.Out, "%s Request to cancel workflow %s submitted.\n", cs.SuccessIcon(), runID)
+	}
+
+	return cancelErrs
+}
+
 func cancelWorkflowRun(client *api.Client, repo ghrepo.Interface, runID string) error {
 	path := fmt.Sprintf("repos/%s/actions/runs/%s/cancel", ghrepo.FullName(repo), runID)
 
@@ -43,6 +43,19 @@ func TestNewCmdCancel(t *testing.T) {
 				RunID: "1234",
 			},
 		},
+		{
+			name: "with filter flags",
+			cli:  "--workflow ci.yml --status queued",
+			wants: CancelOptions{
+				WorkflowSelector: "ci.yml",
+				Status:           "queued",
+			},
+		},
+		{
+			name:     "run id and filter flags",
+			cli:      "1234 --branch trunk",
+			wantsErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -78,6 +91,8 @@ func TestNewCmdCancel(t *testing.T) {
 			assert.NoError(t, err)
 
 			assert.Equal(t, tt.wants.RunID, gotOpts.RunID)
+			assert.Equal(t, tt.wants.WorkflowSelector, gotOpts.WorkflowSelector)
+			assert.Equal(t, tt.wants.Status, gotOpts.Status)
 		})
 	}
 }
@@ -251,3 +266,132 @@ func TestRunCancel(t *testing.T) {
 		})
 	}
 }
+
+func TestRunBulkCancel(t *testing.T) {
+	queuedRun := shared.TestRun(1111, shared.Queued, "")
+	inProgressRun := shared.TestRun(2222, shared.InProgress, "")
+	completedRun := shared.TestRun(3333, shared.Completed, shared.Failure)
+
+	tests := []struct {
+		name        string
+		opts        *CancelOptions
+		httpStubs   func(*httpmock.Registry)
+		promptStubs func(*prompter.MockPrompter)
+		wantErr     bool
+		errMsg      string
+		wantOut     string
+	}{
+		{
+			name: "cancel matching runs",
+			opts: &CancelOptions{
+				Status:      string(shared.Queued),
+				SkipConfirm: true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs"),
+					httpmock.JSONResponse(shared.RunsPayload{
+						WorkflowRuns: []shared.Run{queuedRun, inProgressRun, completedRun},
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows"),
+					httpmock.JSONResponse(workflowShared.WorkflowsPayload{
+						Workflows: []workflowShared.Workflow{
+							shared.TestWorkflow,
+						},
+					}))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/1111/cancel"),
+					httpmock.StatusStringResponse(202, "{}"))
+			},
+			wantOut: "✓ Request to cancel workflow 1111 submitted.\n",
+		},
+		{
+			name: "no matching runs",
+			opts: &CancelOptions{
+				Status:      string(shared.Queued),
+				SkipConfirm: true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs"),
+					httpmock.JSONResponse(shared.RunsPayload{
+						WorkflowRuns: []shared.Run{completedRun},
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows"),
+					httpmock.JSONResponse(workflowShared.WorkflowsPayload{
+						Workflows: []workflowShared.Workflow{
+							shared.TestWorkflow,
+						},
+					}))
+			},
+			wantOut: "no matching runs to cancel\n",
+		},
+		{
+			name: "declined confirmation",
+			opts: &CancelOptions{
+				Status: string(shared.Queued),
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs"),
+					httpmock.JSONResponse(shared.RunsPayload{
+						WorkflowRuns: []shared.Run{queuedRun},
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows"),
+					httpmock.JSONResponse(workflowShared.WorkflowsPayload{
+						Workflows: []workflowShared.Workflow{
+							shared.TestWorkflow,
+						},
+					}))
+			},
+			promptStubs: func(pm *prompter.MockPrompter) {
+				pm.RegisterConfirm("Cancel 1 run?", func(_ string, _ bool) (bool, error) {
+					return false, nil
+				})
+			},
+			wantErr: true,
+			errMsg:  "CancelError",
+		},
+	}
+
+	for _, tt := range tests {
+		reg := &httpmock.Registry{}
+		if tt.httpStubs != nil {
+			tt.httpStubs(reg)
+		}
+		tt.opts.HttpClient = func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		}
+
+		ios, _, stdout, _ := iostreams.Test()
+		ios.SetStdoutTTY(true)
+		ios.SetStdinTTY(true)
+		tt.opts.IO = ios
+		tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		}
+
+		pm := prompter.NewMockPrompter(t)
+		tt.opts.Prompter = pm
+		if tt.promptStubs != nil {
+			tt.promptStubs(pm)
+		}
+
+		t.Run(tt.name, func(t *testing.T) {
+			err := runBulkCancel(tt.opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errMsg != "" {
+					assert.Equal(t, tt.errMsg, err.Error())
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantOut, stdout.String())
+			reg.Verify(t)
+		})
+	}
+}
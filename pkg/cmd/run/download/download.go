@@ -27,7 +27,7 @@ type DownloadOptions struct {
 
 type platform interface {
 	List(runID string) ([]shared.Artifact, error)
-	Download(url string, dir string) error
+	Download(url string, digest shared.ArtifactDigest, dir string) error
 }
 type iprompter interface {
 	MultiSelect(string, []string, []string) ([]int, error)
@@ -165,7 +165,7 @@ func runDownload(opts *DownloadOptions) error {
 		if len(wantPatterns) != 0 || len(wantNames) != 1 {
 			destDir = filepath.Join(destDir, a.Name)
 		}
-		err := opts.Platform.Download(a.DownloadURL, destDir)
+		err := opts.Platform.Download(a.DownloadURL, a.Digest, destDir)
 		if err != nil {
 			return fmt.Errorf("error downloading %s: %w", a.Name, err)
 		}
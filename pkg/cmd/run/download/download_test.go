@@ -176,8 +176,8 @@ func Test_runDownload(t *testing.T) {
 						Expired:     false,
 					},
 				}, nil)
-				p.On("Download", "http://download.com/artifact1.zip", filepath.FromSlash("tmp/artifact-1")).Return(nil)
-				p.On("Download", "http://download.com/artifact2.zip", filepath.FromSlash("tmp/artifact-2")).Return(nil)
+				p.On("Download", "http://download.com/artifact1.zip", shared.ArtifactDigest{}, filepath.FromSlash("tmp/artifact-1")).Return(nil)
+				p.On("Download", "http://download.com/artifact2.zip", shared.ArtifactDigest{}, filepath.FromSlash("tmp/artifact-2")).Return(nil)
 			},
 		},
 		{
@@ -280,7 +280,7 @@ func Test_runDownload(t *testing.T) {
 						Expired:     false,
 					},
 				}, nil)
-				p.On("Download", "http://download.com/artifact2.zip", ".").Return(nil)
+				p.On("Download", "http://download.com/artifact2.zip", shared.ArtifactDigest{}, ".").Return(nil)
 			},
 			promptStubs: func(pm *prompter.MockPrompter) {
 				pm.RegisterMultiSelect("Select artifacts to download:", nil, []string{"artifact-1", "artifact-2"},
@@ -337,7 +337,7 @@ func (p *mockPlatform) List(runID string) ([]shared.Artifact, error) {
 	return args.Get(0).([]shared.Artifact), args.Error(1)
 }
 
-func (p *mockPlatform) Download(url string, dir string) error {
-	args := p.Called(url, dir)
+func (p *mockPlatform) Download(url string, digest shared.ArtifactDigest, dir string) error {
+	args := p.Called(url, digest, dir)
 	return args.Error(0)
 }
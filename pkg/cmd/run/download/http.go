@@ -2,10 +2,14 @@ package download
 
 import (
 	"archive/zip"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"hash"
 	"io"
 	"net/http"
 	"os"
+	"strings"
 
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghrepo"
@@ -21,11 +25,11 @@ func (p *apiPlatform) List(runID string) ([]shared.Artifact, error) {
 	return shared.ListArtifacts(p.client, p.repo, runID)
 }
 
-func (p *apiPlatform) Download(url string, dir string) error {
-	return downloadArtifact(p.client, url, dir)
+func (p *apiPlatform) Download(url string, digest shared.ArtifactDigest, dir string) error {
+	return downloadArtifact(p.client, url, digest, dir)
 }
 
-func downloadArtifact(httpClient *http.Client, url, destDir string) error {
+func downloadArtifact(httpClient *http.Client, url string, digest shared.ArtifactDigest, destDir string) error {
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return err
@@ -52,11 +56,16 @@ func downloadArtifact(httpClient *http.Client, url, destDir string) error {
 		_ = os.Remove(tmpfile.Name())
 	}()
 
-	size, err := io.Copy(tmpfile, resp.Body)
+	hasher := sha256.New()
+	size, err := io.Copy(io.MultiWriter(tmpfile, hasher), resp.Body)
 	if err != nil {
 		return fmt.Errorf("error writing zip archive: %w", err)
 	}
 
+	if err := verifyDigest(digest, hasher); err != nil {
+		return err
+	}
+
 	zipfile, err := zip.NewReader(tmpfile, size)
 	if err != nil {
 		return fmt.Errorf("error extracting zip archive: %w", err)
@@ -67,3 +76,20 @@ func downloadArtifact(httpClient *http.Client, url, destDir string) error {
 
 	return nil
 }
+
+// verifyDigest checks the downloaded zip's SHA-256 checksum against the
+// digest reported by the artifact API, when one was reported. Artifacts
+// uploaded before GitHub started reporting digests, or digests using an
+// algorithm we don't know how to compute, are left unverified.
+func verifyDigest(digest shared.ArtifactDigest, hasher hash.Hash) error {
+	if digest.Value == "" || digest.Algorithm != "sha256" {
+		return nil
+	}
+
+	expected := strings.TrimPrefix(digest.Value, "sha256:")
+	actual := hex.EncodeToString(hasher.Sum(nil))
+	if !strings.EqualFold(expected, actual) {
+		return fmt.Errorf("checksum mismatch: expected %s, got %s; the downloaded artifact may be corrupted or tampered with", expected, actual)
+	}
+	return nil
+}
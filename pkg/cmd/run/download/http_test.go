@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/run/shared"
 	"github.com/cli/cli/v2/pkg/httpmock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -70,7 +71,7 @@ func Test_Download(t *testing.T) {
 	api := &apiPlatform{
 		client: &http.Client{Transport: reg},
 	}
-	err := api.Download("https://api.github.com/repos/OWNER/REPO/actions/artifacts/12345/zip", destDir)
+	err := api.Download("https://api.github.com/repos/OWNER/REPO/actions/artifacts/12345/zip", shared.ArtifactDigest{}, destDir)
 	require.NoError(t, err)
 
 	var paths []string
@@ -104,3 +105,49 @@ func Test_Download(t *testing.T) {
 		filepath.Join("artifact", "src", "util.go"),
 	}, paths)
 }
+
+func Test_Download_digestMatch(t *testing.T) {
+	destDir := filepath.Join(t.TempDir(), "artifact")
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/artifacts/12345/zip"),
+		httpmock.FileResponse("./fixtures/myproject.zip"))
+
+	api := &apiPlatform{
+		client: &http.Client{Transport: reg},
+	}
+	digest := shared.ArtifactDigest{
+		Value:     "sha256:5fe0d07082278b12fccb3cf6879268fcdf039e65af70b9c3b14bcc11e18134e1",
+		Algorithm: "sha256",
+	}
+	err := api.Download("https://api.github.com/repos/OWNER/REPO/actions/artifacts/12345/zip", digest, destDir)
+	require.NoError(t, err)
+}
+
+func Test_Download_digestMismatch(t *testing.T) {
+	destDir := filepath.Join(t.TempDir(), "artifact")
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/artifacts/12345/zip"),
+		httpmock.FileResponse("./fixtures/myproject.zip"))
+
+	api := &apiPlatform{
+		client: &http.Client{Transport: reg},
+	}
+	digest := shared.ArtifactDigest{
+		Value:     "sha256:0000000000000000000000000000000000000000000000000000000000000000",
+		Algorithm: "sha256",
+	}
+	err := api.Download("https://api.github.com/repos/OWNER/REPO/actions/artifacts/12345/zip", digest, destDir)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "checksum mismatch")
+
+	_, err = os.Stat(destDir)
+	assert.True(t, os.IsNotExist(err), "expected nothing to be extracted after a checksum mismatch")
+}
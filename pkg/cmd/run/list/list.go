@@ -8,6 +8,7 @@ import (
 	"github.com/MakeNowJust/heredoc"
 
 	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/browser"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/internal/tableprinter"
 	"github.com/cli/cli/v2/pkg/cmd/run/shared"
@@ -25,7 +26,9 @@ type ListOptions struct {
 	IO         *iostreams.IOStreams
 	HttpClient func() (*http.Client, error)
 	BaseRepo   func() (ghrepo.Interface, error)
+	BaseRepos  func() ([]ghrepo.Interface, error)
 	Prompter   iprompter
+	Browser    browser.Browser
 
 	Exporter cmdutil.Exporter
 
@@ -38,6 +41,8 @@ type ListOptions struct {
 	Created          string
 	Commit           string
 	All              bool
+	Interactive      bool
+	FailFast         bool
 
 	now time.Time
 }
@@ -51,6 +56,7 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 		IO:         f.IOStreams,
 		HttpClient: f.HttpClient,
 		Prompter:   f.Prompter,
+		Browser:    f.Browser,
 		now:        time.Now(),
 	}
 
@@ -62,17 +68,27 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 
 			Note that providing the %[1]sworkflow_name%[1]s to the %[1]s-w%[1]s flag will not fetch disabled workflows.
 			Also pass the %[1]s-a%[1]s flag to fetch disabled workflow runs using the %[1]sworkflow_name%[1]s and the %[1]s-w%[1]s flag.
+
+			Pass %[1]s-R/--repo%[1]s more than once, or give it a comma-separated list, to list and
+			merge runs from several repositories at once. The merged table adds a REPO column, and
+			%[1]s--interactive%[1]s and %[1]s--json%[1]s aren't supported in that mode.
 		`, "`"),
 		Aliases: []string{"ls"},
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// support `-R, --repo` override
 			opts.BaseRepo = f.BaseRepo
+			repoOverrides, _ := cmd.Flags().GetStringArray("repo")
+			opts.BaseRepos = cmdutil.BaseReposOverride(f, repoOverrides)
 
 			if opts.Limit < 1 {
 				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
 			}
 
+			if opts.Interactive && opts.Exporter != nil {
+				return cmdutil.FlagErrorf("specify only one of `--interactive` or `--json`")
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -89,8 +105,10 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	cmd.Flags().StringVarP(&opts.Created, "created", "", "", "Filter runs by the `date` it was created")
 	cmd.Flags().StringVarP(&opts.Commit, "commit", "c", "", "Filter runs by the `SHA` of the commit")
 	cmd.Flags().BoolVarP(&opts.All, "all", "a", false, "Include disabled workflows")
+	cmd.Flags().BoolVarP(&opts.Interactive, "interactive", "i", false, "Filter and select a run to view in the browser")
 	cmdutil.StringEnumFlag(cmd, &opts.Status, "status", "s", "", shared.AllStatuses, "Filter runs by status")
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.RunFields)
+	cmdutil.AddFailFastFlag(cmd, &opts.FailFast)
 
 	_ = cmdutil.RegisterBranchCompletionFlags(f.GitClient, cmd, "branch")
 
@@ -98,7 +116,17 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 }
 
 func listRun(opts *ListOptions) error {
-	baseRepo, err := opts.BaseRepo()
+	if opts.BaseRepos == nil {
+		opts.BaseRepos = func() ([]ghrepo.Interface, error) {
+			repo, err := opts.BaseRepo()
+			if err != nil {
+				return nil, err
+			}
+			return []ghrepo.Interface{repo}, nil
+		}
+	}
+
+	baseRepos, err := opts.BaseRepos()
 	if err != nil {
 		return fmt.Errorf("failed to determine base repo: %w", err)
 	}
@@ -109,6 +137,12 @@ func listRun(opts *ListOptions) error {
 	}
 	client := api.NewClientFromHTTP(c)
 
+	if len(baseRepos) > 1 {
+		return listRunMultiRepo(opts, client, baseRepos)
+	}
+
+	baseRepo := baseRepos[0]
+
 	filters := &shared.FilterOptions{
 		Branch:  opts.Branch,
 		Actor:   opts.Actor,
@@ -141,7 +175,17 @@ func listRun(opts *ListOptions) error {
 	}
 	runs := runsResult.WorkflowRuns
 	if len(runs) == 0 && opts.Exporter == nil {
-		return cmdutil.NewNoResultsError("no runs found")
+		return cmdutil.WrapNoResultsError(cmdutil.NewNoResultsError("no runs found"), opts.FailFast)
+	}
+
+	if opts.Exporter != nil {
+		if err := fetchRequestedTimingFields(client, baseRepo, runs, opts.Exporter.Fields()); err != nil {
+			return fmt.Errorf("failed to get run timing metrics: %w", err)
+		}
+	}
+
+	if opts.Interactive {
+		return interactiveOpen(opts, runs)
 	}
 
 	if err := opts.IO.StartPager(); err == nil {
@@ -182,3 +226,143 @@ func listRun(opts *ListOptions) error {
 
 	return nil
 }
+
+// listRunMultiRepo fetches and merges workflow runs across more than one repository, given via
+// repeated or comma-separated `-R/--repo` flags, adding a REPO column so the results stay
+// attributable.
+func listRunMultiRepo(opts *ListOptions, client *api.Client, baseRepos []ghrepo.Interface) error {
+	if opts.Interactive {
+		return cmdutil.FlagErrorf("multiple `--repo` values aren't supported with `--interactive`")
+	}
+	if opts.Exporter != nil {
+		return cmdutil.FlagErrorf("multiple `--repo` values aren't supported with `--json`")
+	}
+
+	filters := &shared.FilterOptions{
+		Branch:  opts.Branch,
+		Actor:   opts.Actor,
+		Status:  opts.Status,
+		Event:   opts.Event,
+		Created: opts.Created,
+		Commit:  opts.Commit,
+	}
+
+	type repoRun struct {
+		repo ghrepo.Interface
+		run  shared.Run
+	}
+
+	var merged []repoRun
+	opts.IO.StartProgressIndicator()
+	for _, baseRepo := range baseRepos {
+		repoFilters := *filters
+		if opts.WorkflowSelector != "" {
+			states := []workflowShared.WorkflowState{workflowShared.Active}
+			if opts.All {
+				states = append(states, workflowShared.DisabledManually, workflowShared.DisabledInactivity)
+			}
+			workflow, err := workflowShared.ResolveWorkflow(opts.Prompter, opts.IO, client, baseRepo, false, opts.WorkflowSelector, states)
+			if err != nil {
+				opts.IO.StopProgressIndicator()
+				return fmt.Errorf("failed to resolve workflow for %s: %w", ghrepo.FullName(baseRepo), err)
+			}
+			repoFilters.WorkflowID = workflow.ID
+			repoFilters.WorkflowName = workflow.Name
+		}
+
+		runsResult, err := shared.GetRuns(client, baseRepo, &repoFilters, opts.Limit)
+		if err != nil {
+			opts.IO.StopProgressIndicator()
+			return fmt.Errorf("failed to get runs for %s: %w", ghrepo.FullName(baseRepo), err)
+		}
+		for _, run := range runsResult.WorkflowRuns {
+			merged = append(merged, repoRun{repo: baseRepo, run: run})
+		}
+	}
+	opts.IO.StopProgressIndicator()
+
+	if len(merged) == 0 {
+		return cmdutil.WrapNoResultsError(cmdutil.NewNoResultsError("no runs found"), opts.FailFast)
+	}
+
+	if err := opts.IO.StartPager(); err == nil {
+		defer opts.IO.StopPager()
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+	}
+
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("REPO", "STATUS", "TITLE", "WORKFLOW", "BRANCH", "EVENT", "ID", "ELAPSED", "AGE"))
+	cs := opts.IO.ColorScheme()
+
+	for _, rr := range merged {
+		tp.AddField(ghrepo.FullName(rr.repo), tableprinter.WithColor(cs.Gray))
+		if tp.IsTTY() {
+			symbol, symbolColor := shared.Symbol(cs, rr.run.Status, rr.run.Conclusion)
+			tp.AddField(symbol, tableprinter.WithColor(symbolColor))
+		} else {
+			tp.AddField(string(rr.run.Status))
+			tp.AddField(string(rr.run.Conclusion))
+		}
+		tp.AddField(rr.run.Title(), tableprinter.WithColor(cs.Bold))
+		tp.AddField(rr.run.WorkflowName())
+		tp.AddField(rr.run.HeadBranch, tableprinter.WithColor(cs.Bold))
+		tp.AddField(string(rr.run.Event))
+		tp.AddField(fmt.Sprintf("%d", rr.run.ID), tableprinter.WithColor(cs.Cyan))
+		tp.AddField(rr.run.Duration(opts.now).String())
+		tp.AddTimeField(opts.now, rr.run.StartedTime(), cs.Gray)
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
+
+// fetchRequestedTimingFields populates each run's Jobs and/or Usage in place, but only by making
+// the extra per-run API calls that the requested --json fields actually need.
+func fetchRequestedTimingFields(client *api.Client, repo ghrepo.Interface, runs []shared.Run, fields []string) error {
+	needsJobs := false
+	needsUsage := false
+	for _, f := range fields {
+		switch f {
+		case "runnerName", "runnerLabels":
+			needsJobs = true
+		case "billableTime":
+			needsUsage = true
+		}
+	}
+
+	if !needsJobs && !needsUsage {
+		return nil
+	}
+
+	for i := range runs {
+		if needsJobs {
+			if _, err := shared.GetJobs(client, repo, &runs[i], 0); err != nil {
+				return err
+			}
+		}
+		if needsUsage {
+			if _, err := shared.GetRunUsage(client, repo, &runs[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// interactiveOpen lets the user filter and pick a single run from labels,
+// then opens it in the web browser, so they don't have to copy an ID out of
+// the list first.
+func interactiveOpen(opts *ListOptions, runs []shared.Run) error {
+	labels := make([]string, len(runs))
+	for i, run := range runs {
+		labels[i] = fmt.Sprintf("%s %s, %s (%s)", run.Status, run.Title(), run.WorkflowName(), run.HeadBranch)
+	}
+
+	index, err := cmdutil.SelectFromList(opts.IO, opts.Prompter, "Select a run", labels)
+	if err != nil {
+		return err
+	}
+
+	return opts.Browser.Browse(runs[index].URL)
+}
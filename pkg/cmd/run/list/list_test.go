@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/cmd/run/shared"
 	workflowShared "github.com/cli/cli/v2/pkg/cmd/workflow/shared"
@@ -18,6 +19,7 @@ import (
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/google/shlex"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewCmdList(t *testing.T) {
@@ -680,3 +682,107 @@ func TestListRun(t *testing.T) {
 		})
 	}
 }
+
+func TestListRun_multiRepo(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "repos/owner/one/actions/runs"),
+		httpmock.JSONResponse(shared.RunsPayload{
+			WorkflowRuns: []shared.Run{
+				shared.TestRunWithWorkflowAndCommit(123, 101, shared.Completed, shared.Success, "from one"),
+			},
+		}))
+	reg.Register(
+		httpmock.REST("GET", "repos/owner/one/actions/workflows"),
+		httpmock.JSONResponse(workflowShared.WorkflowsPayload{
+			Workflows: []workflowShared.Workflow{shared.TestWorkflow},
+		}))
+	reg.Register(
+		httpmock.REST("GET", "repos/owner/two/actions/runs"),
+		httpmock.JSONResponse(shared.RunsPayload{
+			WorkflowRuns: []shared.Run{
+				shared.TestRunWithWorkflowAndCommit(123, 202, shared.Completed, shared.Failure, "from two"),
+			},
+		}))
+	reg.Register(
+		httpmock.REST("GET", "repos/owner/two/actions/workflows"),
+		httpmock.JSONResponse(workflowShared.WorkflowsPayload{
+			Workflows: []workflowShared.Workflow{shared.TestWorkflow},
+		}))
+
+	ios, _, stdout, stderr := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	opts := &ListOptions{
+		Limit: defaultLimit,
+		now:   shared.TestRunStartTime.Add(time.Minute*4 + time.Second*34),
+		IO:    ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepos: func() ([]ghrepo.Interface, error) {
+			return []ghrepo.Interface{
+				ghrepo.New("owner", "one"),
+				ghrepo.New("owner", "two"),
+			}, nil
+		},
+	}
+
+	err := listRun(opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		REPO       STATUS  TITLE     WORKFLOW  BRANCH  EVENT  ID   ELAPSED  AGE
+		owner/one  ✓       from one  CI        trunk   push   101  4m34s    about 4 m...
+		owner/two  X       from two  CI        trunk   push   202  4m34s    about 4 m...
+	`), stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
+
+func TestFetchRequestedTimingFields(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields []string
+		stubs  func(*httpmock.Registry)
+	}{
+		{
+			name:   "no timing fields requested makes no extra calls",
+			fields: []string{"databaseId"},
+			stubs:  func(reg *httpmock.Registry) {},
+		},
+		{
+			name:   "runnerName requests jobs only",
+			fields: []string{"runnerName"},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "runs/1/jobs"),
+					httpmock.JSONResponse(shared.JobsPayload{}))
+			},
+		},
+		{
+			name:   "billableTime requests usage only",
+			fields: []string{"billableTime"},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1/timing"),
+					httpmock.JSONResponse(shared.WorkflowRunUsage{}))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			tt.stubs(reg)
+
+			client := api.NewClientFromHTTP(&http.Client{Transport: reg})
+			repo, _ := ghrepo.FromFullName("OWNER/REPO")
+			runs := []shared.Run{{ID: 1, JobsURL: "https://api.github.com/runs/1/jobs"}}
+
+			assert.NoError(t, fetchRequestedTimingFields(client, repo, runs, tt.fields))
+		})
+	}
+}
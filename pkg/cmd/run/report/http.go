@@ -0,0 +1,67 @@
+package report
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/run/shared"
+)
+
+var errArtifactNotFound = errors.New("no matching artifact found")
+
+// summarizeRunArtifact downloads the named artifact from a run and summarizes the test report
+// it contains. It returns errArtifactNotFound if the run has no unexpired artifact by that name.
+func summarizeRunArtifact(httpClient *http.Client, repo ghrepo.Interface, runID, artifactName string) (*Summary, error) {
+	artifacts, err := shared.ListArtifacts(httpClient, repo, runID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching artifacts: %w", err)
+	}
+
+	var artifact *shared.Artifact
+	for i, a := range artifacts {
+		if a.Name == artifactName && !a.Expired {
+			artifact = &artifacts[i]
+			break
+		}
+	}
+	if artifact == nil {
+		return nil, errArtifactNotFound
+	}
+
+	zr, err := downloadArtifactZip(httpClient, artifact.DownloadURL)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading %s: %w", artifactName, err)
+	}
+
+	return summarizeZip(zr)
+}
+
+func downloadArtifactZip(httpClient *http.Client, url string) (*zip.Reader, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading artifact: %w", err)
+	}
+
+	return zip.NewReader(bytes.NewReader(data), int64(len(data)))
+}
@@ -0,0 +1,183 @@
+package report
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Summary is the aggregated result of parsing a test-report artifact.
+type Summary struct {
+	Total    int
+	Passed   int
+	Failed   int
+	Skipped  int
+	Duration time.Duration
+	Tests    []TestResult
+}
+
+type TestResult struct {
+	Name     string
+	Duration time.Duration
+	Failed   bool
+	Skipped  bool
+}
+
+func (s *Summary) addResult(name string, duration time.Duration, status string) {
+	result := TestResult{Name: name, Duration: duration}
+
+	switch strings.ToLower(status) {
+	case "failed", "failure", "error":
+		result.Failed = true
+		s.Failed++
+	case "skipped", "skip":
+		result.Skipped = true
+		s.Skipped++
+	default:
+		s.Passed++
+	}
+
+	s.Total++
+	s.Duration += duration
+	s.Tests = append(s.Tests, result)
+}
+
+// summarizeZip parses every JUnit XML or JSON test report found in a zip archive and
+// aggregates their results. It returns an error if the archive contains no recognizable
+// test report.
+func summarizeZip(zr *zip.Reader) (*Summary, error) {
+	summary := &Summary{}
+	found := false
+
+	for _, f := range zr.File {
+		switch strings.ToLower(filepath.Ext(f.Name)) {
+		case ".xml":
+			if err := summarizeJUnitXML(f, summary); err != nil {
+				return nil, fmt.Errorf("error parsing %s: %w", f.Name, err)
+			}
+			found = true
+		case ".json":
+			if err := summarizeJUnitJSON(f, summary); err != nil {
+				return nil, fmt.Errorf("error parsing %s: %w", f.Name, err)
+			}
+			found = true
+		}
+	}
+
+	if !found {
+		return nil, errors.New("no JUnit XML or JSON test report found in artifact")
+	}
+
+	sort.SliceStable(summary.Tests, func(i, j int) bool {
+		return summary.Tests[i].Duration > summary.Tests[j].Duration
+	})
+
+	return summary, nil
+}
+
+type junitTestCase struct {
+	Name      string    `xml:"name,attr"`
+	ClassName string    `xml:"classname,attr"`
+	Time      float64   `xml:"time,attr"`
+	Failure   *struct{} `xml:"failure"`
+	Error     *struct{} `xml:"error"`
+	Skipped   *struct{} `xml:"skipped"`
+}
+
+type junitTestSuite struct {
+	XMLName   xml.Name        `xml:"testsuite"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestSuites struct {
+	XMLName    xml.Name         `xml:"testsuites"`
+	TestSuites []junitTestSuite `xml:"testsuite"`
+}
+
+func summarizeJUnitXML(f *zip.File, summary *Summary) error {
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return err
+	}
+
+	var suites junitTestSuites
+	if err := xml.Unmarshal(data, &suites); err == nil {
+		for _, suite := range suites.TestSuites {
+			addJUnitSuite(summary, suite)
+		}
+		return nil
+	}
+
+	var suite junitTestSuite
+	if err := xml.Unmarshal(data, &suite); err != nil {
+		return err
+	}
+	addJUnitSuite(summary, suite)
+
+	return nil
+}
+
+func addJUnitSuite(summary *Summary, suite junitTestSuite) {
+	for _, tc := range suite.TestCases {
+		name := tc.Name
+		if tc.ClassName != "" {
+			name = fmt.Sprintf("%s.%s", tc.ClassName, tc.Name)
+		}
+
+		status := "passed"
+		if tc.Failure != nil || tc.Error != nil {
+			status = "failed"
+		} else if tc.Skipped != nil {
+			status = "skipped"
+		}
+
+		summary.addResult(name, time.Duration(tc.Time*float64(time.Second)), status)
+	}
+}
+
+type jsonTestReport struct {
+	Tests []jsonTestCase `json:"tests"`
+}
+
+type jsonTestCase struct {
+	Name      string  `json:"name"`
+	ClassName string  `json:"classname"`
+	Time      float64 `json:"time"`
+	Status    string  `json:"status"`
+}
+
+func summarizeJUnitJSON(f *zip.File, summary *Summary) error {
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	var report jsonTestReport
+	if err := json.NewDecoder(rc).Decode(&report); err != nil {
+		return err
+	}
+
+	for _, tc := range report.Tests {
+		name := tc.Name
+		if tc.ClassName != "" {
+			name = fmt.Sprintf("%s.%s", tc.ClassName, tc.Name)
+		}
+		summary.addResult(name, time.Duration(tc.Time*float64(time.Second)), tc.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package report
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func zipFile(t *testing.T, files map[string]string) *zip.Reader {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	return zr
+}
+
+func Test_summarizeZip_junitSuites(t *testing.T) {
+	zr := zipFile(t, map[string]string{
+		"results.xml": `<?xml version="1.0"?>
+<testsuites>
+	<testsuite name="pkg1">
+		<testcase classname="pkg1" name="TestOne" time="0.5"></testcase>
+		<testcase classname="pkg1" name="TestTwo" time="1.2"><failure message="boom"></failure></testcase>
+	</testsuite>
+	<testsuite name="pkg2">
+		<testcase classname="pkg2" name="TestThree" time="0.1"><skipped></skipped></testcase>
+	</testsuite>
+</testsuites>`,
+	})
+
+	summary, err := summarizeZip(zr)
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, summary.Total)
+	assert.Equal(t, 1, summary.Passed)
+	assert.Equal(t, 1, summary.Failed)
+	assert.Equal(t, 1, summary.Skipped)
+	assert.Equal(t, "pkg1.TestTwo", summary.Tests[0].Name)
+}
+
+func Test_summarizeZip_bareTestsuite(t *testing.T) {
+	zr := zipFile(t, map[string]string{
+		"results.xml": `<?xml version="1.0"?>
+<testsuite name="pkg1">
+	<testcase classname="pkg1" name="TestOne" time="0.5"></testcase>
+	<testcase classname="pkg1" name="TestTwo" time="0.2"><error message="oops"></error></testcase>
+</testsuite>`,
+	})
+
+	summary, err := summarizeZip(zr)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, summary.Total)
+	assert.Equal(t, 1, summary.Passed)
+	assert.Equal(t, 1, summary.Failed)
+}
+
+func Test_summarizeZip_json(t *testing.T) {
+	zr := zipFile(t, map[string]string{
+		"results.json": `{"tests": [
+			{"name": "TestOne", "classname": "pkg1", "time": 0.3, "status": "passed"},
+			{"name": "TestTwo", "classname": "pkg1", "time": 0.4, "status": "failed"}
+		]}`,
+	})
+
+	summary, err := summarizeZip(zr)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, summary.Total)
+	assert.Equal(t, 1, summary.Passed)
+	assert.Equal(t, 1, summary.Failed)
+}
+
+func Test_summarizeZip_noReport(t *testing.T) {
+	zr := zipFile(t, map[string]string{
+		"readme.txt": "nothing to see here",
+	})
+
+	_, err := summarizeZip(zr)
+	require.Error(t, err)
+}
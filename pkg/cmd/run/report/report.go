@@ -0,0 +1,234 @@
+package report
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmd/run/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ReportOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Prompter   shared.Prompter
+
+	RunID        string
+	ArtifactName string
+	SlowestCount int
+	Compare      bool
+}
+
+func NewCmdReport(f *cmdutil.Factory, runF func(*ReportOptions) error) *cobra.Command {
+	opts := &ReportOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Prompter:   f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "report [<run-id>]",
+		Short: "Summarize a test report artifact from a workflow run",
+		Long: heredoc.Doc(`
+			Summarize a JUnit XML or JSON test report artifact uploaded by a workflow run.
+
+			If no run ID is specified, the most recent run is used.
+		`),
+		Example: heredoc.Doc(`
+			# Summarize the test report from the most recent run
+			$ gh run report
+
+			# Summarize a specific artifact from a specific run
+			$ gh run report 1234 --name test-results
+
+			# Compare the results against the previous run on the same branch
+			$ gh run report --compare
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if len(args) > 0 {
+				opts.RunID = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return runReport(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.ArtifactName, "name", "n", "test-results", "Name of the artifact containing the test report")
+	cmd.Flags().IntVarP(&opts.SlowestCount, "slow", "s", 5, "Number of slowest tests to display")
+	cmd.Flags().BoolVar(&opts.Compare, "compare", false, "Compare results against the previous run on the same branch")
+
+	return cmd
+}
+
+func runReport(opts *ReportOptions) error {
+	c, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("failed to create http client: %w", err)
+	}
+	client := api.NewClientFromHTTP(c)
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return fmt.Errorf("failed to determine base repo: %w", err)
+	}
+
+	opts.IO.StartProgressIndicator()
+	run, err := resolveRun(client, repo, opts.RunID)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to get run: %w", err)
+	}
+
+	opts.IO.StartProgressIndicator()
+	summary, err := summarizeRunArtifact(c, repo, fmt.Sprintf("%d", run.ID), opts.ArtifactName)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		if errors.Is(err, errArtifactNotFound) {
+			return fmt.Errorf("no %q artifact found for run %d", opts.ArtifactName, run.ID)
+		}
+		return err
+	}
+
+	var previous *Summary
+	if opts.Compare {
+		opts.IO.StartProgressIndicator()
+		previous, err = previousRunSummary(client, c, repo, run, opts.ArtifactName)
+		opts.IO.StopProgressIndicator()
+		if err != nil {
+			return err
+		}
+	}
+
+	cs := opts.IO.ColorScheme()
+	out := opts.IO.Out
+
+	fmt.Fprintf(out, "%s %s · %s\n", cs.Bold("Test report for run"), cs.Cyanf("%d", run.ID), run.HeadBranch)
+	printCounts(opts.IO, summary, previous)
+	fmt.Fprintln(out)
+	printSlowest(opts.IO, summary, opts.SlowestCount)
+
+	return nil
+}
+
+// resolveRun returns the run identified by runID, or the most recent run on the repository
+// if runID is empty.
+func resolveRun(client *api.Client, repo ghrepo.Interface, runID string) (*shared.Run, error) {
+	if runID != "" {
+		return shared.GetRun(client, repo, runID, 0)
+	}
+
+	runs, err := shared.GetRunsWithFilter(client, repo, nil, 1, func(shared.Run) bool { return true })
+	if err != nil {
+		return nil, err
+	}
+	if len(runs) == 0 {
+		return nil, errors.New("no runs found")
+	}
+	return &runs[0], nil
+}
+
+// previousRunSummary looks up the most recent completed run prior to the given run on the same
+// branch and summarizes its test report artifact. It returns nil, nil if no suitable previous
+// run or matching artifact is found, since comparison is a best-effort enhancement.
+func previousRunSummary(client *api.Client, httpClient *http.Client, repo ghrepo.Interface, run *shared.Run, artifactName string) (*Summary, error) {
+	runs, err := shared.GetRunsWithFilter(client, repo, &shared.FilterOptions{Branch: run.HeadBranch}, 10, func(r shared.Run) bool {
+		return r.ID != run.ID && r.Status == shared.Completed
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get previous runs: %w", err)
+	}
+	if len(runs) == 0 {
+		return nil, nil
+	}
+
+	summary, err := summarizeRunArtifact(httpClient, repo, fmt.Sprintf("%d", runs[0].ID), artifactName)
+	if err != nil {
+		if errors.Is(err, errArtifactNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+func printCounts(io *iostreams.IOStreams, summary, previous *Summary) {
+	cs := io.ColorScheme()
+	out := io.Out
+
+	fmt.Fprintf(out, "%s %s passed, %s failed, %s skipped %s\n",
+		cs.Bold(fmt.Sprintf("%d", summary.Total)),
+		cs.Green(fmt.Sprintf("%d", summary.Passed)),
+		cs.Red(fmt.Sprintf("%d", summary.Failed)),
+		cs.Gray(fmt.Sprintf("%d", summary.Skipped)),
+		cs.Grayf("in %s", summary.Duration))
+
+	if previous == nil {
+		return
+	}
+
+	fmt.Fprintf(out, "%s\n", cs.Gray(fmt.Sprintf(
+		"vs previous run: %s tests, %s failed, %s duration",
+		delta(summary.Total, previous.Total),
+		delta(summary.Failed, previous.Failed),
+		durationDelta(summary.Duration, previous.Duration))))
+}
+
+func printSlowest(io *iostreams.IOStreams, summary *Summary, count int) {
+	if count <= 0 || len(summary.Tests) == 0 {
+		return
+	}
+	if count > len(summary.Tests) {
+		count = len(summary.Tests)
+	}
+
+	cs := io.ColorScheme()
+	fmt.Fprintln(io.Out, cs.Bold("Slowest tests"))
+
+	tp := tableprinter.New(io, tableprinter.WithHeader("NAME", "DURATION"))
+	for _, t := range summary.Tests[:count] {
+		name := t.Name
+		if t.Failed {
+			name = cs.Red(name)
+		} else if t.Skipped {
+			name = cs.Gray(name)
+		}
+		tp.AddField(name)
+		tp.AddField(t.Duration.String())
+		tp.EndRow()
+	}
+
+	_ = tp.Render()
+}
+
+func delta(current, previous int) string {
+	d := current - previous
+	if d > 0 {
+		return fmt.Sprintf("+%d", d)
+	}
+	return fmt.Sprintf("%d", d)
+}
+
+func durationDelta(current, previous time.Duration) string {
+	d := current - previous
+	if d >= 0 {
+		return fmt.Sprintf("+%s", d)
+	}
+	return d.String()
+}
@@ -0,0 +1,102 @@
+package report
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdReport(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		want    ReportOptions
+		wantErr string
+	}{
+		{
+			name: "no arguments",
+			args: "",
+			want: ReportOptions{
+				RunID:        "",
+				ArtifactName: "test-results",
+				SlowestCount: 5,
+			},
+		},
+		{
+			name: "with run ID",
+			args: "1234",
+			want: ReportOptions{
+				RunID:        "1234",
+				ArtifactName: "test-results",
+				SlowestCount: 5,
+			},
+		},
+		{
+			name: "with name and slow flags",
+			args: "1234 --name junit --slow 10",
+			want: ReportOptions{
+				RunID:        "1234",
+				ArtifactName: "junit",
+				SlowestCount: 10,
+			},
+		},
+		{
+			name: "with compare flag",
+			args: "--compare",
+			want: ReportOptions{
+				RunID:        "",
+				ArtifactName: "test-results",
+				SlowestCount: 5,
+				Compare:      true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			ios.SetStdoutTTY(true)
+
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+				HttpClient: func() (*http.Client, error) {
+					return nil, nil
+				},
+				BaseRepo: func() (ghrepo.Interface, error) {
+					return ghrepo.New("OWNER", "REPO"), nil
+				},
+			}
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+
+			var gotOpts *ReportOptions
+			cmd := NewCmdReport(f, func(opts *ReportOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want.RunID, gotOpts.RunID)
+			assert.Equal(t, tt.want.ArtifactName, gotOpts.ArtifactName)
+			assert.Equal(t, tt.want.SlowestCount, gotOpts.SlowestCount)
+			assert.Equal(t, tt.want.Compare, gotOpts.Compare)
+		})
+	}
+}
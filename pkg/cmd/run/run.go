@@ -5,7 +5,9 @@ import (
 	cmdDelete "github.com/cli/cli/v2/pkg/cmd/run/delete"
 	cmdDownload "github.com/cli/cli/v2/pkg/cmd/run/download"
 	cmdList "github.com/cli/cli/v2/pkg/cmd/run/list"
+	cmdReport "github.com/cli/cli/v2/pkg/cmd/run/report"
 	cmdRerun "github.com/cli/cli/v2/pkg/cmd/run/rerun"
+	cmdUsage "github.com/cli/cli/v2/pkg/cmd/run/usage"
 	cmdView "github.com/cli/cli/v2/pkg/cmd/run/view"
 	cmdWatch "github.com/cli/cli/v2/pkg/cmd/run/watch"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -28,6 +30,8 @@ func NewCmdRun(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(cmdWatch.NewCmdWatch(f, nil))
 	cmd.AddCommand(cmdCancel.NewCmdCancel(f, nil))
 	cmd.AddCommand(cmdDelete.NewCmdDelete(f, nil))
+	cmd.AddCommand(cmdReport.NewCmdReport(f, nil))
+	cmd.AddCommand(cmdUsage.NewCmdUsage(f, nil))
 
 	return cmd
 }
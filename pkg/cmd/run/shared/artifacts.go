@@ -12,10 +12,19 @@ import (
 )
 
 type Artifact struct {
-	Name        string `json:"name"`
-	Size        uint64 `json:"size_in_bytes"`
-	DownloadURL string `json:"archive_download_url"`
-	Expired     bool   `json:"expired"`
+	Name        string         `json:"name"`
+	Size        uint64         `json:"size_in_bytes"`
+	DownloadURL string         `json:"archive_download_url"`
+	Expired     bool           `json:"expired"`
+	Digest      ArtifactDigest `json:"digest"`
+}
+
+// ArtifactDigest is the checksum GitHub computed for an artifact's zip
+// archive when it was uploaded. Older API responses omit it entirely, in
+// which case Value is empty and no verification is possible.
+type ArtifactDigest struct {
+	Value     string `json:"value"`
+	Algorithm string `json:"algorithm"`
 }
 
 type artifactsPayload struct {
@@ -47,6 +47,22 @@ func RenderJobs(cs *iostreams.ColorScheme, jobs []Job, verbose bool) string {
 	return strings.Join(lines, "\n")
 }
 
+// RenderWhyItFailed formats a condensed, file/line/message summary of a run's failure-level
+// annotations so that a user can tell why a run failed without scrolling through the full logs.
+func RenderWhyItFailed(cs *iostreams.ColorScheme, annotations []Annotation) string {
+	lines := []string{}
+
+	for _, a := range annotations {
+		location := a.JobName
+		if a.Path != "" {
+			location = fmt.Sprintf("%s: %s#%d", a.JobName, a.Path, a.StartLine)
+		}
+		lines = append(lines, fmt.Sprintf("%s %s", cs.Red(location), a.Message))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
 func RenderAnnotations(cs *iostreams.ColorScheme, annotations []Annotation) string {
 	lines := []string{}
 
@@ -81,9 +81,14 @@ var RunFields = []string{
 	"workflowDatabaseId",
 	"workflowName",
 	"url",
+	"queuedDuration",
+	"inProgressDuration",
+	"runnerName",
+	"runnerLabels",
+	"billableTime",
 }
 
-var SingleRunFields = append(RunFields, "jobs")
+var SingleRunFields = append(RunFields, "jobs", "annotations")
 
 type Run struct {
 	Name           string    `json:"name"` // the semantics of this field are unclear
@@ -95,17 +100,19 @@ type Run struct {
 	Conclusion     Conclusion
 	Event          string
 	ID             int64
-	workflowName   string // cache column
-	WorkflowID     int64  `json:"workflow_id"`
-	Number         int64  `json:"run_number"`
-	Attempt        uint64 `json:"run_attempt"`
-	HeadBranch     string `json:"head_branch"`
-	JobsURL        string `json:"jobs_url"`
-	HeadCommit     Commit `json:"head_commit"`
-	HeadSha        string `json:"head_sha"`
-	URL            string `json:"html_url"`
-	HeadRepository Repo   `json:"head_repository"`
-	Jobs           []Job  `json:"-"` // populated by GetJobs
+	workflowName   string            // cache column
+	WorkflowID     int64             `json:"workflow_id"`
+	Number         int64             `json:"run_number"`
+	Attempt        uint64            `json:"run_attempt"`
+	HeadBranch     string            `json:"head_branch"`
+	JobsURL        string            `json:"jobs_url"`
+	HeadCommit     Commit            `json:"head_commit"`
+	HeadSha        string            `json:"head_sha"`
+	URL            string            `json:"html_url"`
+	HeadRepository Repo              `json:"head_repository"`
+	Jobs           []Job             `json:"-"` // populated by GetJobs
+	Annotations    []Annotation      `json:"-"` // populated by GetAllAnnotations
+	Usage          *WorkflowRunUsage `json:"-"` // populated by GetRunUsage
 }
 
 func (r *Run) StartedTime() time.Time {
@@ -127,6 +134,51 @@ func (r *Run) Duration(now time.Time) time.Duration {
 	return d.Round(time.Second)
 }
 
+// QueuedDuration returns how long the run waited between being created and a job actually
+// starting. It is zero for runs that haven't started yet.
+func (r *Run) QueuedDuration() time.Duration {
+	if r.StartedAt.IsZero() {
+		return 0
+	}
+	d := r.StartedAt.Sub(r.CreatedAt)
+	if d < 0 {
+		return 0
+	}
+	return d.Round(time.Second)
+}
+
+// RunnerNames returns the distinct runner names used by the run's jobs, in the order
+// encountered. Requires Jobs to have been populated via GetJobs.
+func (r *Run) RunnerNames() []string {
+	seen := map[string]bool{}
+	var names []string
+	for _, j := range r.Jobs {
+		if j.RunnerName == "" || seen[j.RunnerName] {
+			continue
+		}
+		seen[j.RunnerName] = true
+		names = append(names, j.RunnerName)
+	}
+	return names
+}
+
+// RunnerLabels returns the distinct runner labels used across the run's jobs, in the order
+// encountered. Requires Jobs to have been populated via GetJobs.
+func (r *Run) RunnerLabels() []string {
+	seen := map[string]bool{}
+	var labels []string
+	for _, j := range r.Jobs {
+		for _, l := range j.RunnerLabels {
+			if seen[l] {
+				continue
+			}
+			seen[l] = true
+			labels = append(labels, l)
+		}
+	}
+	return labels
+}
+
 type Repo struct {
 	Owner struct {
 		Login string
@@ -175,6 +227,20 @@ func (r *Run) ExportData(fields []string) map[string]interface{} {
 			data[f] = r.WorkflowID
 		case "workflowName":
 			data[f] = r.WorkflowName()
+		case "queuedDuration":
+			data[f] = r.QueuedDuration().Seconds()
+		case "inProgressDuration":
+			data[f] = r.Duration(time.Now()).Seconds()
+		case "runnerName":
+			data[f] = strings.Join(r.RunnerNames(), ", ")
+		case "runnerLabels":
+			data[f] = r.RunnerLabels()
+		case "billableTime":
+			var seconds float64
+			if r.Usage != nil {
+				seconds = r.Usage.TotalBillableTime().Seconds()
+			}
+			data[f] = seconds
 		case "jobs":
 			jobs := make([]interface{}, 0, len(r.Jobs))
 			for _, j := range r.Jobs {
@@ -203,6 +269,18 @@ func (r *Run) ExportData(fields []string) map[string]interface{} {
 				})
 			}
 			data[f] = jobs
+		case "annotations":
+			annotations := make([]interface{}, 0, len(r.Annotations))
+			for _, a := range r.Annotations {
+				annotations = append(annotations, map[string]interface{}{
+					"jobName":   a.JobName,
+					"message":   a.Message,
+					"path":      a.Path,
+					"level":     a.Level,
+					"startLine": a.StartLine,
+				})
+			}
+			data[f] = annotations
 		default:
 			sf := fieldByName(v, f)
 			data[f] = sf.Interface()
@@ -213,15 +291,17 @@ func (r *Run) ExportData(fields []string) map[string]interface{} {
 }
 
 type Job struct {
-	ID          int64
-	Status      Status
-	Conclusion  Conclusion
-	Name        string
-	Steps       Steps
-	StartedAt   time.Time `json:"started_at"`
-	CompletedAt time.Time `json:"completed_at"`
-	URL         string    `json:"html_url"`
-	RunID       int64     `json:"run_id"`
+	ID           int64
+	Status       Status
+	Conclusion   Conclusion
+	Name         string
+	Steps        Steps
+	StartedAt    time.Time `json:"started_at"`
+	CompletedAt  time.Time `json:"completed_at"`
+	URL          string    `json:"html_url"`
+	RunID        int64     `json:"run_id"`
+	RunnerName   string    `json:"runner_name"`
+	RunnerLabels []string  `json:"labels"`
 }
 
 type Step struct {
@@ -302,6 +382,37 @@ func GetAnnotations(client *api.Client, repo ghrepo.Interface, job Job) ([]Annot
 	return out, nil
 }
 
+// GetAllAnnotations fetches annotations for every job in jobs, returning whether the
+// token lacked sufficient permissions to fetch any of them.
+func GetAllAnnotations(client *api.Client, repo ghrepo.Interface, jobs []Job) ([]Annotation, bool, error) {
+	var annotations []Annotation
+
+	for _, job := range jobs {
+		as, err := GetAnnotations(client, repo, job)
+		if err != nil {
+			if err != ErrMissingAnnotationsPermissions {
+				return nil, false, err
+			}
+			return nil, true, nil
+		}
+		annotations = append(annotations, as...)
+	}
+
+	return annotations, false, nil
+}
+
+// FailureAnnotations filters annotations down to only those at the failure level, for use in a
+// condensed "why it failed" summary.
+func FailureAnnotations(annotations []Annotation) []Annotation {
+	failures := make([]Annotation, 0, len(annotations))
+	for _, a := range annotations {
+		if a.Level == AnnotationFailure {
+			failures = append(failures, a)
+		}
+	}
+	return failures
+}
+
 func IsFailureState(c Conclusion) bool {
 	switch c {
 	case ActionRequired, Failure, StartupFailure, TimedOut:
@@ -478,6 +589,40 @@ func GetJobs(client *api.Client, repo ghrepo.Interface, run *Run, attempt uint64
 	return run.Jobs, nil
 }
 
+// WorkflowRunUsage is the billable time breakdown returned by the run timing endpoint, keyed by
+// the runner OS (e.g. "UBUNTU", "WINDOWS", "MACOS").
+type WorkflowRunUsage struct {
+	Billable map[string]struct {
+		TotalMs int64 `json:"total_ms"`
+	} `json:"billable"`
+}
+
+// TotalBillableTime sums the billable time across every runner OS used by the run.
+func (u *WorkflowRunUsage) TotalBillableTime() time.Duration {
+	var totalMs int64
+	for _, usage := range u.Billable {
+		totalMs += usage.TotalMs
+	}
+	return time.Duration(totalMs) * time.Millisecond
+}
+
+// GetRunUsage fetches the billable time breakdown for a run, caching the result on run.Usage.
+func GetRunUsage(client *api.Client, repo ghrepo.Interface, run *Run) (*WorkflowRunUsage, error) {
+	if run.Usage != nil {
+		return run.Usage, nil
+	}
+
+	path := fmt.Sprintf("repos/%s/actions/runs/%d/timing", ghrepo.FullName(repo), run.ID)
+
+	var usage WorkflowRunUsage
+	if err := client.REST(repo.RepoHost(), "GET", path, nil, &usage); err != nil {
+		return nil, err
+	}
+
+	run.Usage = &usage
+	return run.Usage, nil
+}
+
 func GetJob(client *api.Client, repo ghrepo.Interface, jobID string) (*Job, error) {
 	path := fmt.Sprintf("repos/%s/actions/jobs/%s", ghrepo.FullName(repo), jobID)
 
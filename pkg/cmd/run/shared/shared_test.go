@@ -108,6 +108,87 @@ func TestRun_Duration(t *testing.T) {
 	}
 }
 
+func TestRun_QueuedDuration(t *testing.T) {
+	created, _ := time.Parse(time.RFC3339, "2022-07-20T11:20:13Z")
+
+	tests := []struct {
+		name  string
+		run   Run
+		wants string
+	}{
+		{
+			name: "not started",
+			run: Run{
+				CreatedAt: created,
+			},
+			wants: "0s",
+		},
+		{
+			name: "queued for a while before starting",
+			run: Run{
+				CreatedAt: created,
+				StartedAt: created.Add(90 * time.Second),
+			},
+			wants: "1m30s",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wants, tt.run.QueuedDuration().String())
+		})
+	}
+}
+
+func TestRun_RunnerNamesAndLabels(t *testing.T) {
+	r := Run{
+		Jobs: []Job{
+			{RunnerName: "ubuntu-latest", RunnerLabels: []string{"ubuntu-latest", "self-hosted"}},
+			{RunnerName: "ubuntu-latest", RunnerLabels: []string{"ubuntu-latest"}},
+			{RunnerName: "macos-latest", RunnerLabels: []string{"macos-latest"}},
+		},
+	}
+
+	assert.Equal(t, []string{"ubuntu-latest", "macos-latest"}, r.RunnerNames())
+	assert.Equal(t, []string{"ubuntu-latest", "self-hosted", "macos-latest"}, r.RunnerLabels())
+}
+
+func TestWorkflowRunUsage_TotalBillableTime(t *testing.T) {
+	u := &WorkflowRunUsage{
+		Billable: map[string]struct {
+			TotalMs int64 `json:"total_ms"`
+		}{
+			"UBUNTU": {TotalMs: 60000},
+			"MACOS":  {TotalMs: 30000},
+		},
+	}
+
+	assert.Equal(t, "1m30s", u.TotalBillableTime().String())
+}
+
+func TestGetRunUsage(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234/timing"),
+		httpmock.JSONResponse(WorkflowRunUsage{
+			Billable: map[string]struct {
+				TotalMs int64 `json:"total_ms"`
+			}{
+				"UBUNTU": {TotalMs: 15000},
+			},
+		}))
+
+	httpClient := &http.Client{Transport: reg}
+	client := api.NewClientFromHTTP(httpClient)
+	repo, _ := ghrepo.FromFullName("OWNER/REPO")
+	run := &Run{ID: 1234}
+
+	usage, err := GetRunUsage(client, repo, run)
+	require.NoError(t, err)
+	assert.Equal(t, "15s", usage.TotalBillableTime().String())
+	assert.Same(t, run.Usage, usage)
+}
+
 func TestRunExportData(t *testing.T) {
 	oldestStartedAt, _ := time.Parse(time.RFC3339, "2022-07-20T11:20:13Z")
 	oldestCompletedAt, _ := time.Parse(time.RFC3339, "2022-07-20T11:21:16Z")
@@ -198,6 +279,47 @@ func TestRunExportData(t *testing.T) {
 			},
 			output: `{"attempt":1}`,
 		},
+		{
+			name:   "exports queued and in-progress durations",
+			fields: []string{"queuedDuration", "inProgressDuration"},
+			run: Run{
+				CreatedAt: oldestStartedAt,
+				StartedAt: newestStartedAt,
+				UpdatedAt: oldestCompletedAt,
+				Status:    Completed,
+			},
+			output: `{"inProgressDuration":21,"queuedDuration":42}`,
+		},
+		{
+			name:   "exports runner name and labels",
+			fields: []string{"runnerName", "runnerLabels"},
+			run: Run{
+				Jobs: []Job{
+					{RunnerName: "ubuntu-latest", RunnerLabels: []string{"ubuntu-latest", "self-hosted"}},
+				},
+			},
+			output: `{"runnerLabels":["ubuntu-latest","self-hosted"],"runnerName":"ubuntu-latest"}`,
+		},
+		{
+			name:   "exports billable time",
+			fields: []string{"billableTime"},
+			run: Run{
+				Usage: &WorkflowRunUsage{
+					Billable: map[string]struct {
+						TotalMs int64 `json:"total_ms"`
+					}{
+						"UBUNTU": {TotalMs: 5000},
+					},
+				},
+			},
+			output: `{"billableTime":5}`,
+		},
+		{
+			name:   "exports zero billable time when usage wasn't fetched",
+			fields: []string{"billableTime"},
+			run:    Run{},
+			output: `{"billableTime":0}`,
+		},
 	}
 
 	for _, tt := range tests {
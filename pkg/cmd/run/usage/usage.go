@@ -0,0 +1,147 @@
+package usage
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmd/run/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type UsageOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	RunID string
+}
+
+func NewCmdUsage(f *cmdutil.Factory, runF func(*UsageOptions) error) *cobra.Command {
+	opts := &UsageOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "usage [<run-id>]",
+		Short: "Show billable runner time for a workflow run",
+		Long: heredoc.Doc(`
+			Show the billable minutes a workflow run consumed on each runner OS.
+
+			This only reports time billed against your Actions minutes quota; runs on
+			self-hosted runners and public repositories are not billed and will show
+			no usage.
+
+			If no run ID is specified, the most recent run is used.
+		`),
+		Example: heredoc.Doc(`
+			# Show billable time for the most recent run
+			$ gh run usage
+
+			# Show billable time for a specific run
+			$ gh run usage 12345
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if len(args) > 0 {
+				opts.RunID = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return runUsage(opts)
+		},
+	}
+
+	return cmd
+}
+
+func runUsage(opts *UsageOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("failed to create http client: %w", err)
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return fmt.Errorf("failed to determine base repo: %w", err)
+	}
+
+	opts.IO.StartProgressIndicator()
+	run, err := resolveRun(client, repo, opts.RunID)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to get run: %w", err)
+	}
+
+	opts.IO.StartProgressIndicator()
+	runUsage, err := shared.GetRunUsage(client, repo, run)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to get usage: %w", err)
+	}
+
+	type osUsage struct {
+		OS     string
+		Millis int64
+	}
+	var breakdown []osUsage
+	var maxMillis int64
+	for os, billable := range runUsage.Billable {
+		breakdown = append(breakdown, osUsage{OS: os, Millis: billable.TotalMs})
+		if billable.TotalMs > maxMillis {
+			maxMillis = billable.TotalMs
+		}
+	}
+	sort.Slice(breakdown, func(i, j int) bool { return breakdown[i].OS < breakdown[j].OS })
+
+	cs := opts.IO.ColorScheme()
+	fmt.Fprintf(opts.IO.Out, "%s %s · %s\n\n", cs.Bold("Billable time for run"), cs.Cyanf("%d", run.ID), run.HeadBranch)
+
+	if len(breakdown) == 0 {
+		fmt.Fprintln(opts.IO.Out, "No billable runner time; this run was either free or ran on a self-hosted runner.")
+		return nil
+	}
+
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("RUNNER", "BILLABLE", ""))
+	for _, b := range breakdown {
+		tp.AddField(b.OS)
+		tp.AddField((time.Duration(b.Millis) * time.Millisecond).String())
+		tp.AddField(text.BarChart(int(b.Millis), int(maxMillis), 20), tableprinter.WithColor(cs.Cyan))
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
+
+// resolveRun returns the run identified by runID, or the most recent run on the repository
+// if runID is empty.
+func resolveRun(client *api.Client, repo ghrepo.Interface, runID string) (*shared.Run, error) {
+	if runID != "" {
+		return shared.GetRun(client, repo, runID, 0)
+	}
+
+	runs, err := shared.GetRunsWithFilter(client, repo, nil, 1, func(shared.Run) bool { return true })
+	if err != nil {
+		return nil, err
+	}
+	if len(runs) == 0 {
+		return nil, errors.New("no runs found")
+	}
+	return &runs[0], nil
+}
@@ -0,0 +1,103 @@
+package usage
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdUsage(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   string
+		wantID string
+	}{
+		{name: "no arguments", args: "", wantID: ""},
+		{name: "with run ID", args: "1234", wantID: "1234"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			ios.SetStdoutTTY(true)
+
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+				HttpClient: func() (*http.Client, error) {
+					return nil, nil
+				},
+				BaseRepo: func() (ghrepo.Interface, error) {
+					return ghrepo.New("OWNER", "REPO"), nil
+				},
+			}
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+
+			var gotOpts *UsageOptions
+			cmd := NewCmdUsage(f, func(opts *UsageOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+
+			_, err = cmd.ExecuteC()
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantID, gotOpts.RunID)
+		})
+	}
+}
+
+func Test_runUsage(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234"),
+		httpmock.JSONResponse(map[string]interface{}{
+			"id":          1234,
+			"head_branch": "main",
+			"status":      "completed",
+			"workflow_id": 123,
+		}))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+		httpmock.JSONResponse(map[string]interface{}{
+			"id":   123,
+			"name": "CI",
+		}))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234/timing"),
+		httpmock.JSONResponse(map[string]interface{}{
+			"billable": map[string]interface{}{
+				"UBUNTU": map[string]interface{}{"total_ms": 4000},
+				"MACOS":  map[string]interface{}{"total_ms": 1000},
+			},
+		}))
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	opts := &UsageOptions{
+		IO:         ios,
+		HttpClient: func() (*http.Client, error) { return &http.Client{Transport: reg}, nil },
+		BaseRepo:   func() (ghrepo.Interface, error) { return ghrepo.New("OWNER", "REPO"), nil },
+		RunID:      "1234",
+	}
+
+	require.NoError(t, runUsage(opts))
+
+	out := stdout.String()
+	assert.Contains(t, out, "UBUNTU")
+	assert.Contains(t, out, "MACOS")
+}
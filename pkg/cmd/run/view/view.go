@@ -275,6 +275,15 @@ func runView(opts *ViewOptions) error {
 	}
 
 	if opts.Exporter != nil {
+		if shouldFetchAnnotations(opts) {
+			annotations, missingPermissions, err := shared.GetAllAnnotations(client, repo, jobs)
+			if err != nil {
+				return fmt.Errorf("failed to get annotations: %w", err)
+			}
+			if !missingPermissions {
+				run.Annotations = annotations
+			}
+		}
 		return opts.Exporter.Write(opts.IO, run)
 	}
 
@@ -337,20 +346,9 @@ func runView(opts *ViewOptions) error {
 		}
 	}
 
-	var annotations []shared.Annotation
-	var missingAnnotationsPermissions bool
-
-	for _, job := range jobs {
-		as, err := shared.GetAnnotations(client, repo, job)
-		if err != nil {
-			if err != shared.ErrMissingAnnotationsPermissions {
-				return fmt.Errorf("failed to get annotations: %w", err)
-			}
-
-			missingAnnotationsPermissions = true
-			break
-		}
-		annotations = append(annotations, as...)
+	annotations, missingAnnotationsPermissions, err := shared.GetAllAnnotations(client, repo, jobs)
+	if err != nil {
+		return fmt.Errorf("failed to get annotations: %w", err)
 	}
 
 	out := opts.IO.Out
@@ -359,6 +357,12 @@ func runView(opts *ViewOptions) error {
 	fmt.Fprintln(out, shared.RenderRunHeader(cs, *run, text.FuzzyAgo(opts.Now(), run.StartedTime()), prNumber, attempt))
 	fmt.Fprintln(out)
 
+	if failures := shared.FailureAnnotations(annotations); !missingAnnotationsPermissions && len(failures) > 0 {
+		fmt.Fprintln(out, cs.Bold("WHY IT FAILED"))
+		fmt.Fprintln(out, shared.RenderWhyItFailed(cs, failures))
+		fmt.Fprintln(out)
+	}
+
 	if len(jobs) == 0 && run.Conclusion == shared.Failure || run.Conclusion == shared.StartupFailure {
 		fmt.Fprintf(out, "%s %s\n",
 			cs.FailureIcon(),
@@ -439,7 +443,7 @@ func shouldFetchJobs(opts *ViewOptions) bool {
 	}
 	if opts.Exporter != nil {
 		for _, f := range opts.Exporter.Fields() {
-			if f == "jobs" {
+			if f == "jobs" || f == "annotations" {
 				return true
 			}
 		}
@@ -447,6 +451,18 @@ func shouldFetchJobs(opts *ViewOptions) bool {
 	return false
 }
 
+func shouldFetchAnnotations(opts *ViewOptions) bool {
+	if opts.Exporter == nil {
+		return false
+	}
+	for _, f := range opts.Exporter.Fields() {
+		if f == "annotations" {
+			return true
+		}
+	}
+	return false
+}
+
 func getLog(httpClient *http.Client, logURL string) (io.ReadCloser, error) {
 	req, err := http.NewRequest("GET", logURL, nil)
 	if err != nil {
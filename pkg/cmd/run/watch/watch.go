@@ -2,18 +2,24 @@ package watch
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"os/exec"
+	"strings"
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/notify"
 	"github.com/cli/cli/v2/internal/text"
 	"github.com/cli/cli/v2/pkg/cmd/run/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
 	"github.com/spf13/cobra"
 )
 
@@ -24,16 +30,29 @@ type WatchOptions struct {
 	HttpClient func() (*http.Client, error)
 	BaseRepo   func() (ghrepo.Interface, error)
 	Prompter   shared.Prompter
+	Notifier   notify.Notifier
 
 	RunID      string
 	Interval   int
 	ExitStatus bool
+	Notify     bool
+	OnComplete string
+	JSONEvents bool
 
 	Prompt bool
 
 	Now func() time.Time
 }
 
+// runEvent is a single line of --json-events output, emitted whenever the
+// run's status or conclusion changes.
+type runEvent struct {
+	RunID      int64  `json:"runID"`
+	Status     string `json:"status"`
+	Conclusion string `json:"conclusion"`
+	UpdatedAt  string `json:"updatedAt"`
+}
+
 func NewCmdWatch(f *cmdutil.Factory, runF func(*WatchOptions) error) *cobra.Command {
 	opts := &WatchOptions{
 		IO:         f.IOStreams,
@@ -57,6 +76,15 @@ func NewCmdWatch(f *cmdutil.Factory, runF func(*WatchOptions) error) *cobra.Comm
 
 			# Run some other command when the run is finished
 			gh run watch && notify-send 'run is done!'
+
+			# Get a desktop notification when the run is finished
+			gh run watch --notify
+
+			# Run a command when the run is finished, passing its conclusion and URL
+			gh run watch --on-complete 'notify-send "run {conclusion}" {url}'
+
+			# Stream NDJSON status transitions for consumption by other tooling
+			gh run watch --json-events
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// support `-R, --repo` override
@@ -79,6 +107,9 @@ func NewCmdWatch(f *cmdutil.Factory, runF func(*WatchOptions) error) *cobra.Comm
 	}
 	cmd.Flags().BoolVar(&opts.ExitStatus, "exit-status", false, "Exit with non-zero status if run fails")
 	cmd.Flags().IntVarP(&opts.Interval, "interval", "i", defaultInterval, "Refresh interval in seconds")
+	cmd.Flags().BoolVar(&opts.Notify, "notify", false, "Send a desktop notification when the run finishes")
+	cmd.Flags().StringVar(&opts.OnComplete, "on-complete", "", "Run a `command` when the run finishes, substituting {conclusion} and {url}")
+	cmd.Flags().BoolVar(&opts.JSONEvents, "json-events", false, "Print NDJSON status transition events instead of the interactive view")
 
 	return cmd
 }
@@ -128,7 +159,16 @@ func watchRun(opts *WatchOptions) error {
 	}
 
 	if run.Status == shared.Completed {
-		fmt.Fprintf(opts.IO.Out, "Run %s (%s) has already completed with '%s'\n", cs.Bold(run.WorkflowName()), cs.Cyanf("%d", run.ID), run.Conclusion)
+		if opts.JSONEvents {
+			if err := emitRunEvent(opts.IO.Out, run); err != nil {
+				return err
+			}
+		} else {
+			fmt.Fprintf(opts.IO.Out, "Run %s (%s) has already completed with '%s'\n", cs.Bold(run.WorkflowName()), cs.Cyanf("%d", run.ID), run.Conclusion)
+		}
+		if err := runOnComplete(opts, run); err != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "failed to run --on-complete command: %v\n", err)
+		}
 		if opts.ExitStatus && run.Conclusion != shared.Success {
 			return cmdutil.SilentError
 		}
@@ -148,54 +188,94 @@ func watchRun(opts *WatchOptions) error {
 		return fmt.Errorf("could not parse interval: %w", err)
 	}
 
-	out := &bytes.Buffer{}
-	opts.IO.StartAlternateScreenBuffer()
-	for run.Status != shared.Completed {
-		// Write to a temporary buffer to reduce total number of fetches
-		run, err = renderRun(out, *opts, client, repo, run, prNumber, annotationCache)
-		if err != nil {
-			break
-		}
+	if opts.JSONEvents {
+		var lastStatus shared.Status
+		var lastConclusion shared.Conclusion
+		for {
+			run, err = shared.GetRun(client, repo, fmt.Sprintf("%d", run.ID), 0)
+			if err != nil {
+				return fmt.Errorf("failed to get run: %w", err)
+			}
 
-		if run.Status == shared.Completed {
-			break
+			if run.Status != lastStatus || run.Conclusion != lastConclusion {
+				if err := emitRunEvent(opts.IO.Out, run); err != nil {
+					return err
+				}
+				lastStatus, lastConclusion = run.Status, run.Conclusion
+			}
+
+			if run.Status == shared.Completed {
+				break
+			}
+
+			time.Sleep(duration)
 		}
+	} else {
+		out := &bytes.Buffer{}
+		opts.IO.StartAlternateScreenBuffer()
+		for run.Status != shared.Completed {
+			// Write to a temporary buffer to reduce total number of fetches
+			run, err = renderRun(out, *opts, client, repo, run, prNumber, annotationCache)
+			if err != nil {
+				break
+			}
 
-		// If not completed, refresh the screen buffer and write the temporary buffer to stdout
-		opts.IO.RefreshScreen()
+			if run.Status == shared.Completed {
+				break
+			}
 
-		fmt.Fprintln(opts.IO.Out, cs.Boldf("Refreshing run status every %d seconds. Press Ctrl+C to quit.", opts.Interval))
-		fmt.Fprintln(opts.IO.Out)
+			// If not completed, refresh the screen buffer and write the temporary buffer to stdout
+			opts.IO.RefreshScreen()
 
-		_, err = io.Copy(opts.IO.Out, out)
-		out.Reset()
+			fmt.Fprintln(opts.IO.Out, cs.Boldf("Refreshing run status every %d seconds. Press Ctrl+C to quit.", opts.Interval))
+			fmt.Fprintln(opts.IO.Out)
 
-		if err != nil {
-			break
-		}
+			_, err = io.Copy(opts.IO.Out, out)
+			out.Reset()
 
-		time.Sleep(duration)
-	}
-	opts.IO.StopAlternateScreenBuffer()
+			if err != nil {
+				break
+			}
 
-	if err != nil {
-		return err
-	}
+			time.Sleep(duration)
+		}
+		opts.IO.StopAlternateScreenBuffer()
 
-	// Write the last temporary buffer one last time
-	_, err = io.Copy(opts.IO.Out, out)
-	if err != nil {
-		return err
+		if err != nil {
+			return err
+		}
+
+		// Write the last temporary buffer one last time
+		_, err = io.Copy(opts.IO.Out, out)
+		if err != nil {
+			return err
+		}
 	}
 
 	symbol, symbolColor := shared.Symbol(cs, run.Status, run.Conclusion)
 	id := cs.Cyanf("%d", run.ID)
 
-	if opts.IO.IsStdoutTTY() {
+	if opts.Notify {
+		notifier := opts.Notifier
+		if notifier == nil {
+			notifier = notify.New()
+		}
+		title := fmt.Sprintf("Run %s finished", run.WorkflowName())
+		body := fmt.Sprintf("Run %d completed with '%s'", run.ID, run.Conclusion)
+		if err := notifier.Notify(title, body); err != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "failed to send notification: %v\n", err)
+		}
+	}
+
+	if !opts.JSONEvents && opts.IO.IsStdoutTTY() {
 		fmt.Fprintln(opts.IO.Out)
 		fmt.Fprintf(opts.IO.Out, "%s Run %s (%s) completed with '%s'\n", symbolColor(symbol), cs.Bold(run.WorkflowName()), id, run.Conclusion)
 	}
 
+	if err := runOnComplete(opts, run); err != nil {
+		fmt.Fprintf(opts.IO.ErrOut, "failed to run --on-complete command: %v\n", err)
+	}
+
 	if opts.ExitStatus && run.Conclusion != shared.Success {
 		return cmdutil.SilentError
 	}
@@ -203,6 +283,44 @@ func watchRun(opts *WatchOptions) error {
 	return nil
 }
 
+// emitRunEvent writes a single NDJSON line describing run's current status.
+func emitRunEvent(w io.Writer, run *shared.Run) error {
+	event := runEvent{
+		RunID:      run.ID,
+		Status:     string(run.Status),
+		Conclusion: string(run.Conclusion),
+		UpdatedAt:  run.UpdatedAt.Format(time.RFC3339),
+	}
+	return json.NewEncoder(w).Encode(event)
+}
+
+// runOnComplete runs the user-specified --on-complete command, substituting
+// {conclusion} and {url} with values from run. It is a no-op when
+// --on-complete wasn't set.
+func runOnComplete(opts *WatchOptions, run *shared.Run) error {
+	if opts.OnComplete == "" {
+		return nil
+	}
+
+	command := opts.OnComplete
+	command = strings.ReplaceAll(command, "{conclusion}", string(run.Conclusion))
+	command = strings.ReplaceAll(command, "{url}", run.URL)
+
+	args, err := shlex.Split(command)
+	if err != nil {
+		return fmt.Errorf("could not parse --on-complete command: %w", err)
+	}
+	if len(args) == 0 {
+		return nil
+	}
+
+	execCmd := exec.Command(args[0], args[1:]...)
+	execCmd.Stdout = opts.IO.Out
+	execCmd.Stderr = opts.IO.ErrOut
+	execCmd.Stdin = os.Stdin
+	return execCmd.Run()
+}
+
 func renderRun(out io.Writer, opts WatchOptions, client *api.Client, repo ghrepo.Interface, run *shared.Run, prNumber string, annotationCache map[int64][]shared.Annotation) (*shared.Run, error) {
 	cs := opts.IO.ColorScheme()
 
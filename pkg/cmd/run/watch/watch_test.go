@@ -2,12 +2,19 @@ package watch
 
 import (
 	"bytes"
+	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/notify"
 	"github.com/cli/cli/v2/internal/prompter"
 	"github.com/cli/cli/v2/pkg/cmd/run/shared"
 	workflowShared "github.com/cli/cli/v2/pkg/cmd/workflow/shared"
@@ -17,6 +24,7 @@ import (
 	"github.com/cli/go-gh/v2/pkg/api"
 	"github.com/google/shlex"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewCmdWatch(t *testing.T) {
@@ -57,6 +65,33 @@ func TestNewCmdWatch(t *testing.T) {
 				ExitStatus: true,
 			},
 		},
+		{
+			name: "notify",
+			cli:  "1234 --notify",
+			wants: WatchOptions{
+				Interval: defaultInterval,
+				RunID:    "1234",
+				Notify:   true,
+			},
+		},
+		{
+			name: "on-complete",
+			cli:  `1234 --on-complete "notify-send {conclusion}"`,
+			wants: WatchOptions{
+				Interval:   defaultInterval,
+				RunID:      "1234",
+				OnComplete: "notify-send {conclusion}",
+			},
+		},
+		{
+			name: "json-events",
+			cli:  "1234 --json-events",
+			wants: WatchOptions{
+				Interval:   defaultInterval,
+				RunID:      "1234",
+				JSONEvents: true,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -94,6 +129,9 @@ func TestNewCmdWatch(t *testing.T) {
 			assert.Equal(t, tt.wants.Prompt, gotOpts.Prompt)
 			assert.Equal(t, tt.wants.ExitStatus, gotOpts.ExitStatus)
 			assert.Equal(t, tt.wants.Interval, gotOpts.Interval)
+			assert.Equal(t, tt.wants.Notify, gotOpts.Notify)
+			assert.Equal(t, tt.wants.OnComplete, gotOpts.OnComplete)
+			assert.Equal(t, tt.wants.JSONEvents, gotOpts.JSONEvents)
 		})
 	}
 }
@@ -407,3 +445,145 @@ func TestWatchRun(t *testing.T) {
 		})
 	}
 }
+
+func TestWatchRun_notify(t *testing.T) {
+	inProgressRun := shared.TestRunWithCommit(2, shared.InProgress, "", "commit2")
+	completedRun := shared.TestRun(2, shared.Completed, shared.Success)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/2"),
+		httpmock.JSONResponse(inProgressRun))
+	reg.Register(
+		httpmock.REST("GET", "runs/2/jobs"),
+		httpmock.JSONResponse(shared.JobsPayload{
+			Jobs: []shared.Job{
+				shared.SuccessfulJob,
+			},
+		}))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/check-runs/10/annotations"),
+		httpmock.JSONResponse([]shared.Annotation{}))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/2"),
+		httpmock.JSONResponse(completedRun))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+		httpmock.JSONResponse(shared.TestWorkflow))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+		httpmock.JSONResponse(shared.TestWorkflow))
+
+	notifier := &notify.Stub{}
+
+	ios, _, _, _ := iostreams.Test()
+	opts := &WatchOptions{
+		RunID:  "2",
+		Notify: true,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		IO: ios,
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+		Now: func() time.Time {
+			notnow, _ := time.Parse("2006-01-02 15:04:05", "2021-02-23 05:50:00")
+			return notnow
+		},
+		Notifier: notifier,
+	}
+
+	err := watchRun(opts)
+	assert.NoError(t, err)
+	assert.True(t, notifier.Notified())
+}
+
+func TestRunOnComplete(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("relies on a POSIX shell")
+	}
+
+	tmpDir := t.TempDir()
+	outFile := filepath.Join(tmpDir, "out.txt")
+
+	ios, _, _, _ := iostreams.Test()
+	opts := &WatchOptions{
+		IO:         ios,
+		OnComplete: fmt.Sprintf(`sh -c "echo {conclusion} {url} > %s"`, outFile),
+	}
+
+	run := &shared.Run{ID: 2, Conclusion: shared.Success, URL: "https://github.com/OWNER/REPO/actions/runs/2"}
+
+	require.NoError(t, runOnComplete(opts, run))
+
+	got, err := os.ReadFile(outFile)
+	require.NoError(t, err)
+	assert.Equal(t, "success https://github.com/OWNER/REPO/actions/runs/2\n", string(got))
+}
+
+func TestRunOnComplete_blank(t *testing.T) {
+	ios, _, _, _ := iostreams.Test()
+	opts := &WatchOptions{IO: ios}
+	run := &shared.Run{ID: 2, Conclusion: shared.Success}
+	assert.NoError(t, runOnComplete(opts, run))
+}
+
+func TestWatchRun_jsonEvents(t *testing.T) {
+	inProgressRun := shared.TestRunWithCommit(2, shared.InProgress, "", "commit2")
+	completedRun := shared.TestRun(2, shared.Completed, shared.Success)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/2"),
+		httpmock.JSONResponse(inProgressRun))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+		httpmock.JSONResponse(shared.TestWorkflow))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/2"),
+		httpmock.JSONResponse(inProgressRun))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+		httpmock.JSONResponse(shared.TestWorkflow))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/2"),
+		httpmock.JSONResponse(completedRun))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+		httpmock.JSONResponse(shared.TestWorkflow))
+
+	ios, _, stdout, _ := iostreams.Test()
+	opts := &WatchOptions{
+		RunID:      "2",
+		JSONEvents: true,
+		Interval:   0,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		IO: ios,
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+		Now: func() time.Time {
+			notnow, _ := time.Parse("2006-01-02 15:04:05", "2021-02-23 05:50:00")
+			return notnow
+		},
+	}
+
+	err := watchRun(opts)
+	assert.NoError(t, err)
+
+	lines := strings.Split(strings.TrimRight(stdout.String(), "\n"), "\n")
+	require.Len(t, lines, 2)
+
+	var first, second runEvent
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &first))
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &second))
+
+	assert.Equal(t, "in_progress", first.Status)
+	assert.Equal(t, "completed", second.Status)
+	assert.Equal(t, "success", second.Conclusion)
+}
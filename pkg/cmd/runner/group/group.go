@@ -0,0 +1,21 @@
+package group
+
+import (
+	cmdList "github.com/cli/cli/v2/pkg/cmd/runner/group/list"
+	cmdView "github.com/cli/cli/v2/pkg/cmd/runner/group/view"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdGroup(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group <command>",
+		Short: "Manage self-hosted runner groups",
+		Long:  "Runner groups control which repositories in an organization can use a set of self-hosted runners.",
+	}
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdView.NewCmdView(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,106 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmd/runner/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
+	Exporter   cmdutil.Exporter
+
+	Org string
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list --org <organization>",
+		Short:   "List self-hosted runner groups for an organization",
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			$ gh runner group list --org my-org
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Org == "" {
+				return cmdutil.FlagErrorf("`--org` is required")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Org, "org", "o", "", "The organization to list runner groups for (required)")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.RunnerGroupFields)
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, _ := cfg.Authentication().DefaultHost()
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("could not create http client: %w", err)
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	opts.IO.StartProgressIndicator()
+	groups, err := shared.GetRunnerGroups(client, host, opts.Org)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("could not get runner groups: %w", err)
+	}
+
+	if len(groups) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("no runner groups found for %s", opts.Org))
+	}
+
+	if err := opts.IO.StartPager(); err == nil {
+		defer opts.IO.StopPager()
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, groups)
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("ID", "NAME", "VISIBILITY", "DEFAULT"))
+
+	for _, g := range groups {
+		tp.AddField(fmt.Sprintf("%d", g.ID), tableprinter.WithColor(cs.Cyan))
+		tp.AddField(g.Name)
+		tp.AddField(g.Visibility)
+		tp.AddField(fmt.Sprintf("%v", g.Default))
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
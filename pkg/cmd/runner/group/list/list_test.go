@@ -0,0 +1,84 @@
+package list
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/cmd/runner/shared"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       ListOptions
+		stubs      func(*httpmock.Registry)
+		wantErr    bool
+		wantErrMsg string
+		wantStdout string
+	}{
+		{
+			name: "lists runner groups",
+			opts: ListOptions{Org: "my-org"},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "orgs/my-org/actions/runner-groups"),
+					httpmock.JSONResponse(map[string]interface{}{
+						"runner_groups": []shared.RunnerGroup{
+							{ID: 1, Name: "Default", Visibility: "all", Default: true},
+						},
+					}),
+				)
+			},
+			wantStdout: "1\tDefault\tall\ttrue\n",
+		},
+		{
+			name: "no groups",
+			opts: ListOptions{Org: "my-org"},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "orgs/my-org/actions/runner-groups"),
+					httpmock.JSONResponse(map[string]interface{}{
+						"runner_groups": []shared.RunnerGroup{},
+					}),
+				)
+			},
+			wantErr:    true,
+			wantErrMsg: "no runner groups found for my-org",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			if tt.stubs != nil {
+				tt.stubs(reg)
+			}
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			ios, _, stdout, _ := iostreams.Test()
+			tt.opts.IO = ios
+			tt.opts.Config = func() (gh.Config, error) {
+				return config.NewBlankConfig(), nil
+			}
+			defer reg.Verify(t)
+
+			err := listRun(&tt.opts)
+			if tt.wantErr {
+				if tt.wantErrMsg != "" {
+					assert.EqualError(t, err, tt.wantErrMsg)
+				} else {
+					assert.Error(t, err)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
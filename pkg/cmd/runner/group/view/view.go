@@ -0,0 +1,128 @@
+package view
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmd/runner/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ViewOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
+	Exporter   cmdutil.Exporter
+
+	Org      string
+	Selector string
+	Label    string
+}
+
+func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
+	opts := &ViewOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view {<group-id> | <group-name>} --org <organization>",
+		Short: "View a self-hosted runner group and the runners in it",
+		Example: heredoc.Doc(`
+			$ gh runner group view Default --org my-org
+			$ gh runner group view Default --org my-org --label linux
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Selector = args[0]
+
+			if opts.Org == "" {
+				return cmdutil.FlagErrorf("`--org` is required")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return viewRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Org, "org", "o", "", "The organization the group belongs to (required)")
+	cmd.Flags().StringVarP(&opts.Label, "label", "l", "", "Filter listed runners by label")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.RunnerFields)
+
+	return cmd
+}
+
+func viewRun(opts *ViewOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, _ := cfg.Authentication().DefaultHost()
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("could not create http client: %w", err)
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	opts.IO.StartProgressIndicator()
+	group, err := shared.FindRunnerGroup(client, host, opts.Org, opts.Selector)
+	var runners []shared.Runner
+	if err == nil {
+		runners, err = shared.GetRunnerGroupRunners(client, host, opts.Org, group.ID)
+	}
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.Label != "" {
+		filtered := make([]shared.Runner, 0, len(runners))
+		for _, r := range runners {
+			if r.HasLabel(opts.Label) {
+				filtered = append(filtered, r)
+			}
+		}
+		runners = filtered
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, runners)
+	}
+
+	cs := opts.IO.ColorScheme()
+	out := opts.IO.Out
+
+	fmt.Fprintf(out, "%s %s\n", cs.Bold("Name:"), group.Name)
+	fmt.Fprintf(out, "%s %d\n", cs.Bold("ID:"), group.ID)
+	fmt.Fprintf(out, "%s %s\n", cs.Bold("Visibility:"), group.Visibility)
+	fmt.Fprintf(out, "%s %v\n", cs.Bold("Default:"), group.Default)
+	fmt.Fprintln(out)
+
+	if len(runners) == 0 {
+		fmt.Fprintln(out, "No runners in this group")
+		return nil
+	}
+
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("ID", "NAME", "OS", "STATUS", "BUSY"))
+	for _, r := range runners {
+		tp.AddField(fmt.Sprintf("%d", r.ID), tableprinter.WithColor(cs.Cyan))
+		tp.AddField(r.Name)
+		tp.AddField(r.OS)
+		tp.AddField(r.Status)
+		tp.AddField(fmt.Sprintf("%v", r.Busy))
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
@@ -0,0 +1,93 @@
+package view
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/cmd/runner/shared"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestViewRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       ViewOptions
+		stubs      func(*httpmock.Registry)
+		wantErr    bool
+		wantErrMsg string
+		wantStdout string
+	}{
+		{
+			name: "views a runner group",
+			opts: ViewOptions{Org: "my-org", Selector: "Default"},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "orgs/my-org/actions/runner-groups"),
+					httpmock.JSONResponse(map[string]interface{}{
+						"runner_groups": []shared.RunnerGroup{
+							{ID: 1, Name: "Default", Visibility: "all", Default: true},
+						},
+					}),
+				)
+				reg.Register(
+					httpmock.REST("GET", "orgs/my-org/actions/runner-groups/1/runners"),
+					httpmock.JSONResponse(map[string]interface{}{
+						"runners": []shared.Runner{
+							{ID: 5, Name: "runner-5", OS: "linux", Status: "online"},
+						},
+					}),
+				)
+			},
+			wantStdout: "Name: Default\nID: 1\nVisibility: all\nDefault: true\n\n" +
+				"5\trunner-5\tlinux\tonline\tfalse\n",
+		},
+		{
+			name: "group not found",
+			opts: ViewOptions{Org: "my-org", Selector: "nope"},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "orgs/my-org/actions/runner-groups"),
+					httpmock.JSONResponse(map[string]interface{}{
+						"runner_groups": []shared.RunnerGroup{},
+					}),
+				)
+			},
+			wantErr:    true,
+			wantErrMsg: `could not find a runner group matching "nope"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			if tt.stubs != nil {
+				tt.stubs(reg)
+			}
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			ios, _, stdout, _ := iostreams.Test()
+			tt.opts.IO = ios
+			tt.opts.Config = func() (gh.Config, error) {
+				return config.NewBlankConfig(), nil
+			}
+			defer reg.Verify(t)
+
+			err := viewRun(&tt.opts)
+			if tt.wantErr {
+				if tt.wantErrMsg != "" {
+					assert.EqualError(t, err, tt.wantErrMsg)
+				} else {
+					assert.Error(t, err)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmd/runner/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+const defaultLimit = 30
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Config     func() (gh.Config, error)
+	Exporter   cmdutil.Exporter
+
+	Org   string
+	Label string
+	Limit int
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List self-hosted runners",
+		Long: heredoc.Doc(`
+			List self-hosted runners for a repository or, with --org, an organization.
+		`),
+		Example: heredoc.Doc(`
+			$ gh runner list
+			$ gh runner list --org my-org --label linux
+		`),
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.Limit < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Org, "org", "o", "", "List runners for an organization")
+	cmd.Flags().StringVarP(&opts.Label, "label", "l", "", "Filter by label")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", defaultLimit, "Maximum number of runners to fetch")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.RunnerFields)
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	entity, host, err := shared.ResolveEntity(opts.Org, opts.BaseRepo, opts.Config)
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("could not create http client: %w", err)
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	opts.IO.StartProgressIndicator()
+	runners, err := shared.GetRunners(client, host, entity, opts.Limit)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("could not get runners: %w", err)
+	}
+
+	if opts.Label != "" {
+		filtered := make([]shared.Runner, 0, len(runners))
+		for _, r := range runners {
+			if r.HasLabel(opts.Label) {
+				filtered = append(filtered, r)
+			}
+		}
+		runners = filtered
+	}
+
+	if len(runners) == 0 {
+		return cmdutil.NewNoResultsError("no self-hosted runners found")
+	}
+
+	if err := opts.IO.StartPager(); err == nil {
+		defer opts.IO.StopPager()
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, runners)
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("ID", "NAME", "OS", "STATUS", "BUSY", "LABELS"))
+
+	for _, r := range runners {
+		tp.AddField(fmt.Sprintf("%d", r.ID), tableprinter.WithColor(cs.Cyan))
+		tp.AddField(r.Name)
+		tp.AddField(r.OS)
+		tp.AddField(r.Status)
+		tp.AddField(fmt.Sprintf("%v", r.Busy))
+		tp.AddField(labelNames(r))
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
+
+func labelNames(r shared.Runner) string {
+	names := make([]string, len(r.Labels))
+	for i, l := range r.Labels {
+		names[i] = l.Name
+	}
+	out := ""
+	for i, n := range names {
+		if i > 0 {
+			out += ", "
+		}
+		out += n
+	}
+	return out
+}
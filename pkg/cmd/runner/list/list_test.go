@@ -0,0 +1,185 @@
+package list
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/runner/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdList(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wants    ListOptions
+		wantsErr string
+	}{
+		{
+			name:  "no arguments",
+			input: "",
+			wants: ListOptions{
+				Limit: defaultLimit,
+			},
+		},
+		{
+			name:  "with org and label",
+			input: "--org my-org --label linux",
+			wants: ListOptions{
+				Limit: defaultLimit,
+				Org:   "my-org",
+				Label: "linux",
+			},
+		},
+		{
+			name:     "invalid limit",
+			input:    "-L 0",
+			wantsErr: "invalid limit: 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+			var gotOpts *ListOptions
+			cmd := NewCmdList(f, func(opts *ListOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				assert.EqualError(t, err, tt.wantsErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wants.Limit, gotOpts.Limit)
+			assert.Equal(t, tt.wants.Org, gotOpts.Org)
+			assert.Equal(t, tt.wants.Label, gotOpts.Label)
+		})
+	}
+}
+
+func TestListRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       ListOptions
+		stubs      func(*httpmock.Registry)
+		wantErr    bool
+		wantErrMsg string
+		wantStdout string
+	}{
+		{
+			name: "lists repo runners",
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runners"),
+					httpmock.JSONResponse(map[string]interface{}{
+						"total_count": 2,
+						"runners": []shared.Runner{
+							{ID: 1, Name: "runner-1", OS: "linux", Status: "online", Busy: false, Labels: []shared.RunnerLabel{{Name: "linux"}}},
+							{ID: 2, Name: "runner-2", OS: "macos", Status: "offline", Busy: true},
+						},
+					}),
+				)
+			},
+			wantStdout: "1\trunner-1\tlinux\tonline\tfalse\tlinux\n2\trunner-2\tmacos\toffline\ttrue\t\n",
+		},
+		{
+			name: "filters by label",
+			opts: ListOptions{Label: "linux"},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runners"),
+					httpmock.JSONResponse(map[string]interface{}{
+						"total_count": 2,
+						"runners": []shared.Runner{
+							{ID: 1, Name: "runner-1", OS: "linux", Status: "online", Labels: []shared.RunnerLabel{{Name: "linux"}}},
+							{ID: 2, Name: "runner-2", OS: "macos", Status: "online", Labels: []shared.RunnerLabel{{Name: "macos"}}},
+						},
+					}),
+				)
+			},
+			wantStdout: "1\trunner-1\tlinux\tonline\tfalse\tlinux\n",
+		},
+		{
+			name: "lists org runners",
+			opts: ListOptions{Org: "my-org"},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "orgs/my-org/actions/runners"),
+					httpmock.JSONResponse(map[string]interface{}{
+						"total_count": 0,
+						"runners":     []shared.Runner{},
+					}),
+				)
+			},
+			wantErr:    true,
+			wantErrMsg: "no self-hosted runners found",
+		},
+		{
+			name: "no results",
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runners"),
+					httpmock.JSONResponse(map[string]interface{}{
+						"total_count": 0,
+						"runners":     []shared.Runner{},
+					}),
+				)
+			},
+			wantErr:    true,
+			wantErrMsg: "no self-hosted runners found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			if tt.stubs != nil {
+				tt.stubs(reg)
+			}
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			ios, _, stdout, _ := iostreams.Test()
+			tt.opts.IO = ios
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.New("OWNER", "REPO"), nil
+			}
+			tt.opts.Config = func() (gh.Config, error) {
+				return config.NewBlankConfig(), nil
+			}
+			if tt.opts.Limit == 0 {
+				tt.opts.Limit = defaultLimit
+			}
+			defer reg.Verify(t)
+
+			err := listRun(&tt.opts)
+			if tt.wantErr {
+				if tt.wantErrMsg != "" {
+					assert.EqualError(t, err, tt.wantErrMsg)
+				} else {
+					assert.Error(t, err)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package remove
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/runner/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type RemoveOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Config     func() (gh.Config, error)
+	Prompter   cmdutil.DeletionPrompter
+
+	Org      string
+	Selector string
+
+	Confirmed    bool
+	ConfirmToken string
+}
+
+func NewCmdRemove(f *cmdutil.Factory, runF func(*RemoveOptions) error) *cobra.Command {
+	opts := &RemoveOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		Prompter:   f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "remove {<runner-id> | <runner-name>}",
+		Short:   "Remove a self-hosted runner",
+		Aliases: []string{"delete"},
+		Example: heredoc.Doc(`
+			$ gh runner remove 42
+			$ gh runner remove my-runner --org my-org
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+			opts.Selector = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return removeRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Org, "org", "o", "", "Remove a runner belonging to an organization")
+	cmdutil.AddConfirmDeletionFlags(cmd, &opts.Confirmed, &opts.ConfirmToken)
+
+	return cmd
+}
+
+func removeRun(opts *RemoveOptions) error {
+	entity, host, err := shared.ResolveEntity(opts.Org, opts.BaseRepo, opts.Config)
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("could not create http client: %w", err)
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	runner, err := shared.FindRunner(client, host, entity, opts.Selector)
+	if err != nil {
+		return err
+	}
+
+	if runner.Busy {
+		return fmt.Errorf("runner %s is currently running a job and cannot be removed", runner.Name)
+	}
+
+	if err := cmdutil.ConfirmDeletion(opts.IO, opts.Prompter, opts.Config, host, runner.Name, opts.Confirmed, opts.ConfirmToken); err != nil {
+		return err
+	}
+
+	if err := shared.RemoveRunner(client, host, entity, runner.ID); err != nil {
+		return fmt.Errorf("failed to remove runner: %w", err)
+	}
+
+	if opts.IO.CanPrompt() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Removed runner %s\n", cs.SuccessIconWithColor(cs.Red), cs.Bold(runner.Name))
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package remove
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/runner/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdRemove(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wants    RemoveOptions
+		wantsErr string
+	}{
+		{
+			name:  "runner id",
+			input: "42",
+			wants: RemoveOptions{Selector: "42"},
+		},
+		{
+			name:     "no arguments",
+			input:    "",
+			wantsErr: "accepts 1 arg(s), received 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+			var gotOpts *RemoveOptions
+			cmd := NewCmdRemove(f, func(opts *RemoveOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				assert.EqualError(t, err, tt.wantsErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wants.Selector, gotOpts.Selector)
+		})
+	}
+}
+
+func TestRemoveRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       RemoveOptions
+		stubs      func(*httpmock.Registry)
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{
+			name: "removes a runner",
+			opts: RemoveOptions{Selector: "1", Confirmed: true},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runners"),
+					httpmock.JSONResponse(map[string]interface{}{
+						"total_count": 1,
+						"runners":     []shared.Runner{{ID: 1, Name: "runner-1"}},
+					}),
+				)
+				reg.Register(
+					httpmock.REST("DELETE", "repos/OWNER/REPO/actions/runners/1"),
+					httpmock.StatusStringResponse(204, ""),
+				)
+			},
+		},
+		{
+			name: "refuses to remove a busy runner",
+			opts: RemoveOptions{Selector: "1", Confirmed: true},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runners"),
+					httpmock.JSONResponse(map[string]interface{}{
+						"total_count": 1,
+						"runners":     []shared.Runner{{ID: 1, Name: "runner-1", Busy: true}},
+					}),
+				)
+			},
+			wantErr:    true,
+			wantErrMsg: "runner runner-1 is currently running a job and cannot be removed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			if tt.stubs != nil {
+				tt.stubs(reg)
+			}
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			ios, _, _, _ := iostreams.Test()
+			tt.opts.IO = ios
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.New("OWNER", "REPO"), nil
+			}
+			tt.opts.Config = func() (gh.Config, error) {
+				return config.NewBlankConfig(), nil
+			}
+			defer reg.Verify(t)
+
+			err := removeRun(&tt.opts)
+			if tt.wantErr {
+				if tt.wantErrMsg != "" {
+					assert.EqualError(t, err, tt.wantErrMsg)
+				} else {
+					assert.Error(t, err)
+				}
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
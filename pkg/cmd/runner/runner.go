@@ -0,0 +1,34 @@
+package runner
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdGroup "github.com/cli/cli/v2/pkg/cmd/runner/group"
+	cmdList "github.com/cli/cli/v2/pkg/cmd/runner/list"
+	cmdRemove "github.com/cli/cli/v2/pkg/cmd/runner/remove"
+	cmdToken "github.com/cli/cli/v2/pkg/cmd/runner/token"
+	cmdView "github.com/cli/cli/v2/pkg/cmd/runner/view"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdRunner(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "runner <command>",
+		Short: "Manage self-hosted runners",
+		Long: heredoc.Doc(`
+			Work with GitHub Actions self-hosted runners for a repository or,
+			with --org, an organization.
+		`),
+		GroupID: "actions",
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdView.NewCmdView(f, nil))
+	cmd.AddCommand(cmdRemove.NewCmdRemove(f, nil))
+	cmd.AddCommand(cmdToken.NewCmdToken(f, nil))
+	cmd.AddCommand(cmdGroup.NewCmdGroup(f))
+
+	return cmd
+}
@@ -0,0 +1,232 @@
+package shared
+
+import (
+	"fmt"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+)
+
+var RunnerFields = []string{
+	"id",
+	"name",
+	"os",
+	"status",
+	"busy",
+	"labels",
+}
+
+var RunnerGroupFields = []string{
+	"id",
+	"name",
+	"visibility",
+	"default",
+	"allowsPublicRepositories",
+}
+
+type RunnerLabel struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type Runner struct {
+	ID     int64         `json:"id"`
+	Name   string        `json:"name"`
+	OS     string        `json:"os"`
+	Status string        `json:"status"`
+	Busy   bool          `json:"busy"`
+	Labels []RunnerLabel `json:"labels"`
+}
+
+func (r *Runner) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(r, fields)
+}
+
+func (r *Runner) HasLabel(label string) bool {
+	for _, l := range r.Labels {
+		if l.Name == label {
+			return true
+		}
+	}
+	return false
+}
+
+type RunnerGroup struct {
+	ID                       int64  `json:"id"`
+	Name                     string `json:"name"`
+	Visibility               string `json:"visibility"`
+	Default                  bool   `json:"default"`
+	AllowsPublicRepositories bool   `json:"allows_public_repositories"`
+}
+
+func (g *RunnerGroup) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(g, fields)
+}
+
+type runnersPayload struct {
+	Runners    []Runner `json:"runners"`
+	TotalCount int      `json:"total_count"`
+}
+
+type runnerGroupsPayload struct {
+	RunnerGroups []RunnerGroup `json:"runner_groups"`
+}
+
+// Entity identifies the owner of a set of self-hosted runners: either a
+// single repository or an entire organization.
+type Entity struct {
+	Org  string
+	Repo ghrepo.Interface
+}
+
+// Path returns the REST API path segment that scopes requests to this entity,
+// e.g. "repos/OWNER/REPO" or "orgs/ORG".
+func (e Entity) Path() string {
+	if e.Org != "" {
+		return fmt.Sprintf("orgs/%s", e.Org)
+	}
+	return fmt.Sprintf("repos/%s", ghrepo.FullName(e.Repo))
+}
+
+// ResolveEntity determines whether a runner command should act on an
+// organization or on the current repository, and which host to talk to.
+func ResolveEntity(org string, baseRepo func() (ghrepo.Interface, error), cfg func() (gh.Config, error)) (Entity, string, error) {
+	if org != "" {
+		c, err := cfg()
+		if err != nil {
+			return Entity{}, "", err
+		}
+		host, _ := c.Authentication().DefaultHost()
+		return Entity{Org: org}, host, nil
+	}
+
+	repo, err := baseRepo()
+	if err != nil {
+		return Entity{}, "", err
+	}
+	return Entity{Repo: repo}, repo.RepoHost(), nil
+}
+
+// GetRunners fetches up to limit runners scoped to entity. A limit <= 0 fetches
+// all of them.
+func GetRunners(client *api.Client, host string, entity Entity, limit int) ([]Runner, error) {
+	perPage := limit
+	page := 1
+	if limit <= 0 || limit > 100 {
+		perPage = 100
+	}
+
+	runners := []Runner{}
+
+	for {
+		if limit > 0 && len(runners) >= limit {
+			break
+		}
+
+		var result runnersPayload
+		path := fmt.Sprintf("%s/actions/runners?per_page=%d&page=%d", entity.Path(), perPage, page)
+		if err := client.REST(host, "GET", path, nil, &result); err != nil {
+			return nil, err
+		}
+
+		runners = append(runners, result.Runners...)
+
+		if len(result.Runners) < perPage || (limit > 0 && len(runners) >= limit) {
+			break
+		}
+		page++
+	}
+
+	if limit > 0 && len(runners) > limit {
+		runners = runners[:limit]
+	}
+
+	return runners, nil
+}
+
+// FindRunner resolves selector, which may be a runner ID or an exact runner
+// name, against the runners visible to entity.
+func FindRunner(client *api.Client, host string, entity Entity, selector string) (*Runner, error) {
+	runners, err := GetRunners(client, host, entity, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range runners {
+		if fmt.Sprintf("%d", r.ID) == selector || r.Name == selector {
+			runner := r
+			return &runner, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find a runner matching %q", selector)
+}
+
+func RemoveRunner(client *api.Client, host string, entity Entity, runnerID int64) error {
+	path := fmt.Sprintf("%s/actions/runners/%d", entity.Path(), runnerID)
+	return client.REST(host, "DELETE", path, nil, nil)
+}
+
+type runnerTokenPayload struct {
+	Token string `json:"token"`
+}
+
+func CreateRegistrationToken(client *api.Client, host string, entity Entity) (string, error) {
+	return createRunnerToken(client, host, entity, "registration-token")
+}
+
+func CreateRemoveToken(client *api.Client, host string, entity Entity) (string, error) {
+	return createRunnerToken(client, host, entity, "remove-token")
+}
+
+func createRunnerToken(client *api.Client, host string, entity Entity, kind string) (string, error) {
+	var result runnerTokenPayload
+	path := fmt.Sprintf("%s/actions/runners/%s", entity.Path(), kind)
+	if err := client.REST(host, "POST", path, nil, &result); err != nil {
+		return "", err
+	}
+	return result.Token, nil
+}
+
+// GetRunnerGroups fetches the runner groups visible to org. Runner groups are
+// an organization-level (and enterprise-level) concept; repositories don't
+// have their own groups.
+func GetRunnerGroups(client *api.Client, host, org string) ([]RunnerGroup, error) {
+	var result runnerGroupsPayload
+	path := fmt.Sprintf("orgs/%s/actions/runner-groups", org)
+	if err := client.REST(host, "GET", path, nil, &result); err != nil {
+		return nil, err
+	}
+	return result.RunnerGroups, nil
+}
+
+// FindRunnerGroup resolves selector, which may be a runner group ID or an
+// exact group name, against the groups visible to org.
+func FindRunnerGroup(client *api.Client, host, org, selector string) (*RunnerGroup, error) {
+	groups, err := GetRunnerGroups(client, host, org)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, g := range groups {
+		if fmt.Sprintf("%d", g.ID) == selector || g.Name == selector {
+			group := g
+			return &group, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find a runner group matching %q", selector)
+}
+
+// GetRunnerGroupRunners fetches the runners that belong to a runner group.
+func GetRunnerGroupRunners(client *api.Client, host, org string, groupID int64) ([]Runner, error) {
+	var result runnersPayload
+	path := fmt.Sprintf("orgs/%s/actions/runner-groups/%d/runners?per_page=100", org, groupID)
+	if err := client.REST(host, "GET", path, nil, &result); err != nil {
+		return nil, err
+	}
+	return result.Runners, nil
+}
@@ -0,0 +1,99 @@
+package shared
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntityPath(t *testing.T) {
+	tests := []struct {
+		name   string
+		entity Entity
+		want   string
+	}{
+		{
+			name:   "org",
+			entity: Entity{Org: "my-org"},
+			want:   "orgs/my-org",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.entity.Path())
+		})
+	}
+}
+
+func TestGetRunnersPagination(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	page1 := make([]Runner, 100)
+	for i := range page1 {
+		page1[i] = Runner{ID: int64(i + 1)}
+	}
+	page2 := []Runner{{ID: 101}}
+
+	reg.Register(
+		httpmock.QueryMatcher("GET", "orgs/my-org/actions/runners", url.Values{"page": []string{"1"}}),
+		httpmock.JSONResponse(map[string]interface{}{"total_count": 101, "runners": page1}),
+	)
+	reg.Register(
+		httpmock.QueryMatcher("GET", "orgs/my-org/actions/runners", url.Values{"page": []string{"2"}}),
+		httpmock.JSONResponse(map[string]interface{}{"total_count": 101, "runners": page2}),
+	)
+
+	client := api.NewClientFromHTTP(&http.Client{Transport: reg})
+	runners, err := GetRunners(client, "github.com", Entity{Org: "my-org"}, -1)
+	require.NoError(t, err)
+	assert.Len(t, runners, 101)
+	assert.Equal(t, int64(101), runners[100].ID)
+}
+
+func TestFindRunner(t *testing.T) {
+	entity := Entity{Repo: fakeRepo{}}
+	runnersResponse := func() httpmock.Responder {
+		return httpmock.JSONResponse(map[string]interface{}{
+			"total_count": 2,
+			"runners": []Runner{
+				{ID: 1, Name: "runner-1"},
+				{ID: 2, Name: "runner-2"},
+			},
+		})
+	}
+
+	t.Run("matches by name", func(t *testing.T) {
+		reg := &httpmock.Registry{}
+		defer reg.Verify(t)
+		reg.Register(httpmock.REST("GET", "repos/OWNER/REPO/actions/runners"), runnersResponse())
+
+		client := api.NewClientFromHTTP(&http.Client{Transport: reg})
+		runner, err := FindRunner(client, "github.com", entity, "runner-2")
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), runner.ID)
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		reg := &httpmock.Registry{}
+		defer reg.Verify(t)
+		reg.Register(httpmock.REST("GET", "repos/OWNER/REPO/actions/runners"), runnersResponse())
+
+		client := api.NewClientFromHTTP(&http.Client{Transport: reg})
+		_, err := FindRunner(client, "github.com", entity, "not-found")
+		assert.EqualError(t, err, fmt.Sprintf(`could not find a runner matching "not-found"`))
+	})
+}
+
+type fakeRepo struct{}
+
+func (fakeRepo) RepoName() string  { return "REPO" }
+func (fakeRepo) RepoOwner() string { return "OWNER" }
+func (fakeRepo) RepoHost() string  { return "github.com" }
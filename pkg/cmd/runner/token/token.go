@@ -0,0 +1,93 @@
+package token
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/runner/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type TokenOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Config     func() (gh.Config, error)
+
+	Org    string
+	Remove bool
+}
+
+func NewCmdToken(f *cmdutil.Factory, runF func(*TokenOptions) error) *cobra.Command {
+	opts := &TokenOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "token",
+		Short: "Print a registration or removal token for self-hosted runners",
+		Long: heredoc.Doc(`
+			Print a short-lived token for registering or removing a self-hosted
+			runner for a repository or, with --org, an organization.
+
+			By default, a registration token is printed. Use --remove to print a
+			removal token instead, for use with "config.sh remove" or "config.cmd remove"
+			on the runner host.
+		`),
+		Example: heredoc.Doc(`
+			$ gh runner token
+			$ gh runner token --org my-org --remove
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return tokenRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Org, "org", "o", "", "Print a token for an organization")
+	cmd.Flags().BoolVar(&opts.Remove, "remove", false, "Print a removal token instead of a registration token")
+
+	return cmd
+}
+
+func tokenRun(opts *TokenOptions) error {
+	entity, host, err := shared.ResolveEntity(opts.Org, opts.BaseRepo, opts.Config)
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("could not create http client: %w", err)
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	var token string
+	if opts.Remove {
+		token, err = shared.CreateRemoveToken(client, host, entity)
+	} else {
+		token, err = shared.CreateRegistrationToken(client, host, entity)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create token: %w", err)
+	}
+
+	fmt.Fprintln(opts.IO.Out, token)
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package token
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       TokenOptions
+		stubs      func(*httpmock.Registry)
+		wantStdout string
+	}{
+		{
+			name: "prints a registration token",
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runners/registration-token"),
+					httpmock.JSONResponse(map[string]string{"token": "AABBCC"}),
+				)
+			},
+			wantStdout: "AABBCC\n",
+		},
+		{
+			name: "prints a removal token",
+			opts: TokenOptions{Remove: true},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runners/remove-token"),
+					httpmock.JSONResponse(map[string]string{"token": "DDEEFF"}),
+				)
+			},
+			wantStdout: "DDEEFF\n",
+		},
+		{
+			name: "prints an org registration token",
+			opts: TokenOptions{Org: "my-org"},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("POST", "orgs/my-org/actions/runners/registration-token"),
+					httpmock.JSONResponse(map[string]string{"token": "GGHHII"}),
+				)
+			},
+			wantStdout: "GGHHII\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			if tt.stubs != nil {
+				tt.stubs(reg)
+			}
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			ios, _, stdout, _ := iostreams.Test()
+			tt.opts.IO = ios
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.New("OWNER", "REPO"), nil
+			}
+			tt.opts.Config = func() (gh.Config, error) {
+				return config.NewBlankConfig(), nil
+			}
+			defer reg.Verify(t)
+
+			err := tokenRun(&tt.opts)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
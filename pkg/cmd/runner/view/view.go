@@ -0,0 +1,109 @@
+package view
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/runner/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ViewOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Config     func() (gh.Config, error)
+	Exporter   cmdutil.Exporter
+
+	Org      string
+	Selector string
+}
+
+func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
+	opts := &ViewOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view {<runner-id> | <runner-name>}",
+		Short: "View details about a self-hosted runner",
+		Example: heredoc.Doc(`
+			$ gh runner view 42
+			$ gh runner view my-runner --org my-org
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+			opts.Selector = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return viewRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Org, "org", "o", "", "View a runner belonging to an organization")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.RunnerFields)
+
+	return cmd
+}
+
+func viewRun(opts *ViewOptions) error {
+	entity, host, err := shared.ResolveEntity(opts.Org, opts.BaseRepo, opts.Config)
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("could not create http client: %w", err)
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	opts.IO.StartProgressIndicator()
+	runner, err := shared.FindRunner(client, host, entity, opts.Selector)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, runner)
+	}
+
+	cs := opts.IO.ColorScheme()
+	out := opts.IO.Out
+
+	fmt.Fprintf(out, "%s %s\n", cs.Bold("Name:"), runner.Name)
+	fmt.Fprintf(out, "%s %d\n", cs.Bold("ID:"), runner.ID)
+	fmt.Fprintf(out, "%s %s\n", cs.Bold("OS:"), runner.OS)
+	fmt.Fprintf(out, "%s %s\n", cs.Bold("Status:"), runnerStatus(cs, *runner))
+	fmt.Fprintf(out, "%s %v\n", cs.Bold("Busy:"), runner.Busy)
+
+	labels := make([]string, len(runner.Labels))
+	for i, l := range runner.Labels {
+		labels[i] = l.Name
+	}
+	fmt.Fprintf(out, "%s %s\n", cs.Bold("Labels:"), strings.Join(labels, ", "))
+
+	return nil
+}
+
+func runnerStatus(cs *iostreams.ColorScheme, r shared.Runner) string {
+	if r.Status == "online" {
+		return cs.Green(r.Status)
+	}
+	return cs.Red(r.Status)
+}
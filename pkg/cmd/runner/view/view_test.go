@@ -0,0 +1,144 @@
+package view
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/runner/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdView(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wants    ViewOptions
+		wantsErr string
+	}{
+		{
+			name:  "runner id",
+			input: "42",
+			wants: ViewOptions{Selector: "42"},
+		},
+		{
+			name:  "with org",
+			input: "my-runner --org my-org",
+			wants: ViewOptions{Selector: "my-runner", Org: "my-org"},
+		},
+		{
+			name:     "no arguments",
+			input:    "",
+			wantsErr: "accepts 1 arg(s), received 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+			var gotOpts *ViewOptions
+			cmd := NewCmdView(f, func(opts *ViewOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				assert.EqualError(t, err, tt.wantsErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wants.Selector, gotOpts.Selector)
+			assert.Equal(t, tt.wants.Org, gotOpts.Org)
+		})
+	}
+}
+
+func TestViewRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       ViewOptions
+		stubs      func(*httpmock.Registry)
+		wantErr    bool
+		wantErrMsg string
+		wantStdout string
+	}{
+		{
+			name: "views a runner by id",
+			opts: ViewOptions{Selector: "1"},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runners"),
+					httpmock.JSONResponse(map[string]interface{}{
+						"total_count": 1,
+						"runners": []shared.Runner{
+							{ID: 1, Name: "runner-1", OS: "linux", Status: "online", Labels: []shared.RunnerLabel{{Name: "linux"}, {Name: "self-hosted"}}},
+						},
+					}),
+				)
+			},
+			wantStdout: "Name: runner-1\nID: 1\nOS: linux\nStatus: online\nBusy: false\nLabels: linux, self-hosted\n",
+		},
+		{
+			name: "runner not found",
+			opts: ViewOptions{Selector: "nope"},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runners"),
+					httpmock.JSONResponse(map[string]interface{}{
+						"total_count": 0,
+						"runners":     []shared.Runner{},
+					}),
+				)
+			},
+			wantErr:    true,
+			wantErrMsg: `could not find a runner matching "nope"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			if tt.stubs != nil {
+				tt.stubs(reg)
+			}
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			ios, _, stdout, _ := iostreams.Test()
+			tt.opts.IO = ios
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.New("OWNER", "REPO"), nil
+			}
+			tt.opts.Config = func() (gh.Config, error) {
+				return config.NewBlankConfig(), nil
+			}
+			defer reg.Verify(t)
+
+			err := viewRun(&tt.opts)
+			if tt.wantErr {
+				if tt.wantErrMsg != "" {
+					assert.EqualError(t, err, tt.wantErrMsg)
+				} else {
+					assert.Error(t, err)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
@@ -1,11 +1,21 @@
 package code
 
 import (
+	"context"
+	"encoding/base64"
 	"fmt"
+	"net/http"
+	"net/url"
+	"path/filepath"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/browser"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/internal/text"
 	"github.com/cli/cli/v2/pkg/cmd/search/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -15,19 +25,28 @@ import (
 )
 
 type CodeOptions struct {
-	Browser  browser.Browser
-	Exporter cmdutil.Exporter
-	IO       *iostreams.IOStreams
-	Query    search.Query
-	Searcher search.Searcher
-	WebMode  bool
+	Browser    browser.Browser
+	Config     func() (gh.Config, error)
+	Exporter   cmdutil.Exporter
+	GitClient  *git.Client
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Query      search.Query
+	Searcher   search.Searcher
+	WebMode    bool
+
+	Context     int
+	CheckoutDir string
 }
 
 func NewCmdCode(f *cmdutil.Factory, runF func(*CodeOptions) error) *cobra.Command {
 	opts := &CodeOptions{
-		Browser: f.Browser,
-		IO:      f.IOStreams,
-		Query:   search.Query{Kind: search.KindCode},
+		Browser:    f.Browser,
+		Config:     f.Config,
+		GitClient:  f.GitClient,
+		HttpClient: f.HttpClient,
+		IO:         f.IOStreams,
+		Query:      search.Query{Kind: search.KindCode},
 	}
 
 	cmd := &cobra.Command{
@@ -47,7 +66,13 @@ func NewCmdCode(f *cmdutil.Factory, runF func(*CodeOptions) error) *cobra.Comman
 			# search code matching "react" and "lifecycle"
 			$ gh search code react lifecycle
 
-			# search code matching "error handling" 
+			# search code matching "map" with 3 lines of surrounding context per match
+			$ gh search code map --context 3
+
+			# search code matching "map" and sparse-checkout the matched files into ./results
+			$ gh search code map --checkout ./results
+
+			# search code matching "error handling"
 			$ gh search code "error handling"
 	
 			# search code matching "deque" in Python files
@@ -69,6 +94,9 @@ func NewCmdCode(f *cmdutil.Factory, runF func(*CodeOptions) error) *cobra.Comman
 			if opts.Query.Limit < 1 || opts.Query.Limit > shared.SearchMaxResults {
 				return cmdutil.FlagErrorf("`--limit` must be between 1 and 1000")
 			}
+			if opts.Context < 0 {
+				return cmdutil.FlagErrorf("`--context` must be a non-negative number of lines")
+			}
 			opts.Query.Keywords = args
 			if runF != nil {
 				return runF(opts)
@@ -98,6 +126,10 @@ func NewCmdCode(f *cmdutil.Factory, runF func(*CodeOptions) error) *cobra.Comman
 	cmd.Flags().StringVar(&opts.Query.Qualifiers.Size, "size", "", "Filter on size range, in kilobytes")
 	cmd.Flags().StringSliceVar(&opts.Query.Qualifiers.User, "owner", nil, "Filter on owner")
 
+	// Result display flags
+	cmd.Flags().IntVarP(&opts.Context, "context", "C", 0, "Fetch `N` additional lines of context around each match")
+	cmd.Flags().StringVar(&opts.CheckoutDir, "checkout", "", "Sparse-checkout matched files from each repository into `DIR`")
+
 	return cmd
 }
 
@@ -130,11 +162,45 @@ func codeRun(opts *CodeOptions) error {
 		return opts.Exporter.Write(io, results.Items)
 	}
 
-	return displayResults(io, results)
+	if opts.CheckoutDir != "" {
+		if err := checkoutResults(opts, results); err != nil {
+			return err
+		}
+	}
+
+	return displayResults(opts, results)
 }
 
-func displayResults(io *iostreams.IOStreams, results search.CodeResult) error {
+func displayResults(opts *CodeOptions, results search.CodeResult) error {
+	io := opts.IO
 	cs := io.ColorScheme()
+
+	var httpClient *http.Client
+	if opts.Context > 0 {
+		var err error
+		httpClient, err = opts.HttpClient()
+		if err != nil {
+			return err
+		}
+	}
+
+	matchLines := func(code search.Code, match search.TextMatch) []string {
+		lines := formatMatch(match.Fragment, match.Matches, io.ColorEnabled())
+		if httpClient == nil {
+			return lines
+		}
+		before, after, err := fetchContextLines(httpClient, code, match, opts.Context)
+		if err != nil {
+			fmt.Fprintf(io.ErrOut, "failed to fetch context for %s: %v\n", code.Path, err)
+			return lines
+		}
+		expanded := make([]string, 0, len(before)+len(lines)+len(after))
+		expanded = append(expanded, before...)
+		expanded = append(expanded, lines...)
+		expanded = append(expanded, after...)
+		return expanded
+	}
+
 	if io.IsStdoutTTY() {
 		fmt.Fprintf(io.Out, "\nShowing %d of %d results\n\n", len(results.Items), results.Total)
 		for i, code := range results.Items {
@@ -143,8 +209,7 @@ func displayResults(io *iostreams.IOStreams, results search.CodeResult) error {
 			}
 			fmt.Fprintf(io.Out, "%s %s\n", cs.Blue(code.Repository.FullName), cs.GreenBold(code.Path))
 			for _, match := range code.TextMatches {
-				lines := formatMatch(match.Fragment, match.Matches, io.ColorEnabled())
-				for _, line := range lines {
+				for _, line := range matchLines(code, match) {
 					fmt.Fprintf(io.Out, "\t%s\n", strings.TrimSpace(line))
 				}
 			}
@@ -153,8 +218,7 @@ func displayResults(io *iostreams.IOStreams, results search.CodeResult) error {
 	}
 	for _, code := range results.Items {
 		for _, match := range code.TextMatches {
-			lines := formatMatch(match.Fragment, match.Matches, io.ColorEnabled())
-			for _, line := range lines {
+			for _, line := range matchLines(code, match) {
 				fmt.Fprintf(io.Out, "%s:%s: %s\n", cs.Blue(code.Repository.FullName), cs.GreenBold(code.Path), strings.TrimSpace(line))
 			}
 		}
@@ -162,6 +226,147 @@ func displayResults(io *iostreams.IOStreams, results search.CodeResult) error {
 	return nil
 }
 
+// fetchContextLines fetches the full contents of the file a match was found in and returns up to
+// contextLines lines immediately before and after the matched fragment.
+func fetchContextLines(httpClient *http.Client, code search.Code, match search.TextMatch, contextLines int) (before, after []string, err error) {
+	fileLines, err := fetchFileLines(httpClient, code.Repository.FullName, code.Path, code.Sha)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fragmentLines := strings.Split(match.Fragment, "\n")
+	start := indexOfLines(fileLines, fragmentLines)
+	if start < 0 {
+		return nil, nil, fmt.Errorf("could not locate match within %s", code.Path)
+	}
+	end := start + len(fragmentLines)
+
+	beforeStart := start - contextLines
+	if beforeStart < 0 {
+		beforeStart = 0
+	}
+	afterEnd := end + contextLines
+	if afterEnd > len(fileLines) {
+		afterEnd = len(fileLines)
+	}
+
+	return fileLines[beforeStart:start], fileLines[end:afterEnd], nil
+}
+
+// indexOfLines returns the index at which needle first occurs as a contiguous run within
+// haystack, or -1 if it is not found.
+func indexOfLines(haystack, needle []string) int {
+	if len(needle) == 0 || len(needle) > len(haystack) {
+		return -1
+	}
+	for i := 0; i+len(needle) <= len(haystack); i++ {
+		match := true
+		for j, line := range needle {
+			if haystack[i+j] != line {
+				match = false
+				break
+			}
+		}
+		if match {
+			return i
+		}
+	}
+	return -1
+}
+
+type repositoryContent struct {
+	Content  string `json:"content"`
+	Encoding string `json:"encoding"`
+}
+
+func fetchFileLines(httpClient *http.Client, repo, path, ref string) ([]string, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		segments[i] = url.PathEscape(s)
+	}
+
+	apiPath := fmt.Sprintf("repos/%s/contents/%s?ref=%s", repo, strings.Join(segments, "/"), url.QueryEscape(ref))
+	var content repositoryContent
+	if err := apiClient.REST(ghinstance.Default(), "GET", apiPath, nil, &content); err != nil {
+		return nil, err
+	}
+	if content.Encoding != "base64" {
+		return nil, fmt.Errorf("unsupported content encoding %q", content.Encoding)
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(content.Content, "\n", ""))
+	if err != nil {
+		return nil, err
+	}
+	return strings.Split(string(decoded), "\n"), nil
+}
+
+// checkoutResults sparse-checks out the matched files from each distinct repository in results
+// into a subdirectory of opts.CheckoutDir, smoothing the transition from searching to editing.
+func checkoutResults(opts *CodeOptions, results search.CodeResult) error {
+	io := opts.IO
+	cs := io.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, _ := cfg.Authentication().DefaultHost()
+	protocol := cfg.GitProtocol(host).Value
+
+	var repoOrder []string
+	pathsByRepo := map[string][]string{}
+	for _, code := range results.Items {
+		name := code.Repository.FullName
+		if _, ok := pathsByRepo[name]; !ok {
+			repoOrder = append(repoOrder, name)
+		}
+		pathsByRepo[name] = append(pathsByRepo[name], code.Path)
+	}
+
+	for _, fullName := range repoOrder {
+		repo, err := ghrepo.FromFullNameWithHost(fullName, host)
+		if err != nil {
+			return err
+		}
+		dir := filepath.Join(opts.CheckoutDir, strings.ReplaceAll(fullName, "/", "-"))
+		if err := sparseCheckout(opts.GitClient, repo, protocol, dir, pathsByRepo[fullName]); err != nil {
+			return fmt.Errorf("failed to check out %s: %w", fullName, err)
+		}
+		fmt.Fprintf(io.Out, "%s Checked out %s to %s\n", cs.SuccessIcon(), fullName, dir)
+	}
+	return nil
+}
+
+func sparseCheckout(gitClient *git.Client, repo ghrepo.Interface, protocol, dir string, paths []string) error {
+	ctx := context.Background()
+	cloneURL := ghrepo.FormatRemoteURL(repo, protocol)
+
+	cloneClient := gitClient.Copy()
+	if _, err := cloneClient.Clone(ctx, cloneURL, []string{dir, "--filter=blob:none", "--no-checkout", "--sparse"}); err != nil {
+		return err
+	}
+
+	repoClient := gitClient.Copy()
+	repoClient.RepoDir = dir
+
+	setCmd, err := repoClient.Command(ctx, append([]string{"sparse-checkout", "set"}, paths...)...)
+	if err != nil {
+		return err
+	}
+	if err := setCmd.Run(); err != nil {
+		return err
+	}
+
+	checkoutCmd, err := repoClient.Command(ctx, "checkout")
+	if err != nil {
+		return err
+	}
+	return checkoutCmd.Run()
+}
+
 func formatMatch(t string, matches []search.Match, colorize bool) []string {
 	startIndices := map[int]struct{}{}
 	endIndices := map[int]struct{}{}
@@ -2,15 +2,23 @@ package code
 
 import (
 	"bytes"
+	"encoding/base64"
 	"fmt"
+	"net/http"
 	"testing"
 
+	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/browser"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/run"
 	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/cli/cli/v2/pkg/search"
 	"github.com/google/shlex"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewCmdCode(t *testing.T) {
@@ -67,6 +75,12 @@ func TestNewCmdCode(t *testing.T) {
 			wantErr: true,
 			errMsg:  "`--limit` must be between 1 and 1000",
 		},
+		{
+			name:    "invalid context flag",
+			input:   "--context -1",
+			wantErr: true,
+			errMsg:  "`--context` must be a non-negative number of lines",
+		},
 		{
 			name: "qualifier flags",
 			input: `
@@ -340,3 +354,106 @@ func TestCodeRun(t *testing.T) {
 		})
 	}
 }
+
+func TestCodeRun_context(t *testing.T) {
+	fileContents := "package context\n\nfunc add(r *api.Repository) {\n\trepoMap := map[string]bool{}\n\tfn := ghrepo.FullName(r)\n\t_ = fn\n}\n"
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/cli/cli/contents/context/context.go"),
+		httpmock.StringResponse(fmt.Sprintf(`{"content": %q, "encoding": "base64"}`, base64.StdEncoding.EncodeToString([]byte(fileContents)))),
+	)
+
+	ios, _, stdout, stderr := iostreams.Test()
+	ios.SetStdoutTTY(false)
+
+	opts := &CodeOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Context: 1,
+		Searcher: &search.SearcherMock{
+			CodeFunc: func(query search.Query) (search.CodeResult, error) {
+				return search.CodeResult{
+					Items: []search.Code{
+						{
+							Name: "context.go",
+							Path: "context/context.go",
+							Sha:  "deadbeef",
+							Repository: search.Repository{
+								FullName: "cli/cli",
+							},
+							TextMatches: []search.TextMatch{
+								{
+									Fragment: "\trepoMap := map[string]bool{}",
+									Matches: []search.Match{
+										{
+											Text:    "map",
+											Indices: []int{12, 15},
+										},
+									},
+								},
+							},
+						},
+					},
+					Total: 1,
+				}, nil
+			},
+		},
+	}
+
+	err := codeRun(opts)
+	require.NoError(t, err)
+	assert.Equal(t, "", stderr.String())
+	assert.Equal(t, "cli/cli:context/context.go: func add(r *api.Repository) {\ncli/cli:context/context.go: repoMap := map[string]bool{}\ncli/cli:context/context.go: fn := ghrepo.FullName(r)\n", stdout.String())
+}
+
+func TestCodeRun_checkout(t *testing.T) {
+	cs, restore := run.Stub()
+	defer restore(t)
+	cs.Register(`git clone --filter=blob:none --no-checkout --sparse https://github.com/cli/cli.git somedir/cli-cli`, 0, "")
+	cs.Register(`git -C somedir/cli-cli sparse-checkout set context/context.go`, 0, "")
+	cs.Register(`git -C somedir/cli-cli checkout`, 0, "")
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(false)
+
+	opts := &CodeOptions{
+		IO: ios,
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		GitClient:   &git.Client{GitPath: "path/to/git"},
+		CheckoutDir: "somedir",
+		Searcher: &search.SearcherMock{
+			CodeFunc: func(query search.Query) (search.CodeResult, error) {
+				return search.CodeResult{
+					Items: []search.Code{
+						{
+							Name: "context.go",
+							Path: "context/context.go",
+							Repository: search.Repository{
+								FullName: "cli/cli",
+							},
+							TextMatches: []search.TextMatch{
+								{
+									Fragment: "repoMap := map[string]bool{}",
+									Matches: []search.Match{
+										{Text: "map", Indices: []int{9, 12}},
+									},
+								},
+							},
+						},
+					},
+					Total: 1,
+				}, nil
+			},
+		},
+	}
+
+	err := codeRun(opts)
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "Checked out cli/cli to somedir/cli-cli\n")
+}
@@ -15,6 +15,7 @@ func NewCmdIssues(f *cmdutil.Factory, runF func(*shared.IssuesOptions) error) *c
 	var noAssignee, noLabel, noMilestone, noProject bool
 	var order, sort string
 	var appAuthor string
+	var watchInterval int
 	opts := &shared.IssuesOptions{
 		Browser: f.Browser,
 		Entity:  shared.Issues,
@@ -56,6 +57,9 @@ func NewCmdIssues(f *cmdutil.Factory, runF func(*shared.IssuesOptions) error) *c
 
 			# search issues only from un-archived repositories (default is all repositories)
 			$ gh search issues --owner github --archived=false
+
+			# watch for new issues mentioning yourself, polling every 5 minutes
+			$ gh search issues --mentions=@me --state=open --watch --interval 300
 		`),
 		RunE: func(c *cobra.Command, args []string) error {
 			if len(args) == 0 && c.Flags().NFlag() == 0 {
@@ -99,6 +103,9 @@ func NewCmdIssues(f *cmdutil.Factory, runF func(*shared.IssuesOptions) error) *c
 			if c.Flags().Changed("no-project") && noProject {
 				opts.Query.Qualifiers.No = append(opts.Query.Qualifiers.No, "project")
 			}
+			if err := shared.ValidateWatchFlags(c, opts, watchInterval); err != nil {
+				return err
+			}
 			opts.Query.Keywords = args
 			if runF != nil {
 				return runF(opts)
@@ -115,6 +122,7 @@ func NewCmdIssues(f *cmdutil.Factory, runF func(*shared.IssuesOptions) error) *c
 	// Output flags
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, search.IssueFields)
 	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open the search query in the web browser")
+	shared.AddWatchFlags(cmd, opts, &watchInterval)
 
 	// Query parameter flags
 	cmd.Flags().IntVarP(&opts.Query.Limit, "limit", "L", 30, "Maximum number of results to fetch")
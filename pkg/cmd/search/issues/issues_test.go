@@ -119,6 +119,18 @@ func TestNewCmdIssues(t *testing.T) {
 			wantErr: true,
 			errMsg:  "specify only `--author` or `--app`",
 		},
+		{
+			name:    "interval flag without watch flag",
+			input:   "--interval 30",
+			wantErr: true,
+			errMsg:  "cannot use `--interval` flag without `--watch` flag",
+		},
+		{
+			name:    "watch flag with web flag",
+			input:   "--watch --web",
+			wantErr: true,
+			errMsg:  "specify only one of `--watch` or `--web`",
+		},
 		{
 			name: "qualifier flags",
 			input: `
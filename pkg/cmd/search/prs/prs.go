@@ -17,6 +17,7 @@ func NewCmdPrs(f *cmdutil.Factory, runF func(*shared.IssuesOptions) error) *cobr
 	var order, sort string
 	var appAuthor string
 	var requestedReviewer string
+	var watchInterval int
 	opts := &shared.IssuesOptions{
 		Browser: f.Browser,
 		Entity:  shared.PullRequests,
@@ -58,6 +59,9 @@ func NewCmdPrs(f *cmdutil.Factory, runF func(*shared.IssuesOptions) error) *cobr
 
 			# search pull requests only from un-archived repositories (default is all repositories)
 			$ gh search prs --owner github --archived=false
+
+			# watch for new pull requests requesting your review, polling every 5 minutes
+			$ gh search prs --review-requested=@me --state=open --watch --interval 300
 		`),
 		RunE: func(c *cobra.Command, args []string) error {
 			if len(args) == 0 && c.Flags().NFlag() == 0 {
@@ -111,6 +115,9 @@ func NewCmdPrs(f *cmdutil.Factory, runF func(*shared.IssuesOptions) error) *cobr
 					opts.Query.Qualifiers.ReviewRequested = requestedReviewer
 				}
 			}
+			if err := shared.ValidateWatchFlags(c, opts, watchInterval); err != nil {
+				return err
+			}
 			opts.Query.Keywords = args
 			if runF != nil {
 				return runF(opts)
@@ -127,6 +134,7 @@ func NewCmdPrs(f *cmdutil.Factory, runF func(*shared.IssuesOptions) error) *cobr
 	// Output flags
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, search.PullRequestFields)
 	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open the search query in the web browser")
+	shared.AddWatchFlags(cmd, opts, &watchInterval)
 
 	// Query parameter flags
 	cmd.Flags().IntVarP(&opts.Query.Limit, "limit", "L", 30, "Maximum number of results to fetch")
@@ -12,6 +12,7 @@ import (
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/cli/cli/v2/pkg/search"
+	"github.com/spf13/cobra"
 )
 
 type EntityType int
@@ -35,6 +36,49 @@ type IssuesOptions struct {
 	Query    search.Query
 	Searcher search.Searcher
 	WebMode  bool
+
+	Watch    bool
+	Interval time.Duration
+	Notifier Notifier
+}
+
+// DefaultWatchInterval is how often a watched search is re-run when `--interval` isn't specified.
+const DefaultWatchInterval = 60 * time.Second
+
+// Notifier is notified about new results found while watching a search.
+type Notifier interface {
+	Notify(summary string) error
+}
+
+// TerminalBellNotifier notifies the user by ringing the terminal bell alongside the printed
+// summary, and serves as the default Notifier until a desktop notification backend is wired in.
+type TerminalBellNotifier struct {
+	IO *iostreams.IOStreams
+}
+
+func (n *TerminalBellNotifier) Notify(summary string) error {
+	fmt.Fprintf(n.IO.ErrOut, "\a%s\n", summary)
+	return nil
+}
+
+// AddWatchFlags registers the `--watch` and `--interval` flags shared by the search commands that
+// support polling, storing the parsed interval in seconds.
+func AddWatchFlags(cmd *cobra.Command, opts *IssuesOptions, intervalSeconds *int) {
+	cmd.Flags().BoolVar(&opts.Watch, "watch", false, "Re-run the search on an interval and report new results as they appear")
+	cmd.Flags().IntVar(intervalSeconds, "interval", int(DefaultWatchInterval.Seconds()), "Refresh interval in `seconds` when using `--watch`")
+}
+
+// ValidateWatchFlags checks that `--interval` was only used alongside `--watch` and populates
+// opts.Interval from the parsed flag value.
+func ValidateWatchFlags(cmd *cobra.Command, opts *IssuesOptions, intervalSeconds int) error {
+	if !opts.Watch && cmd.Flags().Changed("interval") {
+		return cmdutil.FlagErrorf("cannot use `--interval` flag without `--watch` flag")
+	}
+	if opts.Watch && opts.WebMode {
+		return cmdutil.FlagErrorf("specify only one of `--watch` or `--web`")
+	}
+	opts.Interval = time.Duration(intervalSeconds) * time.Second
+	return nil
 }
 
 func Searcher(f *cmdutil.Factory) (search.Searcher, error) {
@@ -59,6 +103,9 @@ func SearchIssues(opts *IssuesOptions) error {
 		}
 		return opts.Browser.Browse(url)
 	}
+	if opts.Watch {
+		return watchIssues(opts)
+	}
 	io.StartProgressIndicator()
 	result, err := opts.Searcher.Issues(opts.Query)
 	io.StopProgressIndicator()
@@ -91,6 +138,70 @@ func SearchIssues(opts *IssuesOptions) error {
 	return displayIssueResults(io, opts.Now, opts.Entity, result)
 }
 
+// watchIssues re-runs opts.Query on opts.Interval, printing and notifying about only the results
+// that weren't present in the previous poll. The initial poll displays the full result set to
+// establish a baseline, matching what a plain (non-watch) search would show.
+func watchIssues(opts *IssuesOptions) error {
+	io := opts.IO
+	cs := io.ColorScheme()
+	notifier := opts.Notifier
+	if notifier == nil {
+		notifier = &TerminalBellNotifier{IO: io}
+	}
+	seen := map[string]struct{}{}
+
+	for round := 0; ; round++ {
+		io.StartProgressIndicator()
+		result, err := opts.Searcher.Issues(opts.Query)
+		io.StopProgressIndicator()
+		if err != nil {
+			return err
+		}
+
+		var fresh []search.Issue
+		for _, issue := range result.Items {
+			if _, ok := seen[issue.ID]; ok {
+				continue
+			}
+			seen[issue.ID] = struct{}{}
+			fresh = append(fresh, issue)
+		}
+
+		if round == 0 {
+			if len(result.Items) == 0 && opts.Exporter == nil {
+				fmt.Fprintf(io.Out, "No results yet. Watching for new matches every %v. Press Ctrl+C to quit.\n", opts.Interval)
+			} else if err := displayIssueResults(io, opts.Now, opts.Entity, result); err != nil {
+				return err
+			}
+		} else {
+			for _, issue := range fresh {
+				printNewIssue(io, cs, issue)
+				if err := notifier.Notify(newIssueSummary(issue)); err != nil {
+					fmt.Fprintf(io.ErrOut, "failed to send notification: %v\n", err)
+				}
+			}
+		}
+
+		time.Sleep(opts.Interval)
+	}
+}
+
+func printNewIssue(io *iostreams.IOStreams, cs *iostreams.ColorScheme, issue search.Issue) {
+	comp := strings.Split(issue.RepositoryURL, "/")
+	name := strings.Join(comp[len(comp)-2:], "/")
+	fmt.Fprintf(io.Out, "%s %s#%d %s\n", cs.Gray(time.Now().Format(time.Kitchen)), name, issue.Number, text.RemoveExcessiveWhitespace(issue.Title))
+}
+
+func newIssueSummary(issue search.Issue) string {
+	kind := "issue"
+	if issue.IsPullRequest() {
+		kind = "pull request"
+	}
+	comp := strings.Split(issue.RepositoryURL, "/")
+	name := strings.Join(comp[len(comp)-2:], "/")
+	return fmt.Sprintf("New %s: %s#%d %s", kind, name, issue.Number, issue.Title)
+}
+
 func displayIssueResults(io *iostreams.IOStreams, now time.Time, et EntityType, results search.IssuesResult) error {
 	if now.IsZero() {
 		now = time.Now()
@@ -208,3 +208,47 @@ func TestSearchIssues(t *testing.T) {
 		})
 	}
 }
+
+func TestSearchIssuesWatch(t *testing.T) {
+	calls := 0
+	opts := &IssuesOptions{
+		Entity: Issues,
+		Watch:  true,
+		Query:  search.Query{Keywords: []string{"keyword"}, Kind: "issues", Limit: 30},
+		Searcher: &search.SearcherMock{
+			IssuesFunc: func(query search.Query) (search.IssuesResult, error) {
+				calls++
+				switch calls {
+				case 1:
+					return search.IssuesResult{
+						Items: []search.Issue{
+							{ID: "1", RepositoryURL: "github.com/cli/cli", Number: 1, Title: "first"},
+						},
+						Total: 1,
+					}, nil
+				case 2:
+					return search.IssuesResult{
+						Items: []search.Issue{
+							{ID: "1", RepositoryURL: "github.com/cli/cli", Number: 1, Title: "first"},
+							{ID: "2", RepositoryURL: "github.com/cli/cli", Number: 2, Title: "second"},
+						},
+						Total: 2,
+					}, nil
+				default:
+					return search.IssuesResult{}, fmt.Errorf("stopping the watch loop")
+				}
+			},
+		},
+	}
+
+	ios, _, stdout, stderr := iostreams.Test()
+	opts.IO = ios
+
+	err := SearchIssues(opts)
+	assert.EqualError(t, err, "stopping the watch loop")
+	assert.Equal(t, 3, calls)
+	assert.Contains(t, stdout.String(), "first")
+	assert.Contains(t, stdout.String(), "cli/cli#2 second")
+	assert.NotContains(t, stdout.String(), "cli/cli#1 first")
+	assert.Contains(t, stderr.String(), "New issue: cli/cli#2 second")
+}
@@ -19,12 +19,15 @@ type DeleteOptions struct {
 	IO         *iostreams.IOStreams
 	Config     func() (gh.Config, error)
 	BaseRepo   func() (ghrepo.Interface, error)
-
-	SecretName  string
-	OrgName     string
-	EnvName     string
-	UserSecrets bool
-	Application string
+	Prompter   cmdutil.DeletionPrompter
+
+	SecretName   string
+	OrgName      string
+	EnvName      string
+	UserSecrets  bool
+	Application  string
+	Confirmed    bool
+	ConfirmToken string
 }
 
 func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Command {
@@ -32,6 +35,7 @@ func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Co
 		IO:         f.IOStreams,
 		Config:     f.Config,
 		HttpClient: f.HttpClient,
+		Prompter:   f.Prompter,
 	}
 
 	cmd := &cobra.Command{
@@ -69,6 +73,7 @@ func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Co
 	cmd.Flags().StringVarP(&opts.EnvName, "env", "e", "", "Delete a secret for an environment")
 	cmd.Flags().BoolVarP(&opts.UserSecrets, "user", "u", false, "Delete a secret for your user")
 	cmdutil.StringEnumFlag(cmd, &opts.Application, "app", "a", "", []string{shared.Actions, shared.Codespaces, shared.Dependabot}, "Delete a secret for a specific application")
+	cmdutil.AddConfirmDeletionFlags(cmd, &opts.Confirmed, &opts.ConfirmToken)
 
 	return cmd
 }
@@ -127,6 +132,10 @@ func removeRun(opts *DeleteOptions) error {
 		host = baseRepo.RepoHost()
 	}
 
+	if err := cmdutil.ConfirmDeletion(opts.IO, opts.Prompter, opts.Config, host, opts.SecretName, opts.Confirmed, opts.ConfirmToken); err != nil {
+		return err
+	}
+
 	err = client.REST(host, "DELETE", path, nil, nil)
 	if err != nil {
 		return fmt.Errorf("failed to delete secret %s: %w", opts.SecretName, err)
@@ -8,6 +8,7 @@ import (
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/prompter"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/httpmock"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -200,6 +201,7 @@ func Test_removeRun_repo(t *testing.T) {
 		tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
 			return ghrepo.FromFullNameWithHost("owner/repo", tt.host)
 		}
+		tt.opts.Confirmed = true
 
 		err := removeRun(tt.opts)
 		assert.NoError(t, err)
@@ -258,6 +260,7 @@ func Test_removeRun_env(t *testing.T) {
 		tt.opts.Config = func() (gh.Config, error) {
 			return config.NewBlankConfig(), nil
 		}
+		tt.opts.Confirmed = true
 
 		err := removeRun(tt.opts)
 		require.NoError(t, err)
@@ -316,6 +319,7 @@ func Test_removeRun_org(t *testing.T) {
 			}
 			tt.opts.IO = ios
 			tt.opts.SecretName = "tVirus"
+			tt.opts.Confirmed = true
 
 			err := removeRun(tt.opts)
 			assert.NoError(t, err)
@@ -344,6 +348,7 @@ func Test_removeRun_user(t *testing.T) {
 		},
 		SecretName:  "cool_secret",
 		UserSecrets: true,
+		Confirmed:   true,
 	}
 
 	err := removeRun(opts)
@@ -351,3 +356,81 @@ func Test_removeRun_user(t *testing.T) {
 
 	reg.Verify(t)
 }
+
+func Test_removeRun_confirmation(t *testing.T) {
+	t.Run("non-interactive without --yes or --confirm-token errors", func(t *testing.T) {
+		ios, _, _, _ := iostreams.Test()
+		opts := &DeleteOptions{
+			IO: ios,
+			HttpClient: func() (*http.Client, error) {
+				return &http.Client{Transport: &httpmock.Registry{}}, nil
+			},
+			Config: func() (gh.Config, error) {
+				return config.NewBlankConfig(), nil
+			},
+			BaseRepo: func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("owner/repo")
+			},
+			SecretName: "cool_secret",
+		}
+
+		err := removeRun(opts)
+		assert.EqualError(t, err, "--yes or --confirm-token required when not running interactively")
+	})
+
+	t.Run("confirm-token matching the secret name skips the prompt", func(t *testing.T) {
+		reg := &httpmock.Registry{}
+		reg.Register(httpmock.REST("DELETE", "repos/owner/repo/actions/secrets/cool_secret"), httpmock.StatusStringResponse(204, "No Content"))
+		defer reg.Verify(t)
+
+		ios, _, _, _ := iostreams.Test()
+		opts := &DeleteOptions{
+			IO: ios,
+			HttpClient: func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			},
+			Config: func() (gh.Config, error) {
+				return config.NewBlankConfig(), nil
+			},
+			BaseRepo: func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("owner/repo")
+			},
+			SecretName:   "cool_secret",
+			ConfirmToken: "cool_secret",
+		}
+
+		err := removeRun(opts)
+		require.NoError(t, err)
+	})
+
+	t.Run("interactive prompts to type the secret name", func(t *testing.T) {
+		reg := &httpmock.Registry{}
+		reg.Register(httpmock.REST("DELETE", "repos/owner/repo/actions/secrets/cool_secret"), httpmock.StatusStringResponse(204, "No Content"))
+		defer reg.Verify(t)
+
+		ios, _, _, _ := iostreams.Test()
+		ios.SetStdinTTY(true)
+		ios.SetStdoutTTY(true)
+
+		pm := prompter.NewMockPrompter(t)
+		pm.RegisterConfirmDeletion("cool_secret", func(_ string) error { return nil })
+
+		opts := &DeleteOptions{
+			IO:       ios,
+			Prompter: pm,
+			HttpClient: func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			},
+			Config: func() (gh.Config, error) {
+				return config.NewBlankConfig(), nil
+			},
+			BaseRepo: func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("owner/repo")
+			},
+			SecretName: "cool_secret",
+		}
+
+		err := removeRun(opts)
+		require.NoError(t, err)
+	})
+}
@@ -5,6 +5,8 @@ import (
 	cmdDelete "github.com/cli/cli/v2/pkg/cmd/secret/delete"
 	cmdList "github.com/cli/cli/v2/pkg/cmd/secret/list"
 	cmdSet "github.com/cli/cli/v2/pkg/cmd/secret/set"
+	cmdShare "github.com/cli/cli/v2/pkg/cmd/secret/share"
+	cmdVerify "github.com/cli/cli/v2/pkg/cmd/secret/verify"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/spf13/cobra"
 )
@@ -25,7 +27,9 @@ func NewCmdSecret(f *cmdutil.Factory) *cobra.Command {
 
 	cmd.AddCommand(cmdList.NewCmdList(f, nil))
 	cmd.AddCommand(cmdSet.NewCmdSet(f, nil))
+	cmd.AddCommand(cmdShare.NewCmdShare(f, nil))
 	cmd.AddCommand(cmdDelete.NewCmdDelete(f, nil))
+	cmd.AddCommand(cmdVerify.NewCmdVerify(f, nil))
 
 	return cmd
 }
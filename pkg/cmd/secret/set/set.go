@@ -360,6 +360,7 @@ func getSecretsFromOptions(opts *SetOptions) (map[string][]byte, error) {
 			return nil, fmt.Errorf("no secrets found in file")
 		}
 		for key, value := range envs {
+			opts.IO.AddSecret(value)
 			secrets[key] = []byte(value)
 		}
 		return secrets, nil
@@ -369,6 +370,7 @@ func getSecretsFromOptions(opts *SetOptions) (map[string][]byte, error) {
 	if err != nil {
 		return nil, fmt.Errorf("did not understand secret body: %w", err)
 	}
+	opts.IO.AddSecret(string(body))
 	secrets[opts.SecretName] = body
 	return secrets, nil
 }
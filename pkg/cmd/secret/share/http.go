@@ -0,0 +1,49 @@
+package share
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/secret/shared"
+)
+
+func putOrgSecretRepositories(client *api.Client, host, orgName string, app shared.App, secretName string, repositoryIDs []int64) error {
+	payload := struct {
+		Repositories []int64 `json:"selected_repository_ids"`
+	}{Repositories: repositoryIDs}
+
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to serialize: %w", err)
+	}
+
+	path := fmt.Sprintf("orgs/%s/%s/secrets/%s/repositories", orgName, app, secretName)
+	return client.REST(host, "PUT", path, bytes.NewReader(payloadBytes), nil)
+}
+
+func resolveRepositoryIDs(client *api.Client, host, defaultOwner string, repositoryNames []string) ([]int64, error) {
+	repos := make([]ghrepo.Interface, 0, len(repositoryNames))
+	for _, repositoryName := range repositoryNames {
+		var repo ghrepo.Interface
+		if strings.Contains(repositoryName, "/") || defaultOwner == "" {
+			var err error
+			repo, err = ghrepo.FromFullNameWithHost(repositoryName, host)
+			if err != nil {
+				return nil, fmt.Errorf("invalid repository name: %w", err)
+			}
+		} else {
+			repo = ghrepo.NewWithHost(defaultOwner, repositoryName, host)
+		}
+		repos = append(repos, repo)
+	}
+
+	repositoryIDs, err := api.GetRepoIDs(client, host, repos)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up IDs for repositories %v: %w", repositoryNames, err)
+	}
+	return repositoryIDs, nil
+}
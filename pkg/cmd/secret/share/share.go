@@ -0,0 +1,113 @@
+package share
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/cmd/secret/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ShareOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
+
+	SecretName      string
+	OrgName         string
+	Application     string
+	RepositoryNames []string
+}
+
+func NewCmdShare(f *cmdutil.Factory, runF func(*ShareOptions) error) *cobra.Command {
+	opts := &ShareOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "share <secret-name> --org <organization>",
+		Short: "Change which repositories can access an organization secret",
+		Long: heredoc.Doc(`
+			Replace the selected-repository access list of an organization secret in bulk.
+
+			The repositories passed to --repos become the secret's entire selected
+			repository set; any repository left off the list loses access. This has no
+			effect on secrets with "all" or "private" visibility.
+		`),
+		Example: heredoc.Doc(`
+			# Restrict MYSECRET to exactly repo1, repo2, and repo3
+			$ gh secret share MYSECRET --org myOrg --repos repo1,repo2,repo3
+
+			# Update the repository list for a Dependabot organization secret
+			$ gh secret share MYSECRET --org myOrg --app dependabot --repos repo1,repo2
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.SecretName = args[0]
+
+			if opts.OrgName == "" {
+				return cmdutil.FlagErrorf("`--org` is required")
+			}
+			if len(opts.RepositoryNames) == 0 {
+				return cmdutil.FlagErrorf("`--repos` list required")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return shareRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.OrgName, "org", "o", "", "Share a secret belonging to `organization`")
+	cmd.Flags().StringSliceVarP(&opts.RepositoryNames, "repos", "r", []string{}, "Replace the list of `repositories` that can access the secret")
+	cmdutil.StringEnumFlag(cmd, &opts.Application, "app", "a", shared.Actions, []string{shared.Actions, shared.Codespaces, shared.Dependabot}, "The application the secret belongs to")
+
+	return cmd
+}
+
+func shareRun(opts *ShareOptions) error {
+	c, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("could not create http client: %w", err)
+	}
+	client := api.NewClientFromHTTP(c)
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, _ := cfg.Authentication().DefaultHost()
+
+	app, err := shared.GetSecretApp(opts.Application, shared.Organization)
+	if err != nil {
+		return err
+	}
+	if !shared.IsSupportedSecretEntity(app, shared.Organization) {
+		return fmt.Errorf("organization secrets are not supported for %s", app)
+	}
+
+	repositoryIDs, err := resolveRepositoryIDs(client, host, opts.OrgName, opts.RepositoryNames)
+	if err != nil {
+		return err
+	}
+
+	if err := putOrgSecretRepositories(client, host, opts.OrgName, app, opts.SecretName, repositoryIDs); err != nil {
+		return fmt.Errorf("failed to update repositories for secret %q: %w", opts.SecretName, err)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Updated repositories for %s secret %s in %s\n",
+			cs.SuccessIcon(), app.Title(), opts.SecretName, opts.OrgName)
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package share
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/cmd/secret/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdShare(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wants    ShareOptions
+		wantsErr string
+	}{
+		{
+			name:     "missing org",
+			cli:      "MYSECRET --repos repo1",
+			wantsErr: "`--org` is required",
+		},
+		{
+			name:     "missing repos",
+			cli:      "MYSECRET --org myOrg",
+			wantsErr: "`--repos` list required",
+		},
+		{
+			name: "org and repos",
+			cli:  "MYSECRET --org myOrg --repos repo1,repo2",
+			wants: ShareOptions{
+				SecretName:      "MYSECRET",
+				OrgName:         "myOrg",
+				RepositoryNames: []string{"repo1", "repo2"},
+				Application:     shared.Actions,
+			},
+		},
+		{
+			name: "app flag",
+			cli:  "MYSECRET --org myOrg --repos repo1 --app dependabot",
+			wants: ShareOptions{
+				SecretName:      "MYSECRET",
+				OrgName:         "myOrg",
+				RepositoryNames: []string{"repo1"},
+				Application:     shared.Dependabot,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			var opts *ShareOptions
+			cmd := NewCmdShare(f, func(o *ShareOptions) error {
+				opts = o
+				return nil
+			})
+
+			argv, err := shlex.Split(tt.cli)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				require.EqualError(t, err, tt.wantsErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wants.SecretName, opts.SecretName)
+			assert.Equal(t, tt.wants.OrgName, opts.OrgName)
+			assert.Equal(t, tt.wants.RepositoryNames, opts.RepositoryNames)
+			assert.Equal(t, tt.wants.Application, opts.Application)
+		})
+	}
+}
+
+func Test_shareRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(httpmock.GraphQL(`query MapRepositoryNames\b`),
+		httpmock.StringResponse(`{"data":{"repo_0001":{"databaseId":1},"repo_0002":{"databaseId":2}}}`))
+
+	reg.Register(
+		httpmock.REST("PUT", "orgs/myOrg/dependabot/secrets/MYSECRET/repositories"),
+		httpmock.StatusStringResponse(204, ""),
+	)
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	opts := &ShareOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		SecretName:      "MYSECRET",
+		OrgName:         "myOrg",
+		Application:     shared.Dependabot,
+		RepositoryNames: []string{"myOrg/repo1", "myOrg/repo2"},
+	}
+
+	require.NoError(t, shareRun(opts))
+	assert.Equal(t, "✓ Updated repositories for Dependabot secret MYSECRET in myOrg\n", stdout.String())
+
+	data, err := io.ReadAll(reg.Requests[len(reg.Requests)-1].Body)
+	require.NoError(t, err)
+	var payload struct {
+		Repositories []int64 `json:"selected_repository_ids"`
+	}
+	require.NoError(t, json.Unmarshal(data, &payload))
+	assert.ElementsMatch(t, []int64{1, 2}, payload.Repositories)
+}
@@ -0,0 +1,174 @@
+package verify
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/secret/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type VerifyOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Exporter cmdutil.Exporter
+
+	SecretName  string
+	OrgName     string
+	EnvName     string
+	UserSecrets bool
+	Application string
+}
+
+var secretFields = []string{
+	"name",
+	"createdAt",
+	"updatedAt",
+}
+
+func NewCmdVerify(f *cmdutil.Factory, runF func(*VerifyOptions) error) *cobra.Command {
+	opts := &VerifyOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "verify <secret-name>",
+		Short: "Verify a secret exists",
+		Long: heredoc.Doc(`
+			Verify that a secret exists on one of the following levels, without
+			changing or listing every secret:
+			- repository (default): available to GitHub Actions runs or Dependabot in a repository
+			- environment: available to GitHub Actions runs for a deployment environment in a repository
+			- organization: available to GitHub Actions runs, Dependabot, or Codespaces within an organization
+			- user: available to Codespaces for your user
+
+			Since secret values cannot be read back, this command only reports
+			whether the secret exists and when it was last updated. It exits with
+			a non-zero status if the secret is not found, so it can be used in
+			scripts to check for configuration drift.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if err := cmdutil.MutuallyExclusive("specify only one of `--org`, `--env`, or `--user`", opts.OrgName != "", opts.EnvName != "", opts.UserSecrets); err != nil {
+				return err
+			}
+
+			opts.SecretName = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return verifyRun(opts)
+		},
+	}
+	cmd.Flags().StringVarP(&opts.OrgName, "org", "o", "", "Verify a secret for an organization")
+	cmd.Flags().StringVarP(&opts.EnvName, "env", "e", "", "Verify a secret for an environment")
+	cmd.Flags().BoolVarP(&opts.UserSecrets, "user", "u", false, "Verify a secret for your user")
+	cmdutil.StringEnumFlag(cmd, &opts.Application, "app", "a", "", []string{shared.Actions, shared.Codespaces, shared.Dependabot}, "Verify a secret for a specific application")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, secretFields)
+
+	return cmd
+}
+
+type Secret struct {
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (s *Secret) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(s, fields)
+}
+
+func verifyRun(opts *VerifyOptions) error {
+	c, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("could not create http client: %w", err)
+	}
+	client := api.NewClientFromHTTP(c)
+
+	orgName := opts.OrgName
+	envName := opts.EnvName
+
+	secretEntity, err := shared.GetSecretEntity(orgName, envName, opts.UserSecrets)
+	if err != nil {
+		return err
+	}
+
+	secretApp, err := shared.GetSecretApp(opts.Application, secretEntity)
+	if err != nil {
+		return err
+	}
+
+	if !shared.IsSupportedSecretEntity(secretApp, secretEntity) {
+		return fmt.Errorf("%s secrets are not supported for %s", secretEntity, secretApp)
+	}
+
+	var baseRepo ghrepo.Interface
+	if secretEntity == shared.Repository || secretEntity == shared.Environment {
+		baseRepo, err = opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	var path string
+	var host string
+	switch secretEntity {
+	case shared.Organization:
+		path = fmt.Sprintf("orgs/%s/%s/secrets/%s", orgName, secretApp, opts.SecretName)
+		host, _ = cfg.Authentication().DefaultHost()
+	case shared.Environment:
+		path = fmt.Sprintf("repos/%s/environments/%s/secrets/%s", ghrepo.FullName(baseRepo), envName, opts.SecretName)
+		host = baseRepo.RepoHost()
+	case shared.User:
+		path = fmt.Sprintf("user/codespaces/secrets/%s", opts.SecretName)
+		host, _ = cfg.Authentication().DefaultHost()
+	case shared.Repository:
+		path = fmt.Sprintf("repos/%s/%s/secrets/%s", ghrepo.FullName(baseRepo), secretApp, opts.SecretName)
+		host = baseRepo.RepoHost()
+	}
+
+	var secret Secret
+	if err := client.REST(host, "GET", path, nil, &secret); err != nil {
+		var httpErr api.HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("secret %s was not found", opts.SecretName)
+		}
+
+		return fmt.Errorf("failed to verify secret %s: %w", opts.SecretName, err)
+	}
+	secret.Name = opts.SecretName
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, &secret)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Secret %s exists, last updated %s\n", cs.SuccessIcon(), opts.SecretName, secret.UpdatedAt.Format(time.RFC3339))
+	}
+	return nil
+}
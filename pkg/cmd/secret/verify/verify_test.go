@@ -0,0 +1,265 @@
+package verify
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdVerify(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wants    VerifyOptions
+		wantsErr bool
+	}{
+		{
+			name:     "no args",
+			wantsErr: true,
+		},
+		{
+			name: "repo",
+			cli:  "cool",
+			wants: VerifyOptions{
+				SecretName: "cool",
+			},
+		},
+		{
+			name: "org",
+			cli:  "cool --org anOrg",
+			wants: VerifyOptions{
+				SecretName: "cool",
+				OrgName:    "anOrg",
+			},
+		},
+		{
+			name: "env",
+			cli:  "cool --env anEnv",
+			wants: VerifyOptions{
+				SecretName: "cool",
+				EnvName:    "anEnv",
+			},
+		},
+		{
+			name: "user",
+			cli:  "cool -u",
+			wants: VerifyOptions{
+				SecretName:  "cool",
+				UserSecrets: true,
+			},
+		},
+		{
+			name:     "org and env",
+			cli:      "cool --org anOrg --env anEnv",
+			wantsErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *VerifyOptions
+			cmd := NewCmdVerify(f, func(opts *VerifyOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.SecretName, gotOpts.SecretName)
+			assert.Equal(t, tt.wants.OrgName, gotOpts.OrgName)
+			assert.Equal(t, tt.wants.EnvName, gotOpts.EnvName)
+			assert.Equal(t, tt.wants.UserSecrets, gotOpts.UserSecrets)
+		})
+	}
+}
+
+func Test_verifyRun_repo(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "repos/owner/repo/actions/secrets/cool_secret"),
+		httpmock.StatusStringResponse(200, `{"name":"cool_secret","created_at":"2021-01-01T00:00:00Z","updated_at":"2023-05-17T00:00:00Z"}`))
+	defer reg.Verify(t)
+
+	ios, _, out, _ := iostreams.Test()
+
+	opts := &VerifyOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("owner/repo")
+		},
+		SecretName: "cool_secret",
+	}
+
+	err := verifyRun(opts)
+	require.NoError(t, err)
+	assert.Equal(t, "", out.String())
+}
+
+func Test_verifyRun_env(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "repos/owner/repo/environments/production/secrets/cool_secret"),
+		httpmock.StatusStringResponse(200, `{"name":"cool_secret","created_at":"2021-01-01T00:00:00Z","updated_at":"2023-05-17T00:00:00Z"}`))
+	defer reg.Verify(t)
+
+	ios, _, _, _ := iostreams.Test()
+
+	opts := &VerifyOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("owner/repo")
+		},
+		SecretName: "cool_secret",
+		EnvName:    "production",
+	}
+
+	err := verifyRun(opts)
+	require.NoError(t, err)
+}
+
+func Test_verifyRun_org(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "orgs/UmbrellaCorporation/actions/secrets/tVirus"),
+		httpmock.StatusStringResponse(200, `{"name":"tVirus","created_at":"2021-01-01T00:00:00Z","updated_at":"2023-05-17T00:00:00Z"}`))
+	defer reg.Verify(t)
+
+	ios, _, _, _ := iostreams.Test()
+
+	opts := &VerifyOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		SecretName: "tVirus",
+		OrgName:    "UmbrellaCorporation",
+	}
+
+	err := verifyRun(opts)
+	require.NoError(t, err)
+}
+
+func Test_verifyRun_user(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "user/codespaces/secrets/cool_secret"),
+		httpmock.StatusStringResponse(200, `{"name":"cool_secret","created_at":"2021-01-01T00:00:00Z","updated_at":"2023-05-17T00:00:00Z"}`))
+	defer reg.Verify(t)
+
+	ios, _, _, _ := iostreams.Test()
+
+	opts := &VerifyOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		SecretName:  "cool_secret",
+		UserSecrets: true,
+	}
+
+	err := verifyRun(opts)
+	require.NoError(t, err)
+}
+
+func Test_verifyRun_notFound(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "repos/owner/repo/actions/secrets/missing_secret"),
+		httpmock.StatusStringResponse(404, `{"message":"Not Found"}`))
+	defer reg.Verify(t)
+
+	ios, _, _, _ := iostreams.Test()
+
+	opts := &VerifyOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("owner/repo")
+		},
+		SecretName: "missing_secret",
+	}
+
+	err := verifyRun(opts)
+	assert.EqualError(t, err, "secret missing_secret was not found")
+}
+
+func Test_verifyRun_jsonOutput(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "repos/owner/repo/actions/secrets/cool_secret"),
+		httpmock.StatusStringResponse(200, `{"name":"cool_secret","created_at":"2021-01-01T00:00:00Z","updated_at":"2023-05-17T00:00:00Z"}`))
+	defer reg.Verify(t)
+
+	ios, _, out, _ := iostreams.Test()
+	exporter := cmdutil.NewJSONExporter()
+	exporter.SetFields([]string{"name", "updatedAt"})
+
+	opts := &VerifyOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("owner/repo")
+		},
+		SecretName: "cool_secret",
+		Exporter:   exporter,
+	}
+
+	err := verifyRun(opts)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"cool_secret","updatedAt":"2023-05-17T00:00:00Z"}`, out.String())
+}
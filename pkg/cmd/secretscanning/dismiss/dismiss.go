@@ -0,0 +1,114 @@
+package dismiss
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/secretscanning/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/cobra"
+)
+
+type DismissOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	AlertNumbers []int
+	Resolution   string
+}
+
+func NewCmdDismiss(f *cmdutil.Factory, runF func(*DismissOptions) error) *cobra.Command {
+	opts := &DismissOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "dismiss <alert-number>... [flags]",
+		Short: "Dismiss one or more secret scanning alerts",
+		Long: heredoc.Docf(`
+			Resolve one or more secret scanning alerts in a repository.
+
+			A resolution is required and must be one of: %s.
+		`, strings.Join(shared.Resolutions, ", ")),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			for _, arg := range args {
+				number, err := strconv.Atoi(arg)
+				if err != nil {
+					return cmdutil.FlagErrorf("invalid alert number: %q", arg)
+				}
+				opts.AlertNumbers = append(opts.AlertNumbers, number)
+			}
+
+			if !slices.Contains(shared.Resolutions, opts.Resolution) {
+				return cmdutil.FlagErrorf("--resolution must be one of: %s", strings.Join(shared.Resolutions, ", "))
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return dismissRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Resolution, "resolution", "", "Resolution for the alert(s) (required)")
+	_ = cmd.MarkFlagRequired("resolution")
+
+	return cmd
+}
+
+func dismissRun(opts *DismissOptions) error {
+	client, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("could not create http client: %w", err)
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	apiClient := api.NewClientFromHTTP(client)
+	cs := opts.IO.ColorScheme()
+
+	body := struct {
+		State      string `json:"state"`
+		Resolution string `json:"resolution"`
+	}{
+		State:      "resolved",
+		Resolution: opts.Resolution,
+	}
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	var errs *multierror.Error
+	for _, number := range opts.AlertNumbers {
+		path := fmt.Sprintf("repos/%s/secret-scanning/alerts/%d", ghrepo.FullName(baseRepo), number)
+		if err := apiClient.REST(baseRepo.RepoHost(), "PATCH", path, bytes.NewReader(payload), nil); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("failed to resolve alert #%d: %w", number, err))
+			continue
+		}
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "%s Resolved alert #%d\n", cs.SuccessIcon(), number)
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
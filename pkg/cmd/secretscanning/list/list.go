@@ -0,0 +1,122 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmd/secretscanning/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Exporter cmdutil.Exporter
+
+	State      string
+	Resolution string
+	SecretType string
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List secret scanning alerts",
+		Long: heredoc.Doc(`
+			List secret scanning alerts in a repository.
+
+			Alerts can be filtered by state, resolution, and secret type.
+		`),
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.State, "state", "", "Filter by state: {open|resolved}")
+	cmd.Flags().StringVar(&opts.Resolution, "resolution", "", "Filter by resolution: {false_positive|wont_fix|revoked|used_in_tests}")
+	cmd.Flags().StringVar(&opts.SecretType, "secret-type", "", "Filter by a comma-separated list of secret types")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.AlertJSONFields)
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	client, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("could not create http client: %w", err)
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("repos/%s/secret-scanning/alerts?per_page=100", ghrepo.FullName(baseRepo))
+	if opts.State != "" {
+		path += "&state=" + opts.State
+	}
+	if opts.Resolution != "" {
+		path += "&resolution=" + opts.Resolution
+	}
+	if opts.SecretType != "" {
+		path += "&secret_type=" + opts.SecretType
+	}
+
+	apiClient := api.NewClientFromHTTP(client)
+
+	var alerts []shared.Alert
+	for path != "" {
+		var page []shared.Alert
+		path, err = apiClient.RESTWithNext(baseRepo.RepoHost(), "GET", path, nil, &page)
+		if err != nil {
+			return fmt.Errorf("failed to get alerts: %w", err)
+		}
+		alerts = append(alerts, page...)
+	}
+
+	if len(alerts) == 0 && opts.Exporter == nil {
+		return cmdutil.NewNoResultsError("no secret scanning alerts found")
+	}
+
+	if err := opts.IO.StartPager(); err == nil {
+		defer opts.IO.StopPager()
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, alerts)
+	}
+
+	table := tableprinter.New(opts.IO, tableprinter.WithHeader("NUMBER", "SECRET TYPE", "STATE", "RESOLUTION"))
+	for _, alert := range alerts {
+		table.AddField(fmt.Sprintf("#%d", alert.Number))
+		table.AddField(alert.SecretTypeDisplayName)
+		table.AddField(alert.State)
+		table.AddField(alert.Resolution)
+		table.EndRow()
+	}
+
+	return table.Render()
+}
@@ -0,0 +1,28 @@
+package secretscanning
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdDismiss "github.com/cli/cli/v2/pkg/cmd/secretscanning/dismiss"
+	cmdList "github.com/cli/cli/v2/pkg/cmd/secretscanning/list"
+	cmdView "github.com/cli/cli/v2/pkg/cmd/secretscanning/view"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdSecretScanning(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "secret-scanning <command>",
+		Short: "Manage secret scanning alerts",
+		Long: heredoc.Doc(`
+			Work with secret scanning alerts for a repository.
+		`),
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdView.NewCmdView(f, nil))
+	cmd.AddCommand(cmdDismiss.NewCmdDismiss(f, nil))
+
+	return cmd
+}
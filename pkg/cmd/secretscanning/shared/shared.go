@@ -0,0 +1,46 @@
+package shared
+
+import (
+	"time"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+)
+
+type Alert struct {
+	Number                int        `json:"number"`
+	State                 string     `json:"state"`
+	SecretType            string     `json:"secret_type"`
+	SecretTypeDisplayName string     `json:"secret_type_display_name"`
+	URL                   string     `json:"html_url"`
+	CreatedAt             time.Time  `json:"created_at"`
+	Resolution            string     `json:"resolution"`
+	ResolvedAt            *time.Time `json:"resolved_at"`
+	ResolvedBy            struct {
+		Login string `json:"login"`
+	} `json:"resolved_by"`
+}
+
+var AlertJSONFields = []string{
+	"number",
+	"state",
+	"secretType",
+	"secretTypeDisplayName",
+	"url",
+	"createdAt",
+	"resolution",
+	"resolvedAt",
+	"resolvedBy",
+}
+
+func (a *Alert) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(a, fields)
+}
+
+// Resolutions are the resolution states accepted by the secret scanning
+// alerts API when resolving an alert.
+var Resolutions = []string{
+	"false_positive",
+	"wont_fix",
+	"revoked",
+	"used_in_tests",
+}
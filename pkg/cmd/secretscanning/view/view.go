@@ -0,0 +1,98 @@
+package view
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmd/secretscanning/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ViewOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Exporter cmdutil.Exporter
+
+	AlertNumber int
+}
+
+func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
+	opts := &ViewOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view <alert-number>",
+		Short: "View a secret scanning alert",
+		Long: heredoc.Doc(`
+			Display the details of a secret scanning alert in a repository.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			number, err := strconv.Atoi(args[0])
+			if err != nil {
+				return cmdutil.FlagErrorf("invalid alert number: %q", args[0])
+			}
+			opts.AlertNumber = number
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return viewRun(opts)
+		},
+	}
+
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.AlertJSONFields)
+
+	return cmd
+}
+
+func viewRun(opts *ViewOptions) error {
+	client, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("could not create http client: %w", err)
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	apiClient := api.NewClientFromHTTP(client)
+
+	var alert shared.Alert
+	path := fmt.Sprintf("repos/%s/secret-scanning/alerts/%d", ghrepo.FullName(baseRepo), opts.AlertNumber)
+	if err := apiClient.REST(baseRepo.RepoHost(), "GET", path, nil, &alert); err != nil {
+		return fmt.Errorf("failed to get alert: %w", err)
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, &alert)
+	}
+
+	cs := opts.IO.ColorScheme()
+	out := opts.IO.Out
+
+	fmt.Fprintf(out, "%s #%d %s\n", cs.Bold(alert.SecretTypeDisplayName), alert.Number, alert.State)
+	fmt.Fprintf(out, "Created: %s\n", text.FuzzyAgo(time.Now(), alert.CreatedAt))
+	if alert.ResolvedAt != nil {
+		fmt.Fprintf(out, "Resolved: %s (%s) by %s\n", text.FuzzyAgo(time.Now(), *alert.ResolvedAt), alert.Resolution, alert.ResolvedBy.Login)
+	}
+	fmt.Fprintf(out, "\n%s\n", cs.Gray(alert.URL))
+
+	return nil
+}
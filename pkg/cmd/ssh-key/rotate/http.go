@@ -0,0 +1,66 @@
+package rotate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+)
+
+type sshKey struct {
+	Title string
+}
+
+func getSSHKey(httpClient *http.Client, host string, keyID string) (*sshKey, error) {
+	url := fmt.Sprintf("%suser/keys/%s", ghinstance.RESTPrefix(host), keyID)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var key sshKey
+	err = json.Unmarshal(b, &key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+func deleteSSHKey(httpClient *http.Client, host string, keyID string) error {
+	url := fmt.Sprintf("%suser/keys/%s", ghinstance.RESTPrefix(host), keyID)
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return api.HandleHTTPError(resp)
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package rotate
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/prompter"
+	"github.com/cli/cli/v2/pkg/cmd/ssh-key/add"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/ssh"
+	"github.com/spf13/cobra"
+)
+
+type RotateOptions struct {
+	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
+	HTTPClient func() (*http.Client, error)
+	Prompter   prompter.Prompter
+	SSHContext ssh.Context
+
+	KeyName   string
+	Title     string
+	OldKeyID  string
+	Confirmed bool
+}
+
+func NewCmdRotate(f *cmdutil.Factory, runF func(*RotateOptions) error) *cobra.Command {
+	opts := &RotateOptions{
+		HTTPClient: f.HttpClient,
+		Config:     f.Config,
+		IO:         f.IOStreams,
+		Prompter:   f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "rotate [<old-key-id>]",
+		Short: "Replace the SSH key used to authenticate with GitHub",
+		Long: heredoc.Doc(`
+			Generate a new ed25519 SSH key, upload it to your GitHub account, and switch your
+			local SSH config and agent over to it.
+
+			Once connectivity with the new key has been verified, pass the ID of an existing key
+			(as shown by "gh ssh-key list") to also remove it from your account.
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.OldKeyID = args[0]
+			}
+
+			if opts.OldKeyID != "" && !opts.IO.CanPrompt() && !opts.Confirmed {
+				return cmdutil.FlagErrorf("--yes required when not running interactively and deleting an old key")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return rotateRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Title, "title", "t", "", "Title for the new key")
+	cmd.Flags().StringVar(&opts.KeyName, "key-name", "", "Filename for the new key, relative to ~/.ssh (default: derived from the hostname)")
+	cmd.Flags().BoolVarP(&opts.Confirmed, "yes", "y", false, "Skip the confirmation prompt before deleting the old key")
+
+	return cmd
+}
+
+func rotateRun(opts *RotateOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	hostname, _ := cfg.Authentication().DefaultHost()
+	io := opts.IO
+	cs := io.ColorScheme()
+
+	if !opts.SSHContext.HasKeygen() {
+		return errors.New("ssh-keygen is required to generate a new SSH key but was not found on your system")
+	}
+
+	var passphrase string
+	if io.CanPrompt() {
+		passphrase, err = opts.Prompter.Password("Enter a passphrase for your new SSH key (Optional):")
+		if err != nil {
+			return err
+		}
+	}
+
+	keyName := opts.KeyName
+	if keyName == "" {
+		keyName = fmt.Sprintf("id_ed25519_%s", strings.ReplaceAll(hostname, ".", "_"))
+	}
+
+	keyPair, err := opts.SSHContext.GenerateSSHKey(keyName, passphrase)
+	if err != nil {
+		if errors.Is(err, ssh.ErrKeyAlreadyExists) {
+			return fmt.Errorf("a key already exists at %s; use `--key-name` to choose a different name", keyPair.PrivateKeyPath)
+		}
+		return fmt.Errorf("failed to generate a new SSH key: %w", err)
+	}
+	fmt.Fprintf(io.ErrOut, "%s Generated a new SSH key at %s\n", cs.SuccessIcon(), keyPair.PrivateKeyPath)
+
+	pubKeyFile, err := os.Open(keyPair.PublicKeyPath)
+	if err != nil {
+		return err
+	}
+	defer pubKeyFile.Close()
+
+	title := opts.Title
+	if title == "" {
+		title = fmt.Sprintf("GitHub CLI (%s)", keyName)
+	}
+
+	uploaded, err := add.SSHKeyUpload(httpClient, hostname, pubKeyFile, title)
+	if err != nil {
+		return fmt.Errorf("failed to upload the new SSH key: %w", err)
+	}
+	if uploaded {
+		fmt.Fprintf(io.ErrOut, "%s Uploaded new public key to your account\n", cs.SuccessIcon())
+	} else {
+		fmt.Fprintf(io.ErrOut, "%s New public key already exists on your account\n", cs.SuccessIcon())
+	}
+
+	if err := opts.SSHContext.UpdateConfigIdentity(hostname, keyPair.PrivateKeyPath); err != nil {
+		return fmt.Errorf("failed to update local SSH config: %w", err)
+	}
+	fmt.Fprintf(io.ErrOut, "%s Updated SSH config to use the new key for %s\n", cs.SuccessIcon(), hostname)
+
+	if err := opts.SSHContext.AddKeyToAgent(keyPair.PrivateKeyPath); err != nil {
+		fmt.Fprintf(io.ErrOut, "%s Could not add the new key to the SSH agent: %s\n", cs.WarningIcon(), err)
+	} else {
+		fmt.Fprintf(io.ErrOut, "%s Added the new key to the SSH agent\n", cs.SuccessIcon())
+	}
+
+	if err := opts.SSHContext.VerifyConnection(hostname); err != nil {
+		return fmt.Errorf("could not verify SSH connectivity to %s using the new key: %w", hostname, err)
+	}
+	fmt.Fprintf(io.ErrOut, "%s Verified SSH connectivity to %s using the new key\n", cs.SuccessIcon(), hostname)
+
+	if opts.OldKeyID == "" {
+		return nil
+	}
+
+	oldKey, err := getSSHKey(httpClient, hostname, opts.OldKeyID)
+	if err != nil {
+		return err
+	}
+
+	if !opts.Confirmed {
+		if err := opts.Prompter.ConfirmDeletion(oldKey.Title); err != nil {
+			return err
+		}
+	}
+
+	if err := deleteSSHKey(httpClient, hostname, opts.OldKeyID); err != nil {
+		return fmt.Errorf("failed to delete old SSH key: %w", err)
+	}
+	fmt.Fprintf(io.ErrOut, "%s Deleted old SSH key %q (%s) from your account\n", cs.SuccessIcon(), oldKey.Title, opts.OldKeyID)
+
+	return nil
+}
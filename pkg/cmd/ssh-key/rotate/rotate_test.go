@@ -0,0 +1,193 @@
+package rotate
+
+import (
+	"bytes"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/prompter"
+	"github.com/cli/cli/v2/internal/run"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/ssh"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdRotate(t *testing.T) {
+	tests := []struct {
+		name       string
+		tty        bool
+		input      string
+		output     RotateOptions
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{
+			name:   "no old key id",
+			tty:    true,
+			input:  "",
+			output: RotateOptions{OldKeyID: "", Confirmed: false},
+		},
+		{
+			name:   "with old key id, tty",
+			tty:    true,
+			input:  "123",
+			output: RotateOptions{OldKeyID: "123", Confirmed: false},
+		},
+		{
+			name:       "with old key id, no tty, no confirm",
+			input:      "123",
+			wantErr:    true,
+			wantErrMsg: "--yes required when not running interactively and deleting an old key",
+		},
+		{
+			name:   "with old key id, no tty, confirmed",
+			input:  "123 --yes",
+			output: RotateOptions{OldKeyID: "123", Confirmed: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			ios.SetStdinTTY(tt.tty)
+			ios.SetStdoutTTY(tt.tty)
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+
+			var cmdOpts *RotateOptions
+			cmd := NewCmdRotate(f, func(opts *RotateOptions) error {
+				cmdOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.wantErrMsg)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.OldKeyID, cmdOpts.OldKeyID)
+			assert.Equal(t, tt.output.Confirmed, cmdOpts.Confirmed)
+		})
+	}
+}
+
+func Test_rotateRun(t *testing.T) {
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "id_ed25519_github_com")
+
+	tests := []struct {
+		name          string
+		opts          RotateOptions
+		httpStubs     func(*httpmock.Registry)
+		prompterStubs func(*prompter.PrompterMock)
+		wantStderr    string
+		wantErr       bool
+		wantErrMsg    string
+	}{
+		{
+			name: "rotate without deleting an old key",
+			opts: RotateOptions{},
+			prompterStubs: func(pm *prompter.PrompterMock) {
+				pm.PasswordFunc = func(_ string) (string, error) {
+					return "", nil
+				}
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(httpmock.REST("GET", "user/keys"), httpmock.StringResponse(`[]`))
+				reg.Register(httpmock.REST("POST", "user/keys"), httpmock.StatusStringResponse(201, `{}`))
+			},
+			wantStderr: "✓ Generated a new SSH key at " + keyFile + "\n" +
+				"✓ Uploaded new public key to your account\n" +
+				"✓ Updated SSH config to use the new key for github.com\n" +
+				"✓ Added the new key to the SSH agent\n" +
+				"✓ Verified SSH connectivity to github.com using the new key\n",
+		},
+		{
+			name: "rotate and delete the old key",
+			opts: RotateOptions{OldKeyID: "123", Confirmed: true},
+			prompterStubs: func(pm *prompter.PrompterMock) {
+				pm.PasswordFunc = func(_ string) (string, error) {
+					return "", nil
+				}
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(httpmock.REST("GET", "user/keys"), httpmock.StringResponse(`[]`))
+				reg.Register(httpmock.REST("POST", "user/keys"), httpmock.StatusStringResponse(201, `{}`))
+				reg.Register(httpmock.REST("GET", "user/keys/123"), httpmock.StringResponse(`{"title":"Old Key"}`))
+				reg.Register(httpmock.REST("DELETE", "user/keys/123"), httpmock.StatusStringResponse(204, ""))
+			},
+			wantStderr: "✓ Generated a new SSH key at " + keyFile + "\n" +
+				"✓ Uploaded new public key to your account\n" +
+				"✓ Updated SSH config to use the new key for github.com\n" +
+				"✓ Added the new key to the SSH agent\n" +
+				"✓ Verified SSH connectivity to github.com using the new key\n" +
+				`✓ Deleted old SSH key "Old Key" (123) from your account` + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_ = os.Remove(keyFile)
+			_ = os.Remove(keyFile + ".pub")
+			_ = os.Remove(filepath.Join(dir, "config"))
+
+			pm := &prompter.PrompterMock{}
+			if tt.prompterStubs != nil {
+				tt.prompterStubs(pm)
+			}
+			tt.opts.Prompter = pm
+
+			reg := &httpmock.Registry{}
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+			defer reg.Verify(t)
+
+			tt.opts.HTTPClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			tt.opts.Config = func() (gh.Config, error) {
+				return config.NewBlankConfig(), nil
+			}
+			tt.opts.SSHContext = ssh.Context{ConfigDir: dir, KeygenExe: "ssh-keygen"}
+
+			ios, _, _, stderr := iostreams.Test()
+			ios.SetStdinTTY(true)
+			ios.SetStdoutTTY(true)
+			tt.opts.IO = ios
+
+			cs, cmdTeardown := run.Stub()
+			defer cmdTeardown(t)
+			cs.Register(`ssh-keygen`, 0, "", func(args []string) {
+				_ = os.WriteFile(keyFile+".pub", []byte("ssh-ed25519 AAAA"), 0600)
+			})
+			cs.Register(`ssh-add`, 0, "")
+			cs.Register(`ssh -T`, 1, "")
+
+			err := rotateRun(&tt.opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.wantErrMsg)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStderr, stderr.String())
+		})
+	}
+}
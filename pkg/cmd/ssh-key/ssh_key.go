@@ -4,6 +4,7 @@ import (
 	cmdAdd "github.com/cli/cli/v2/pkg/cmd/ssh-key/add"
 	cmdDelete "github.com/cli/cli/v2/pkg/cmd/ssh-key/delete"
 	cmdList "github.com/cli/cli/v2/pkg/cmd/ssh-key/list"
+	cmdRotate "github.com/cli/cli/v2/pkg/cmd/ssh-key/rotate"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/spf13/cobra"
 )
@@ -18,6 +19,7 @@ func NewCmdSSHKey(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(cmdAdd.NewCmdAdd(f, nil))
 	cmd.AddCommand(cmdDelete.NewCmdDelete(f, nil))
 	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdRotate.NewCmdRotate(f, nil))
 
 	return cmd
 }
@@ -0,0 +1,67 @@
+package list
+
+import (
+	"github.com/cli/cli/v2/api"
+	"github.com/shurcooL/githubv4"
+)
+
+type starredRepo struct {
+	NameWithOwner  string
+	Description    string
+	StargazerCount int
+	StarredAt      string
+}
+
+// listStarred returns the repositories the viewer has starred, most
+// recently starred first.
+func listStarred(client *api.Client, host string, limit int) ([]starredRepo, error) {
+	type query struct {
+		Viewer struct {
+			StarredRepositories struct {
+				Edges []struct {
+					StarredAt string
+					Node      starredRepo `graphql:"node"`
+				}
+				PageInfo struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			} `graphql:"starredRepositories(first: $perPage, after: $endCursor, orderBy: {field: STARRED_AT, direction: DESC})"`
+		}
+	}
+
+	perPage := limit
+	if perPage > 100 {
+		perPage = 100
+	}
+
+	variables := map[string]interface{}{
+		"perPage":   githubv4.Int(perPage),
+		"endCursor": (*githubv4.String)(nil),
+	}
+
+	var repos []starredRepo
+pagination:
+	for {
+		var q query
+		if err := client.Query(host, "ViewerStarredRepositories", &q, variables); err != nil {
+			return nil, err
+		}
+
+		for _, edge := range q.Viewer.StarredRepositories.Edges {
+			repo := edge.Node
+			repo.StarredAt = edge.StarredAt
+			repos = append(repos, repo)
+			if len(repos) >= limit {
+				break pagination
+			}
+		}
+
+		if !q.Viewer.StarredRepositories.PageInfo.HasNextPage {
+			break
+		}
+		variables["endCursor"] = githubv4.String(q.Viewer.StarredRepositories.PageInfo.EndCursor)
+	}
+
+	return repos, nil
+}
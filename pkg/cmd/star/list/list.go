@@ -0,0 +1,128 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/internal/text"
+	starShared "github.com/cli/cli/v2/pkg/cmd/star/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (gh.Config, error)
+	IO         *iostreams.IOStreams
+
+	List  string
+	Limit int
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List starred repositories",
+		Long: heredoc.Doc(`
+			List the repositories you have starred.
+
+			Use the --list flag to view the repositories in one of your GitHub Lists
+			instead of your full set of starred repositories.
+		`),
+		Example: heredoc.Doc(`
+			$ gh star list
+			$ gh star list --list "Read later"
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Limit < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.List, "list", "", "List repositories in a GitHub List instead of all starred repositories")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of repositories to list")
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, _ := cfg.Authentication().DefaultHost()
+
+	if opts.List != "" {
+		list, err := starShared.FindStarList(apiClient, host, opts.List)
+		if err != nil {
+			return err
+		}
+
+		names, err := starShared.ListRepositories(apiClient, host, list)
+		if err != nil {
+			return err
+		}
+
+		if len(names) == 0 {
+			return cmdutil.NewNoResultsError(fmt.Sprintf("no repositories in the %q list", list.Name))
+		}
+
+		tp := tableprinter.New(opts.IO, tableprinter.WithHeader("REPOSITORY"))
+		for _, name := range names {
+			tp.AddField(name)
+			tp.EndRow()
+		}
+		return tp.Render()
+	}
+
+	repos, err := listStarred(apiClient, host, opts.Limit)
+	if err != nil {
+		return err
+	}
+
+	if len(repos) == 0 {
+		return cmdutil.NewNoResultsError("you have not starred any repositories")
+	}
+
+	cs := opts.IO.ColorScheme()
+	now := time.Now()
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("REPOSITORY", "DESCRIPTION", "STARS", "STARRED"))
+	for _, repo := range repos {
+		tp.AddField(repo.NameWithOwner, tableprinter.WithColor(cs.Bold))
+		tp.AddField(text.RemoveExcessiveWhitespace(repo.Description))
+		tp.AddField(fmt.Sprintf("%d", repo.StargazerCount))
+		if t, err := time.Parse(time.RFC3339, repo.StarredAt); err == nil {
+			tp.AddTimeField(now, t, cs.Gray)
+		} else {
+			tp.AddField(repo.StarredAt)
+		}
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
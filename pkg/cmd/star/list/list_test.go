@@ -0,0 +1,98 @@
+package list
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       ListOptions
+		stubs      func(*httpmock.Registry)
+		wantErr    bool
+		wantErrMsg string
+		wantStdout string
+	}{
+		{
+			name: "lists starred repositories",
+			opts: ListOptions{Limit: 30},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query ViewerStarredRepositories\b`),
+					httpmock.StringResponse(`{ "data": { "viewer": { "starredRepositories": {
+						"edges": [{ "starredAt": "2021-01-01T00:00:00Z", "node": { "nameWithOwner": "cli/cli", "description": "GitHub CLI", "stargazerCount": 100 } }],
+						"pageInfo": { "hasNextPage": false, "endCursor": "" }
+					} } } }`))
+			},
+			wantStdout: "cli/cli\tGitHub CLI\t100\t2021-01-01T00:00:00Z\n",
+		},
+		{
+			name: "no starred repositories",
+			opts: ListOptions{Limit: 30},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query ViewerStarredRepositories\b`),
+					httpmock.StringResponse(`{ "data": { "viewer": { "starredRepositories": {
+						"edges": [],
+						"pageInfo": { "hasNextPage": false, "endCursor": "" }
+					} } } }`))
+			},
+			wantErr:    true,
+			wantErrMsg: "you have not starred any repositories",
+		},
+		{
+			name: "lists repositories in a named list",
+			opts: ListOptions{Limit: 30, List: "Read later"},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query ViewerStarLists\b`),
+					httpmock.StringResponse(`{ "data": { "viewer": { "lists": { "nodes": [
+						{ "id": "LIST-ID", "name": "Read later", "description": "" }
+					] } } } }`))
+				reg.Register(
+					httpmock.GraphQL(`query StarListRepositories\b`),
+					httpmock.StringResponse(`{ "data": { "node": {
+						"items": { "nodes": [ { "nameWithOwner": "cli/cli" } ] }
+					} } } }`))
+			},
+			wantStdout: "cli/cli\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			if tt.stubs != nil {
+				tt.stubs(reg)
+			}
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			ios, _, stdout, _ := iostreams.Test()
+			tt.opts.IO = ios
+			tt.opts.Config = func() (gh.Config, error) {
+				return config.NewBlankConfig(), nil
+			}
+			defer reg.Verify(t)
+
+			err := listRun(&tt.opts)
+			if tt.wantErr {
+				if tt.wantErrMsg != "" {
+					assert.EqualError(t, err, tt.wantErrMsg)
+				} else {
+					assert.Error(t, err)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
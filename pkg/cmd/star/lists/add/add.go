@@ -0,0 +1,126 @@
+package add
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	starShared "github.com/cli/cli/v2/pkg/cmd/star/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/cobra"
+)
+
+type AddOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (gh.Config, error)
+	IO         *iostreams.IOStreams
+
+	List     string
+	RepoArgs []string
+}
+
+func NewCmdAdd(f *cmdutil.Factory, runF func(*AddOptions) error) *cobra.Command {
+	opts := &AddOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "add <list> <repository>...",
+		Short: "Add repositories to a GitHub List",
+		Args:  cobra.MinimumNArgs(2),
+		Example: heredoc.Doc(`
+			$ gh star lists add "Read later" cli/cli
+			$ gh star lists add "Read later" cli/cli cli/go-gh
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.List = args[0]
+			opts.RepoArgs = args[1:]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return addRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func addRun(opts *AddOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, _ := cfg.Authentication().DefaultHost()
+
+	list, err := starShared.FindStarList(apiClient, host, opts.List)
+	if err != nil {
+		return err
+	}
+
+	repos, err := resolveRepos(apiClient, host, opts.RepoArgs)
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	var errs error
+	for _, toAdd := range repos {
+		fullName := ghrepo.FullName(toAdd)
+
+		repo, err := api.FetchRepository(apiClient, toAdd, []string{"id"})
+		if err != nil {
+			fmt.Fprintf(opts.IO.Out, "%s %s: %s\n", cs.FailureIcon(), fullName, err)
+			errs = multierror.Append(errs, fmt.Errorf("%s: %w", fullName, err))
+			continue
+		}
+
+		if err := starShared.AddRepositoryToList(apiClient, host, repo.ID, list); err != nil {
+			fmt.Fprintf(opts.IO.Out, "%s %s: %s\n", cs.FailureIcon(), fullName, err)
+			errs = multierror.Append(errs, fmt.Errorf("%s: %w", fullName, err))
+			continue
+		}
+
+		fmt.Fprintf(opts.IO.Out, "%s Added %s to %s\n", cs.SuccessIcon(), fullName, list.Name)
+	}
+
+	return errs
+}
+
+// resolveRepos turns owner-less repository selectors into fully qualified
+// ones, using the authenticated user as the implied owner.
+func resolveRepos(apiClient *api.Client, host string, args []string) ([]ghrepo.Interface, error) {
+	repos := make([]ghrepo.Interface, len(args))
+	for i, arg := range args {
+		selector := arg
+		if !strings.Contains(selector, "/") {
+			currentUser, err := api.CurrentLoginName(apiClient, host)
+			if err != nil {
+				return nil, err
+			}
+			selector = currentUser + "/" + selector
+		}
+
+		repo, err := ghrepo.FromFullName(selector)
+		if err != nil {
+			return nil, fmt.Errorf("argument error: %w", err)
+		}
+		repos[i] = repo
+	}
+
+	return repos, nil
+}
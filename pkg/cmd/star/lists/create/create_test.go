@@ -0,0 +1,39 @@
+package create
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`mutation CreateUserList\b`),
+		httpmock.StringResponse(`{ "data": { "createUserList": { "list": { "id": "LIST-1", "name": "Read later", "description": "" } } } }`))
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	opts := &CreateOptions{
+		Name: "Read later",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		IO: ios,
+	}
+
+	require.NoError(t, createRun(opts))
+	assert.Equal(t, "✓ Created list Read later\n", stdout.String())
+}
@@ -0,0 +1,75 @@
+package list
+
+import (
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/internal/text"
+	starShared "github.com/cli/cli/v2/pkg/cmd/star/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (gh.Config, error)
+	IO         *iostreams.IOStreams
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List your GitHub Lists",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, _ := cfg.Authentication().DefaultHost()
+
+	lists, err := starShared.GetStarLists(apiClient, host)
+	if err != nil {
+		return err
+	}
+
+	if len(lists) == 0 {
+		return cmdutil.NewNoResultsError("you have not created any GitHub Lists")
+	}
+
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("NAME", "DESCRIPTION"))
+	for _, l := range lists {
+		tp.AddField(l.Name, tableprinter.WithColor(opts.IO.ColorScheme().Bold))
+		tp.AddField(text.RemoveExcessiveWhitespace(l.Description))
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
@@ -0,0 +1,71 @@
+package list
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		stubs      func(*httpmock.Registry)
+		wantErr    bool
+		wantErrMsg string
+		wantStdout string
+	}{
+		{
+			name: "lists GitHub Lists",
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query ViewerStarLists\b`),
+					httpmock.StringResponse(`{ "data": { "viewer": { "lists": { "nodes": [
+						{ "id": "LIST-1", "name": "Read later", "description": "Repos to look at" }
+					] } } } }`))
+			},
+			wantStdout: "Read later\tRepos to look at\n",
+		},
+		{
+			name: "no lists",
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query ViewerStarLists\b`),
+					httpmock.StringResponse(`{ "data": { "viewer": { "lists": { "nodes": [] } } } }`))
+			},
+			wantErr:    true,
+			wantErrMsg: "you have not created any GitHub Lists",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			tt.stubs(reg)
+			defer reg.Verify(t)
+
+			opts := &ListOptions{
+				HttpClient: func() (*http.Client, error) {
+					return &http.Client{Transport: reg}, nil
+				},
+				Config: func() (gh.Config, error) {
+					return config.NewBlankConfig(), nil
+				},
+			}
+			ios, _, stdout, _ := iostreams.Test()
+			opts.IO = ios
+
+			err := listRun(opts)
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.wantErrMsg)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
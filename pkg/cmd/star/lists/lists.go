@@ -0,0 +1,25 @@
+package lists
+
+import (
+	cmdAdd "github.com/cli/cli/v2/pkg/cmd/star/lists/add"
+	cmdCreate "github.com/cli/cli/v2/pkg/cmd/star/lists/create"
+	cmdList "github.com/cli/cli/v2/pkg/cmd/star/lists/list"
+	cmdRemove "github.com/cli/cli/v2/pkg/cmd/star/lists/remove"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdLists(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lists <command>",
+		Short: "Manage your GitHub Lists",
+		Long:  "GitHub Lists are named, user-curated collections of starred repositories.",
+	}
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdCreate.NewCmdCreate(f, nil))
+	cmd.AddCommand(cmdAdd.NewCmdAdd(f, nil))
+	cmd.AddCommand(cmdRemove.NewCmdRemove(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,52 @@
+package remove
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query ViewerStarLists\b`),
+		httpmock.StringResponse(`{ "data": { "viewer": { "lists": { "nodes": [
+			{ "id": "LIST-1", "name": "Read later", "description": "" }
+		] } } } }`))
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`{ "data": { "repository": { "id": "CLI-ID" } } }`))
+	reg.Register(
+		httpmock.GraphQL(`query StarListMemberships\b`),
+		httpmock.StringResponse(`{ "data": { "viewer": { "lists": { "nodes": [
+			{ "id": "LIST-1", "items": { "nodes": [ { "id": "CLI-ID" } ] } }
+		] } } } }`))
+	reg.Register(
+		httpmock.GraphQL(`mutation UpdateUserListsForItem\b`),
+		httpmock.StringResponse(`{ "data": { "updateUserListsForItem": { "item": { "id": "CLI-ID" } } } }`))
+
+	ios, _, stdout, _ := iostreams.Test()
+
+	opts := &RemoveOptions{
+		List:     "Read later",
+		RepoArgs: []string{"cli/cli"},
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		IO: ios,
+	}
+
+	require.NoError(t, removeRun(opts))
+	assert.Equal(t, "✓ Removed cli/cli from Read later\n", stdout.String())
+}
@@ -0,0 +1,191 @@
+package shared
+
+import (
+	"fmt"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/shurcooL/githubv4"
+)
+
+// StarList is a GitHub List: a named, user-curated collection of starred
+// repositories.
+type StarList struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+func (l *StarList) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(l, fields)
+}
+
+// GetStarLists fetches all of the viewer's GitHub Lists.
+func GetStarLists(client *api.Client, host string) ([]StarList, error) {
+	var query struct {
+		Viewer struct {
+			Lists struct {
+				Nodes []StarList
+			} `graphql:"lists(first: 100)"`
+		}
+	}
+
+	if err := client.Query(host, "ViewerStarLists", &query, nil); err != nil {
+		return nil, err
+	}
+
+	return query.Viewer.Lists.Nodes, nil
+}
+
+// FindStarList looks up a GitHub List by name.
+func FindStarList(client *api.Client, host, name string) (*StarList, error) {
+	lists, err := GetStarLists(client, host)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, l := range lists {
+		if l.Name == name {
+			return &l, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find a list matching %q", name)
+}
+
+// CreateStarList creates a new GitHub List.
+func CreateStarList(client *api.Client, host, name, description string) (*StarList, error) {
+	var mutation struct {
+		CreateUserList struct {
+			List StarList
+		} `graphql:"createUserList(input: $input)"`
+	}
+
+	input := githubv4.CreateUserListInput{Name: githubv4.String(name)}
+	if description != "" {
+		desc := githubv4.String(description)
+		input.Description = &desc
+	}
+
+	variables := map[string]interface{}{"input": input}
+	if err := client.Mutate(host, "CreateUserList", &mutation, variables); err != nil {
+		return nil, err
+	}
+
+	return &mutation.CreateUserList.List, nil
+}
+
+// ListRepositories returns the full names of the repositories in a list.
+func ListRepositories(client *api.Client, host string, list *StarList) ([]string, error) {
+	var query struct {
+		Node struct {
+			List struct {
+				Items struct {
+					Nodes []struct {
+						Repository struct {
+							NameWithOwner string
+						} `graphql:"... on Repository"`
+					}
+				} `graphql:"items(first: 100)"`
+			} `graphql:"... on UserList"`
+		} `graphql:"node(id: $id)"`
+	}
+
+	variables := map[string]interface{}{"id": githubv4.ID(list.ID)}
+	if err := client.Query(host, "StarListRepositories", &query, variables); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(query.Node.List.Items.Nodes))
+	for _, n := range query.Node.List.Items.Nodes {
+		names = append(names, n.Repository.NameWithOwner)
+	}
+
+	return names, nil
+}
+
+// listMemberships returns, for every list the viewer owns, whether repoID is
+// currently one of its items.
+func listMemberships(client *api.Client, host, repoID string) (map[string]bool, error) {
+	var query struct {
+		Viewer struct {
+			Lists struct {
+				Nodes []struct {
+					ID    string
+					Items struct {
+						Nodes []struct {
+							Repository struct {
+								ID string
+							} `graphql:"... on Repository"`
+						}
+					} `graphql:"items(first: 100)"`
+				}
+			} `graphql:"lists(first: 100)"`
+		}
+	}
+
+	if err := client.Query(host, "StarListMemberships", &query, nil); err != nil {
+		return nil, err
+	}
+
+	memberships := map[string]bool{}
+	for _, l := range query.Viewer.Lists.Nodes {
+		memberships[l.ID] = false
+		for _, item := range l.Items.Nodes {
+			if item.Repository.ID == repoID {
+				memberships[l.ID] = true
+				break
+			}
+		}
+	}
+
+	return memberships, nil
+}
+
+// AddRepositoryToList adds repoID to list, leaving its membership in every
+// other list unchanged.
+func AddRepositoryToList(client *api.Client, host, repoID string, list *StarList) error {
+	memberships, err := listMemberships(client, host, repoID)
+	if err != nil {
+		return err
+	}
+	memberships[list.ID] = true
+	return updateListsForItem(client, host, repoID, memberships)
+}
+
+// RemoveRepositoryFromList removes repoID from list, leaving its membership
+// in every other list unchanged.
+func RemoveRepositoryFromList(client *api.Client, host, repoID string, list *StarList) error {
+	memberships, err := listMemberships(client, host, repoID)
+	if err != nil {
+		return err
+	}
+	memberships[list.ID] = false
+	return updateListsForItem(client, host, repoID, memberships)
+}
+
+func updateListsForItem(client *api.Client, host, repoID string, memberships map[string]bool) error {
+	var listIDs []githubv4.ID
+	for listID, isMember := range memberships {
+		if isMember {
+			listIDs = append(listIDs, githubv4.ID(listID))
+		}
+	}
+
+	var mutation struct {
+		UpdateUserListsForItem struct {
+			Item struct {
+				ID string
+			}
+		} `graphql:"updateUserListsForItem(input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"input": githubv4.UpdateUserListsForItemInput{
+			ItemID:  githubv4.ID(repoID),
+			ListIDs: listIDs,
+		},
+	}
+
+	return client.Mutate(host, "UpdateUserListsForItem", &mutation, variables)
+}
@@ -0,0 +1,57 @@
+package shared
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddRepositoryToList(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query StarListMemberships\b`),
+		httpmock.StringResponse(`{ "data": { "viewer": { "lists": { "nodes": [
+			{ "id": "LIST-1", "items": { "nodes": [] } },
+			{ "id": "LIST-2", "items": { "nodes": [ { "id": "REPO-ID" } ] } }
+		] } } } }`))
+	reg.Register(
+		httpmock.GraphQL(`mutation UpdateUserListsForItem\b`),
+		httpmock.StringResponse(`{ "data": { "updateUserListsForItem": { "item": { "id": "REPO-ID" } } } }`))
+
+	client := &http.Client{Transport: reg}
+	apiClient := api.NewClientFromHTTP(client)
+
+	err := AddRepositoryToList(apiClient, "github.com", "REPO-ID", &StarList{ID: "LIST-1"})
+	require.NoError(t, err)
+}
+
+func TestFindStarList(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query ViewerStarLists\b`),
+		httpmock.StringResponse(`{ "data": { "viewer": { "lists": { "nodes": [
+			{ "id": "LIST-1", "name": "Read later", "description": "" }
+		] } } } }`))
+
+	client := &http.Client{Transport: reg}
+	apiClient := api.NewClientFromHTTP(client)
+
+	list, err := FindStarList(apiClient, "github.com", "Read later")
+	require.NoError(t, err)
+	assert.Equal(t, "LIST-1", list.ID)
+
+	reg.Register(
+		httpmock.GraphQL(`query ViewerStarLists\b`),
+		httpmock.StringResponse(`{ "data": { "viewer": { "lists": { "nodes": [] } } } }`))
+
+	_, err = FindStarList(apiClient, "github.com", "Read later")
+	assert.EqualError(t, err, `could not find a list matching "Read later"`)
+}
@@ -0,0 +1,27 @@
+package star
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdList "github.com/cli/cli/v2/pkg/cmd/star/list"
+	cmdLists "github.com/cli/cli/v2/pkg/cmd/star/lists"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdStar(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "star <command>",
+		Short: "View and manage starred repositories",
+		Long: heredoc.Doc(`
+			View starred repositories and organize them into GitHub Lists.
+
+			To star or unstar a repository, see 'gh repo star' and 'gh repo unstar'.
+		`),
+		GroupID: "core",
+	}
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdLists.NewCmdLists(f))
+
+	return cmd
+}
@@ -30,12 +30,15 @@ type hostConfig interface {
 }
 
 type StatusOptions struct {
-	HttpClient   func() (*http.Client, error)
-	HostConfig   hostConfig
-	CachedClient func(*http.Client, time.Duration) *http.Client
-	IO           *iostreams.IOStreams
-	Org          string
-	Exclude      []string
+	HttpClient        func() (*http.Client, error)
+	HostConfig        hostConfig
+	CachedClient      func(*http.Client, time.Duration) *http.Client
+	ConditionalClient func(*http.Client, string) *http.Client
+	CacheDir          string
+	IO                *iostreams.IOStreams
+	Org               string
+	Exclude           []string
+	Refresh           bool
 }
 
 func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Command {
@@ -43,6 +46,9 @@ func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Co
 		CachedClient: func(c *http.Client, ttl time.Duration) *http.Client {
 			return api.NewCachedHTTPClient(c, ttl)
 		},
+		ConditionalClient: func(c *http.Client, cacheDir string) *http.Client {
+			return api.NewConditionalCacheHTTPClient(c, cacheDir)
+		},
 	}
 	opts.HttpClient = f.HttpClient
 	opts.IO = f.IOStreams
@@ -69,6 +75,7 @@ func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Co
 			}
 
 			opts.HostConfig = cfg.Authentication()
+			opts.CacheDir = cfg.CacheDir()
 
 			if runF != nil {
 				return runF(opts)
@@ -80,6 +87,7 @@ func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Co
 
 	cmd.Flags().StringVarP(&opts.Org, "org", "o", "", "Report status within an organization")
 	cmd.Flags().StringSliceVarP(&opts.Exclude, "exclude", "e", []string{}, "Comma separated list of repos to exclude in owner/name format")
+	cmd.Flags().BoolVar(&opts.Refresh, "refresh", false, "Bypass the local cache and fetch fresh data")
 
 	return cmd
 }
@@ -169,16 +177,19 @@ type stringSet interface {
 }
 
 type StatusGetter struct {
-	Client         *http.Client
-	cachedClient   func(*http.Client, time.Duration) *http.Client
-	host           string
-	Org            string
-	Exclude        []string
-	AssignedPRs    []StatusItem
-	AssignedIssues []StatusItem
-	Mentions       []StatusItem
-	ReviewRequests []StatusItem
-	RepoActivity   []StatusItem
+	Client            *http.Client
+	cachedClient      func(*http.Client, time.Duration) *http.Client
+	conditionalClient func(*http.Client, string) *http.Client
+	cacheDir          string
+	host              string
+	Org               string
+	Exclude           []string
+	Refresh           bool
+	AssignedPRs       []StatusItem
+	AssignedIssues    []StatusItem
+	Mentions          []StatusItem
+	ReviewRequests    []StatusItem
+	RepoActivity      []StatusItem
 
 	authErrors   stringSet
 	authErrorsMu sync.Mutex
@@ -189,11 +200,14 @@ type StatusGetter struct {
 
 func NewStatusGetter(client *http.Client, hostname string, opts *StatusOptions) *StatusGetter {
 	return &StatusGetter{
-		Client:       client,
-		Org:          opts.Org,
-		Exclude:      opts.Exclude,
-		cachedClient: opts.CachedClient,
-		host:         hostname,
+		Client:            client,
+		Org:               opts.Org,
+		Exclude:           opts.Exclude,
+		Refresh:           opts.Refresh,
+		cachedClient:      opts.CachedClient,
+		conditionalClient: opts.ConditionalClient,
+		cacheDir:          opts.CacheDir,
+		host:              hostname,
 	}
 }
 
@@ -205,6 +219,17 @@ func (s *StatusGetter) CachedClient(ttl time.Duration) *http.Client {
 	return s.cachedClient(s.Client, ttl)
 }
 
+// queryClient returns the HTTP client to use for the main status queries: a
+// conditionally-revalidated cache by default (so a status lookup that hasn't
+// changed server-side is served from disk without waiting out a blind TTL),
+// or the uncached client when --refresh was passed.
+func (s *StatusGetter) queryClient() *http.Client {
+	if s.Refresh {
+		return s.Client
+	}
+	return s.conditionalClient(s.Client, s.cacheDir)
+}
+
 func (s *StatusGetter) ShouldExclude(repo string) bool {
 	for _, exclude := range s.Exclude {
 		if repo == exclude {
@@ -263,7 +288,7 @@ func (s *StatusGetter) ActualMention(commentURL string) (string, error) {
 // Populate .Mentions
 func (s *StatusGetter) LoadNotifications() error {
 	perPage := 100
-	c := api.NewClientFromHTTP(s.Client)
+	c := api.NewClientFromHTTP(s.queryClient())
 	query := url.Values{}
 	query.Add("per_page", fmt.Sprintf("%d", perPage))
 	query.Add("participating", "true")
@@ -413,7 +438,7 @@ query AssignedSearch($searchAssigns: String!, $searchReviews: String!, $limit: I
 
 // Populate .AssignedPRs, .AssignedIssues, .ReviewRequests
 func (s *StatusGetter) LoadSearchResults() error {
-	c := api.NewClientFromHTTP(s.Client)
+	c := api.NewClientFromHTTP(s.queryClient())
 
 	searchAssigns := `assignee:@me state:open archived:false`
 	searchReviews := `review-requested:@me state:open archived:false`
@@ -529,7 +554,7 @@ func (s *StatusGetter) LoadSearchResults() error {
 // Populate .RepoActivity
 func (s *StatusGetter) LoadEvents() error {
 	perPage := 100
-	c := api.NewClientFromHTTP(s.Client)
+	c := api.NewClientFromHTTP(s.queryClient())
 	query := url.Values{}
 	query.Add("per_page", fmt.Sprintf("%d", perPage))
 
@@ -442,6 +442,9 @@ func TestStatusRun(t *testing.T) {
 		tt.opts.CachedClient = func(c *http.Client, _ time.Duration) *http.Client {
 			return c
 		}
+		tt.opts.ConditionalClient = func(c *http.Client, _ string) *http.Client {
+			return c
+		}
 		tt.opts.HostConfig = testHostConfig("github.com")
 		ios, _, stdout, _ := iostreams.Test()
 		ios.SetStdoutTTY(true)
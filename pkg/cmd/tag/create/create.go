@@ -0,0 +1,119 @@
+package create
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/tag/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type CreateOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	TagName string
+	Target  string
+	Message string
+	Force   bool
+}
+
+func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
+	opts := &CreateOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create <tag> [<commit-ish>]",
+		Short: "Create a new git tag",
+		Long: heredoc.Docf(`
+			Create a new git tag in a repository.
+
+			Without %[1]s<commit-ish>%[1]s, the tag points at the tip of the repository's default
+			branch. Pass %[1]s--message%[1]s to create an annotated tag instead of a lightweight one.
+
+			This command creates the tag through the GitHub API and therefore cannot produce a
+			GPG-signed tag. To create a signed tag, use %[1]sgit tag -s%[1]s locally and push it with
+			%[1]sgit push --tags%[1]s.
+
+			If the tag name matches an active tag protection ruleset, a warning is printed but the
+			tag is still created; rulesets that reject the push are enforced by the API itself.
+		`, "`"),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			opts.TagName = args[0]
+			if len(args) > 1 {
+				opts.Target = args[1]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return createRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Message, "message", "m", "", "Create an annotated tag with the given `message`")
+	cmd.Flags().BoolVarP(&opts.Force, "force", "f", false, "Overwrite an existing tag of the same name")
+
+	return cmd
+}
+
+func createRun(opts *CreateOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	target := opts.Target
+	if target == "" {
+		target, err = defaultBranch(httpClient, baseRepo)
+		if err != nil {
+			return err
+		}
+	}
+
+	sha, err := resolveCommitish(httpClient, baseRepo, target)
+	if err != nil {
+		return fmt.Errorf("could not resolve %s to a commit: %w", target, err)
+	}
+
+	if opts.Message != "" {
+		sha, err = createAnnotatedTagObject(httpClient, baseRepo, opts.TagName, opts.Message, sha)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := createOrUpdateTagRef(httpClient, baseRepo, opts.TagName, sha, opts.Force); err != nil {
+		return err
+	}
+
+	if rulesets := shared.ProtectingRulesets(httpClient, baseRepo, opts.TagName); len(rulesets) > 0 {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.ErrOut, "%s tag %s matches a protected pattern in ruleset(s): %s\n",
+			cs.WarningIcon(), opts.TagName, strings.Join(rulesets, ", "))
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Created tag %s\n", cs.SuccessIcon(), opts.TagName)
+	}
+
+	return nil
+}
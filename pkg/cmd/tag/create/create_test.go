@@ -0,0 +1,161 @@
+package create
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		want    CreateOptions
+		wantErr string
+	}{
+		{
+			name:    "no arguments",
+			args:    "",
+			wantErr: "accepts between 1 and 2 arg(s), received 0",
+		},
+		{
+			name: "tag only",
+			args: "v1.2.3",
+			want: CreateOptions{
+				TagName: "v1.2.3",
+			},
+		},
+		{
+			name: "tag, target, message and force",
+			args: `v1.2.3 main --message "Release v1.2.3" --force`,
+			want: CreateOptions{
+				TagName: "v1.2.3",
+				Target:  "main",
+				Message: "Release v1.2.3",
+				Force:   true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			var opts *CreateOptions
+			cmd := NewCmdCreate(f, func(o *CreateOptions) error {
+				opts = o
+				return nil
+			})
+			cmd.PersistentFlags().StringP("repo", "R", "", "")
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want.TagName, opts.TagName)
+			assert.Equal(t, tt.want.Target, opts.Target)
+			assert.Equal(t, tt.want.Message, opts.Message)
+			assert.Equal(t, tt.want.Force, opts.Force)
+		})
+	}
+}
+
+func noRulesetsStub(reg *httpmock.Registry) {
+	reg.Register(
+		httpmock.GraphQL(`query RepoRulesetList\b`),
+		httpmock.StringResponse(`{"data": {"level": {"rulesets": {"totalCount": 0, "nodes": [], "pageInfo": {"hasNextPage": false, "endCursor": ""}}}}}`),
+	)
+}
+
+func Test_createRun_lightweight(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/commits/main"),
+		httpmock.StringResponse(`{"sha": "6a6872b918c601a0e730710ad8473938a7516d30"}`),
+	)
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/git/refs"),
+		httpmock.StringResponse(`{"ref": "refs/tags/v1.2.3"}`),
+	)
+	noRulesetsStub(reg)
+	defer reg.Verify(t)
+
+	opts := &CreateOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+		TagName: "v1.2.3",
+		Target:  "main",
+	}
+
+	err := createRun(opts)
+	require.NoError(t, err)
+	assert.Equal(t, "✓ Created tag v1.2.3\n", stdout.String())
+}
+
+func Test_createRun_annotated(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/commits/main"),
+		httpmock.StringResponse(`{"sha": "6a6872b918c601a0e730710ad8473938a7516d30"}`),
+	)
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/git/tags"),
+		httpmock.StringResponse(`{"sha": "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}`),
+	)
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/git/refs"),
+		httpmock.StringResponse(`{"ref": "refs/tags/v1.2.3"}`),
+	)
+	noRulesetsStub(reg)
+	defer reg.Verify(t)
+
+	opts := &CreateOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+		TagName: "v1.2.3",
+		Target:  "main",
+		Message: "Release v1.2.3",
+	}
+
+	err := createRun(opts)
+	require.NoError(t, err)
+	assert.Equal(t, "✓ Created tag v1.2.3\n", stdout.String())
+}
@@ -0,0 +1,143 @@
+package create
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+func defaultBranch(httpClient *http.Client, repo ghrepo.Interface) (string, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	return api.RepoDefaultBranch(apiClient, repo)
+}
+
+func resolveCommitish(httpClient *http.Client, repo ghrepo.Interface, commitish string) (string, error) {
+	path := fmt.Sprintf("repos/%s/%s/commits/%s", repo.RepoOwner(), repo.RepoName(), commitish)
+	apiClient := api.NewClientFromHTTP(httpClient)
+	var commit struct {
+		Sha string `json:"sha"`
+	}
+	if err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &commit); err != nil {
+		return "", err
+	}
+	return commit.Sha, nil
+}
+
+// createAnnotatedTagObject creates the underlying tag object for an annotated tag and returns its
+// sha, which the tag ref should then point at in place of the target commit's sha.
+func createAnnotatedTagObject(httpClient *http.Client, repo ghrepo.Interface, tagName, message, targetSha string) (string, error) {
+	params := map[string]interface{}{
+		"tag":     tagName,
+		"message": message,
+		"object":  targetSha,
+		"type":    "commit",
+	}
+
+	bodyBytes, err := json.Marshal(params)
+	if err != nil {
+		return "", err
+	}
+
+	path := fmt.Sprintf("repos/%s/%s/git/tags", repo.RepoOwner(), repo.RepoName())
+	url := ghinstance.RESTPrefix(repo.RepoHost()) + path
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(bodyBytes))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return "", api.HandleHTTPError(resp)
+	}
+
+	var tagObject struct {
+		Sha string `json:"sha"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tagObject); err != nil {
+		return "", err
+	}
+
+	return tagObject.Sha, nil
+}
+
+func createOrUpdateTagRef(httpClient *http.Client, repo ghrepo.Interface, tagName, sha string, force bool) error {
+	if force {
+		return updateTagRef(httpClient, repo, tagName, sha)
+	}
+	return createTagRef(httpClient, repo, tagName, sha)
+}
+
+func createTagRef(httpClient *http.Client, repo ghrepo.Interface, tagName, sha string) error {
+	params := map[string]interface{}{
+		"ref": fmt.Sprintf("refs/tags/%s", tagName),
+		"sha": sha,
+	}
+
+	bodyBytes, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("repos/%s/%s/git/refs", repo.RepoOwner(), repo.RepoName())
+	url := ghinstance.RESTPrefix(repo.RepoHost()) + path
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(bodyBytes))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return api.HandleHTTPError(resp)
+	}
+
+	return nil
+}
+
+func updateTagRef(httpClient *http.Client, repo ghrepo.Interface, tagName, sha string) error {
+	params := map[string]interface{}{
+		"sha":   sha,
+		"force": true,
+	}
+
+	bodyBytes, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("repos/%s/%s/git/refs/tags/%s", repo.RepoOwner(), repo.RepoName(), tagName)
+	url := ghinstance.RESTPrefix(repo.RepoHost()) + path
+	req, err := http.NewRequest("PATCH", url, bytes.NewBuffer(bodyBytes))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return api.HandleHTTPError(resp)
+	}
+
+	return nil
+}
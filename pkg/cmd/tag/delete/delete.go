@@ -0,0 +1,107 @@
+package delete
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/tag/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type iprompter interface {
+	Confirm(string, bool) (bool, error)
+}
+
+type DeleteOptions struct {
+	HttpClient   func() (*http.Client, error)
+	GitClient    *git.Client
+	IO           *iostreams.IOStreams
+	BaseRepo     func() (ghrepo.Interface, error)
+	RepoOverride string
+	Prompter     iprompter
+
+	TagName     string
+	SkipConfirm bool
+}
+
+func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Command {
+	opts := &DeleteOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		GitClient:  f.GitClient,
+		Prompter:   f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete <tag>",
+		Short: "Delete a git tag",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+			opts.RepoOverride, _ = cmd.Flags().GetString("repo")
+
+			opts.TagName = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return deleteRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.SkipConfirm, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func deleteRun(opts *DeleteOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	if !opts.SkipConfirm && opts.IO.CanPrompt() {
+		confirmed, err := opts.Prompter.Confirm(
+			fmt.Sprintf("Delete tag %s in %s?", opts.TagName, ghrepo.FullName(baseRepo)), true)
+		if err != nil {
+			return err
+		}
+
+		if !confirmed {
+			return cmdutil.CancelError
+		}
+	}
+
+	if rulesets := shared.ProtectingRulesets(httpClient, baseRepo, opts.TagName); len(rulesets) > 0 {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.ErrOut, "%s tag %s matches a protected pattern in ruleset(s): %s\n",
+			cs.WarningIcon(), opts.TagName, strings.Join(rulesets, ", "))
+	}
+
+	if err := deleteTagRef(httpClient, baseRepo, opts.TagName); err != nil {
+		return err
+	}
+
+	if opts.RepoOverride == "" {
+		_ = opts.GitClient.DeleteLocalTag(context.Background(), opts.TagName)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Deleted tag %s\n", cs.SuccessIconWithColor(cs.Red), opts.TagName)
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package delete
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/prompter"
+	"github.com/cli/cli/v2/internal/run"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdDelete(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		want    DeleteOptions
+		wantErr string
+	}{
+		{
+			name:    "no arguments",
+			args:    "",
+			wantErr: "accepts 1 arg(s), received 0",
+		},
+		{
+			name: "tag name",
+			args: "v1.2.3",
+			want: DeleteOptions{
+				TagName: "v1.2.3",
+			},
+		},
+		{
+			name: "skip confirmation",
+			args: "v1.2.3 --yes",
+			want: DeleteOptions{
+				TagName:     "v1.2.3",
+				SkipConfirm: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			var opts *DeleteOptions
+			cmd := NewCmdDelete(f, func(o *DeleteOptions) error {
+				opts = o
+				return nil
+			})
+			cmd.PersistentFlags().StringP("repo", "R", "", "")
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want.TagName, opts.TagName)
+			assert.Equal(t, tt.want.SkipConfirm, opts.SkipConfirm)
+		})
+	}
+}
+
+func Test_deleteRun(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	ios.SetStdinTTY(true)
+
+	pm := &prompter.PrompterMock{}
+	pm.ConfirmFunc = func(p string, d bool) (bool, error) {
+		if p == "Delete tag v1.2.3 in OWNER/REPO?" {
+			return true, nil
+		}
+		return false, prompter.NoSuchPromptErr(p)
+	}
+
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.GraphQL(`query RepoRulesetList\b`),
+		httpmock.StringResponse(`{"data": {"level": {"rulesets": {"totalCount": 0, "nodes": [], "pageInfo": {"hasNextPage": false, "endCursor": ""}}}}}`),
+	)
+	reg.Register(httpmock.REST("DELETE", "repos/OWNER/REPO/git/refs/tags/v1.2.3"), httpmock.StatusStringResponse(204, ""))
+	defer reg.Verify(t)
+
+	rs, teardown := run.Stub()
+	defer teardown(t)
+	rs.Register(`git tag -d v1\.2\.3`, 0, "")
+
+	opts := &DeleteOptions{
+		IO:       ios,
+		Prompter: pm,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+		GitClient: &git.Client{GitPath: "some/path/git"},
+		TagName:   "v1.2.3",
+	}
+
+	err := deleteRun(opts)
+	require.NoError(t, err)
+	assert.Equal(t, "✓ Deleted tag v1.2.3\n", stdout.String())
+}
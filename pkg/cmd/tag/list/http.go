@@ -0,0 +1,39 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+type tag struct {
+	Name   string `json:"name"`
+	Commit struct {
+		Sha string `json:"sha"`
+	} `json:"commit"`
+}
+
+func (t tag) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "name":
+			data[f] = t.Name
+		case "sha":
+			data[f] = t.Commit.Sha
+		}
+	}
+	return data
+}
+
+func fetchTags(httpClient *http.Client, repo ghrepo.Interface, limit int) ([]tag, error) {
+	path := fmt.Sprintf("repos/%s/%s/tags?per_page=%d", repo.RepoOwner(), repo.RepoName(), limit)
+	apiClient := api.NewClientFromHTTP(httpClient)
+	var tags []tag
+	if err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &tags); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
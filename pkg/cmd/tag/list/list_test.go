@@ -0,0 +1,122 @@
+package list
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdList(t *testing.T) {
+	tests := []struct {
+		name string
+		args string
+		want ListOptions
+	}{
+		{
+			name: "no arguments",
+			args: "",
+			want: ListOptions{
+				LimitResults: 30,
+			},
+		},
+		{
+			name: "limit",
+			args: "--limit 5",
+			want: ListOptions{
+				LimitResults: 5,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			var opts *ListOptions
+			cmd := NewCmdList(f, func(o *ListOptions) error {
+				opts = o
+				return nil
+			})
+			cmd.PersistentFlags().StringP("repo", "R", "", "")
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+
+			_, err = cmd.ExecuteC()
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want.LimitResults, opts.LimitResults)
+		})
+	}
+}
+
+func Test_listRun(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/tags"),
+		httpmock.StringResponse(`[
+			{ "name": "v1.2.3", "commit": { "sha": "6a6872b918c601a0e730710ad8473938a7516d30" } },
+			{ "name": "v1.2.2", "commit": { "sha": "7a6872b918c601a0e730710ad8473938a7516d31" } }
+		]`),
+	)
+	defer reg.Verify(t)
+
+	opts := &ListOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+		LimitResults: 30,
+	}
+
+	err := listRun(opts)
+	require.NoError(t, err)
+	assert.Equal(t, "NAME    SHA\nv1.2.3  6a6872b918c601a0e730710ad8473938a7516d30\nv1.2.2  7a6872b918c601a0e730710ad8473938a7516d31\n", stdout.String())
+}
+
+func Test_listRun_noTags(t *testing.T) {
+	ios, _, _, _ := iostreams.Test()
+
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/tags"),
+		httpmock.StringResponse(`[]`),
+	)
+	defer reg.Verify(t)
+
+	opts := &ListOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+		LimitResults: 30,
+	}
+
+	err := listRun(opts)
+	assert.EqualError(t, err, "no tags found")
+}
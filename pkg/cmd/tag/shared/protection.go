@@ -0,0 +1,89 @@
+package shared
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	rulesetShared "github.com/cli/cli/v2/pkg/cmd/ruleset/shared"
+)
+
+// ProtectingRulesets returns the names of any active rulesets targeting tags whose ref_name
+// condition matches tagName, so that callers can warn the user before creating or deleting a
+// protected tag. It is best-effort: errors encountered while checking are swallowed and treated
+// as "no protection found", since this is a convenience warning rather than an enforcement
+// mechanism the API itself already enforces.
+func ProtectingRulesets(httpClient *http.Client, repo ghrepo.Interface, tagName string) []string {
+	list, err := rulesetShared.ListRepoRulesets(httpClient, repo, 100, true)
+	if err != nil {
+		return nil
+	}
+
+	refName := "refs/tags/" + tagName
+
+	var names []string
+	for _, rs := range list.Rulesets {
+		if !strings.EqualFold(rs.Target, "tag") || !strings.EqualFold(rs.Enforcement, "active") {
+			continue
+		}
+
+		full, err := fetchRuleset(httpClient, repo, rs.DatabaseId)
+		if err != nil {
+			continue
+		}
+
+		if matchesRefNameCondition(full.Conditions, refName) {
+			names = append(names, rs.Name)
+		}
+	}
+
+	return names
+}
+
+func fetchRuleset(httpClient *http.Client, repo ghrepo.Interface, databaseID int) (*rulesetShared.RulesetREST, error) {
+	path := fmt.Sprintf("repos/%s/%s/rulesets/%d", repo.RepoOwner(), repo.RepoName(), databaseID)
+	apiClient := api.NewClientFromHTTP(httpClient)
+	var result rulesetShared.RulesetREST
+	if err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func matchesRefNameCondition(conditions map[string]map[string]interface{}, refName string) bool {
+	cond, ok := conditions["ref_name"]
+	if !ok {
+		return false
+	}
+
+	if matchesPatternList(cond["exclude"], refName) {
+		return false
+	}
+
+	return matchesPatternList(cond["include"], refName)
+}
+
+func matchesPatternList(raw interface{}, refName string) bool {
+	patterns, ok := raw.([]interface{})
+	if !ok {
+		return false
+	}
+
+	for _, p := range patterns {
+		pattern, ok := p.(string)
+		if !ok {
+			continue
+		}
+		if pattern == "~ALL" {
+			return true
+		}
+		if isMatch, err := filepath.Match(pattern, refName); err == nil && isMatch {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,33 @@
+package tag
+
+import (
+	cmdCreate "github.com/cli/cli/v2/pkg/cmd/tag/create"
+	cmdDelete "github.com/cli/cli/v2/pkg/cmd/tag/delete"
+	cmdList "github.com/cli/cli/v2/pkg/cmd/tag/list"
+	cmdView "github.com/cli/cli/v2/pkg/cmd/tag/view"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdTag(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "tag <command>",
+		Short:   "Manage git tags",
+		Long:    "Work with git tags on GitHub.",
+		GroupID: "core",
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmdutil.AddGroup(cmd, "General commands",
+		cmdList.NewCmdList(f, nil),
+		cmdCreate.NewCmdCreate(f, nil),
+	)
+
+	cmdutil.AddGroup(cmd, "Targeted commands",
+		cmdView.NewCmdView(f, nil),
+		cmdDelete.NewCmdDelete(f, nil),
+	)
+
+	return cmd
+}
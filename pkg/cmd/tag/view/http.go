@@ -0,0 +1,100 @@
+package view
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+var tagFields = []string{
+	"name",
+	"sha",
+	"annotated",
+	"message",
+	"tagger",
+}
+
+type tagDetail struct {
+	Name      string `json:"name"`
+	Sha       string `json:"sha"`
+	Annotated bool   `json:"annotated"`
+	Message   string `json:"message"`
+	Tagger    struct {
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	} `json:"tagger"`
+}
+
+func (t tagDetail) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "name":
+			data[f] = t.Name
+		case "sha":
+			data[f] = t.Sha
+		case "annotated":
+			data[f] = t.Annotated
+		case "message":
+			data[f] = t.Message
+		case "tagger":
+			data[f] = map[string]interface{}{
+				"name":  t.Tagger.Name,
+				"email": t.Tagger.Email,
+			}
+		}
+	}
+	return data
+}
+
+// fetchTag resolves a tag's ref to find its target object, then, if the tag is annotated, fetches
+// the tag object itself to obtain its message and tagger.
+func fetchTag(httpClient *http.Client, repo ghrepo.Interface, tagName string) (*tagDetail, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	var ref struct {
+		Object struct {
+			Sha  string `json:"sha"`
+			Type string `json:"type"`
+		} `json:"object"`
+	}
+
+	refPath := fmt.Sprintf("repos/%s/%s/git/ref/tags/%s", repo.RepoOwner(), repo.RepoName(), tagName)
+	if err := apiClient.REST(repo.RepoHost(), "GET", refPath, nil, &ref); err != nil {
+		return nil, err
+	}
+
+	detail := &tagDetail{Name: tagName}
+
+	if ref.Object.Type != "tag" {
+		detail.Sha = ref.Object.Sha
+		return detail, nil
+	}
+
+	detail.Annotated = true
+
+	var tagObject struct {
+		Sha     string `json:"sha"`
+		Message string `json:"message"`
+		Object  struct {
+			Sha string `json:"sha"`
+		} `json:"object"`
+		Tagger struct {
+			Name  string `json:"name"`
+			Email string `json:"email"`
+		} `json:"tagger"`
+	}
+
+	tagPath := fmt.Sprintf("repos/%s/%s/git/tags/%s", repo.RepoOwner(), repo.RepoName(), ref.Object.Sha)
+	if err := apiClient.REST(repo.RepoHost(), "GET", tagPath, nil, &tagObject); err != nil {
+		return nil, err
+	}
+
+	detail.Sha = tagObject.Object.Sha
+	detail.Message = tagObject.Message
+	detail.Tagger = tagObject.Tagger
+
+	return detail, nil
+}
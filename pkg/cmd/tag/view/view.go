@@ -0,0 +1,88 @@
+package view
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ViewOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Exporter   cmdutil.Exporter
+
+	TagName string
+}
+
+func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
+	opts := &ViewOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view <tag>",
+		Short: "View information about a git tag",
+		Long: heredoc.Doc(`
+			View information about a git tag, including whether it is a lightweight
+			or annotated tag and, for annotated tags, its message and tagger.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+			opts.TagName = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return viewRun(opts)
+		},
+	}
+
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, tagFields)
+
+	return cmd
+}
+
+func viewRun(opts *ViewOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	tag, err := fetchTag(httpClient, baseRepo, opts.TagName)
+	if err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, tag)
+	}
+
+	cs := opts.IO.ColorScheme()
+	w := opts.IO.Out
+
+	fmt.Fprintf(w, "%s\n", cs.Bold(tag.Name))
+	fmt.Fprintf(w, "commit:\t%s\n", tag.Sha)
+	if tag.Annotated {
+		fmt.Fprintf(w, "type:\tannotated\n")
+		fmt.Fprintf(w, "tagger:\t%s <%s>\n", tag.Tagger.Name, tag.Tagger.Email)
+		fmt.Fprintf(w, "\n%s\n", tag.Message)
+	} else {
+		fmt.Fprintf(w, "type:\tlightweight\n")
+	}
+
+	return nil
+}
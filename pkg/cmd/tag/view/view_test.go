@@ -0,0 +1,131 @@
+package view
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdView(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		want    ViewOptions
+		wantErr string
+	}{
+		{
+			name:    "no arguments",
+			args:    "",
+			wantErr: "accepts 1 arg(s), received 0",
+		},
+		{
+			name: "tag name",
+			args: "v1.2.3",
+			want: ViewOptions{
+				TagName: "v1.2.3",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			var opts *ViewOptions
+			cmd := NewCmdView(f, func(o *ViewOptions) error {
+				opts = o
+				return nil
+			})
+			cmd.PersistentFlags().StringP("repo", "R", "", "")
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want.TagName, opts.TagName)
+		})
+	}
+}
+
+func Test_viewRun_lightweight(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/git/ref/tags/v1.2.3"),
+		httpmock.StringResponse(`{"object": {"sha": "6a6872b918c601a0e730710ad8473938a7516d30", "type": "commit"}}`),
+	)
+	defer reg.Verify(t)
+
+	opts := &ViewOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+		TagName: "v1.2.3",
+	}
+
+	err := viewRun(opts)
+	require.NoError(t, err)
+	assert.Equal(t, "v1.2.3\ncommit:\t6a6872b918c601a0e730710ad8473938a7516d30\ntype:\tlightweight\n", stdout.String())
+}
+
+func Test_viewRun_annotated(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/git/ref/tags/v1.2.3"),
+		httpmock.StringResponse(`{"object": {"sha": "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "type": "tag"}}`),
+	)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/git/tags/aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"),
+		httpmock.StringResponse(`{
+			"sha": "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			"message": "Release v1.2.3",
+			"object": {"sha": "6a6872b918c601a0e730710ad8473938a7516d30"},
+			"tagger": {"name": "Monalisa", "email": "monalisa@github.com"}
+		}`),
+	)
+	defer reg.Verify(t)
+
+	opts := &ViewOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+		TagName: "v1.2.3",
+	}
+
+	err := viewRun(opts)
+	require.NoError(t, err)
+	assert.Equal(t, "v1.2.3\ncommit:\t6a6872b918c601a0e730710ad8473938a7516d30\ntype:\tannotated\ntagger:\tMonalisa <monalisa@github.com>\n\nRelease v1.2.3\n", stdout.String())
+}
@@ -0,0 +1,103 @@
+package report
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/internal/telemetry"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ReportOptions struct {
+	IO      *iostreams.IOStreams
+	ReadAll func() ([]telemetry.Record, error)
+}
+
+func NewCmdTelemetryReport(f *cmdutil.Factory, runF func(*ReportOptions) error) *cobra.Command {
+	opts := &ReportOptions{
+		IO:      f.IOStreams,
+		ReadAll: telemetry.ReadAll,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Summarize local command timing history",
+		Long: heredoc.Doc(`
+			Summarize the local, opt-in record of gh command durations and exit
+			codes, grouped by command.
+
+			This currently reports total wall-clock time per command; it does not
+			yet break that time down into API latency, git, and prompt time, since
+			those subsystems aren't individually instrumented.
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+			return reportRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+type commandStats struct {
+	name     string
+	count    int
+	failures int
+	total    time.Duration
+}
+
+func reportRun(opts *ReportOptions) error {
+	records, err := opts.ReadAll()
+	if err != nil {
+		return fmt.Errorf("failed to read telemetry history: %w", err)
+	}
+
+	if len(records) == 0 {
+		return cmdutil.NewNoResultsError("no telemetry history found; enable it with `gh config set telemetry enabled`")
+	}
+
+	byCommand := map[string]*commandStats{}
+	var order []string
+	for _, r := range records {
+		s, ok := byCommand[r.Command]
+		if !ok {
+			s = &commandStats{name: r.Command}
+			byCommand[r.Command] = s
+			order = append(order, r.Command)
+		}
+		s.count++
+		if r.ExitCode != 0 {
+			s.failures++
+		}
+		s.total += time.Duration(r.DurationMS) * time.Millisecond
+	}
+
+	sort.Slice(order, func(i, j int) bool {
+		return byCommand[order[i]].total > byCommand[order[j]].total
+	})
+
+	if err := opts.IO.StartPager(); err == nil {
+		defer opts.IO.StopPager()
+	}
+
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("COMMAND", "RUNS", "FAILURES", "TOTAL", "AVERAGE"))
+	for _, name := range order {
+		s := byCommand[name]
+		tp.AddField(s.name)
+		tp.AddField(fmt.Sprintf("%d", s.count))
+		tp.AddField(fmt.Sprintf("%d", s.failures))
+		tp.AddField(s.total.Round(time.Millisecond).String())
+		tp.AddField((s.total / time.Duration(s.count)).Round(time.Millisecond).String())
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
@@ -0,0 +1,31 @@
+package telemetry
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdReport "github.com/cli/cli/v2/pkg/cmd/telemetry/report"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdTelemetry(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "telemetry <command>",
+		Short: "Inspect local command timing history",
+		Long: heredoc.Doc(`
+			Work with the local, opt-in record of how long gh commands take to run.
+
+			Nothing is recorded until telemetry is enabled with:
+
+				gh config set telemetry enabled
+
+			Once enabled, every invocation appends its command name, duration, and
+			exit code to a file on disk. No data ever leaves the machine.
+		`),
+	}
+
+	cmdutil.DisableAuthCheck(cmd)
+
+	cmd.AddCommand(cmdReport.NewCmdTelemetryReport(f, nil))
+
+	return cmd
+}
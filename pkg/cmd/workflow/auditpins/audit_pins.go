@@ -0,0 +1,241 @@
+package auditpins
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmd/workflow/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type AuditPinsOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Exporter   cmdutil.Exporter
+
+	Selector string
+	Path     string
+	All      bool
+	Fix      bool
+}
+
+var findingFields = []string{
+	"file",
+	"line",
+	"action",
+	"ref",
+	"pinned",
+	"advertisedTag",
+	"resolvedSHA",
+	"stale",
+	"fixed",
+}
+
+func NewCmdAuditPins(f *cmdutil.Factory, runF func(*AuditPinsOptions) error) *cobra.Command {
+	opts := &AuditPinsOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "audit-pins [<workflow-id> | <workflow-name> | <filename>]",
+		Short: "Audit actions referenced by workflows for unpinned or stale SHAs",
+		Long: heredoc.Doc(`
+			Scan workflow files for "uses:" references that aren't pinned to a full commit
+			SHA, and for pinned SHAs that no longer match the tag named in a trailing
+			comment (e.g. "actions/checkout@b4ffde6 # v4.1.1").
+
+			By default the workflow files are fetched from the repository on GitHub. Pass
+			--path to audit local files instead, which is also required to use --fix.
+		`),
+		Example: heredoc.Doc(`
+			# Audit every active workflow in the repository
+			$ gh workflow audit-pins
+
+			# Audit and rewrite local workflow files to pin unpinned or stale actions
+			$ gh workflow audit-pins --path .github/workflows --fix
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if len(args) > 0 {
+				opts.Selector = args[0]
+			}
+
+			if opts.Fix && opts.Path == "" {
+				return cmdutil.FlagErrorf("--fix requires --path")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return auditPinsRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Path, "path", "", "Audit local workflow files under `path` instead of fetching them from GitHub")
+	cmd.Flags().BoolVarP(&opts.All, "all", "a", false, "Include disabled workflows")
+	cmd.Flags().BoolVar(&opts.Fix, "fix", false, "Rewrite unpinned or stale action references to the resolved commit SHA")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, findingFields)
+
+	return cmd
+}
+
+func auditPinsRun(opts *AuditPinsOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("could not create http client: %w", err)
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	files, err := gatherWorkflowFiles(opts, client, repo)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return cmdutil.NewNoResultsError("no workflow files found")
+	}
+
+	var findings []*pinFinding
+	for _, wf := range files {
+		fileFindings, fixed, err := auditFile(client, repo.RepoHost(), wf.name, wf.content, opts.Fix)
+		if err != nil {
+			return fmt.Errorf("could not audit %s: %w", wf.name, err)
+		}
+		if opts.Fix && wf.path != "" && len(fixed) > 0 {
+			if err := os.WriteFile(wf.path, fixed, 0o644); err != nil {
+				return fmt.Errorf("could not write %s: %w", wf.path, err)
+			}
+		}
+		findings = append(findings, fileFindings...)
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, findings)
+	}
+
+	if len(findings) == 0 {
+		fmt.Fprintln(opts.IO.Out, "No action references found.")
+		return nil
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("FILE", "LINE", "ACTION", "REF", "STATUS"))
+	for _, f := range findings {
+		tp.AddField(f.File)
+		tp.AddField(fmt.Sprintf("%d", f.Line))
+		tp.AddField(f.Action)
+		tp.AddField(f.Ref)
+		tp.AddField(f.status(), tableprinter.WithColor(f.statusColor(cs)))
+		tp.EndRow()
+	}
+
+	if err := tp.Render(); err != nil {
+		return err
+	}
+
+	if opts.Fix {
+		fmt.Fprintln(opts.IO.Out)
+		fmt.Fprintln(opts.IO.Out, "Rewrote unpinned and stale references where a commit SHA could be resolved.")
+	}
+
+	return nil
+}
+
+type workflowFile struct {
+	name    string // display name, e.g. the workflow's file name
+	path    string // local file path, set only when read from disk (required to --fix)
+	content []byte
+}
+
+func gatherWorkflowFiles(opts *AuditPinsOptions, client *api.Client, repo ghrepo.Interface) ([]workflowFile, error) {
+	if opts.Path != "" {
+		return localWorkflowFiles(opts.Path)
+	}
+	return remoteWorkflowFiles(opts, client, repo)
+}
+
+func localWorkflowFiles(path string) ([]workflowFile, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	if info.IsDir() {
+		for _, ext := range []string{"*.yml", "*.yaml"} {
+			matches, err := filepath.Glob(filepath.Join(path, ext))
+			if err != nil {
+				return nil, err
+			}
+			paths = append(paths, matches...)
+		}
+	} else {
+		paths = append(paths, path)
+	}
+
+	files := make([]workflowFile, 0, len(paths))
+	for _, p := range paths {
+		content, err := os.ReadFile(p)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, workflowFile{name: p, path: p, content: content})
+	}
+	return files, nil
+}
+
+func remoteWorkflowFiles(opts *AuditPinsOptions, client *api.Client, repo ghrepo.Interface) ([]workflowFile, error) {
+	states := []shared.WorkflowState{shared.Active}
+	if opts.All {
+		states = append(states, shared.DisabledManually, shared.DisabledInactivity)
+	}
+
+	var workflows []shared.Workflow
+	if opts.Selector != "" {
+		found, err := shared.FindWorkflow(client, repo, opts.Selector, states)
+		if err != nil {
+			return nil, err
+		}
+		workflows = found
+	} else {
+		all, err := shared.GetWorkflows(client, repo, 0)
+		if err != nil {
+			return nil, fmt.Errorf("could not get workflows: %w", err)
+		}
+		for _, w := range all {
+			for _, s := range states {
+				if w.State == s {
+					workflows = append(workflows, w)
+					break
+				}
+			}
+		}
+	}
+
+	files := make([]workflowFile, 0, len(workflows))
+	for _, w := range workflows {
+		content, err := shared.GetWorkflowContent(client, repo, w, "")
+		if err != nil {
+			return nil, fmt.Errorf("could not get workflow file content for %s: %w", w.Base(), err)
+		}
+		files = append(files, workflowFile{name: w.Base(), content: content})
+	}
+	return files, nil
+}
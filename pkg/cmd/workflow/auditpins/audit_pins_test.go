@@ -0,0 +1,125 @@
+package auditpins
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdAuditPins(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wants    AuditPinsOptions
+		wantsErr string
+	}{
+		{
+			name: "no arguments",
+			wants: AuditPinsOptions{
+				Selector: "",
+			},
+		},
+		{
+			name: "selector",
+			cli:  "ci.yml",
+			wants: AuditPinsOptions{
+				Selector: "ci.yml",
+			},
+		},
+		{
+			name: "path and fix",
+			cli:  "--path .github/workflows --fix",
+			wants: AuditPinsOptions{
+				Path: ".github/workflows",
+				Fix:  true,
+			},
+		},
+		{
+			name:     "fix without path",
+			cli:      "--fix",
+			wantsErr: "--fix requires --path",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			var opts *AuditPinsOptions
+			cmd := NewCmdAuditPins(f, func(o *AuditPinsOptions) error {
+				opts = o
+				return nil
+			})
+
+			argv, err := shlex.Split(tt.cli)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				require.EqualError(t, err, tt.wantsErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wants.Selector, opts.Selector)
+			assert.Equal(t, tt.wants.Path, opts.Path)
+			assert.Equal(t, tt.wants.Fix, opts.Fix)
+		})
+	}
+}
+
+func TestAuditPinsRun_local(t *testing.T) {
+	dir := t.TempDir()
+	workflowPath := filepath.Join(dir, "ci.yml")
+	require.NoError(t, os.WriteFile(workflowPath, []byte(
+		"name: CI\njobs:\n  build:\n    steps:\n      - uses: actions/checkout@v4\n",
+	), 0o644))
+
+	fakeHTTP := &httpmock.Registry{}
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.Register(
+		httpmock.REST("GET", "repos/actions/checkout/commits/v4"),
+		httpmock.JSONResponse(map[string]string{"sha": "1111111111111111111111111111111111111111"}),
+	)
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	opts := &AuditPinsOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: fakeHTTP}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+		Path: dir,
+		Fix:  true,
+	}
+
+	require.NoError(t, auditPinsRun(opts))
+	assert.Contains(t, stdout.String(), "fixed")
+
+	fixed, err := os.ReadFile(workflowPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(fixed), "actions/checkout@1111111111111111111111111111111111111111 # v4")
+}
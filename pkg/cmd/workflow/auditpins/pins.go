@@ -0,0 +1,163 @@
+package auditpins
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+)
+
+// pinFinding describes a single "uses:" reference found while auditing a workflow file.
+type pinFinding struct {
+	File          string
+	Line          int
+	Action        string
+	Ref           string
+	Pinned        bool
+	AdvertisedTag string
+	ResolvedSHA   string
+	Stale         bool
+	Fixed         bool
+}
+
+func (f *pinFinding) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(f, fields)
+}
+
+func (f *pinFinding) status() string {
+	switch {
+	case f.Fixed:
+		return "fixed"
+	case !f.Pinned:
+		return "unpinned"
+	case f.Stale:
+		return "stale"
+	default:
+		return "pinned"
+	}
+}
+
+func (f *pinFinding) statusColor(cs *iostreams.ColorScheme) func(string) string {
+	switch f.status() {
+	case "fixed", "pinned":
+		return cs.Green
+	case "stale":
+		return cs.Red
+	default:
+		return cs.Yellow
+	}
+}
+
+var shaRefRE = regexp.MustCompile(`^[0-9a-fA-F]{40}$`)
+
+// usesLineRE matches a step-level `uses:` line, capturing the surrounding indentation/quoting so
+// a rewritten reference can be spliced back in without disturbing the rest of the line, plus an
+// optional trailing `# <tag>` comment of the kind GitHub recommends when pinning to a SHA.
+var usesLineRE = regexp.MustCompile(`^(\s*-?\s*uses:\s*)(['"]?)([^'"#\s]+)(['"]?)\s*(?:#\s*(\S+).*)?$`)
+
+// scanActionRefs finds every `uses:` reference to a versioned action (skipping local actions and
+// Docker images, which aren't pinned via git refs) in a workflow file.
+func scanActionRefs(filename string, content []byte) []*pinFinding {
+	var findings []*pinFinding
+	for i, line := range strings.Split(string(content), "\n") {
+		m := usesLineRE.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+
+		ref := m[3]
+		if strings.HasPrefix(ref, "./") || strings.HasPrefix(ref, "docker://") {
+			continue
+		}
+
+		idx := strings.LastIndex(ref, "@")
+		if idx == -1 {
+			continue
+		}
+
+		version := ref[idx+1:]
+		findings = append(findings, &pinFinding{
+			File:          filename,
+			Line:          i + 1,
+			Action:        ref[:idx],
+			Ref:           version,
+			Pinned:        shaRefRE.MatchString(version),
+			AdvertisedTag: m[5],
+		})
+	}
+	return findings
+}
+
+// auditFile scans a workflow file's action references and, for each one that can be resolved
+// against GitHub, checks whether it's pinned and whether a pinned SHA still matches the tag it
+// claims to be. Any reference whose tag can't be resolved (e.g. the tag was deleted, or a network
+// error) is reported as-is rather than failing the whole audit.
+func auditFile(client *api.Client, host, filename string, content []byte, fix bool) ([]*pinFinding, []byte, error) {
+	findings := scanActionRefs(filename, content)
+	if len(findings) == 0 {
+		return findings, nil, nil
+	}
+
+	lines := strings.Split(string(content), "\n")
+	changed := false
+
+	for _, f := range findings {
+		tagToResolve := f.AdvertisedTag
+		if !f.Pinned {
+			tagToResolve = f.Ref
+		}
+		if tagToResolve == "" {
+			continue
+		}
+
+		sha, err := resolveActionRef(client, host, f.Action, tagToResolve)
+		if err != nil {
+			continue
+		}
+		f.ResolvedSHA = sha
+		if f.Pinned {
+			f.Stale = !strings.EqualFold(sha, f.Ref)
+		}
+
+		if fix && (!f.Pinned || f.Stale) {
+			lines[f.Line-1] = rewriteUsesLine(lines[f.Line-1], f.Action, sha, tagToResolve)
+			f.Fixed = true
+			changed = true
+		}
+	}
+
+	if !changed {
+		return findings, nil, nil
+	}
+	return findings, []byte(strings.Join(lines, "\n")), nil
+}
+
+func rewriteUsesLine(old, action, sha, tag string) string {
+	m := usesLineRE.FindStringSubmatch(old)
+	if m == nil {
+		return old
+	}
+	return fmt.Sprintf("%s%s%s@%s%s # %s", m[1], m[2], action, sha, m[4], tag)
+}
+
+// resolveActionRef resolves a ref (tag, branch, or SHA) of an action to the commit SHA it
+// currently points to.
+func resolveActionRef(client *api.Client, host, action, ref string) (string, error) {
+	parts := strings.SplitN(action, "/", 3)
+	if len(parts) < 2 {
+		return "", fmt.Errorf("%q is not a valid action reference", action)
+	}
+
+	path := fmt.Sprintf("repos/%s/%s/commits/%s", parts[0], parts[1], url.PathEscape(ref))
+	var result struct {
+		SHA string `json:"sha"`
+	}
+	if err := client.REST(host, "GET", path, nil, &result); err != nil {
+		return "", err
+	}
+	return result.SHA, nil
+}
@@ -0,0 +1,59 @@
+package auditpins
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_scanActionRefs(t *testing.T) {
+	content := []byte(`name: CI
+jobs:
+  build:
+    steps:
+      - uses: actions/checkout@v4
+      - uses: actions/setup-node@60edb5dd545a775178f52524783378180af0d1fa # v4.0.2
+      - uses: ./.github/actions/local
+      - uses: docker://alpine:3.19
+`)
+
+	findings := scanActionRefs("ci.yml", content)
+	require.Len(t, findings, 2)
+
+	assert.Equal(t, "actions/checkout", findings[0].Action)
+	assert.Equal(t, "v4", findings[0].Ref)
+	assert.False(t, findings[0].Pinned)
+	assert.Equal(t, "", findings[0].AdvertisedTag)
+	assert.Equal(t, 5, findings[0].Line)
+
+	assert.Equal(t, "actions/setup-node", findings[1].Action)
+	assert.Equal(t, "60edb5dd545a775178f52524783378180af0d1fa", findings[1].Ref)
+	assert.True(t, findings[1].Pinned)
+	assert.Equal(t, "v4.0.2", findings[1].AdvertisedTag)
+}
+
+func Test_rewriteUsesLine(t *testing.T) {
+	tests := []struct {
+		name string
+		old  string
+		want string
+	}{
+		{
+			name: "unpinned",
+			old:  "      - uses: actions/checkout@v4",
+			want: "      - uses: actions/checkout@1111111111111111111111111111111111111111 # v4",
+		},
+		{
+			name: "stale pin keeps its tag comment",
+			old:  "      - uses: actions/checkout@0000000000000000000000000000000000000000 # v4",
+			want: "      - uses: actions/checkout@1111111111111111111111111111111111111111 # v4",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := rewriteUsesLine(tt.old, "actions/checkout", "1111111111111111111111111111111111111111", "v4")
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/internal/prompter"
 	"github.com/cli/cli/v2/pkg/cmd/workflow/shared"
@@ -50,6 +52,12 @@ func TestNewCmdDisable(t *testing.T) {
 				Selector: "123",
 			},
 		},
+		{
+			name:     "org without selector",
+			cli:      "--org my-org",
+			tty:      true,
+			wantsErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -268,6 +276,7 @@ func TestDisableRun(t *testing.T) {
 		ios.SetStdoutTTY(tt.tty)
 		ios.SetStdinTTY(tt.tty)
 		tt.opts.IO = ios
+		tt.opts.Confirmed = true
 		tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
 			return ghrepo.FromFullName("OWNER/REPO")
 		}
@@ -291,3 +300,104 @@ func TestDisableRun(t *testing.T) {
 		})
 	}
 }
+
+func TestBulkDisableRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/repos"),
+		httpmock.JSONResponse([]map[string]interface{}{
+			{"name": "service-a", "owner": map[string]string{"login": "my-org"}},
+			{"name": "service-b", "owner": map[string]string{"login": "my-org"}},
+			{"name": "other", "owner": map[string]string{"login": "my-org"}},
+		}))
+	reg.Register(
+		httpmock.REST("GET", "repos/my-org/service-a/actions/workflows/ci.yml"),
+		httpmock.JSONResponse(shared.AWorkflow))
+	reg.Register(
+		httpmock.REST("PUT", "repos/my-org/service-a/actions/workflows/123/disable"),
+		httpmock.StatusStringResponse(204, "{}"))
+	reg.Register(
+		httpmock.REST("GET", "repos/my-org/service-b/actions/workflows/ci.yml"),
+		httpmock.StatusStringResponse(404, "not found"))
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	ios.SetColorEnabled(false)
+
+	opts := &DisableOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		Prompter:    prompter.NewMockPrompter(t),
+		Selector:    "ci.yml",
+		Org:         "my-org",
+		Match:       "service-*",
+		Concurrency: 1,
+	}
+
+	err := runBulkDisable(opts)
+	assert.Error(t, err)
+	assert.Contains(t, stdout.String(), "my-org/service-a")
+	assert.Contains(t, stdout.String(), "my-org/service-b")
+	assert.NotContains(t, stdout.String(), "my-org/other")
+}
+
+func TestDisableRun_confirmation(t *testing.T) {
+	t.Run("non-interactive without --yes or --confirm-token errors", func(t *testing.T) {
+		reg := &httpmock.Registry{}
+		reg.Register(
+			httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+			httpmock.JSONResponse(shared.AWorkflow))
+		defer reg.Verify(t)
+
+		ios, _, _, _ := iostreams.Test()
+		opts := &DisableOptions{
+			IO: ios,
+			HttpClient: func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			},
+			BaseRepo: func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			},
+			Prompter: prompter.NewMockPrompter(t),
+			Selector: "123",
+		}
+
+		err := runDisable(opts)
+		assert.EqualError(t, err, "--yes or --confirm-token required when not running interactively")
+	})
+
+	t.Run("confirm-token matching the workflow name skips the prompt", func(t *testing.T) {
+		reg := &httpmock.Registry{}
+		reg.Register(
+			httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+			httpmock.JSONResponse(shared.AWorkflow))
+		reg.Register(
+			httpmock.REST("PUT", "repos/OWNER/REPO/actions/workflows/123/disable"),
+			httpmock.StatusStringResponse(204, "{}"))
+		defer reg.Verify(t)
+
+		ios, _, _, _ := iostreams.Test()
+		opts := &DisableOptions{
+			IO: ios,
+			HttpClient: func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			},
+			BaseRepo: func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			},
+			Prompter:     prompter.NewMockPrompter(t),
+			Selector:     "123",
+			ConfirmToken: shared.AWorkflow.Name,
+		}
+
+		err := runDisable(opts)
+		assert.NoError(t, err)
+	})
+}
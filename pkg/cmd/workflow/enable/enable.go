@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/cmd/workflow/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/hashicorp/go-multierror"
 	"github.com/spf13/cobra"
 )
 
@@ -17,10 +20,15 @@ type EnableOptions struct {
 	HttpClient func() (*http.Client, error)
 	IO         *iostreams.IOStreams
 	BaseRepo   func() (ghrepo.Interface, error)
+	Config     func() (gh.Config, error)
 	Prompter   iprompter
 
 	Selector string
 	Prompt   bool
+
+	Org         string
+	Match       string
+	Concurrency int
 }
 
 type iprompter interface {
@@ -31,20 +39,32 @@ func NewCmdEnable(f *cmdutil.Factory, runF func(*EnableOptions) error) *cobra.Co
 	opts := &EnableOptions{
 		IO:         f.IOStreams,
 		HttpClient: f.HttpClient,
+		Config:     f.Config,
 		Prompter:   f.Prompter,
 	}
 
 	cmd := &cobra.Command{
 		Use:   "enable [<workflow-id> | <workflow-name>]",
 		Short: "Enable a workflow",
-		Long:  "Enable a workflow, allowing it to be run and show up when listing workflows.",
-		Args:  cobra.MaximumNArgs(1),
+		Long: heredoc.Doc(`
+			Enable a workflow, allowing it to be run and show up when listing workflows.
+
+			With --org, the workflow is enabled across every repository in the
+			organization, optionally narrowed with --match.
+		`),
+		Example: heredoc.Doc(`
+			$ gh workflow enable ci.yml
+			$ gh workflow enable ci.yml --org my-org --match "service-*"
+		`),
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// support `-R, --repo` override
 			opts.BaseRepo = f.BaseRepo
 
 			if len(args) > 0 {
 				opts.Selector = args[0]
+			} else if opts.Org != "" {
+				return cmdutil.FlagErrorf("workflow ID or name required when using --org")
 			} else if !opts.IO.CanPrompt() {
 				return cmdutil.FlagErrorf("workflow ID or name required when not running interactively")
 			} else {
@@ -54,10 +74,17 @@ func NewCmdEnable(f *cmdutil.Factory, runF func(*EnableOptions) error) *cobra.Co
 			if runF != nil {
 				return runF(opts)
 			}
+			if opts.Org != "" {
+				return runBulkEnable(opts)
+			}
 			return runEnable(opts)
 		},
 	}
 
+	cmd.Flags().StringVar(&opts.Org, "org", "", "Enable the workflow across every repository in an organization")
+	cmd.Flags().StringVar(&opts.Match, "match", "", "Glob `pattern` limiting which repositories in --org are affected")
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", 5, "Number of repositories to process at once when using --org")
+
 	return cmd
 }
 
@@ -84,9 +111,7 @@ func runEnable(opts *EnableOptions) error {
 		return err
 	}
 
-	path := fmt.Sprintf("repos/%s/actions/workflows/%d/enable", ghrepo.FullName(repo), workflow.ID)
-	err = client.REST(repo.RepoHost(), "PUT", path, nil, nil)
-	if err != nil {
+	if err := enableWorkflow(client, repo, workflow.ID); err != nil {
 		return fmt.Errorf("failed to enable workflow: %w", err)
 	}
 
@@ -97,3 +122,57 @@ func runEnable(opts *EnableOptions) error {
 
 	return nil
 }
+
+func runBulkEnable(opts *EnableOptions) error {
+	c, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("could not build http client: %w", err)
+	}
+	client := api.NewClientFromHTTP(c)
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	hostname, _ := cfg.Authentication().DefaultHost()
+
+	repos, err := shared.ListOrgRepos(client, hostname, opts.Org, opts.Match)
+	if err != nil {
+		return fmt.Errorf("failed to list repositories for %s: %w", opts.Org, err)
+	}
+	if len(repos) == 0 {
+		return fmt.Errorf("no repositories in %s matched", opts.Org)
+	}
+
+	cs := opts.IO.ColorScheme()
+	states := []shared.WorkflowState{shared.DisabledManually, shared.DisabledInactivity}
+
+	results := shared.BulkApply(repos, opts.Concurrency, func(repo ghrepo.Interface) error {
+		workflow, err := shared.ResolveWorkflow(opts.Prompter, opts.IO, client, repo, false, opts.Selector, states)
+		if err != nil {
+			return err
+		}
+		return enableWorkflow(client, repo, workflow.ID)
+	})
+
+	var errs error
+	for _, result := range results {
+		var fae shared.FilteredAllError
+		switch {
+		case errors.As(result.Err, &fae):
+			fmt.Fprintf(opts.IO.Out, "%s %s: no disabled workflow found\n", cs.Gray("-"), ghrepo.FullName(result.Repo))
+		case result.Err != nil:
+			fmt.Fprintf(opts.IO.Out, "%s %s: %s\n", cs.FailureIcon(), ghrepo.FullName(result.Repo), result.Err)
+			errs = multierror.Append(errs, fmt.Errorf("%s: %w", ghrepo.FullName(result.Repo), result.Err))
+		default:
+			fmt.Fprintf(opts.IO.Out, "%s %s\n", cs.SuccessIcon(), ghrepo.FullName(result.Repo))
+		}
+	}
+
+	return errs
+}
+
+func enableWorkflow(client *api.Client, repo ghrepo.Interface, workflowID int64) error {
+	path := fmt.Sprintf("repos/%s/actions/workflows/%d/enable", ghrepo.FullName(repo), workflowID)
+	return client.REST(repo.RepoHost(), "PUT", path, nil, nil)
+}
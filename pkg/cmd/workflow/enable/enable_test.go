@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/internal/prompter"
 	"github.com/cli/cli/v2/pkg/cmd/workflow/shared"
@@ -50,6 +52,12 @@ func TestNewCmdEnable(t *testing.T) {
 				Selector: "123",
 			},
 		},
+		{
+			name:     "org without selector",
+			cli:      "--org my-org",
+			tty:      true,
+			wantsErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -331,3 +339,50 @@ func TestEnableRun(t *testing.T) {
 		})
 	}
 }
+
+func TestBulkEnableRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/repos"),
+		httpmock.JSONResponse([]map[string]interface{}{
+			{"name": "service-a", "owner": map[string]string{"login": "my-org"}},
+			{"name": "service-b", "owner": map[string]string{"login": "my-org"}},
+			{"name": "other", "owner": map[string]string{"login": "my-org"}},
+		}))
+	reg.Register(
+		httpmock.REST("GET", "repos/my-org/service-a/actions/workflows/ci.yml"),
+		httpmock.JSONResponse(shared.DisabledWorkflow))
+	reg.Register(
+		httpmock.REST("PUT", "repos/my-org/service-a/actions/workflows/456/enable"),
+		httpmock.StatusStringResponse(204, "{}"))
+	reg.Register(
+		httpmock.REST("GET", "repos/my-org/service-b/actions/workflows/ci.yml"),
+		httpmock.StatusStringResponse(404, "not found"))
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	ios.SetColorEnabled(false)
+
+	opts := &EnableOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		Prompter:    prompter.NewMockPrompter(t),
+		Selector:    "ci.yml",
+		Org:         "my-org",
+		Match:       "service-*",
+		Concurrency: 1,
+	}
+
+	err := runBulkEnable(opts)
+	assert.Error(t, err)
+	assert.Contains(t, stdout.String(), "my-org/service-a")
+	assert.Contains(t, stdout.String(), "my-org/service-b")
+	assert.NotContains(t, stdout.String(), "my-org/other")
+}
@@ -7,16 +7,20 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
 	"reflect"
 	"sort"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
 	"github.com/cli/cli/v2/pkg/cmd/workflow/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/hashicorp/go-multierror"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
 )
@@ -25,6 +29,7 @@ type RunOptions struct {
 	HttpClient func() (*http.Client, error)
 	IO         *iostreams.IOStreams
 	BaseRepo   func() (ghrepo.Interface, error)
+	Config     func() (gh.Config, error)
 	Prompter   iprompter
 
 	Selector  string
@@ -36,6 +41,11 @@ type RunOptions struct {
 	RawFields   []string
 
 	Prompt bool
+
+	Org         string
+	Match       string
+	ReposFile   string
+	Concurrency int
 }
 
 type iprompter interface {
@@ -47,6 +57,7 @@ func NewCmdRun(f *cmdutil.Factory, runF func(*RunOptions) error) *cobra.Command
 	opts := &RunOptions{
 		IO:         f.IOStreams,
 		HttpClient: f.HttpClient,
+		Config:     f.Config,
 		Prompter:   f.Prompter,
 	}
 
@@ -64,6 +75,10 @@ func NewCmdRun(f *cmdutil.Factory, runF func(*RunOptions) error) *cobra.Command
 			- Interactively
 			- Via %[1]s-f/--raw-field%[1]s or %[1]s-F/--field%[1]s flags
 			- As JSON, via standard input
+
+			With %[1]s--org%[1]s or %[1]s--repos-file%[1]s, the same event (with the same ref and inputs) is
+			dispatched across many repositories, up to %[1]s--concurrency%[1]s at a time, and a table of
+			per-repository results is printed.
 		`, "`"),
 		Example: heredoc.Doc(`
 			# Have gh prompt you for what workflow you'd like to run and interactively collect inputs
@@ -80,6 +95,12 @@ func NewCmdRun(f *cmdutil.Factory, runF func(*RunOptions) error) *cobra.Command
 
 			# Run the workflow file 'triage.yml' with JSON via standard input
 			$ echo '{"name":"scully", "greeting":"hello"}' | gh workflow run triage.yml --json
+
+			# Run the workflow file 'triage.yml' across every repository in an organization
+			$ gh workflow run triage.yml --org my-org --match "service-*"
+
+			# Run the workflow file 'triage.yml' across repositories listed in a file
+			$ gh workflow run triage.yml --repos-file repos.txt
 		`),
 		Args: func(cmd *cobra.Command, args []string) error {
 			if len(opts.MagicFields)+len(opts.RawFields) > 0 && len(args) == 0 {
@@ -91,10 +112,22 @@ func NewCmdRun(f *cmdutil.Factory, runF func(*RunOptions) error) *cobra.Command
 			// support `-R, --repo` override
 			opts.BaseRepo = f.BaseRepo
 
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--org` or `--repos-file`",
+				opts.Org != "",
+				opts.ReposFile != "",
+			); err != nil {
+				return err
+			}
+
+			fleet := opts.Org != "" || opts.ReposFile != ""
+
 			inputFieldsPassed := len(opts.MagicFields)+len(opts.RawFields) > 0
 
 			if len(args) > 0 {
 				opts.Selector = args[0]
+			} else if fleet {
+				return cmdutil.FlagErrorf("workflow argument required when using `--org` or `--repos-file`")
 			} else if !opts.IO.CanPrompt() {
 				return cmdutil.FlagErrorf("workflow ID, name, or filename required when not running interactively")
 			} else {
@@ -125,6 +158,9 @@ func NewCmdRun(f *cmdutil.Factory, runF func(*RunOptions) error) *cobra.Command
 				return runF(opts)
 			}
 
+			if fleet {
+				return runFleetRun(opts)
+			}
 			return runRun(opts)
 		},
 	}
@@ -132,6 +168,10 @@ func NewCmdRun(f *cmdutil.Factory, runF func(*RunOptions) error) *cobra.Command
 	cmd.Flags().StringArrayVarP(&opts.MagicFields, "field", "F", nil, "Add a string parameter in `key=value` format, respecting @ syntax (see \"gh help api\").")
 	cmd.Flags().StringArrayVarP(&opts.RawFields, "raw-field", "f", nil, "Add a string parameter in `key=value` format")
 	cmd.Flags().BoolVar(&opts.JSON, "json", false, "Read workflow inputs as JSON via STDIN")
+	cmd.Flags().StringVar(&opts.Org, "org", "", "Dispatch the workflow across every repository in an organization")
+	cmd.Flags().StringVar(&opts.Match, "match", "", "Glob `pattern` limiting which repositories in --org are dispatched to")
+	cmd.Flags().StringVar(&opts.ReposFile, "repos-file", "", "`file` containing one OWNER/REPO per line to dispatch the workflow to")
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", 5, "Number of repositories to dispatch to at once when using --org or --repos-file")
 
 	_ = cmdutil.RegisterBranchCompletionFlags(f.GitClient, cmd, "ref")
 
@@ -303,37 +343,139 @@ func runRun(opts *RunOptions) error {
 		}
 	}
 
+	if err := dispatchWorkflow(client, repo, workflow.ID, ref, providedInputs); err != nil {
+		return fmt.Errorf("could not create workflow dispatch event: %w", err)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		out := opts.IO.Out
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(out, "%s Created workflow_dispatch event for %s at %s\n",
+			cs.SuccessIcon(), cs.Cyan(workflow.Base()), cs.Bold(ref))
+
+		fmt.Fprintln(out)
+
+		fmt.Fprintf(out, "To see runs for this workflow, try: %s\n",
+			cs.Boldf("gh run list --workflow=%s", workflow.Base()))
+	}
+
+	return nil
+}
+
+func dispatchWorkflow(client *api.Client, repo ghrepo.Interface, workflowID int64, ref string, inputs map[string]string) error {
 	path := fmt.Sprintf("repos/%s/actions/workflows/%d/dispatches",
-		ghrepo.FullName(repo), workflow.ID)
+		ghrepo.FullName(repo), workflowID)
 
 	requestByte, err := json.Marshal(map[string]interface{}{
 		"ref":    ref,
-		"inputs": providedInputs,
+		"inputs": inputs,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to serialize workflow inputs: %w", err)
 	}
 
-	body := bytes.NewReader(requestByte)
+	return client.REST(repo.RepoHost(), "POST", path, bytes.NewReader(requestByte), nil)
+}
 
-	err = client.REST(repo.RepoHost(), "POST", path, body, nil)
+// runFleetRun dispatches the same workflow_dispatch event, with identical
+// inputs, across every repository in --org or --repos-file.
+func runFleetRun(opts *RunOptions) error {
+	c, err := opts.HttpClient()
 	if err != nil {
-		return fmt.Errorf("could not create workflow dispatch event: %w", err)
+		return fmt.Errorf("could not build http client: %w", err)
 	}
+	client := api.NewClientFromHTTP(c)
 
-	if opts.IO.IsStdoutTTY() {
-		out := opts.IO.Out
-		cs := opts.IO.ColorScheme()
-		fmt.Fprintf(out, "%s Created workflow_dispatch event for %s at %s\n",
-			cs.SuccessIcon(), cs.Cyan(workflow.Base()), cs.Bold(ref))
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	hostname, _ := cfg.Authentication().DefaultHost()
 
-		fmt.Fprintln(out)
+	var repos []ghrepo.Interface
+	if opts.Org != "" {
+		repos, err = shared.ListOrgRepos(client, hostname, opts.Org, opts.Match)
+		if err != nil {
+			return fmt.Errorf("failed to list repositories for %s: %w", opts.Org, err)
+		}
+	} else {
+		f, err := os.Open(opts.ReposFile)
+		if err != nil {
+			return fmt.Errorf("failed to open %s: %w", opts.ReposFile, err)
+		}
+		defer f.Close()
+		repos, err = shared.ParseRepoList(f, hostname)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", opts.ReposFile, err)
+		}
+	}
+	if len(repos) == 0 {
+		return errors.New("no repositories to dispatch to")
+	}
 
-		fmt.Fprintf(out, "To see runs for this workflow, try: %s\n",
-			cs.Boldf("gh run list --workflow=%s", workflow.Base()))
+	providedInputs := map[string]string{}
+	if len(opts.MagicFields)+len(opts.RawFields) > 0 {
+		providedInputs, err = parseFields(*opts)
+		if err != nil {
+			return err
+		}
+	} else if opts.JSONInput != "" {
+		if err := json.Unmarshal([]byte(opts.JSONInput), &providedInputs); err != nil {
+			return fmt.Errorf("could not parse provided JSON: %w", err)
+		}
 	}
 
-	return nil
+	states := []shared.WorkflowState{shared.Active}
+
+	type fleetResult struct {
+		ref string
+		err error
+	}
+	resultByRepo := map[string]fleetResult{}
+
+	results := shared.BulkApply(repos, opts.Concurrency, func(repo ghrepo.Interface) error {
+		ref := opts.Ref
+		if ref == "" {
+			var err error
+			ref, err = api.RepoDefaultBranch(client, repo)
+			if err != nil {
+				resultByRepo[ghrepo.FullName(repo)] = fleetResult{err: err}
+				return err
+			}
+		}
+		resultByRepo[ghrepo.FullName(repo)] = fleetResult{ref: ref}
+
+		workflow, err := shared.ResolveWorkflow(opts.Prompter, opts.IO, client, repo, false, opts.Selector, states)
+		if err != nil {
+			return err
+		}
+		return dispatchWorkflow(client, repo, workflow.ID, ref, providedInputs)
+	})
+
+	cs := opts.IO.ColorScheme()
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("REPO", "REF", "STATUS"))
+
+	var errs error
+	for _, result := range results {
+		name := ghrepo.FullName(result.Repo)
+		ref := resultByRepo[name].ref
+		tp.AddField(name)
+		tp.AddField(ref)
+		switch {
+		case result.Err != nil:
+			tp.AddField(result.Err.Error(), tableprinter.WithColor(cs.Red))
+			errs = multierror.Append(errs, fmt.Errorf("%s: %w", name, result.Err))
+		default:
+			tp.AddField("dispatched", tableprinter.WithColor(cs.Green))
+		}
+		tp.EndRow()
+	}
+
+	if err := tp.Render(); err != nil {
+		return err
+	}
+
+	return errs
 }
 
 type WorkflowInput struct {
@@ -11,6 +11,8 @@ import (
 	"testing"
 
 	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/internal/prompter"
 	"github.com/cli/cli/v2/pkg/cmd/workflow/shared"
@@ -97,6 +99,39 @@ func TestNewCmdRun(t *testing.T) {
 				Selector:  "workflow.yml",
 			},
 		},
+		{
+			name: "org flag",
+			tty:  true,
+			cli:  "triage.yml --org my-org --match service-*",
+			wants: RunOptions{
+				Selector: "triage.yml",
+				Org:      "my-org",
+				Match:    "service-*",
+			},
+		},
+		{
+			name: "repos-file flag",
+			tty:  true,
+			cli:  "triage.yml --repos-file repos.txt",
+			wants: RunOptions{
+				Selector:  "triage.yml",
+				ReposFile: "repos.txt",
+			},
+		},
+		{
+			name:     "org without workflow argument",
+			tty:      true,
+			cli:      "--org my-org",
+			wantsErr: true,
+			errMsg:   "workflow argument required when using `--org` or `--repos-file`",
+		},
+		{
+			name:     "org and repos-file are mutually exclusive",
+			tty:      true,
+			cli:      "triage.yml --org my-org --repos-file repos.txt",
+			wantsErr: true,
+			errMsg:   "specify only one of `--org` or `--repos-file`",
+		},
 	}
 
 	for _, tt := range tests {
@@ -144,6 +179,9 @@ func TestNewCmdRun(t *testing.T) {
 			assert.Equal(t, tt.wants.Ref, gotOpts.Ref)
 			assert.ElementsMatch(t, tt.wants.RawFields, gotOpts.RawFields)
 			assert.ElementsMatch(t, tt.wants.MagicFields, gotOpts.MagicFields)
+			assert.Equal(t, tt.wants.Org, gotOpts.Org)
+			assert.Equal(t, tt.wants.Match, gotOpts.Match)
+			assert.Equal(t, tt.wants.ReposFile, gotOpts.ReposFile)
 		})
 	}
 }
@@ -827,3 +865,56 @@ jobs:
 		})
 	}
 }
+
+func TestFleetRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/repos"),
+		httpmock.JSONResponse([]map[string]interface{}{
+			{"name": "service-a", "owner": map[string]string{"login": "my-org"}},
+			{"name": "service-b", "owner": map[string]string{"login": "my-org"}},
+			{"name": "other", "owner": map[string]string{"login": "my-org"}},
+		}))
+	reg.Register(
+		httpmock.REST("GET", "repos/my-org/service-a/actions/workflows/ci.yml"),
+		httpmock.JSONResponse(shared.Workflow{
+			Name:  "ci",
+			ID:    1,
+			State: shared.Active,
+		}))
+	reg.Register(
+		httpmock.REST("POST", "repos/my-org/service-a/actions/workflows/1/dispatches"),
+		httpmock.StatusStringResponse(204, "cool"))
+	reg.Register(
+		httpmock.REST("GET", "repos/my-org/service-b/actions/workflows/ci.yml"),
+		httpmock.StatusStringResponse(404, "not found"))
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	ios.SetColorEnabled(false)
+
+	opts := &RunOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		Prompter:    prompter.NewMockPrompter(t),
+		Selector:    "ci.yml",
+		Ref:         "main",
+		Org:         "my-org",
+		Match:       "service-*",
+		Concurrency: 1,
+	}
+
+	err := runFleetRun(opts)
+	assert.Error(t, err)
+	assert.Contains(t, stdout.String(), "my-org/service-a")
+	assert.Contains(t, stdout.String(), "dispatched")
+	assert.Contains(t, stdout.String(), "my-org/service-b")
+	assert.NotContains(t, stdout.String(), "my-org/other")
+}
@@ -0,0 +1,244 @@
+package view
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// jobSecurityInfo summarizes the parts of a job definition that matter when reviewing a workflow
+// for supply-chain and permission risk: what it calls out to, how many concrete variants the
+// matrix strategy produces, and what it's trusted with.
+type jobSecurityInfo struct {
+	Name        string
+	Uses        string
+	Pinned      bool
+	Variants    []string
+	Secrets     string
+	Permissions string
+}
+
+var shaRefRE = regexp.MustCompile(`^[0-9a-fA-F]{40}$`)
+
+// parseWorkflowJobs walks the workflow YAML as a node tree, rather than unmarshaling into a Go
+// struct, so that jobs are reported in the order they're defined in the file instead of Go's
+// randomized map order.
+func parseWorkflowJobs(data []byte) (topPermissions string, jobs []jobSecurityInfo, err error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return "", nil, err
+	}
+	if len(doc.Content) == 0 {
+		return "", nil, nil
+	}
+
+	root := doc.Content[0]
+	topPermissions = formatScalarOrMap(mapLookup(root, "permissions"))
+
+	jobsNode := mapLookup(root, "jobs")
+	if jobsNode == nil || jobsNode.Kind != yaml.MappingNode {
+		return topPermissions, nil, nil
+	}
+
+	for i := 0; i+1 < len(jobsNode.Content); i += 2 {
+		jobNode := jobsNode.Content[i+1]
+		info := jobSecurityInfo{Name: jobsNode.Content[i].Value}
+
+		if usesNode := mapLookup(jobNode, "uses"); usesNode != nil {
+			info.Uses = usesNode.Value
+			info.Pinned = isPinnedToSHA(info.Uses)
+		}
+
+		info.Secrets = formatScalarOrMap(mapLookup(jobNode, "secrets"))
+
+		info.Permissions = formatScalarOrMap(mapLookup(jobNode, "permissions"))
+		if info.Permissions == "" {
+			info.Permissions = topPermissions
+		}
+
+		info.Variants = expandMatrix(mapLookup(mapLookup(jobNode, "strategy"), "matrix"))
+
+		jobs = append(jobs, info)
+	}
+
+	return topPermissions, jobs, nil
+}
+
+// isPinnedToSHA reports whether a `uses:` reference to a reusable workflow is pinned to a full
+// commit SHA, as opposed to a mutable branch or tag name. Local reusable workflows (`./...`)
+// don't carry a ref at all and are treated as unpinned.
+func isPinnedToSHA(uses string) bool {
+	idx := strings.LastIndex(uses, "@")
+	if idx == -1 {
+		return false
+	}
+	return shaRefRE.MatchString(uses[idx+1:])
+}
+
+// expandMatrix turns a `strategy.matrix` definition into the list of concrete job variants it
+// produces, honoring `include` (appended as-is) and `exclude` (dropped from the generated
+// combinations), the same way the Actions runner resolves a matrix before scheduling jobs.
+func expandMatrix(matrix *yaml.Node) []string {
+	if matrix == nil || matrix.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	type axis struct {
+		name   string
+		values []string
+	}
+	var axes []axis
+	var includes, excludes []map[string]string
+
+	for i := 0; i+1 < len(matrix.Content); i += 2 {
+		key := matrix.Content[i].Value
+		val := matrix.Content[i+1]
+		switch key {
+		case "include":
+			includes = append(includes, sequenceToMaps(val)...)
+		case "exclude":
+			excludes = append(excludes, sequenceToMaps(val)...)
+		default:
+			if val.Kind != yaml.SequenceNode {
+				continue
+			}
+			values := make([]string, 0, len(val.Content))
+			for _, item := range val.Content {
+				values = append(values, scalarOrInline(item))
+			}
+			axes = append(axes, axis{name: key, values: values})
+		}
+	}
+
+	var combos []map[string]string
+	if len(axes) > 0 {
+		combos = []map[string]string{{}}
+		for _, ax := range axes {
+			next := make([]map[string]string, 0, len(combos)*len(ax.values))
+			for _, c := range combos {
+				for _, v := range ax.values {
+					nc := make(map[string]string, len(c)+1)
+					for k, vv := range c {
+						nc[k] = vv
+					}
+					nc[ax.name] = v
+					next = append(next, nc)
+				}
+			}
+			combos = next
+		}
+	}
+
+	combos = dropExcluded(combos, excludes)
+	combos = append(combos, includes...)
+
+	variants := make([]string, 0, len(combos))
+	for _, c := range combos {
+		variants = append(variants, formatVariant(c))
+	}
+	return variants
+}
+
+func dropExcluded(combos, excludes []map[string]string) []map[string]string {
+	if len(excludes) == 0 {
+		return combos
+	}
+	kept := make([]map[string]string, 0, len(combos))
+	for _, c := range combos {
+		excluded := false
+		for _, ex := range excludes {
+			matches := true
+			for k, v := range ex {
+				if c[k] != v {
+					matches = false
+					break
+				}
+			}
+			if matches {
+				excluded = true
+				break
+			}
+		}
+		if !excluded {
+			kept = append(kept, c)
+		}
+	}
+	return kept
+}
+
+func sequenceToMaps(n *yaml.Node) []map[string]string {
+	if n == nil || n.Kind != yaml.SequenceNode {
+		return nil
+	}
+	maps := make([]map[string]string, 0, len(n.Content))
+	for _, item := range n.Content {
+		if item.Kind != yaml.MappingNode {
+			continue
+		}
+		m := make(map[string]string, len(item.Content)/2)
+		for i := 0; i+1 < len(item.Content); i += 2 {
+			m[item.Content[i].Value] = scalarOrInline(item.Content[i+1])
+		}
+		maps = append(maps, m)
+	}
+	return maps
+}
+
+func formatVariant(c map[string]string) string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf("%s=%s", k, c[k]))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func scalarOrInline(n *yaml.Node) string {
+	if n.Kind == yaml.ScalarNode {
+		return n.Value
+	}
+	b, err := yaml.Marshal(n)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(strings.ReplaceAll(string(b), "\n", " "))
+}
+
+// formatScalarOrMap renders a node that's conventionally either a bare scalar (e.g.
+// `secrets: inherit`, `permissions: read-all`) or a mapping (e.g. individual secret bindings or
+// per-scope permissions) as a single display string, preserving the order it was written in.
+func formatScalarOrMap(n *yaml.Node) string {
+	if n == nil {
+		return ""
+	}
+	if n.Kind == yaml.ScalarNode {
+		return n.Value
+	}
+	if n.Kind != yaml.MappingNode {
+		return ""
+	}
+	parts := make([]string, 0, len(n.Content)/2)
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		parts = append(parts, fmt.Sprintf("%s: %s", n.Content[i].Value, n.Content[i+1].Value))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func mapLookup(n *yaml.Node, key string) *yaml.Node {
+	if n == nil || n.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseWorkflowJobs(t *testing.T) {
+	yaml := `
+permissions:
+  contents: read
+jobs:
+  build:
+    uses: octo-org/octo-repo/.github/workflows/reusable.yml@main
+    strategy:
+      matrix:
+        os: [ubuntu-latest, macos-latest]
+        include:
+          - os: windows-latest
+            experimental: true
+        exclude:
+          - os: macos-latest
+  deploy:
+    uses: octo-org/octo-repo/.github/workflows/deploy.yml@0000000000000000000000000000000000000000
+    permissions: write-all
+    secrets: inherit
+`
+
+	topPermissions, jobs, err := parseWorkflowJobs([]byte(yaml))
+	require.NoError(t, err)
+	assert.Equal(t, "contents: read", topPermissions)
+	require.Len(t, jobs, 2)
+
+	build := jobs[0]
+	assert.Equal(t, "build", build.Name)
+	assert.False(t, build.Pinned)
+	assert.Equal(t, "contents: read", build.Permissions)
+	assert.ElementsMatch(t, []string{"os=ubuntu-latest", "experimental=true, os=windows-latest"}, build.Variants)
+
+	deploy := jobs[1]
+	assert.Equal(t, "deploy", deploy.Name)
+	assert.True(t, deploy.Pinned)
+	assert.Equal(t, "write-all", deploy.Permissions)
+	assert.Equal(t, "inherit", deploy.Secrets)
+	assert.Nil(t, deploy.Variants)
+}
+
+func Test_isPinnedToSHA(t *testing.T) {
+	tests := []struct {
+		uses string
+		want bool
+	}{
+		{"octo-org/octo-repo/.github/workflows/ci.yml@main", false},
+		{"octo-org/octo-repo/.github/workflows/ci.yml@v1", false},
+		{"octo-org/octo-repo/.github/workflows/ci.yml@0000000000000000000000000000000000000000", true},
+		{"./.github/workflows/local.yml", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, isPinnedToSHA(tt.uses), tt.uses)
+	}
+}
@@ -34,6 +34,7 @@ type ViewOptions struct {
 	Prompt   bool
 	Raw      bool
 	YAML     bool
+	Jobs     bool
 
 	now time.Time
 }
@@ -76,7 +77,7 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 				opts.Prompt = true
 			}
 
-			if !opts.YAML && opts.Ref != "" {
+			if !opts.YAML && !opts.Jobs && opts.Ref != "" {
 				return cmdutil.FlagErrorf("`--yaml` required when specifying `--ref`")
 			}
 
@@ -89,6 +90,7 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 
 	cmd.Flags().BoolVarP(&opts.Web, "web", "w", false, "Open workflow in the browser")
 	cmd.Flags().BoolVarP(&opts.YAML, "yaml", "y", false, "View the workflow yaml file")
+	cmd.Flags().BoolVarP(&opts.Jobs, "jobs", "j", false, "Resolve reusable workflow calls and matrix strategies, and show each job's secrets and permissions")
 	cmd.Flags().StringVarP(&opts.Ref, "ref", "r", "", "The branch or tag name which contains the version of the workflow file you'd like to view")
 
 	_ = cmdutil.RegisterBranchCompletionFlags(f.GitClient, cmd, "ref")
@@ -146,6 +148,8 @@ func runView(opts *ViewOptions) error {
 
 	if opts.YAML {
 		err = viewWorkflowContent(opts, client, repo, workflow, opts.Ref)
+	} else if opts.Jobs {
+		err = viewWorkflowJobs(opts, client, repo, workflow, opts.Ref)
 	} else {
 		err = viewWorkflowInfo(opts, client, repo, workflow)
 	}
@@ -202,6 +206,82 @@ func viewWorkflowContent(opts *ViewOptions, client *api.Client, repo ghrepo.Inte
 	return nil
 }
 
+func viewWorkflowJobs(opts *ViewOptions, client *api.Client, repo ghrepo.Interface, workflow *shared.Workflow, ref string) error {
+	yamlBytes, err := shared.GetWorkflowContent(client, repo, *workflow, ref)
+	if err != nil {
+		if s, ok := err.(api.HTTPError); ok && s.StatusCode == 404 {
+			if ref != "" {
+				return fmt.Errorf("could not find workflow file %s on %s, try specifying a different ref", workflow.Base(), ref)
+			}
+			return fmt.Errorf("could not find workflow file %s, try specifying a branch or tag using `--ref`", workflow.Base())
+		}
+		return fmt.Errorf("could not get workflow file content: %w", err)
+	}
+
+	topPermissions, jobs, err := parseWorkflowJobs(yamlBytes)
+	if err != nil {
+		return fmt.Errorf("could not parse workflow file: %w", err)
+	}
+
+	out := opts.IO.Out
+	cs := opts.IO.ColorScheme()
+
+	filename := workflow.Base()
+	fmt.Fprintf(out, "%s - %s\n", cs.Bold(workflow.Name), cs.Cyan(filename))
+	fmt.Fprintf(out, "ID: %s\n\n", cs.Cyanf("%d", workflow.ID))
+
+	if topPermissions != "" {
+		fmt.Fprintf(out, "Default permissions: %s\n\n", topPermissions)
+	}
+
+	if len(jobs) == 0 {
+		fmt.Fprintln(out, "No jobs found in this workflow.")
+		return nil
+	}
+
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("JOB", "USES", "PINNED", "MATRIX", "SECRETS", "PERMISSIONS"))
+	for _, job := range jobs {
+		tp.AddField(job.Name, tableprinter.WithColor(cs.Bold))
+		tp.AddField(emptyDash(job.Uses))
+
+		pinned := "-"
+		if job.Uses != "" {
+			if job.Pinned {
+				pinned = cs.SuccessIcon()
+			} else {
+				pinned = cs.WarningIcon()
+			}
+		}
+		tp.AddField(pinned)
+
+		matrix := "-"
+		if len(job.Variants) > 0 {
+			matrix = fmt.Sprintf("%s (%s)", text.Pluralize(len(job.Variants), "variant"), strings.Join(job.Variants, "; "))
+		}
+		tp.AddField(matrix)
+
+		tp.AddField(emptyDash(job.Secrets))
+		tp.AddField(emptyDash(job.Permissions))
+
+		tp.EndRow()
+	}
+
+	if err := tp.Render(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(out)
+	fmt.Fprintf(out, "To see the YAML for this workflow, try: gh workflow view %s --yaml\n", filename)
+	return nil
+}
+
+func emptyDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
 func viewWorkflowInfo(opts *ViewOptions, client *api.Client, repo ghrepo.Interface, workflow *shared.Workflow) error {
 	wr, err := runShared.GetRuns(client, repo, &runShared.FilterOptions{
 		WorkflowID:   workflow.ID,
@@ -120,6 +120,15 @@ func TestNewCmdView(t *testing.T) {
 				Selector: "123",
 			},
 		},
+		{
+			name: "jobs nontty",
+			cli:  "-j 123",
+			wants: ViewOptions{
+				Raw:      true,
+				Jobs:     true,
+				Selector: "123",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -158,6 +167,7 @@ func TestNewCmdView(t *testing.T) {
 			assert.Equal(t, tt.wants.Prompt, gotOpts.Prompt)
 			assert.Equal(t, tt.wants.Raw, gotOpts.Raw)
 			assert.Equal(t, tt.wants.YAML, gotOpts.YAML)
+			assert.Equal(t, tt.wants.Jobs, gotOpts.Jobs)
 		})
 	}
 }
@@ -170,6 +180,7 @@ func TestViewRun(t *testing.T) {
 		State: shared.Active,
 	}
 	aWorkflowContent := `{"content":"bmFtZTogYSB3b3JrZmxvdwo="}`
+	aWorkflowWithJobsContent := `{"content":"bmFtZTogYSB3b3JrZmxvdwpwZXJtaXNzaW9uczoKICBjb250ZW50czogcmVhZApqb2JzOgogIGJ1aWxkOgogICAgdXNlczogb2N0by1vcmcvb2N0by1yZXBvLy5naXRodWIvd29ya2Zsb3dzL3JldXNhYmxlLnltbEBtYWluCiAgICBzZWNyZXRzOiBpbmhlcml0CiAgICBzdHJhdGVneToKICAgICAgbWF0cml4OgogICAgICAgIG9zOiBbdWJ1bnR1LWxhdGVzdCwgbWFjb3MtbGF0ZXN0XQogIGRlcGxveToKICAgIHVzZXM6IG9jdG8tb3JnL29jdG8tcmVwby8uZ2l0aHViL3dvcmtmbG93cy9kZXBsb3kueW1sQDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAKICAgIHBlcm1pc3Npb25zOiB3cml0ZS1hbGwKICAgIHNlY3JldHM6CiAgICAgIERFUExPWV9UT0tFTjogJHt7IHNlY3JldHMuREVQTE9ZX1RPS0VOIH19Cg=="}`
 	aWorkflowInfo := heredoc.Doc(`
 		a workflow - flow.yml
 		ID: 123
@@ -354,6 +365,36 @@ func TestViewRun(t *testing.T) {
 			},
 			wantOut: "a workflow - flow.yml\nID: 123\n\nname: a workflow\n\n\n",
 		},
+		{
+			name: "workflow jobs",
+			tty:  true,
+			opts: &ViewOptions{
+				Selector: "123",
+				Jobs:     true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(aWorkflow),
+				)
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/contents/.github/workflows/flow.yml"),
+					httpmock.StringResponse(aWorkflowWithJobsContent),
+				)
+			},
+			wantOut: heredoc.Doc(`
+				a workflow - flow.yml
+				ID: 123
+
+				Default permissions: contents: read
+
+				JOB     USES              PINNED  MATRIX          SECRETS         PERMISSIONS
+				build   octo-org/octo...  !       2 variants ...  inherit         contents: read
+				deploy  octo-org/octo...  ✓       -               DEPLOY_TOKE...  write-all
+
+				To see the YAML for this workflow, try: gh workflow view flow.yml --yaml
+			`),
+		},
 		{
 			name: "workflow info",
 			tty:  true,
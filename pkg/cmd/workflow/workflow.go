@@ -1,6 +1,7 @@
 package workflow
 
 import (
+	cmdAuditPins "github.com/cli/cli/v2/pkg/cmd/workflow/auditpins"
 	cmdDisable "github.com/cli/cli/v2/pkg/cmd/workflow/disable"
 	cmdEnable "github.com/cli/cli/v2/pkg/cmd/workflow/enable"
 	cmdList "github.com/cli/cli/v2/pkg/cmd/workflow/list"
@@ -24,6 +25,7 @@ func NewCmdWorkflow(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(cmdDisable.NewCmdDisable(f, nil))
 	cmd.AddCommand(cmdView.NewCmdView(f, nil))
 	cmd.AddCommand(cmdRun.NewCmdRun(f, nil))
+	cmd.AddCommand(cmdAuditPins.NewCmdAuditPins(f, nil))
 
 	return cmd
 }
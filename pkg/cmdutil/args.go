@@ -1,8 +1,7 @@
 package cmdutil
 
 import (
-	"fmt"
-
+	"github.com/cli/cli/v2/internal/i18n"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
@@ -23,7 +22,7 @@ func MinimumArgs(n int, msg string) cobra.PositionalArgs {
 func ExactArgs(n int, msg string) cobra.PositionalArgs {
 	return func(cmd *cobra.Command, args []string) error {
 		if len(args) > n {
-			return FlagErrorf("too many arguments")
+			return FlagErrorf("%s", i18n.T("too many arguments"))
 		}
 
 		if len(args) < n {
@@ -39,9 +38,9 @@ func NoArgsQuoteReminder(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	errMsg := fmt.Sprintf("unknown argument %q", args[0])
+	errMsg := i18n.T("unknown argument %q", args[0])
 	if len(args) > 1 {
-		errMsg = fmt.Sprintf("unknown arguments %q", args)
+		errMsg = i18n.T("unknown arguments %q", args)
 	}
 
 	hasValueFlag := false
@@ -52,7 +51,7 @@ func NoArgsQuoteReminder(cmd *cobra.Command, args []string) error {
 	})
 
 	if hasValueFlag {
-		errMsg += "; please quote all values that have spaces"
+		errMsg += i18n.T("; please quote all values that have spaces")
 	}
 
 	return FlagErrorf("%s", errMsg)
@@ -0,0 +1,53 @@
+package cmdutil
+
+import (
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+// DeletionPrompter is the minimal capability ConfirmDeletion needs to ask the user to type a
+// resource's name back before a destructive action proceeds.
+type DeletionPrompter interface {
+	ConfirmDeletion(string) error
+}
+
+// AddConfirmDeletionFlags adds the --yes and --confirm-token flags shared by destructive commands
+// that use ConfirmDeletion to gate the action. confirmToken is a new flag for non-interactive
+// callers that would rather assert the exact resource name than blindly pass --yes.
+func AddConfirmDeletionFlags(cmd *cobra.Command, yes *bool, confirmToken *string) {
+	cmd.Flags().BoolVarP(yes, "yes", "y", false, "Confirm deletion without prompting")
+	cmd.Flags().StringVar(confirmToken, "confirm-token", "", "Skip the interactive prompt by asserting the resource's name, for use in scripts")
+}
+
+// ConfirmDeletion applies this repo's standard two-factor confirmation for destructive commands.
+// Interactively, the user must type resourceName back via p.ConfirmDeletion. Non-interactively,
+// yes alone is enough unless the strict_deletion_confirmation config option is enabled for
+// hostname, in which case confirmToken must be supplied and must match resourceName exactly.
+func ConfirmDeletion(io *iostreams.IOStreams, p DeletionPrompter, cfg func() (gh.Config, error), hostname, resourceName string, yes bool, confirmToken string) error {
+	if confirmToken != "" {
+		if confirmToken != resourceName {
+			return FlagErrorf("--confirm-token %q does not match %q", confirmToken, resourceName)
+		}
+		return nil
+	}
+
+	if io.CanPrompt() {
+		if yes {
+			return nil
+		}
+		return p.ConfirmDeletion(resourceName)
+	}
+
+	if !yes {
+		return FlagErrorf("--yes or --confirm-token required when not running interactively")
+	}
+
+	if cfg != nil {
+		if c, err := cfg(); err == nil && c.StrictDeletionConfirmation(hostname).Value == "enabled" {
+			return FlagErrorf("--confirm-token required when not running interactively and strict_deletion_confirmation is enabled")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package cmdutil
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/gh"
+	ghmock "github.com/cli/cli/v2/internal/gh/mock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDeletionPrompter struct {
+	err error
+}
+
+func (p *fakeDeletionPrompter) ConfirmDeletion(name string) error {
+	return p.err
+}
+
+func strictConfig(strict bool) func() (gh.Config, error) {
+	value := "disabled"
+	if strict {
+		value = "enabled"
+	}
+	return func() (gh.Config, error) {
+		return &ghmock.ConfigMock{
+			StrictDeletionConfirmationFunc: func(string) gh.ConfigEntry {
+				return gh.ConfigEntry{Value: value}
+			},
+		}, nil
+	}
+}
+
+func TestConfirmDeletion(t *testing.T) {
+	t.Run("confirm-token matching the resource name succeeds", func(t *testing.T) {
+		io, _, _, _ := iostreams.Test()
+		err := ConfirmDeletion(io, &fakeDeletionPrompter{}, nil, "", "my-repo", false, "my-repo")
+		require.NoError(t, err)
+	})
+
+	t.Run("confirm-token not matching the resource name fails", func(t *testing.T) {
+		io, _, _, _ := iostreams.Test()
+		err := ConfirmDeletion(io, &fakeDeletionPrompter{}, nil, "", "my-repo", false, "not-my-repo")
+		assert.EqualError(t, err, `--confirm-token "not-my-repo" does not match "my-repo"`)
+	})
+
+	t.Run("interactive without yes delegates to the prompter", func(t *testing.T) {
+		io, _, _, _ := iostreams.Test()
+		io.SetStdinTTY(true)
+		io.SetStdoutTTY(true)
+		err := ConfirmDeletion(io, &fakeDeletionPrompter{err: errors.New("boom")}, nil, "", "my-repo", false, "")
+		assert.EqualError(t, err, "boom")
+	})
+
+	t.Run("interactive with yes skips the prompter", func(t *testing.T) {
+		io, _, _, _ := iostreams.Test()
+		io.SetStdinTTY(true)
+		io.SetStdoutTTY(true)
+		err := ConfirmDeletion(io, &fakeDeletionPrompter{err: errors.New("boom")}, nil, "", "my-repo", true, "")
+		require.NoError(t, err)
+	})
+
+	t.Run("non-interactive without yes or confirm-token fails", func(t *testing.T) {
+		io, _, _, _ := iostreams.Test()
+		err := ConfirmDeletion(io, &fakeDeletionPrompter{}, nil, "", "my-repo", false, "")
+		assert.EqualError(t, err, "--yes or --confirm-token required when not running interactively")
+	})
+
+	t.Run("non-interactive with yes succeeds by default", func(t *testing.T) {
+		io, _, _, _ := iostreams.Test()
+		err := ConfirmDeletion(io, &fakeDeletionPrompter{}, strictConfig(false), "github.com", "my-repo", true, "")
+		require.NoError(t, err)
+	})
+
+	t.Run("non-interactive with yes fails when strict_deletion_confirmation is enabled", func(t *testing.T) {
+		io, _, _, _ := iostreams.Test()
+		err := ConfirmDeletion(io, &fakeDeletionPrompter{}, strictConfig(true), "github.com", "my-repo", true, "")
+		assert.EqualError(t, err, "--confirm-token required when not running interactively and strict_deletion_confirmation is enabled")
+	})
+}
@@ -3,8 +3,11 @@ package cmdutil
 import (
 	"errors"
 	"fmt"
+	"net"
+	"net/url"
 
 	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/cli/cli/v2/api"
 )
 
 // FlagErrorf returns a new FlagError that wraps an error produced by
@@ -68,3 +71,57 @@ func (e NoResultsError) Error() string {
 func NewNoResultsError(message string) NoResultsError {
 	return NoResultsError{message: message}
 }
+
+// FailFastError wraps a NoResultsError to signal that, per the --fail-fast
+// flag added by AddFailFastFlag, having no results should be treated as a
+// command failure with a distinct exit code (see `gh help exit-codes`)
+// instead of the default success exit code.
+type FailFastError struct {
+	err error
+}
+
+func (e FailFastError) Error() string {
+	return e.err.Error()
+}
+
+func (e FailFastError) Unwrap() error {
+	return e.err
+}
+
+// WrapNoResultsError returns err unchanged unless failFast is set, in which
+// case it is wrapped in a FailFastError so that it is reported as a command
+// failure.
+func WrapNoResultsError(err NoResultsError, failFast bool) error {
+	if !failFast {
+		return err
+	}
+	return FailFastError{err}
+}
+
+// IsRateLimitError reports whether err represents a GitHub API response that
+// was rejected because a rate limit was exceeded.
+func IsRateLimitError(err error) bool {
+	var httpErr api.HTTPError
+	if !errors.As(err, &httpErr) {
+		return false
+	}
+	if httpErr.StatusCode == 429 {
+		return true
+	}
+	return httpErr.StatusCode == 403 && httpErr.Headers.Get("X-RateLimit-Remaining") == "0"
+}
+
+// IsNetworkError reports whether err represents a failure to reach the
+// network at all, as opposed to an error response from the server.
+func IsNetworkError(err error) bool {
+	var httpErr api.HTTPError
+	if errors.As(err, &httpErr) {
+		return false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
@@ -0,0 +1,92 @@
+package cmdutil
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/api"
+	ghAPI "github.com/cli/go-gh/v2/pkg/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsRateLimitError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "too many requests",
+			err:  api.HTTPError{HTTPError: &ghAPI.HTTPError{StatusCode: 429}},
+			want: true,
+		},
+		{
+			name: "forbidden with exhausted rate limit header",
+			err: api.HTTPError{HTTPError: &ghAPI.HTTPError{
+				StatusCode: 403,
+				Headers:    http.Header{"X-Ratelimit-Remaining": []string{"0"}},
+			}},
+			want: true,
+		},
+		{
+			name: "plain forbidden",
+			err:  api.HTTPError{HTTPError: &ghAPI.HTTPError{StatusCode: 403}},
+			want: false,
+		},
+		{
+			name: "unrelated error",
+			err:  errors.New("boom"),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsRateLimitError(tt.err))
+		})
+	}
+}
+
+func TestIsNetworkError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "dns error",
+			err:  &net.DNSError{Err: "no such host", Name: "api.github.com"},
+			want: true,
+		},
+		{
+			name: "http error is not a network error",
+			err:  api.HTTPError{HTTPError: &ghAPI.HTTPError{StatusCode: 500}},
+			want: false,
+		},
+		{
+			name: "unrelated error",
+			err:  errors.New("boom"),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsNetworkError(tt.err))
+		})
+	}
+}
+
+func TestWrapNoResultsError(t *testing.T) {
+	err := NewNoResultsError("no items found")
+
+	assert.Equal(t, err, WrapNoResultsError(err, false))
+
+	wrapped := WrapNoResultsError(err, true)
+	var failFastErr FailFastError
+	assert.ErrorAs(t, wrapped, &failFastErr)
+	assert.Equal(t, "no items found", wrapped.Error())
+	assert.ErrorIs(t, wrapped, err)
+}
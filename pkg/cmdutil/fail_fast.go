@@ -0,0 +1,11 @@
+package cmdutil
+
+import "github.com/spf13/cobra"
+
+// AddFailFastFlag adds a --fail-fast flag to a list command. By default,
+// finding no results exits 0; with --fail-fast, it exits with the distinct
+// code documented in `gh help exit-codes` so scripts can branch on it
+// without parsing stderr.
+func AddFailFastFlag(cmd *cobra.Command, failFast *bool) {
+	cmd.Flags().BoolVar(failFast, "fail-fast", false, "Exit with a distinct status code when there are no results")
+}
@@ -0,0 +1,35 @@
+package cmdutil
+
+import (
+	"errors"
+
+	"github.com/cli/cli/v2/internal/i18n"
+	"github.com/cli/cli/v2/pkg/iostreams"
+)
+
+// ErrInteractiveUnsupported is returned when interactive selection is
+// requested but stdin/stdout are not connected to a terminal.
+var ErrInteractiveUnsupported = errors.New(i18n.T("--interactive requires an interactive terminal"))
+
+// selectPrompter is the minimal capability SelectFromList needs. Both
+// prompter.Prompter and the narrower per-package Prompter interfaces used by
+// commands like `gh run list` satisfy it.
+type selectPrompter interface {
+	Select(prompt, defaultValue string, options []string) (int, error)
+}
+
+// SelectFromList prompts the user to filter and pick a single entry from
+// labels, returning its index. It is meant for commands that offer an
+// `--interactive` flag as an alternative to parsing a number out of tabular
+// output. Callers must check IO.IsStdoutTTY (and IsStdinTTY) before calling
+// this, otherwise ErrInteractiveUnsupported is returned.
+func SelectFromList(io *iostreams.IOStreams, p selectPrompter, prompt string, labels []string) (int, error) {
+	if !io.IsStdinTTY() || !io.IsStdoutTTY() {
+		return -1, ErrInteractiveUnsupported
+	}
+	if len(labels) == 0 {
+		return -1, errors.New(i18n.T("no items to select from"))
+	}
+
+	return p.Select(prompt, "", labels)
+}
@@ -0,0 +1,43 @@
+package cmdutil
+
+import (
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSelectPrompter struct {
+	index int
+	err   error
+}
+
+func (p *fakeSelectPrompter) Select(prompt, defaultValue string, options []string) (int, error) {
+	return p.index, p.err
+}
+
+func TestSelectFromList(t *testing.T) {
+	t.Run("not a terminal", func(t *testing.T) {
+		io, _, _, _ := iostreams.Test()
+		_, err := SelectFromList(io, &fakeSelectPrompter{}, "Select an item", []string{"one", "two"})
+		assert.ErrorIs(t, err, ErrInteractiveUnsupported)
+	})
+
+	t.Run("no items", func(t *testing.T) {
+		io, _, _, _ := iostreams.Test()
+		io.SetStdinTTY(true)
+		io.SetStdoutTTY(true)
+		_, err := SelectFromList(io, &fakeSelectPrompter{}, "Select an item", nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("delegates to the prompter", func(t *testing.T) {
+		io, _, _, _ := iostreams.Test()
+		io.SetStdinTTY(true)
+		io.SetStdoutTTY(true)
+		index, err := SelectFromList(io, &fakeSelectPrompter{index: 1}, "Select an item", []string{"one", "two"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, index)
+	})
+}
@@ -53,7 +53,7 @@ func AddJSONFlags(cmd *cobra.Command, exportTarget *Exporter, fields []string) {
 				return err
 			}
 		}
-		if export, err := checkJSONFlags(c); err == nil {
+		if export, err := checkJSONFlags(c, fields); err == nil {
 			if export == nil {
 				*exportTarget = nil
 			} else {
@@ -94,7 +94,7 @@ func AddJSONFlags(cmd *cobra.Command, exportTarget *Exporter, fields []string) {
 	cmd.Annotations["help:json-fields"] = strings.Join(fields, ",")
 }
 
-func checkJSONFlags(cmd *cobra.Command) (*jsonExporter, error) {
+func checkJSONFlags(cmd *cobra.Command, allFields []string) (*jsonExporter, error) {
 	f := cmd.Flags()
 	jsonFlag := f.Lookup("json")
 	jqFlag := f.Lookup("jq")
@@ -111,10 +111,19 @@ func checkJSONFlags(cmd *cobra.Command) (*jsonExporter, error) {
 			filter:   jqFlag.Value.String(),
 			template: tplFlag.Value.String(),
 		}, nil
+	} else if tplFlag.Changed {
+		// `--template` can be used on its own, without `--json`, in which case
+		// every available field is exported for use in the template.
+		if webFlag != nil && webFlag.Changed {
+			return nil, errors.New("cannot use `--web` with `--template`")
+		}
+		return &jsonExporter{
+			fields:   allFields,
+			filter:   jqFlag.Value.String(),
+			template: tplFlag.Value.String(),
+		}, nil
 	} else if jqFlag.Changed {
 		return nil, errors.New("cannot use `--jq` without specifying `--json`")
-	} else if tplFlag.Changed {
-		return nil, errors.New("cannot use `--template` without specifying `--json`")
 	}
 	return nil, nil
 }
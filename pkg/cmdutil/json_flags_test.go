@@ -57,11 +57,21 @@ func TestAddJSONFlags(t *testing.T) {
 			wantsError:  "cannot use `--jq` without specifying `--json`",
 		},
 		{
-			name:        "cannot use --template without --json",
-			fields:      []string{},
-			args:        []string{"--template", "{{.number}}"},
+			name:   "--template without --json exports all fields",
+			fields: []string{"id", "number"},
+			args:   []string{"--template", "{{.number}}"},
+			wantsExport: &jsonExporter{
+				fields:   []string{"id", "number"},
+				filter:   "",
+				template: "{{.number}}",
+			},
+		},
+		{
+			name:        "cannot combine --template with --web when used without --json",
+			fields:      []string{"id", "number", "title"},
+			args:        []string{"--template", "{{.number}}", "--web"},
 			wantsExport: nil,
-			wantsError:  "cannot use `--template` without specifying `--json`",
+			wantsError:  "cannot use `--web` with `--template`",
 		},
 		{
 			name:   "with JSON fields",
@@ -0,0 +1,105 @@
+package cmdutil
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// AddOutputFlag registers an `--output` flag that lets a command write its
+// generated document to a file instead of stdout. Commands that produce a
+// single document (API responses, exports, diffs) can opt in by calling this
+// during construction and using the returned flag's Open method in their Run
+// function.
+func AddOutputFlag(cmd *cobra.Command) *OutputFileFlag {
+	of := &OutputFileFlag{}
+	cmd.Flags().StringVarP(&of.path, "output", "O", "", "Write output to `file` instead of stdout; gzip-compress when the name ends in \".gz\"")
+	return of
+}
+
+// OutputFileFlag holds the value of an `--output` flag added by AddOutputFlag.
+type OutputFileFlag struct {
+	path string
+}
+
+// IsSet reports whether `--output` was given a file path, as opposed to
+// falling back to the command's default writer.
+func (o *OutputFileFlag) IsSet() bool {
+	return o != nil && o.path != ""
+}
+
+// Open returns a writer for a command's output. With no `--output` path it
+// wraps defaultWriter (typically IO.Out) directly. With a path, it writes to
+// a temporary file in the same directory, gzip-compressing along the way if
+// the path ends in ".gz". Call Close once writing succeeds to atomically
+// rename the temporary file into place, or Discard to remove it after a
+// failed write; a defaultWriter-backed OutputFile treats both the same way,
+// as a no-op.
+func (o *OutputFileFlag) Open(defaultWriter io.Writer) (*OutputFile, error) {
+	if !o.IsSet() {
+		return &OutputFile{Writer: defaultWriter}, nil
+	}
+
+	dir := filepath.Dir(o.path)
+	tmp, err := os.CreateTemp(dir, filepath.Base(o.path)+".tmp-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create output file: %w", err)
+	}
+
+	out := &OutputFile{file: tmp, finalPath: o.path}
+	if strings.HasSuffix(o.path, ".gz") {
+		out.gz = gzip.NewWriter(tmp)
+		out.Writer = out.gz
+	} else {
+		out.Writer = tmp
+	}
+	return out, nil
+}
+
+// OutputFile is a writer returned by OutputFileFlag.Open. Write through the
+// embedded io.Writer; call Close to finalize or Discard to abandon.
+type OutputFile struct {
+	io.Writer
+
+	file      *os.File
+	gz        *gzip.Writer
+	finalPath string
+}
+
+// Close finalizes the output file, atomically renaming the temporary file
+// into place. When no `--output` path was given, this is a no-op.
+func (o *OutputFile) Close() error {
+	if o.file == nil {
+		return nil
+	}
+
+	if o.gz != nil {
+		if err := o.gz.Close(); err != nil {
+			o.Discard()
+			return err
+		}
+	}
+
+	if err := o.file.Close(); err != nil {
+		_ = os.Remove(o.file.Name())
+		return err
+	}
+
+	return os.Rename(o.file.Name(), o.finalPath)
+}
+
+// Discard abandons the output file, removing the temporary file without
+// touching the requested output path. Call this after a failed write in
+// place of Close. When no `--output` path was given, this is a no-op.
+func (o *OutputFile) Discard() {
+	if o.file == nil {
+		return
+	}
+	_ = o.file.Close()
+	_ = os.Remove(o.file.Name())
+}
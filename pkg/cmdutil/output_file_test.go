@@ -0,0 +1,105 @@
+package cmdutil
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutputFileFlag_defaultsToWriter(t *testing.T) {
+	cmd := &cobra.Command{}
+	of := AddOutputFlag(cmd)
+
+	var buf bytes.Buffer
+	w, err := of.Open(&buf)
+	require.NoError(t, err)
+
+	_, err = w.Write([]byte("hello"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	assert.Equal(t, "hello", buf.String())
+}
+
+func TestOutputFileFlag_writesFileAtomically(t *testing.T) {
+	cmd := &cobra.Command{}
+	of := AddOutputFlag(cmd)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "out.json")
+	require.NoError(t, cmd.Flags().Set("output", path))
+
+	w, err := of.Open(io.Discard)
+	require.NoError(t, err)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "expected a temporary file before Close")
+	assert.NotEqual(t, "out.json", entries[0].Name())
+
+	_, err = w.Write([]byte(`{"ok":true}`))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	contents, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, `{"ok":true}`, string(contents))
+}
+
+func TestOutputFileFlag_discardRemovesTempFile(t *testing.T) {
+	cmd := &cobra.Command{}
+	of := AddOutputFlag(cmd)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "out.json")
+	require.NoError(t, cmd.Flags().Set("output", path))
+
+	w, err := of.Open(io.Discard)
+	require.NoError(t, err)
+
+	_, err = w.Write([]byte(`{"ok":`))
+	require.NoError(t, err)
+	w.Discard()
+
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 0)
+}
+
+func TestOutputFileFlag_gzipsWhenSuffixMatches(t *testing.T) {
+	cmd := &cobra.Command{}
+	of := AddOutputFlag(cmd)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "out.json.gz")
+	require.NoError(t, cmd.Flags().Set("output", path))
+
+	w, err := of.Open(io.Discard)
+	require.NoError(t, err)
+
+	_, err = w.Write([]byte(`{"ok":true}`))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	defer gz.Close()
+
+	contents, err := io.ReadAll(gz)
+	require.NoError(t, err)
+	assert.Equal(t, `{"ok":true}`, string(contents))
+}
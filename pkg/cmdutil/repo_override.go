@@ -20,7 +20,7 @@ func executeParentHooks(cmd *cobra.Command, args []string) error {
 }
 
 func EnableRepoOverride(cmd *cobra.Command, f *Factory) {
-	cmd.PersistentFlags().StringP("repo", "R", "", "Select another repository using the `[HOST/]OWNER/REPO` format")
+	cmd.PersistentFlags().StringArrayP("repo", "R", nil, "Select another repository using the `[HOST/]OWNER/REPO` format")
 	_ = cmd.RegisterFlagCompletionFunc("repo", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		remotes, err := f.Remotes()
 		if err != nil {
@@ -51,8 +51,8 @@ func EnableRepoOverride(cmd *cobra.Command, f *Factory) {
 		if err := executeParentHooks(cmd, args); err != nil {
 			return err
 		}
-		repoOverride, _ := cmd.Flags().GetString("repo")
-		f.BaseRepo = OverrideBaseRepoFunc(f, repoOverride)
+		repoOverrides, _ := cmd.Flags().GetStringArray("repo")
+		f.BaseRepo = OverrideBaseRepoFunc(f, strings.Join(repoOverrides, ","))
 		return nil
 	}
 }
@@ -68,3 +68,43 @@ func OverrideBaseRepoFunc(f *Factory, override string) func() (ghrepo.Interface,
 	}
 	return f.BaseRepo
 }
+
+// BaseReposOverride returns a function resolving every repository requested via one or more
+// -R/--repo flags, each of which may itself contain a comma-separated list, so that commands
+// which can operate across several repositories at once don't have to repeat this parsing. With
+// no override given, it falls back to the single current-directory repository, matching the
+// behavior of OverrideBaseRepoFunc.
+func BaseReposOverride(f *Factory, overrides []string) func() ([]ghrepo.Interface, error) {
+	return func() ([]ghrepo.Interface, error) {
+		var names []string
+		for _, override := range overrides {
+			for _, name := range strings.Split(override, ",") {
+				if name = strings.TrimSpace(name); name != "" {
+					names = append(names, name)
+				}
+			}
+		}
+		if len(names) == 0 {
+			if envRepo := os.Getenv("GH_REPO"); envRepo != "" {
+				names = append(names, envRepo)
+			}
+		}
+		if len(names) == 0 {
+			repo, err := f.BaseRepo()
+			if err != nil {
+				return nil, err
+			}
+			return []ghrepo.Interface{repo}, nil
+		}
+
+		repos := make([]ghrepo.Interface, len(names))
+		for i, name := range names {
+			repo, err := ghrepo.FromFullName(name)
+			if err != nil {
+				return nil, err
+			}
+			repos[i] = repo
+		}
+		return repos, nil
+	}
+}
@@ -78,6 +78,15 @@ type IOStreams struct {
 
 	neverPrompt bool
 
+	// accessiblePrompterEnabled switches progress indicators to plain,
+	// periodic textual lines and disables alternate screen buffer usage, for
+	// screen-reader users.
+	accessiblePrompterEnabled bool
+	accessibleProgressTicker  *time.Ticker
+	accessibleProgressDone    chan struct{}
+
+	redactor *secretRedactor
+
 	TempFileOverride *os.File
 }
 
@@ -263,6 +272,24 @@ func (s *IOStreams) SetNeverPrompt(v bool) {
 	s.neverPrompt = v
 }
 
+// SetAccessiblePrompterEnabled switches progress indicators to plain,
+// periodic textual lines and disables alternate screen buffer usage, for
+// screen-reader users.
+func (s *IOStreams) SetAccessiblePrompterEnabled(accessiblePrompterEnabled bool) {
+	s.accessiblePrompterEnabled = accessiblePrompterEnabled
+}
+
+func (s *IOStreams) AccessiblePrompterEnabled() bool {
+	return s.accessiblePrompterEnabled
+}
+
+// AddSecret registers a string, such as an auth token or a newly created
+// secret value, to be masked wherever it would otherwise be written to Out
+// or ErrOut, including progress indicator labels.
+func (s *IOStreams) AddSecret(secret string) {
+	s.redactor.AddSecret(secret)
+}
+
 func (s *IOStreams) StartProgressIndicator() {
 	s.StartProgressIndicatorWithLabel("")
 }
@@ -275,6 +302,11 @@ func (s *IOStreams) StartProgressIndicatorWithLabel(label string) {
 	s.progressIndicatorMu.Lock()
 	defer s.progressIndicatorMu.Unlock()
 
+	if s.accessiblePrompterEnabled {
+		s.startAccessibleProgressIndicator(label)
+		return
+	}
+
 	if s.progressIndicator != nil {
 		if label == "" {
 			s.progressIndicator.Prefix = ""
@@ -295,9 +327,54 @@ func (s *IOStreams) StartProgressIndicatorWithLabel(label string) {
 	s.progressIndicator = sp
 }
 
+// startAccessibleProgressIndicator prints a plain progress line immediately
+// and then again at a fixed interval, instead of an animated spinner that
+// redraws the cursor. Callers must hold progressIndicatorMu.
+func (s *IOStreams) startAccessibleProgressIndicator(label string) {
+	s.stopAccessibleProgressIndicator()
+
+	if label == "" {
+		label = "Working"
+	}
+	fmt.Fprintf(s.ErrOut, "%s...\n", label)
+
+	done := make(chan struct{})
+	ticker := time.NewTicker(5 * time.Second)
+	s.accessibleProgressDone = done
+	s.accessibleProgressTicker = ticker
+
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				fmt.Fprintf(s.ErrOut, "%s...\n", label)
+			}
+		}
+	}()
+}
+
+// stopAccessibleProgressIndicator must be called with progressIndicatorMu held.
+func (s *IOStreams) stopAccessibleProgressIndicator() {
+	if s.accessibleProgressTicker == nil {
+		return
+	}
+	s.accessibleProgressTicker.Stop()
+	close(s.accessibleProgressDone)
+	s.accessibleProgressTicker = nil
+	s.accessibleProgressDone = nil
+}
+
 func (s *IOStreams) StopProgressIndicator() {
 	s.progressIndicatorMu.Lock()
 	defer s.progressIndicatorMu.Unlock()
+
+	if s.accessiblePrompterEnabled {
+		s.stopAccessibleProgressIndicator()
+		return
+	}
+
 	if s.progressIndicator == nil {
 		return
 	}
@@ -313,7 +390,7 @@ func (s *IOStreams) RunWithProgress(label string, run func() error) error {
 }
 
 func (s *IOStreams) StartAlternateScreenBuffer() {
-	if s.alternateScreenBufferEnabled {
+	if s.alternateScreenBufferEnabled && !s.accessiblePrompterEnabled {
 		s.alternateScreenBufferMu.Lock()
 		defer s.alternateScreenBufferMu.Unlock()
 
@@ -416,12 +493,15 @@ func System() *IOStreams {
 		}
 	}
 
+	redactor := &secretRedactor{}
+
 	io := &IOStreams{
 		In:           os.Stdin,
-		Out:          stdout,
-		ErrOut:       stderr,
+		Out:          &redactingWriter{fileWriter: stdout, redactor: redactor},
+		ErrOut:       &redactingWriter{fileWriter: stderr, redactor: redactor},
 		pagerCommand: os.Getenv("PAGER"),
 		term:         &terminal,
+		redactor:     redactor,
 	}
 
 	stdoutIsTTY := io.IsStdoutTTY()
@@ -468,14 +548,16 @@ func Test() (*IOStreams, *bytes.Buffer, *bytes.Buffer, *bytes.Buffer) {
 	in := &bytes.Buffer{}
 	out := &bytes.Buffer{}
 	errOut := &bytes.Buffer{}
+	redactor := &secretRedactor{}
 	io := &IOStreams{
 		In: &fdReader{
 			fd:         0,
 			ReadCloser: io.NopCloser(in),
 		},
-		Out:    &fdWriter{fd: 1, Writer: out},
-		ErrOut: &fdWriter{fd: 2, Writer: errOut},
-		term:   &fakeTerm{},
+		Out:      &redactingWriter{fileWriter: &fdWriter{fd: 1, Writer: out}, redactor: redactor},
+		ErrOut:   &redactingWriter{fileWriter: &fdWriter{fd: 2, Writer: errOut}, redactor: redactor},
+		term:     &fakeTerm{},
+		redactor: redactor,
 	}
 	io.SetStdinTTY(false)
 	io.SetStdoutTTY(false)
@@ -504,6 +586,55 @@ func (w *pagerWriter) Write(d []byte) (int, error) {
 	return n, err
 }
 
+// secretRedactor holds strings that should never reach a terminal or log,
+// such as auth tokens or freshly generated secret values, and masks them out
+// of anything written through a redactingWriter.
+type secretRedactor struct {
+	mu      sync.Mutex
+	secrets []string
+}
+
+func (r *secretRedactor) AddSecret(secret string) {
+	if secret == "" {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.secrets = append(r.secrets, secret)
+}
+
+func (r *secretRedactor) redact(p []byte) []byte {
+	r.mu.Lock()
+	secrets := r.secrets
+	r.mu.Unlock()
+
+	if len(secrets) == 0 {
+		return p
+	}
+
+	s := string(p)
+	for _, secret := range secrets {
+		s = strings.ReplaceAll(s, secret, "████████")
+	}
+	return []byte(s)
+}
+
+// redactingWriter wraps a fileWriter and masks any registered secrets before
+// they are written out.
+type redactingWriter struct {
+	fileWriter
+	redactor *secretRedactor
+}
+
+func (w *redactingWriter) Write(p []byte) (int, error) {
+	if _, err := w.fileWriter.Write(w.redactor.redact(p)); err != nil {
+		return 0, err
+	}
+	// Report the original length so callers comparing the result against
+	// len(p) don't see a mismatch caused by redaction changing the size.
+	return len(p), nil
+}
+
 // fdWriter represents a wrapped stdout Writer that preserves the original file descriptor
 type fdWriter struct {
 	io.Writer
@@ -24,6 +24,22 @@ func TestStopAlternateScreenBuffer(t *testing.T) {
 	}
 }
 
+func TestAddSecret(t *testing.T) {
+	ios, _, stdout, stderr := Test()
+	ios.AddSecret("sekrit")
+
+	fmt.Fprint(ios.Out, "token is sekrit")
+	fmt.Fprint(ios.ErrOut, "token is sekrit")
+
+	const want = "token is ████████"
+	if got := stdout.String(); got != want {
+		t.Errorf("after IOStreams.AddSecret() got Out %q, want %q", got, want)
+	}
+	if got := stderr.String(); got != want {
+		t.Errorf("after IOStreams.AddSecret() got ErrOut %q, want %q", got, want)
+	}
+}
+
 func TestIOStreams_pager(t *testing.T) {
 	t.Skip("TODO: fix this test in race detection mode")
 	ios, _, stdout, _ := Test()
@@ -7,6 +7,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"strings"
 
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/run"
@@ -79,6 +80,84 @@ func (c *Context) GenerateSSHKey(keyName string, passphrase string) (*KeyPair, e
 	return &keyPair, nil
 }
 
+// AddKeyToAgent loads the given private key into the running ssh-agent, so that it is picked
+// up without the user having to start a new shell session.
+func (c *Context) AddKeyToAgent(privateKeyPath string) error {
+	sshAddExe, err := safeexec.LookPath("ssh-add")
+	if err != nil {
+		return err
+	}
+
+	return run.PrepareCmd(exec.Command(sshAddExe, privateKeyPath)).Run()
+}
+
+// UpdateConfigIdentity points hostname at identityFilePath by writing (or rewriting) a block in
+// the user's SSH config that this package manages, so that future SSH connections for hostname
+// use the given key without requiring the user to hand-edit their SSH config.
+func (c *Context) UpdateConfigIdentity(hostname, identityFilePath string) error {
+	sshDir, err := c.sshDir()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(sshDir, 0700); err != nil {
+		return fmt.Errorf("could not create .ssh directory: %w", err)
+	}
+
+	configPath := filepath.Join(sshDir, "config")
+	existing, err := os.ReadFile(configPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	beginMarker := fmt.Sprintf("# >>> managed by gh ssh-key rotate (%s) >>>", hostname)
+	endMarker := fmt.Sprintf("# <<< managed by gh ssh-key rotate (%s) <<<", hostname)
+	block := fmt.Sprintf("%s\nHost %s\n  IdentityFile %s\n%s\n", beginMarker, hostname, identityFilePath, endMarker)
+
+	content := string(existing)
+	if start := strings.Index(content, beginMarker); start != -1 {
+		stop := strings.Index(content, endMarker)
+		if stop == -1 {
+			return fmt.Errorf("found the start but not the end of a managed block for %s in %s", hostname, configPath)
+		}
+		content = content[:start] + block + content[stop+len(endMarker)+1:]
+	} else {
+		if content != "" && !strings.HasSuffix(content, "\n") {
+			content += "\n"
+		}
+		content += block
+	}
+
+	return os.WriteFile(configPath, []byte(content), 0600)
+}
+
+// VerifyConnection attempts an SSH connection to hostname and returns an error unless the server
+// confirms that authentication succeeded. GitHub's SSH servers reject interactive shell sessions
+// but exit with status 1 once a key has authenticated successfully, so that status is treated as
+// success here.
+func (c *Context) VerifyConnection(hostname string) error {
+	sshExe, err := safeexec.LookPath("ssh")
+	if err != nil {
+		return err
+	}
+
+	err = run.PrepareCmd(exec.Command(sshExe, "-T", fmt.Sprintf("git@%s", hostname))).Run()
+	if err == nil {
+		return nil
+	}
+
+	var execError errWithExitCode
+	if errors.As(err, &execError) && execError.ExitCode() == 1 {
+		return nil
+	}
+
+	return err
+}
+
+type errWithExitCode interface {
+	ExitCode() int
+}
+
 func (c *Context) sshDir() (string, error) {
 	if c.ConfigDir != "" {
 		return c.ConfigDir, nil